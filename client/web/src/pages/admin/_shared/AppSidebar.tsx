@@ -1,10 +1,12 @@
 "use client";
 
 import {
+  BarChart3,
   Bell,
   Calendar,
   ClipboardList,
   DoorOpen,
+  FilePenLine,
   Handshake,
   MessageSquare,
   ScanLine,
@@ -42,6 +44,11 @@ const applicantsNav = [
     url: "/admin/reviews",
     icon: UserCheck,
   },
+  {
+    name: "Analytics",
+    url: "/admin/analytics",
+    icon: BarChart3,
+  },
 ];
 
 const eventNav = [
@@ -65,6 +72,11 @@ const eventNav = [
     url: "/admin/faq",
     icon: MessageSquare,
   },
+  {
+    name: "Policies",
+    url: "/admin/policies",
+    icon: FilePenLine,
+  },
 ];
 
 const superAdminNav = [
@@ -1,24 +1,41 @@
+import { Download, Flag } from "lucide-react";
 import { useCallback, useEffect, useRef, useState } from "react";
 
 import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
 import {
   Card,
   CardContent,
   CardDescription,
   CardHeader,
 } from "@/components/ui/card";
+import {
+  DropdownMenu,
+  DropdownMenuContent,
+  DropdownMenuItem,
+  DropdownMenuTrigger,
+} from "@/components/ui/dropdown-menu";
 import { Skeleton } from "@/components/ui/skeleton";
 import { SearchBar } from "@/pages/admin/_shared";
-import { usePointsConfigStore } from "@/shared/stores";
+import { usePointsConfigStore, useUserStore } from "@/shared/stores";
 
+import { buildApplicationsExportURL } from "./api";
+import { AdvancedFiltersPopover } from "./components/AdvancedFiltersPopover";
 import { ApplicationDetailPanel } from "./components/ApplicationDetailPanel";
 import { ApplicationsTable } from "./components/ApplicationsTable";
+import { BulkStatusDialog } from "./components/BulkStatusDialog";
 import { PaginationControls } from "./components/PaginationControls";
+import { ColumnsMenu, SavedViewsMenu } from "./components/SavedViewsMenu";
 import { SectionCards } from "./components/SectionCards";
 import { StatusFilterTabs } from "./components/StatusFilterTabs";
 import { useApplicationDetail } from "./hooks/useApplicationDetail";
 import { useApplicationsStore } from "./store";
-import type { ApplicationStatus } from "./types";
+import type {
+  AdvancedFilters,
+  ApplicationSortBy,
+  ApplicationStatus,
+  SavedView,
+} from "./types";
 import { getStatusColor } from "./utils";
 
 export default function AllApplicantsPage() {
@@ -28,13 +45,21 @@ export default function AllApplicantsPage() {
   const prevCursor = useApplicationsStore((s) => s.prevCursor);
   const currentStatus = useApplicationsStore((s) => s.currentStatus);
   const currentSearch = useApplicationsStore((s) => s.currentSearch);
+  const currentSortBy = useApplicationsStore((s) => s.currentSortBy);
+  const currentFlagged = useApplicationsStore((s) => s.currentFlagged);
+  const currentFilters = useApplicationsStore((s) => s.currentFilters);
   const stats = useApplicationsStore((s) => s.stats);
   const statsLoading = useApplicationsStore((s) => s.statsLoading);
   const fetchApplications = useApplicationsStore((s) => s.fetchApplications);
   const fetchStats = useApplicationsStore((s) => s.fetchStats);
   const fetchPointsConfig = usePointsConfigStore((s) => s.fetchPointsConfig);
+  const isSuperAdmin = useUserStore((s) => s.user?.role === "super_admin");
 
   const [searchInput, setSearchInput] = useState(currentSearch);
+  // The applied saved view, cleared once any of its filters is changed so the
+  // export doesn't bring them back.
+  const [activeViewId, setActiveViewId] = useState<string | null>(null);
+  const [visibleColumns, setVisibleColumns] = useState<string[] | null>(null);
   const [selectedApplicationId, setSelectedApplicationId] = useState<
     string | null
   >(null);
@@ -59,9 +84,11 @@ export default function AllApplicantsPage() {
       return;
     }
     const timer = setTimeout(() => {
-      fetchApplications({
-        search: searchInput.length >= 2 ? searchInput : "",
-      });
+      const search = searchInput.length >= 2 ? searchInput : "";
+      if (search !== useApplicationsStore.getState().currentSearch) {
+        setActiveViewId(null);
+      }
+      fetchApplications({ search });
     }, 500);
     return () => clearTimeout(timer);
   }, [searchInput, fetchApplications]);
@@ -73,11 +100,67 @@ export default function AllApplicantsPage() {
 
   const handleStatusFilter = useCallback(
     (status: ApplicationStatus | null) => {
+      setActiveViewId(null);
       fetchApplications({ status });
     },
     [fetchApplications],
   );
 
+  const handleToggleFlagged = useCallback(() => {
+    setActiveViewId(null);
+    fetchApplications({ flagged: !currentFlagged });
+  }, [currentFlagged, fetchApplications]);
+
+  const handleApplyFilters = useCallback(
+    (filters: AdvancedFilters) => {
+      setActiveViewId(null);
+      fetchApplications({ filters });
+    },
+    [fetchApplications],
+  );
+
+  const handleApplyView = useCallback(
+    (view: SavedView) => {
+      const { status, search, sort_by, flagged, ...rest } = view.query;
+      const filters: AdvancedFilters = {};
+      for (const [key, values] of Object.entries(rest)) {
+        filters[key] = values[0];
+      }
+
+      setActiveViewId(view.id);
+      setVisibleColumns(view.columns.length > 0 ? view.columns : null);
+      setSearchInput(search?.[0] ?? "");
+      fetchApplications({
+        status: (status?.[0] as ApplicationStatus | undefined) ?? null,
+        search: search?.[0] ?? "",
+        sort_by:
+          (sort_by?.[0] as ApplicationSortBy | undefined) ?? "created_at",
+        flagged: flagged?.[0] === "true",
+        filters,
+      });
+    },
+    [fetchApplications],
+  );
+
+  const handleColumnsChange = useCallback((columns: string[] | null) => {
+    setActiveViewId(null);
+    setVisibleColumns(columns);
+  }, []);
+
+  const currentQuery: Record<string, string[]> = {};
+  if (currentStatus) currentQuery.status = [currentStatus];
+  if (currentSearch) currentQuery.search = [currentSearch];
+  if (currentSortBy) currentQuery.sort_by = [currentSortBy];
+  if (currentFlagged) currentQuery.flagged = ["true"];
+  for (const [key, value] of Object.entries(currentFilters)) {
+    currentQuery[key] = [value];
+  }
+
+  const handleBulkStatusApplied = useCallback(() => {
+    fetchApplications();
+    fetchStats();
+  }, [fetchApplications, fetchStats]);
+
   const handleNextPage = useCallback(() => {
     if (nextCursor) {
       fetchApplications({ cursor: nextCursor });
@@ -90,6 +173,19 @@ export default function AllApplicantsPage() {
     }
   }, [prevCursor, fetchApplications]);
 
+  const exportURL = (format: "csv" | "xlsx") =>
+    buildApplicationsExportURL(
+      format,
+      {
+        status: currentStatus,
+        search: currentSearch,
+        sort_by: currentSortBy,
+        flagged: currentFlagged,
+        filters: currentFilters,
+      },
+      activeViewId ?? undefined,
+    );
+
   const isInitialLoad =
     statsLoading && loading && applications.length === 0 && !searchInput;
 
@@ -119,6 +215,32 @@ export default function AllApplicantsPage() {
         <div className="flex items-center gap-2">
           <div className="h-5 w-px bg-border shrink-0" />
           <SearchBar value={searchInput} onChange={setSearchInput} />
+          <Button
+            variant={currentFlagged ? "default" : "outline"}
+            size="sm"
+            className="cursor-pointer shrink-0"
+            disabled={loading}
+            onClick={handleToggleFlagged}
+            title="Show only applications flagged as likely duplicates"
+          >
+            <Flag className="size-4" />
+            Flagged
+          </Button>
+          <AdvancedFiltersPopover
+            filters={currentFilters}
+            disabled={loading}
+            onApply={handleApplyFilters}
+          />
+          <ColumnsMenu
+            visibleColumns={visibleColumns}
+            onChange={handleColumnsChange}
+          />
+          <SavedViewsMenu
+            activeViewId={activeViewId}
+            visibleColumns={visibleColumns}
+            currentQuery={currentQuery}
+            onApplyView={handleApplyView}
+          />
         </div>
         <div className="flex justify-end">
           <PaginationControls
@@ -135,7 +257,7 @@ export default function AllApplicantsPage() {
         <Card
           className={`overflow-hidden flex flex-col ${selectedApplicationId ? "w-1/2 rounded-r-none" : "w-full"}`}
         >
-          <CardHeader className="shrink-0">
+          <CardHeader className="shrink-0 flex flex-row items-center justify-between">
             <CardDescription className="font-light flex items-center gap-1.5">
               <span>{applications.length} application(s) on this page</span>
               {currentStatus && (
@@ -147,7 +269,44 @@ export default function AllApplicantsPage() {
                 </>
               )}
               {currentSearch && <span>matching "{currentSearch}"</span>}
+              {currentFlagged && <span>flagged as likely duplicates</span>}
+              {Object.keys(currentFilters).length > 0 && (
+                <span>with {Object.keys(currentFilters).length} filter(s)</span>
+              )}
             </CardDescription>
+            <div className="flex items-center gap-2">
+              {isSuperAdmin && (
+                <BulkStatusDialog
+                  filters={currentQuery}
+                  disabled={loading}
+                  onApplied={handleBulkStatusApplied}
+                />
+              )}
+              <DropdownMenu modal={false}>
+                <DropdownMenuTrigger asChild>
+                  <Button
+                    variant="outline"
+                    size="sm"
+                    className="cursor-pointer"
+                  >
+                    <Download className="size-4" />
+                    Export
+                  </Button>
+                </DropdownMenuTrigger>
+                <DropdownMenuContent align="end">
+                  <DropdownMenuItem asChild>
+                    <a href={exportURL("csv")} download>
+                      CSV
+                    </a>
+                  </DropdownMenuItem>
+                  <DropdownMenuItem asChild>
+                    <a href={exportURL("xlsx")} download>
+                      Excel (.xlsx)
+                    </a>
+                  </DropdownMenuItem>
+                </DropdownMenuContent>
+              </DropdownMenu>
+            </div>
           </CardHeader>
           <hr className="border-border -mb-2" />
           <CardContent className="p-0 flex-1 overflow-auto">
@@ -155,6 +314,7 @@ export default function AllApplicantsPage() {
               applications={applications}
               loading={loading}
               selectedId={selectedApplicationId}
+              visibleColumns={visibleColumns}
               onSelectApplication={setSelectedApplicationId}
             />
           </CardContent>
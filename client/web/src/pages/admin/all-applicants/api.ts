@@ -1,17 +1,40 @@
-import { getRequest, putRequest } from "@/shared/lib/api";
+import {
+  deleteRequest,
+  getRequest,
+  postRequest,
+  putRequest,
+} from "@/shared/lib/api";
 import type { ApiResponse, Application } from "@/types";
 
 import type {
+  AdvancedFilters,
+  ApplicationFilterFieldsResponse,
   ApplicationListResult,
   ApplicationStats,
   ApplicationStatus,
+  BulkStatusPayload,
+  BulkStatusResult,
   FetchParams,
+  SavedView,
+  SavedViewPayload,
+  SavedViewResource,
 } from "./types";
 
 interface ResumeDownloadURLResponse {
   download_url: string;
 }
 
+function setAdvancedFilters(
+  queryParams: URLSearchParams,
+  filters?: AdvancedFilters,
+) {
+  for (const [key, value] of Object.entries(filters ?? {})) {
+    if (value !== "") {
+      queryParams.set(key, value);
+    }
+  }
+}
+
 /**
  * Fetch paginated applications with optional status filter
  */
@@ -41,12 +64,103 @@ export async function fetchApplications(
     queryParams.set("sort_by", params.sort_by);
   }
 
+  if (params?.flagged) {
+    queryParams.set("flagged", "true");
+  }
+
+  setAdvancedFilters(queryParams, params?.filters);
+
   const queryString = queryParams.toString();
   const endpoint = `/admin/applications${queryString ? `?${queryString}` : ""}`;
 
   return getRequest<ApplicationListResult>(endpoint, "applications", signal);
 }
 
+/**
+ * Build the download URL for a spreadsheet export of the applications
+ * matching the current filters. The browser downloads it directly so the
+ * file streams to disk instead of being buffered in memory.
+ */
+export function buildApplicationsExportURL(
+  format: "csv" | "xlsx",
+  params?: Pick<
+    FetchParams,
+    "status" | "search" | "sort_by" | "flagged" | "filters"
+  >,
+  viewId?: string,
+): string {
+  const queryParams = new URLSearchParams({ format });
+
+  // The view only contributes its visible columns here; every filter it set
+  // is already in params.
+  if (viewId) {
+    queryParams.set("view_id", viewId);
+  }
+
+  if (params?.status) {
+    queryParams.set("status", params.status);
+  }
+
+  if (params?.search) {
+    queryParams.set("search", params.search);
+  }
+
+  if (params?.sort_by) {
+    queryParams.set("sort_by", params.sort_by);
+  }
+
+  if (params?.flagged) {
+    queryParams.set("flagged", "true");
+  }
+
+  setAdvancedFilters(queryParams, params?.filters);
+
+  return `/v1/admin/applications/export?${queryParams.toString()}`;
+}
+
+/**
+ * Fetch the schema fields the application list can be filtered on
+ */
+export async function fetchApplicationFilterFields(
+  signal?: AbortSignal,
+): Promise<ApiResponse<ApplicationFilterFieldsResponse>> {
+  return getRequest<ApplicationFilterFieldsResponse>(
+    "/admin/applications/filters",
+    "application filters",
+    signal,
+  );
+}
+
+/**
+ * Fetch the caller's saved views of a list page and those shared with them
+ */
+export async function fetchSavedViews(
+  resource: SavedViewResource,
+  signal?: AbortSignal,
+): Promise<ApiResponse<{ views: SavedView[] }>> {
+  return getRequest<{ views: SavedView[] }>(
+    `/admin/views?resource=${resource}`,
+    "saved views",
+    signal,
+  );
+}
+
+/**
+ * Save a named view
+ */
+export async function createSavedView(
+  payload: SavedViewPayload,
+): Promise<ApiResponse<SavedView>> {
+  return postRequest<SavedView>("/admin/views", payload, "saved view");
+}
+
+/**
+ * Delete one of the caller's saved views
+ */
+export async function deleteSavedView(id: string): Promise<ApiResponse<void>> {
+  return deleteRequest<void>(`/admin/views/${id}`, "saved view");
+}
+
 /**
  * Fetch application statistics
  */
@@ -86,6 +200,19 @@ export async function fetchApplicationResumeURL(
   );
 }
 
+/**
+ * Fetch a signed URL for a file uploaded to one of the schema's file fields
+ */
+export async function fetchApplicationFileURL(
+  id: string,
+  fieldId: string,
+): Promise<ApiResponse<ResumeDownloadURLResponse>> {
+  return getRequest<ResumeDownloadURLResponse>(
+    `/admin/applications/${id}/files/${encodeURIComponent(fieldId)}/url`,
+    "file",
+  );
+}
+
 /**
  * Update application status
  */
@@ -99,3 +226,17 @@ export async function updateApplicationStatus(
     "application status",
   );
 }
+
+/**
+ * Set the status of every application matching filters, or preview the
+ * change with dry_run (Super Admin)
+ */
+export async function bulkSetApplicationStatus(
+  payload: BulkStatusPayload,
+): Promise<ApiResponse<BulkStatusResult>> {
+  return postRequest<BulkStatusResult>(
+    "/superadmin/applications/status/bulk",
+    payload,
+    "application statuses",
+  );
+}
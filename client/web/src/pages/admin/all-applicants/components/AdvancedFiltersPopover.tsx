@@ -0,0 +1,268 @@
+import { SlidersHorizontal } from "lucide-react";
+import { useEffect, useState } from "react";
+
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import {
+  Popover,
+  PopoverContent,
+  PopoverTrigger,
+} from "@/components/ui/popover";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+
+import { fetchApplicationFilterFields } from "../api";
+import type {
+  AdvancedFilters,
+  ApplicationFilterFieldsResponse,
+} from "../types";
+
+// Radix Select can't hold an empty value, so "any" stands in for no filter.
+const ANY = "__any__";
+
+// Submitted dates are picked as whole days in the admin's own timezone.
+function dayStart(date: string): string {
+  return date ? new Date(`${date}T00:00:00`).toISOString() : "";
+}
+
+function dayEnd(date: string): string {
+  return date ? new Date(`${date}T23:59:59.999`).toISOString() : "";
+}
+
+interface AdvancedFiltersPopoverProps {
+  filters: AdvancedFilters;
+  disabled?: boolean;
+  onApply: (filters: AdvancedFilters) => void;
+}
+
+export function AdvancedFiltersPopover({
+  filters,
+  disabled,
+  onApply,
+}: AdvancedFiltersPopoverProps) {
+  const [open, setOpen] = useState(false);
+  const [schema, setSchema] = useState<ApplicationFilterFieldsResponse | null>(
+    null,
+  );
+  // Form values by query parameter; submitted dates are kept as YYYY-MM-DD
+  // until applied.
+  const [draft, setDraft] = useState<Record<string, string>>({});
+
+  useEffect(() => {
+    if (!open || schema) return;
+    const controller = new AbortController();
+    fetchApplicationFilterFields(controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setSchema(res.data);
+      }
+    });
+    return () => controller.abort();
+  }, [open, schema]);
+
+  const activeCount = Object.values(filters).filter((v) => v !== "").length;
+
+  const set = (key: string, value: string) =>
+    setDraft((d) => ({ ...d, [key]: value === ANY ? "" : value }));
+
+  const handleApply = () => {
+    const next: AdvancedFilters = {};
+    for (const [key, value] of Object.entries(draft)) {
+      if (value === "") continue;
+      if (key === "submitted_from") next[key] = dayStart(value);
+      else if (key === "submitted_to") next[key] = dayEnd(value);
+      else next[key] = value.trim();
+    }
+    onApply(next);
+    setOpen(false);
+  };
+
+  const handleClear = () => {
+    setDraft({});
+    onApply({});
+    setOpen(false);
+  };
+
+  const rangeInputs = (label: string, minKey: string, maxKey: string) => (
+    <div className="space-y-1.5">
+      <Label className="text-xs text-muted-foreground">{label}</Label>
+      <div className="flex items-center gap-2">
+        <Input
+          type="number"
+          placeholder="Min"
+          value={draft[minKey] ?? ""}
+          onChange={(e) => set(minKey, e.target.value)}
+          className="h-8"
+        />
+        <span className="text-muted-foreground">–</span>
+        <Input
+          type="number"
+          placeholder="Max"
+          value={draft[maxKey] ?? ""}
+          onChange={(e) => set(maxKey, e.target.value)}
+          className="h-8"
+        />
+      </div>
+    </div>
+  );
+
+  return (
+    <Popover open={open} onOpenChange={setOpen}>
+      <PopoverTrigger asChild>
+        <Button
+          variant={activeCount > 0 ? "default" : "outline"}
+          size="sm"
+          className="cursor-pointer shrink-0"
+          disabled={disabled}
+        >
+          <SlidersHorizontal className="size-4" />
+          Filters
+          {activeCount > 0 && (
+            <Badge variant="secondary" className="ml-0.5 px-1.5 py-0 text-xs">
+              {activeCount}
+            </Badge>
+          )}
+        </Button>
+      </PopoverTrigger>
+      <PopoverContent align="end" className="w-96 p-0">
+        <div className="max-h-[70vh] space-y-4 overflow-y-auto p-4">
+          {schema?.text_search && (
+            <div className="space-y-1.5">
+              <Label className="text-xs text-muted-foreground">
+                Search long answers
+              </Label>
+              <Input
+                placeholder='e.g. "machine learning" -crypto'
+                value={draft.text_search ?? ""}
+                onChange={(e) => set("text_search", e.target.value)}
+                className="h-8"
+              />
+            </div>
+          )}
+
+          <div className="grid grid-cols-2 gap-3">
+            <div className="space-y-1.5">
+              <Label className="text-xs text-muted-foreground">Resume</Label>
+              <Select
+                value={draft.has_resume || ANY}
+                onValueChange={(v) => set("has_resume", v)}
+              >
+                <SelectTrigger className="h-8 w-full">
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value={ANY}>Any</SelectItem>
+                  <SelectItem value="true">Has resume</SelectItem>
+                  <SelectItem value="false">No resume</SelectItem>
+                </SelectContent>
+              </Select>
+            </div>
+            <div className="space-y-1.5">
+              <Label className="text-xs text-muted-foreground">
+                Meal group
+              </Label>
+              <Input
+                value={draft.meal_group ?? ""}
+                onChange={(e) => set("meal_group", e.target.value)}
+                className="h-8"
+              />
+            </div>
+          </div>
+
+          {rangeInputs("AI percent", "ai_percent_min", "ai_percent_max")}
+          {rangeInputs("Points", "points_min", "points_max")}
+
+          <div className="space-y-1.5">
+            <Label className="text-xs text-muted-foreground">Submitted</Label>
+            <div className="flex items-center gap-2">
+              <Input
+                type="date"
+                value={draft.submitted_from ?? ""}
+                onChange={(e) => set("submitted_from", e.target.value)}
+                className="h-8"
+              />
+              <span className="text-muted-foreground">–</span>
+              <Input
+                type="date"
+                value={draft.submitted_to ?? ""}
+                onChange={(e) => set("submitted_to", e.target.value)}
+                className="h-8"
+              />
+            </div>
+          </div>
+
+          {schema && schema.fields.length > 0 && (
+            <div className="space-y-3 border-t pt-4">
+              <p className="text-xs font-medium">Answers</p>
+              {schema.fields.map((field) => {
+                const key = `f.${field.id}`;
+                if (field.type === "number") {
+                  return (
+                    <div key={field.id}>
+                      {rangeInputs(field.label, `${key}.min`, `${key}.max`)}
+                    </div>
+                  );
+                }
+                const label =
+                  field.type === "multi_select"
+                    ? `${field.label} (includes)`
+                    : field.label;
+                return (
+                  <div key={field.id} className="space-y-1.5">
+                    <Label className="text-xs text-muted-foreground">
+                      {label}
+                    </Label>
+                    {field.options && field.options.length > 0 ? (
+                      <Select
+                        value={draft[key] || ANY}
+                        onValueChange={(v) => set(key, v)}
+                      >
+                        <SelectTrigger className="h-8 w-full">
+                          <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                          <SelectItem value={ANY}>Any</SelectItem>
+                          {field.options.map((option) => (
+                            <SelectItem key={option} value={option}>
+                              {option}
+                            </SelectItem>
+                          ))}
+                        </SelectContent>
+                      </Select>
+                    ) : (
+                      <Input
+                        value={draft[key] ?? ""}
+                        onChange={(e) => set(key, e.target.value)}
+                        className="h-8"
+                      />
+                    )}
+                  </div>
+                );
+              })}
+            </div>
+          )}
+        </div>
+        <div className="flex justify-end gap-2 border-t p-3">
+          <Button
+            variant="ghost"
+            size="sm"
+            className="cursor-pointer"
+            onClick={handleClear}
+          >
+            Clear
+          </Button>
+          <Button size="sm" className="cursor-pointer" onClick={handleApply}>
+            Apply
+          </Button>
+        </div>
+      </PopoverContent>
+    </Popover>
+  );
+}
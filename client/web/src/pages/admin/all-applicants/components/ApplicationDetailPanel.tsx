@@ -17,7 +17,7 @@ import type { Application } from "@/types";
 
 import { fetchApplicationResumeURL } from "../api";
 import { formatName, getStatusColor } from "../utils";
-import { LinksSection } from "./detail-sections";
+import { FlagsSection, LinksSection } from "./detail-sections";
 import { SchemaDetailRenderer } from "./detail-sections/SchemaDetailRenderer";
 import { TimelineSection } from "./detail-sections/TimelineSection";
 
@@ -123,6 +123,7 @@ export const ApplicationDetailPanel = memo(function ApplicationDetailPanel({
           </div>
         ) : application ? (
           <div className="space-y-6 pb-2">
+            <FlagsSection application={application} />
             <SchemaDetailRenderer application={application} />
             <LinksSection
               application={application}
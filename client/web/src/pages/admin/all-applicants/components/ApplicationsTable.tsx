@@ -1,4 +1,4 @@
-import { Maximize2 } from "lucide-react";
+import { Flag, Maximize2 } from "lucide-react";
 import { memo } from "react";
 
 import { Badge } from "@/components/ui/badge";
@@ -16,10 +16,51 @@ import { usePointsConfigStore } from "@/shared/stores";
 import type { ApplicationListItem } from "../types";
 import { formatName, getStatusColor } from "../utils";
 
+/** Table columns in display order, keyed as saved views list them. */
+export const APPLICATION_COLUMNS: { key: string; label: string }[] = [
+  { key: "status", label: "Status" },
+  { key: "name", label: "Name" },
+  { key: "email", label: "Email" },
+  { key: "phone", label: "Phone" },
+  { key: "age", label: "Age" },
+  { key: "country_of_residence", label: "Country" },
+  { key: "gender", label: "Gender" },
+  { key: "university", label: "University" },
+  { key: "major", label: "Major" },
+  { key: "level_of_study", label: "Level of Study" },
+  { key: "hackathons_attended", label: "Hackathons" },
+  { key: "submitted_at", label: "Submitted" },
+  { key: "created_at", label: "Created" },
+  { key: "updated_at", label: "Updated" },
+  { key: "ai_percent", label: "AI Percent" },
+  { key: "points", label: "Points" },
+];
+
+const COLUMN_WIDTHS: Record<string, string> = {
+  status: "w-28",
+  name: "w-48",
+  email: "w-56",
+  phone: "w-36",
+  age: "w-16",
+  country_of_residence: "w-32",
+  gender: "w-28",
+  university: "w-48",
+  major: "w-40",
+  level_of_study: "w-40",
+  hackathons_attended: "w-28",
+  submitted_at: "w-28",
+  created_at: "w-28",
+  updated_at: "w-28",
+  ai_percent: "w-24",
+  points: "w-24",
+};
+
 interface ApplicationsTableProps {
   applications: ApplicationListItem[];
   loading: boolean;
   selectedId: string | null;
+  /** Column keys to show; null shows every column. */
+  visibleColumns?: string[] | null;
   onSelectApplication: (id: string) => void;
 }
 
@@ -27,10 +68,92 @@ export const ApplicationsTable = memo(function ApplicationsTable({
   applications,
   loading,
   selectedId,
+  visibleColumns,
   onSelectApplication,
 }: ApplicationsTableProps) {
   const pointsName = usePointsConfigStore((s) => s.pointsName);
 
+  // Name holds the button that opens the detail panel, so it always shows.
+  const columns = APPLICATION_COLUMNS.filter(
+    (c) =>
+      c.key === "name" || !visibleColumns || visibleColumns.includes(c.key),
+  );
+
+  const renderCell = (key: string, app: ApplicationListItem) => {
+    switch (key) {
+      case "status":
+        return (
+          <Badge className={getStatusColor(app.status)}>{app.status}</Badge>
+        );
+      case "name":
+        return (
+          <div className="flex items-center justify-between gap-4">
+            <span className="flex items-center gap-1.5">
+              {formatName(app.first_name, app.last_name)}
+              {app.flagged && (
+                <Flag
+                  className="h-3.5 w-3.5 text-red-500"
+                  aria-label="Flagged as a likely duplicate"
+                />
+              )}
+            </span>
+            <Button
+              variant="ghost"
+              size="icon-sm"
+              className="opacity-0 cursor-pointer group-hover:opacity-100 transition-opacity h-6 w-6"
+              onClick={() => onSelectApplication(app.id)}
+            >
+              <Maximize2 className="h-4 w-4 text-muted-foreground" />
+            </Button>
+          </div>
+        );
+      case "email":
+        return app.email;
+      case "phone":
+        return app.phone ?? "-";
+      case "age":
+        return app.age ?? "-";
+      case "country_of_residence":
+        return app.country_of_residence ?? "-";
+      case "gender":
+        return app.gender ?? "-";
+      case "university":
+        return app.university ?? "-";
+      case "major":
+        return app.major ?? "-";
+      case "level_of_study":
+        return app.level_of_study ?? "-";
+      case "hackathons_attended":
+        return app.hackathons_attended ?? "-";
+      case "submitted_at":
+        return app.submitted_at
+          ? new Date(app.submitted_at).toLocaleDateString()
+          : "-";
+      case "created_at":
+        return new Date(app.created_at).toLocaleDateString();
+      case "updated_at":
+        return new Date(app.updated_at).toLocaleDateString();
+      case "ai_percent":
+        return app.ai_percent != null ? `${app.ai_percent}%` : "-";
+      case "points":
+        return app.points;
+    }
+  };
+
+  const cellClassName = (key: string) => {
+    switch (key) {
+      case "name":
+      case "submitted_at":
+      case "created_at":
+      case "updated_at":
+        return "whitespace-nowrap";
+      case "points":
+        return "tabular-nums";
+      default:
+        return undefined;
+    }
+  };
+
   return (
     <div className="relative overflow-auto h-full p-6 pt-0">
       {loading && (
@@ -39,28 +162,20 @@ export const ApplicationsTable = memo(function ApplicationsTable({
       <Table className="border-collapse table-fixed min-w-[1400px] [&_th]:border-r [&_th]:border-gray-200 [&_td]:border-r [&_td]:border-gray-200 [&_th:last-child]:border-r-0 [&_td:last-child]:border-r-0">
         <TableHeader className="sticky top-0 bg-card z-10">
           <TableRow>
-            <TableHead className="w-28">Status</TableHead>
-            <TableHead className="w-48">Name</TableHead>
-            <TableHead className="w-56">Email</TableHead>
-            <TableHead className="w-36">Phone</TableHead>
-            <TableHead className="w-16">Age</TableHead>
-            <TableHead className="w-32">Country</TableHead>
-            <TableHead className="w-28">Gender</TableHead>
-            <TableHead className="w-48">University</TableHead>
-            <TableHead className="w-40">Major</TableHead>
-            <TableHead className="w-40">Level of Study</TableHead>
-            <TableHead className="w-28">Hackathons</TableHead>
-            <TableHead className="w-28">Submitted</TableHead>
-            <TableHead className="w-28">Created</TableHead>
-            <TableHead className="w-28">Updated</TableHead>
-            <TableHead className="w-24">AI Percent</TableHead>
-            <TableHead className="w-24">{pointsName}</TableHead>
+            {columns.map((c) => (
+              <TableHead key={c.key} className={COLUMN_WIDTHS[c.key]}>
+                {c.key === "points" ? pointsName : c.label}
+              </TableHead>
+            ))}
           </TableRow>
         </TableHeader>
         <TableBody>
           {applications.length === 0 ? (
             <TableRow>
-              <TableCell colSpan={16} className="text-center text-gray-500">
+              <TableCell
+                colSpan={columns.length}
+                className="text-center text-gray-500"
+              >
                 No applications found
               </TableCell>
             </TableRow>
@@ -70,48 +185,11 @@ export const ApplicationsTable = memo(function ApplicationsTable({
                 key={app.id}
                 className={`group hover:bg-muted/50 [&>td]:py-3 ${selectedId === app.id ? "bg-muted/50" : ""}`}
               >
-                <TableCell>
-                  <Badge className={getStatusColor(app.status)}>
-                    {app.status}
-                  </Badge>
-                </TableCell>
-                <TableCell className="whitespace-nowrap">
-                  <div className="flex items-center justify-between gap-4">
-                    <span>{formatName(app.first_name, app.last_name)}</span>
-                    <Button
-                      variant="ghost"
-                      size="icon-sm"
-                      className="opacity-0 cursor-pointer group-hover:opacity-100 transition-opacity h-6 w-6"
-                      onClick={() => onSelectApplication(app.id)}
-                    >
-                      <Maximize2 className="h-4 w-4 text-muted-foreground" />
-                    </Button>
-                  </div>
-                </TableCell>
-                <TableCell>{app.email}</TableCell>
-                <TableCell>{app.phone ?? "-"}</TableCell>
-                <TableCell>{app.age ?? "-"}</TableCell>
-                <TableCell>{app.country_of_residence ?? "-"}</TableCell>
-                <TableCell>{app.gender ?? "-"}</TableCell>
-                <TableCell>{app.university ?? "-"}</TableCell>
-                <TableCell>{app.major ?? "-"}</TableCell>
-                <TableCell>{app.level_of_study ?? "-"}</TableCell>
-                <TableCell>{app.hackathons_attended ?? "-"}</TableCell>
-                <TableCell className="whitespace-nowrap">
-                  {app.submitted_at
-                    ? new Date(app.submitted_at).toLocaleDateString()
-                    : "-"}
-                </TableCell>
-                <TableCell className="whitespace-nowrap">
-                  {new Date(app.created_at).toLocaleDateString()}
-                </TableCell>
-                <TableCell className="whitespace-nowrap">
-                  {new Date(app.updated_at).toLocaleDateString()}
-                </TableCell>
-                <TableCell>
-                  {app.ai_percent != null ? `${app.ai_percent}%` : "-"}
-                </TableCell>
-                <TableCell className="tabular-nums">{app.points}</TableCell>
+                {columns.map((c) => (
+                  <TableCell key={c.key} className={cellClassName(c.key)}>
+                    {renderCell(c.key, app)}
+                  </TableCell>
+                ))}
               </TableRow>
             ))
           )}
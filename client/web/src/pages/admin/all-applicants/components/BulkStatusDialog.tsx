@@ -0,0 +1,225 @@
+import { ListChecks } from "lucide-react";
+import { useState } from "react";
+import { toast } from "sonner";
+
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { errorAlert } from "@/shared/lib/api";
+
+import { bulkSetApplicationStatus } from "../api";
+import type { BulkStatusResult, DecisionStatus } from "../types";
+import { formatQuotaBreach, getStatusColor } from "../utils";
+
+interface BulkStatusDialogProps {
+  /** The list's current filters; every matching application is changed. */
+  filters: Record<string, string[]>;
+  disabled?: boolean;
+  onApplied: () => void;
+}
+
+export function BulkStatusDialog({
+  filters,
+  disabled,
+  onApplied,
+}: BulkStatusDialogProps) {
+  const [open, setOpen] = useState(false);
+  const [status, setStatus] = useState<DecisionStatus>("accepted");
+  const [reason, setReason] = useState("");
+  const [preview, setPreview] = useState<BulkStatusResult | null>(null);
+  const [loading, setLoading] = useState(false);
+
+  const handleOpenChange = (next: boolean) => {
+    setOpen(next);
+    if (!next) {
+      setPreview(null);
+      setReason("");
+    }
+  };
+
+  const run = async (dryRun: boolean) => {
+    setLoading(true);
+    const res = await bulkSetApplicationStatus({
+      status,
+      filters,
+      reason: reason.trim() || undefined,
+      dry_run: dryRun,
+    });
+    setLoading(false);
+
+    if (res.status !== 200 || !res.data) {
+      errorAlert(res);
+      return;
+    }
+    if (dryRun) {
+      setPreview(res.data);
+      return;
+    }
+    toast.success(`Set ${res.data.affected} application(s) to ${status}`);
+    const over = res.data.quota_breaches.map(formatQuotaBreach).join(", ");
+    if (over) toast.warning(`Over quota: ${over}`);
+    handleOpenChange(false);
+    onApplied();
+  };
+
+  return (
+    <>
+      <Button
+        variant="outline"
+        size="sm"
+        className="cursor-pointer"
+        disabled={disabled}
+        onClick={() => setOpen(true)}
+      >
+        <ListChecks className="size-4" />
+        Set status
+      </Button>
+
+      <Dialog open={open} onOpenChange={handleOpenChange}>
+        <DialogContent className="sm:max-w-lg">
+          <DialogHeader>
+            <DialogTitle>Set status</DialogTitle>
+            <DialogDescription>
+              Changes every application matching the current filters, not
+              just this page. Drafts and withdrawn applications are skipped.
+            </DialogDescription>
+          </DialogHeader>
+
+          <div className="space-y-1.5">
+            <Label className="text-xs text-muted-foreground">New status</Label>
+            <Select
+              value={status}
+              onValueChange={(v) => {
+                setStatus(v as DecisionStatus);
+                setPreview(null);
+              }}
+            >
+              <SelectTrigger className="h-8 w-full">
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                <SelectItem value="accepted">Accepted</SelectItem>
+                <SelectItem value="waitlisted">Waitlisted</SelectItem>
+                <SelectItem value="rejected">Rejected</SelectItem>
+              </SelectContent>
+            </Select>
+          </div>
+
+          <div className="space-y-1.5">
+            <Label
+              htmlFor="bulk-status-reason"
+              className="text-xs text-muted-foreground"
+            >
+              Reason (optional)
+            </Label>
+            <Input
+              id="bulk-status-reason"
+              value={reason}
+              onChange={(e) => setReason(e.target.value)}
+              maxLength={1000}
+              placeholder="Shown on each application's timeline"
+              className="h-8"
+            />
+          </div>
+
+          {preview && (
+            <div className="space-y-2 text-sm">
+              <p>
+                <span className="font-medium">{preview.affected}</span> of{" "}
+                {preview.matched} matching application(s) will change.
+                {preview.unchanged > 0 &&
+                  ` ${preview.unchanged} already ${status}.`}
+                {preview.skipped > 0 && ` ${preview.skipped} skipped.`}
+              </p>
+              {preview.sample.length > 0 && (
+                <ul
+                  className="max-h-56 overflow-auto rounded-md border divide-y"
+                >
+                  {preview.sample.map((a) => (
+                    <li
+                      key={a.application_id}
+                      className="flex items-center justify-between gap-2 px-3 py-1.5"
+                    >
+                      <span className="truncate">
+                        {[a.first_name, a.last_name]
+                          .filter(Boolean)
+                          .join(" ") || a.email}
+                      </span>
+                      <Badge className={getStatusColor(a.from_status)}>
+                        {a.from_status}
+                      </Badge>
+                    </li>
+                  ))}
+                </ul>
+              )}
+              {preview.affected > preview.sample.length && (
+                <p className="text-xs text-muted-foreground">
+                  and {preview.affected - preview.sample.length} more
+                </p>
+              )}
+              {preview.quota_breaches.map((b) => (
+                <p
+                  key={`${b.quota_id}:${b.answer ?? ""}`}
+                  className={
+                    b.hard ? "text-xs text-red-600" : "text-xs text-amber-600"
+                  }
+                >
+                  {b.hard ? "Blocked by quota" : "Over soft quota"}{" "}
+                  {formatQuotaBreach(b)}
+                </p>
+              ))}
+            </div>
+          )}
+
+          <DialogFooter>
+            <Button
+              type="button"
+              variant="outline"
+              onClick={() => handleOpenChange(false)}
+              className="cursor-pointer"
+            >
+              Cancel
+            </Button>
+            {preview ? (
+              <Button
+                loading={loading}
+                disabled={
+                  preview.affected === 0 ||
+                  preview.quota_breaches.some((b) => b.hard)
+                }
+                onClick={() => run(false)}
+                className="cursor-pointer"
+              >
+                Set {preview.affected} to {status}
+              </Button>
+            ) : (
+              <Button
+                loading={loading}
+                onClick={() => run(true)}
+                className="cursor-pointer"
+              >
+                Preview
+              </Button>
+            )}
+          </DialogFooter>
+        </DialogContent>
+      </Dialog>
+    </>
+  );
+}
@@ -0,0 +1,265 @@
+import { Bookmark, Columns3, Trash2, Users } from "lucide-react";
+import { useCallback, useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import {
+  Dialog,
+  DialogContent,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import {
+  DropdownMenu,
+  DropdownMenuCheckboxItem,
+  DropdownMenuContent,
+  DropdownMenuItem,
+  DropdownMenuLabel,
+  DropdownMenuSeparator,
+  DropdownMenuTrigger,
+} from "@/components/ui/dropdown-menu";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { errorAlert } from "@/shared/lib/api";
+import { useUserStore } from "@/shared/stores";
+
+import { createSavedView, deleteSavedView, fetchSavedViews } from "../api";
+import type { SavedView } from "../types";
+import { APPLICATION_COLUMNS } from "./ApplicationsTable";
+
+interface SavedViewsMenuProps {
+  activeViewId: string | null;
+  visibleColumns: string[] | null;
+  /** The list's current filters and sort, as saved in a new view. */
+  currentQuery: Record<string, string[]>;
+  onApplyView: (view: SavedView) => void;
+}
+
+export function SavedViewsMenu({
+  activeViewId,
+  visibleColumns,
+  currentQuery,
+  onApplyView,
+}: SavedViewsMenuProps) {
+  const userId = useUserStore((s) => s.user?.id);
+  const [views, setViews] = useState<SavedView[]>([]);
+  const [dialogOpen, setDialogOpen] = useState(false);
+  const [name, setName] = useState("");
+  const [shared, setShared] = useState(false);
+  const [saving, setSaving] = useState(false);
+
+  const loadViews = useCallback(async (signal?: AbortSignal) => {
+    const res = await fetchSavedViews("applications", signal);
+    if (signal?.aborted) return;
+    if (res.status === 200 && res.data) {
+      setViews(res.data.views);
+    }
+  }, []);
+
+  useEffect(() => {
+    const controller = new AbortController();
+    loadViews(controller.signal);
+    return () => controller.abort();
+  }, [loadViews]);
+
+  const activeView = views.find((v) => v.id === activeViewId);
+
+  const handleSave = async (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!name.trim()) return;
+
+    setSaving(true);
+    const res = await createSavedView({
+      name: name.trim(),
+      resource: "applications",
+      query: currentQuery,
+      columns: visibleColumns ?? [],
+      shared,
+    });
+    setSaving(false);
+
+    if (res.status === 201 && res.data) {
+      const view = res.data;
+      toast.success("View saved");
+      setViews((vs) => [...vs, view]);
+      setDialogOpen(false);
+      setName("");
+      setShared(false);
+      onApplyView(view);
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const handleDelete = async (view: SavedView) => {
+    const res = await deleteSavedView(view.id);
+    if (res.status === 204) {
+      toast.success(`Deleted "${view.name}"`);
+      setViews((vs) => vs.filter((v) => v.id !== view.id));
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  return (
+    <>
+      <DropdownMenu modal={false}>
+        <DropdownMenuTrigger asChild>
+          <Button
+            variant={activeView ? "default" : "outline"}
+            size="sm"
+            className="cursor-pointer shrink-0 max-w-48"
+          >
+            <Bookmark className="size-4" />
+            <span className="truncate">{activeView?.name ?? "Views"}</span>
+          </Button>
+        </DropdownMenuTrigger>
+        <DropdownMenuContent align="end" className="w-64">
+          {views.length === 0 ? (
+            <DropdownMenuLabel className="font-normal text-muted-foreground">
+              No saved views yet
+            </DropdownMenuLabel>
+          ) : (
+            views.map((view) => (
+              <DropdownMenuItem
+                key={view.id}
+                className="cursor-pointer justify-between"
+                onSelect={() => onApplyView(view)}
+              >
+                <span className="flex min-w-0 flex-col">
+                  <span className="truncate">{view.name}</span>
+                  {view.owner_id !== userId && (
+                    <span className="truncate text-xs text-muted-foreground">
+                      Shared by {view.owner_email}
+                    </span>
+                  )}
+                </span>
+                {view.owner_id === userId ? (
+                  <Button
+                    variant="ghost"
+                    size="icon-sm"
+                    className="h-6 w-6 shrink-0 cursor-pointer"
+                    aria-label={`Delete ${view.name}`}
+                    onClick={(e) => {
+                      e.stopPropagation();
+                      handleDelete(view);
+                    }}
+                  >
+                    <Trash2 className="h-3.5 w-3.5 text-muted-foreground" />
+                  </Button>
+                ) : (
+                  <Users
+                    className="h-3.5 w-3.5 shrink-0 text-muted-foreground"
+                  />
+                )}
+              </DropdownMenuItem>
+            ))
+          )}
+          <DropdownMenuSeparator />
+          <DropdownMenuItem
+            className="cursor-pointer"
+            onSelect={() => setDialogOpen(true)}
+          >
+            Save current view…
+          </DropdownMenuItem>
+        </DropdownMenuContent>
+      </DropdownMenu>
+
+      <Dialog open={dialogOpen} onOpenChange={setDialogOpen}>
+        <DialogContent className="sm:max-w-md">
+          <DialogHeader>
+            <DialogTitle>Save view</DialogTitle>
+          </DialogHeader>
+          <form onSubmit={handleSave} className="space-y-4">
+            <div className="space-y-2">
+              <Label htmlFor="saved-view-name">Name</Label>
+              <Input
+                id="saved-view-name"
+                value={name}
+                onChange={(e) => setName(e.target.value)}
+                placeholder="Submitted, needs votes"
+                maxLength={100}
+                required
+              />
+              <p className="text-xs text-muted-foreground">
+                Saves the current status, search, sort, filters and visible
+                columns.
+              </p>
+            </div>
+            <div className="flex items-center gap-2">
+              <Checkbox
+                id="saved-view-shared"
+                checked={shared}
+                onCheckedChange={(checked) => setShared(checked === true)}
+              />
+              <Label htmlFor="saved-view-shared" className="font-normal">
+                Share with all admins
+              </Label>
+            </div>
+            <DialogFooter>
+              <Button
+                type="button"
+                variant="outline"
+                onClick={() => setDialogOpen(false)}
+                className="cursor-pointer"
+              >
+                Cancel
+              </Button>
+              <Button
+                type="submit"
+                loading={saving}
+                disabled={!name.trim()}
+                className="cursor-pointer"
+              >
+                Save
+              </Button>
+            </DialogFooter>
+          </form>
+        </DialogContent>
+      </Dialog>
+    </>
+  );
+}
+
+interface ColumnsMenuProps {
+  visibleColumns: string[] | null;
+  onChange: (columns: string[] | null) => void;
+}
+
+export function ColumnsMenu({ visibleColumns, onChange }: ColumnsMenuProps) {
+  const isVisible = (key: string) =>
+    !visibleColumns || visibleColumns.includes(key);
+
+  const toggle = (key: string, checked: boolean) => {
+    const next = APPLICATION_COLUMNS.map((c) => c.key).filter((k) =>
+      k === key ? checked : isVisible(k),
+    );
+    onChange(next.length === APPLICATION_COLUMNS.length ? null : next);
+  };
+
+  return (
+    <DropdownMenu modal={false}>
+      <DropdownMenuTrigger asChild>
+        <Button variant="outline" size="sm" className="cursor-pointer shrink-0">
+          <Columns3 className="size-4" />
+          Columns
+        </Button>
+      </DropdownMenuTrigger>
+      <DropdownMenuContent align="end" className="w-48">
+        {APPLICATION_COLUMNS.map((c) => (
+          <DropdownMenuCheckboxItem
+            key={c.key}
+            checked={isVisible(c.key)}
+            disabled={c.key === "name"}
+            onSelect={(e) => e.preventDefault()}
+            onCheckedChange={(checked) => toggle(c.key, checked === true)}
+          >
+            {c.label}
+          </DropdownMenuCheckboxItem>
+        ))}
+      </DropdownMenuContent>
+    </DropdownMenu>
+  );
+}
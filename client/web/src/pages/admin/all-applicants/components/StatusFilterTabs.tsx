@@ -102,6 +102,18 @@ export const StatusFilterTabs = memo(function StatusFilterTabs({
             </Badge>
           )}
         </TabsTrigger>
+        <TabsTrigger
+          value="withdrawn"
+          disabled={loading}
+          className="font-light cursor-pointer"
+        >
+          Withdrawn
+          {stats && (
+            <Badge variant="secondary" className="ml-1.5 px-1.5 py-0 text-xs">
+              {stats.withdrawn}
+            </Badge>
+          )}
+        </TabsTrigger>
       </TabsList>
     </Tabs>
   );
@@ -0,0 +1,45 @@
+import { Flag } from "lucide-react";
+
+import type { Application, ApplicationFlagReason } from "@/types";
+
+const REASON_LABELS: Record<ApplicationFlagReason, string> = {
+  duplicate_phone: "Same phone number",
+  duplicate_name_birthdate: "Same name and birthdate",
+  duplicate_resume: "Same resume file",
+  disposable_email: "Disposable email domain",
+};
+
+interface FlagsSectionProps {
+  application: Application;
+}
+
+export function FlagsSection({ application }: FlagsSectionProps) {
+  if (!application.flags?.length) {
+    return null;
+  }
+
+  return (
+    <div className="rounded-md border border-red-200 bg-red-50 p-3">
+      <h4 className="text-sm font-semibold mb-2 flex items-center gap-1.5 text-red-700">
+        <Flag className="h-4 w-4" />
+        Possible duplicate
+      </h4>
+      <ul className="space-y-1.5 text-sm">
+        {application.flags.map((flag, i) => (
+          <li key={`${flag.reason}-${flag.related_application_id ?? i}`}>
+            <span className="font-medium">{REASON_LABELS[flag.reason]}</span>
+            {flag.related_email ? (
+              <span className="text-muted-foreground">
+                {" "}
+                as {flag.related_email}
+              </span>
+            ) : null}
+            {flag.detail && (
+              <span className="text-muted-foreground"> ({flag.detail})</span>
+            )}
+          </li>
+        ))}
+      </ul>
+    </div>
+  );
+}
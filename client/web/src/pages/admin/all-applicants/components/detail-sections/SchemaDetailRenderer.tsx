@@ -1,4 +1,5 @@
 import { Label } from "@/components/ui/label";
+import { errorAlert } from "@/shared/lib/api";
 import {
   deriveSections,
   formatResponseValue,
@@ -7,12 +8,23 @@ import {
 } from "@/shared/lib/schema-utils";
 import type { Application } from "@/types";
 
+import { fetchApplicationFileURL } from "../../api";
+
 interface SchemaDetailRendererProps {
   application: Application;
   /** Sections to skip (e.g., "links" if rendered separately). */
   skipSections?: string[];
 }
 
+async function openFile(applicationId: string, fieldId: string) {
+  const res = await fetchApplicationFileURL(applicationId, fieldId);
+  if (res.status === 200 && res.data) {
+    window.open(res.data.download_url, "_blank", "noopener,noreferrer");
+  } else {
+    errorAlert(res);
+  }
+}
+
 export function SchemaDetailRenderer({
   application,
   skipSections = [],
@@ -36,10 +48,33 @@ export function SchemaDetailRenderer({
               <div className="grid grid-cols-2 gap-3 text-sm">
                 {fields.map((field) => {
                   const value = getResponseValue(responses, field.id, null);
-                  // For links section fields (text type with URL-like values), render as links
                   if (
-                    section.id === "links" &&
-                    field.type === "text" &&
+                    field.type === "file" &&
+                    typeof value === "string" &&
+                    value
+                  ) {
+                    return (
+                      <div key={field.id}>
+                        <Label className="text-muted-foreground text-xs">
+                          {field.label}
+                        </Label>
+                        <p>
+                          <button
+                            type="button"
+                            onClick={() => openFile(application.id, field.id)}
+                            className="text-blue-600 hover:underline cursor-pointer"
+                          >
+                            View PDF
+                          </button>
+                        </p>
+                      </div>
+                    );
+                  }
+
+                  // URL fields, and text fields in the links section, render as links
+                  if (
+                    (field.type === "url" ||
+                      (section.id === "links" && field.type === "text")) &&
                     typeof value === "string" &&
                     value
                   ) {
@@ -1,5 +1,31 @@
+import { Badge } from "@/components/ui/badge";
 import { Label } from "@/components/ui/label";
-import type { Application } from "@/types";
+import type {
+  Application,
+  ApplicationStatusEvent,
+  ApplicationStatusEventSource,
+} from "@/types";
+
+import { getStatusColor } from "../../utils";
+
+const SOURCE_LABELS: Record<ApplicationStatusEventSource, string> = {
+  submit: "Submitted",
+  admin: "Set",
+  bulk: "Bulk change",
+  rules: "Decision rules",
+  withdraw: "Withdrew",
+  waitlist_promotion: "Waitlist promotion",
+  walk_in: "Walk-in",
+};
+
+function describeEvent(event: ApplicationStatusEvent) {
+  const by = event.actor_email
+    ? ` by ${event.actor_email}`
+    : event.actor_id
+      ? " by a deleted user"
+      : "";
+  return `${SOURCE_LABELS[event.source]}${by}`;
+}
 
 interface TimelineSectionProps {
   application: Application;
@@ -26,7 +52,61 @@ export function TimelineSection({ application }: TimelineSectionProps) {
           <Label className="text-muted-foreground text-xs">Last Updated</Label>
           <p>{new Date(application.updated_at).toLocaleString()}</p>
         </div>
+        {application.withdrawn_at && (
+          <div>
+            <Label className="text-muted-foreground text-xs">
+              Withdrawn{" "}
+              {application.withdrawn_from &&
+                `(was ${application.withdrawn_from})`}
+            </Label>
+            <p>{new Date(application.withdrawn_at).toLocaleString()}</p>
+          </div>
+        )}
+        {application.withdrawal_reason && (
+          <div className="col-span-2">
+            <Label className="text-muted-foreground text-xs">
+              Withdrawal Reason
+            </Label>
+            <p className="whitespace-pre-wrap">
+              {application.withdrawal_reason}
+            </p>
+          </div>
+        )}
       </div>
+
+      {application.timeline?.length ? (
+        <div className="mt-4">
+          <Label className="text-muted-foreground text-xs">
+            Status History
+          </Label>
+          <ol className="mt-2 space-y-3 border-l pl-4 text-sm">
+            {application.timeline.map((event) => (
+              <li key={event.id} className="space-y-1">
+                <div className="flex flex-wrap items-center gap-1.5">
+                  {event.from_status && (
+                    <>
+                      <Badge className={getStatusColor(event.from_status)}>
+                        {event.from_status}
+                      </Badge>
+                      <span className="text-muted-foreground">→</span>
+                    </>
+                  )}
+                  <Badge className={getStatusColor(event.to_status)}>
+                    {event.to_status}
+                  </Badge>
+                </div>
+                <p className="text-xs text-muted-foreground">
+                  {describeEvent(event)} ·{" "}
+                  {new Date(event.changed_at).toLocaleString()}
+                </p>
+                {event.reason && (
+                  <p className="whitespace-pre-wrap">{event.reason}</p>
+                )}
+              </li>
+            ))}
+          </ol>
+        </div>
+      ) : null}
     </div>
   );
 }
@@ -1,3 +1,4 @@
+export { FlagsSection } from "./FlagsSection";
 export { LinksSection } from "./LinksSection";
 export { SchemaDetailRenderer } from "./SchemaDetailRenderer";
 export { TimelineSection } from "./TimelineSection";
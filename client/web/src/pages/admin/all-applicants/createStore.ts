@@ -5,6 +5,7 @@ import {
   fetchApplicationStats,
 } from "./api";
 import type {
+  AdvancedFilters,
   ApplicationListItem,
   ApplicationSortBy,
   ApplicationStats,
@@ -21,6 +22,8 @@ export interface ApplicationsState {
   currentStatus: ApplicationStatus | null;
   currentSearch: string;
   currentSortBy?: ApplicationSortBy;
+  currentFlagged: boolean;
+  currentFilters: AdvancedFilters;
   stats: ApplicationStats | null;
   statsLoading: boolean;
   fetchApplications: (
@@ -47,6 +50,8 @@ export function createApplicationsStore(config: ApplicationsStoreConfig) {
     currentStatus: config.defaultStatus,
     currentSearch: "",
     currentSortBy: config.defaultSortBy,
+    currentFlagged: false,
+    currentFilters: {},
     stats: null,
     statsLoading: false,
 
@@ -74,12 +79,23 @@ export function createApplicationsStore(config: ApplicationsStoreConfig) {
         sortBy = get().currentSortBy;
       }
 
+      let flagged: boolean;
+      if (params && "flagged" in params) {
+        flagged = params.flagged ?? false;
+      } else {
+        flagged = get().currentFlagged;
+      }
+
+      const filters = params?.filters ?? get().currentFilters;
+
       const res = await apiFetchApplications(
         {
           ...params,
           status,
           search: search || undefined,
           sort_by: sortBy,
+          flagged: flagged || undefined,
+          filters,
         },
         signal,
       );
@@ -96,6 +112,8 @@ export function createApplicationsStore(config: ApplicationsStoreConfig) {
           currentStatus: status,
           currentSearch: search,
           currentSortBy: sortBy,
+          currentFlagged: flagged,
+          currentFilters: filters,
         });
       } else {
         set({
@@ -135,6 +153,8 @@ export function createApplicationsStore(config: ApplicationsStoreConfig) {
         currentStatus: config.defaultStatus,
         currentSearch: "",
         currentSortBy: config.defaultSortBy,
+        currentFlagged: false,
+        currentFilters: {},
       });
     },
   }));
@@ -3,7 +3,8 @@ export type ApplicationStatus =
   | "submitted"
   | "accepted"
   | "rejected"
-  | "waitlisted";
+  | "waitlisted"
+  | "withdrawn";
 
 export interface ApplicationListItem {
   id: string;
@@ -31,6 +32,9 @@ export interface ApplicationListItem {
   reviews_completed: number;
   has_resume: boolean;
   points: number;
+  team_id: string | null;
+  team_name: string | null;
+  flagged: boolean;
 }
 
 export interface ApplicationListResult {
@@ -47,7 +51,12 @@ export interface ApplicationStats {
   rejected: number;
   waitlisted: number;
   draft: number;
+  withdrawn: number;
   acceptance_rate: number;
+  confirmation_pending: number;
+  confirmed: number;
+  declined: number;
+  expired: number;
 }
 
 export type ApplicationSortBy =
@@ -56,10 +65,107 @@ export type ApplicationSortBy =
   | "reject_votes"
   | "waitlist_votes";
 
+/**
+ * Structured filters keyed by query parameter: has_resume, ai_percent_min,
+ * ai_percent_max, points_min, points_max, submitted_from, submitted_to,
+ * meal_group, text_search, and f.<field> / f.<field>.min / f.<field>.max for
+ * schema answers.
+ */
+export type AdvancedFilters = Record<string, string>;
+
+export interface ApplicationFilterField {
+  id: string;
+  label: string;
+  type: "select" | "country" | "multi_select" | "number";
+  options?: string[];
+}
+
+export interface ApplicationFilterFieldsResponse {
+  fields: ApplicationFilterField[];
+  /** False when the schema has no textarea answers to search. */
+  text_search: boolean;
+}
+
+export type SavedViewResource = "applications" | "users";
+
+/**
+ * A named set of list query parameters and visible columns. Views other
+ * admins shared can be applied but not changed.
+ */
+export interface SavedView {
+  id: string;
+  owner_id: string;
+  owner_email: string;
+  resource: SavedViewResource;
+  name: string;
+  query: Record<string, string[]>;
+  columns: string[];
+  shared: boolean;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface SavedViewPayload {
+  name: string;
+  resource: SavedViewResource;
+  query: Record<string, string[]>;
+  columns: string[];
+  shared: boolean;
+}
+
+export type DecisionStatus = "accepted" | "rejected" | "waitlisted";
+
+export interface BulkStatusPayload {
+  status: DecisionStatus;
+  filters: Record<string, string[]>;
+  /** Recorded on each changed application's status timeline. */
+  reason?: string;
+  dry_run: boolean;
+}
+
+export interface BulkStatusSample {
+  application_id: string;
+  email: string;
+  first_name: string | null;
+  last_name: string | null;
+  from_status: ApplicationStatus;
+}
+
+/**
+ * Outcome of a bulk status change. Unchanged applications already had the
+ * status; drafts and withdrawn applications are skipped.
+ */
+export interface BulkStatusResult {
+  dry_run: boolean;
+  bulk_id?: string;
+  matched: number;
+  affected: number;
+  unchanged: number;
+  skipped: number;
+  sample: BulkStatusSample[];
+  quota_breaches: QuotaBreach[];
+}
+
+/**
+ * An admission quota a change would take past its cap. `answer` names the
+ * answer over its cap for a per-answer quota; `count` is after the change.
+ * A hard breach blocks the change.
+ */
+export interface QuotaBreach {
+  quota_id: string;
+  label: string;
+  answer?: string;
+  max: number;
+  count: number;
+  hard: boolean;
+}
+
 export interface FetchParams {
   cursor?: string;
   status?: ApplicationStatus | null;
   direction?: "forward" | "backward";
   search?: string;
   sort_by?: ApplicationSortBy;
+  flagged?: boolean;
+  filters?: AdvancedFilters;
 }
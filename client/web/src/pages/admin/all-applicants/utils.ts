@@ -1,3 +1,5 @@
+import type { QuotaBreach } from "./types";
+
 export function getStatusColor(status: string): string {
   switch (status) {
     case "accepted":
@@ -10,11 +12,20 @@ export function getStatusColor(status: string): string {
       return "bg-blue-100 text-blue-800";
     case "draft":
       return "bg-gray-100 text-gray-800";
+    case "withdrawn":
+      return "bg-zinc-200 text-zinc-600";
     default:
       return "bg-gray-100 text-gray-800";
   }
 }
 
+export function formatQuotaBreach(breach: QuotaBreach): string {
+  const label = breach.answer
+    ? `${breach.label} (${breach.answer})`
+    : breach.label;
+  return `${label}: ${breach.count} / ${breach.max}`;
+}
+
 export function formatName(
   firstName: string | null,
   lastName: string | null,
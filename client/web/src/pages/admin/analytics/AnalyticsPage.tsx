@@ -0,0 +1,295 @@
+import { Clock, FileCheck, FilePen, Percent } from "lucide-react";
+import { useEffect, useState } from "react";
+import {
+  Bar,
+  BarChart,
+  CartesianGrid,
+  Line,
+  LineChart,
+  XAxis,
+  YAxis,
+} from "recharts";
+
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+import {
+  type ChartConfig,
+  ChartContainer,
+  ChartLegend,
+  ChartLegendContent,
+  ChartTooltip,
+  ChartTooltipContent,
+} from "@/components/ui/chart";
+import { Skeleton } from "@/components/ui/skeleton";
+import { errorAlert } from "@/shared/lib/api";
+
+import {
+  fetchConversion,
+  fetchDailyApplications,
+  fetchDailyReviews,
+  fetchFieldBreakdown,
+} from "./api";
+import { CSVLink } from "./components/CSVLink";
+import { FieldBreakdownCard } from "./components/FieldBreakdownCard";
+import type {
+  ApplicationConversion,
+  DailyApplicationsResult,
+  DailyReviewsResult,
+  FieldBreakdown,
+} from "./types";
+
+const applicationsChartConfig = {
+  drafts_created: { label: "Drafts started", color: "var(--chart-1)" },
+  submitted: { label: "Submitted", color: "var(--chart-2)" },
+} satisfies ChartConfig;
+
+const reviewsChartConfig = {
+  accept: { label: "Accept", color: "var(--chart-2)" },
+  waitlist: { label: "Waitlist", color: "var(--chart-4)" },
+  reject: { label: "Reject", color: "var(--chart-5)" },
+} satisfies ChartConfig;
+
+// Dates are calendar days, so format them without shifting through the
+// browser's zone.
+function formatDay(date: string) {
+  const [, month, day] = date.split("-");
+  return `${Number(month)}/${Number(day)}`;
+}
+
+export default function AnalyticsPage() {
+  const [conversion, setConversion] = useState<ApplicationConversion | null>(
+    null,
+  );
+  const [daily, setDaily] = useState<DailyApplicationsResult | null>(null);
+  const [reviews, setReviews] = useState<DailyReviewsResult | null>(null);
+  const [fields, setFields] = useState<FieldBreakdown[]>([]);
+  const [loading, setLoading] = useState(true);
+
+  useEffect(() => {
+    const controller = new AbortController();
+
+    async function load() {
+      const [conversionRes, dailyRes, reviewsRes, fieldsRes] =
+        await Promise.all([
+          fetchConversion(controller.signal),
+          fetchDailyApplications(controller.signal),
+          fetchDailyReviews(controller.signal),
+          fetchFieldBreakdown(controller.signal),
+        ]);
+      if (controller.signal.aborted) return;
+
+      if (conversionRes.status === 200 && conversionRes.data) {
+        setConversion(conversionRes.data);
+      } else {
+        errorAlert(conversionRes);
+      }
+      if (dailyRes.status === 200 && dailyRes.data) {
+        setDaily(dailyRes.data);
+      } else {
+        errorAlert(dailyRes);
+      }
+      if (reviewsRes.status === 200 && reviewsRes.data) {
+        setReviews(reviewsRes.data);
+      } else {
+        errorAlert(reviewsRes);
+      }
+      if (fieldsRes.status === 200 && fieldsRes.data) {
+        setFields(fieldsRes.data.fields);
+      } else {
+        errorAlert(fieldsRes);
+      }
+      setLoading(false);
+    }
+
+    load();
+    return () => controller.abort();
+  }, []);
+
+  if (loading) {
+    return (
+      <div className="space-y-4 overflow-auto">
+        <div className="grid grid-cols-2 gap-4 lg:grid-cols-4">
+          {[...Array(4)].map((_, i) => (
+            <Skeleton key={i} className="h-28 w-full" />
+          ))}
+        </div>
+        <Skeleton className="h-80 w-full" />
+        <Skeleton className="h-80 w-full" />
+      </div>
+    );
+  }
+
+  const median = conversion?.median_hours_to_submit;
+  const cards = [
+    {
+      title: "Started",
+      value: conversion?.started ?? 0,
+      icon: FilePen,
+      description: `${conversion?.drafts ?? 0} still drafts, ${conversion?.withdrawn_drafts ?? 0} withdrawn unsubmitted`,
+    },
+    {
+      title: "Submitted",
+      value: conversion?.submitted ?? 0,
+      icon: FileCheck,
+      description: "Including withdrawn after submitting",
+    },
+    {
+      title: "Conversion Rate",
+      value: `${(conversion?.conversion_rate ?? 0).toFixed(1)}%`,
+      icon: Percent,
+      description: "Of started applications",
+    },
+    {
+      title: "Median Time to Submit",
+      value: median == null ? "–" : `${median.toFixed(1)}h`,
+      icon: Clock,
+      description: "From starting the draft",
+    },
+  ];
+
+  return (
+    <div className="space-y-4 overflow-auto">
+      <div className="flex items-center justify-end">
+        <CSVLink report="applications/conversion" />
+      </div>
+      <div className="grid grid-cols-2 gap-4 lg:grid-cols-4">
+        {cards.map((card) => (
+          <Card key={card.title} className="min-w-0">
+            <CardHeader className="min-w-0">
+              <div className="flex items-center justify-between gap-2">
+                <CardDescription className="truncate">
+                  {card.title}
+                </CardDescription>
+                <card.icon className="size-5 shrink-0 text-muted-foreground" />
+              </div>
+              <CardTitle className="truncate text-2xl font-semibold tabular-nums">
+                {card.value}
+              </CardTitle>
+              <p className="truncate text-sm text-muted-foreground">
+                {card.description}
+              </p>
+            </CardHeader>
+          </Card>
+        ))}
+      </div>
+
+      <Card>
+        <CardHeader className="flex flex-row items-start justify-between gap-4">
+          <div className="space-y-1.5">
+            <CardTitle>Applications per Day</CardTitle>
+            <CardDescription>
+              Drafts started and applications submitted each day
+              {daily ? ` (${daily.timezone})` : ""}.
+            </CardDescription>
+          </div>
+          <CSVLink report="applications/daily" />
+        </CardHeader>
+        <CardContent>
+          {!daily || daily.days.length === 0 ? (
+            <p className="text-sm text-muted-foreground">
+              No applications yet.
+            </p>
+          ) : (
+            <ChartContainer
+              config={applicationsChartConfig}
+              className="aspect-auto h-72 w-full"
+            >
+              <LineChart data={daily.days}>
+                <CartesianGrid vertical={false} />
+                <XAxis
+                  dataKey="date"
+                  tickFormatter={formatDay}
+                  tickLine={false}
+                  axisLine={false}
+                  minTickGap={24}
+                />
+                <YAxis
+                  allowDecimals={false}
+                  tickLine={false}
+                  axisLine={false}
+                />
+                <ChartTooltip content={<ChartTooltipContent />} />
+                <ChartLegend content={<ChartLegendContent />} />
+                <Line
+                  dataKey="drafts_created"
+                  stroke="var(--color-drafts_created)"
+                  strokeWidth={2}
+                  dot={false}
+                />
+                <Line
+                  dataKey="submitted"
+                  stroke="var(--color-submitted)"
+                  strokeWidth={2}
+                  dot={false}
+                />
+              </LineChart>
+            </ChartContainer>
+          )}
+        </CardContent>
+      </Card>
+
+      <Card>
+        <CardHeader className="flex flex-row items-start justify-between gap-4">
+          <div className="space-y-1.5">
+            <CardTitle>Review Throughput</CardTitle>
+            <CardDescription>
+              Review votes cast each day
+              {reviews ? ` (${reviews.timezone})` : ""}.
+            </CardDescription>
+          </div>
+          <CSVLink report="reviews/daily" />
+        </CardHeader>
+        <CardContent>
+          {!reviews || reviews.days.length === 0 ? (
+            <p className="text-sm text-muted-foreground">No reviews yet.</p>
+          ) : (
+            <ChartContainer
+              config={reviewsChartConfig}
+              className="aspect-auto h-72 w-full"
+            >
+              <BarChart data={reviews.days}>
+                <CartesianGrid vertical={false} />
+                <XAxis
+                  dataKey="date"
+                  tickFormatter={formatDay}
+                  tickLine={false}
+                  axisLine={false}
+                  minTickGap={24}
+                />
+                <YAxis
+                  allowDecimals={false}
+                  tickLine={false}
+                  axisLine={false}
+                />
+                <ChartTooltip content={<ChartTooltipContent />} />
+                <ChartLegend content={<ChartLegendContent />} />
+                <Bar
+                  dataKey="accept"
+                  stackId="votes"
+                  fill="var(--color-accept)"
+                />
+                <Bar
+                  dataKey="waitlist"
+                  stackId="votes"
+                  fill="var(--color-waitlist)"
+                />
+                <Bar
+                  dataKey="reject"
+                  stackId="votes"
+                  fill="var(--color-reject)"
+                />
+              </BarChart>
+            </ChartContainer>
+          )}
+        </CardContent>
+      </Card>
+
+      <FieldBreakdownCard fields={fields} />
+    </div>
+  );
+}
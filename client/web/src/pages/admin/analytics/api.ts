@@ -0,0 +1,58 @@
+import { getRequest } from "@/shared/lib/api";
+import type { ApiResponse } from "@/types";
+
+import type {
+  AnalyticsReport,
+  ApplicationConversion,
+  DailyApplicationsResult,
+  DailyReviewsResult,
+  FieldBreakdownResult,
+} from "./types";
+
+export async function fetchFieldBreakdown(
+  signal?: AbortSignal,
+): Promise<ApiResponse<FieldBreakdownResult>> {
+  return getRequest<FieldBreakdownResult>(
+    "/admin/analytics/fields",
+    "answer breakdown",
+    signal,
+  );
+}
+
+export async function fetchDailyApplications(
+  signal?: AbortSignal,
+): Promise<ApiResponse<DailyApplicationsResult>> {
+  return getRequest<DailyApplicationsResult>(
+    "/admin/analytics/applications/daily",
+    "daily applications",
+    signal,
+  );
+}
+
+export async function fetchConversion(
+  signal?: AbortSignal,
+): Promise<ApiResponse<ApplicationConversion>> {
+  return getRequest<ApplicationConversion>(
+    "/admin/analytics/applications/conversion",
+    "application conversion",
+    signal,
+  );
+}
+
+export async function fetchDailyReviews(
+  signal?: AbortSignal,
+): Promise<ApiResponse<DailyReviewsResult>> {
+  return getRequest<DailyReviewsResult>(
+    "/admin/analytics/reviews/daily",
+    "daily reviews",
+    signal,
+  );
+}
+
+/**
+ * Build the download URL for a report as CSV. The browser downloads it
+ * directly, like the applications export.
+ */
+export function buildAnalyticsCSVURL(report: AnalyticsReport): string {
+  return `/v1/admin/analytics/${report}?format=csv`;
+}
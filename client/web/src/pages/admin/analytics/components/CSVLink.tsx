@@ -0,0 +1,17 @@
+import { Download } from "lucide-react";
+
+import { Button } from "@/components/ui/button";
+
+import { buildAnalyticsCSVURL } from "../api";
+import type { AnalyticsReport } from "../types";
+
+export function CSVLink({ report }: { report: AnalyticsReport }) {
+  return (
+    <Button variant="outline" size="sm" asChild>
+      <a href={buildAnalyticsCSVURL(report)} download>
+        <Download className="size-4" />
+        CSV
+      </a>
+    </Button>
+  );
+}
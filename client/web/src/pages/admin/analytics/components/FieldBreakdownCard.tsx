@@ -0,0 +1,119 @@
+import { useState } from "react";
+
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import type { ApplicationStatus } from "@/pages/admin/all-applicants/types";
+
+import type { FieldBreakdown } from "../types";
+import { CSVLink } from "./CSVLink";
+
+const STATUS_COLUMNS: ApplicationStatus[] = [
+  "draft",
+  "submitted",
+  "accepted",
+  "waitlisted",
+  "rejected",
+  "withdrawn",
+];
+
+interface FieldBreakdownCardProps {
+  fields: FieldBreakdown[];
+}
+
+export function FieldBreakdownCard({ fields }: FieldBreakdownCardProps) {
+  const [fieldId, setFieldId] = useState<string>();
+  const field = fields.find((f) => f.field_id === fieldId) ?? fields[0];
+
+  return (
+    <Card>
+      <CardHeader className="flex flex-row items-start justify-between gap-4">
+        <div className="space-y-1.5">
+          <CardTitle>Answers by Status</CardTitle>
+          <CardDescription>
+            Multi-select options are counted separately, so an applicant can
+            count under several.
+          </CardDescription>
+        </div>
+        <div className="flex items-center gap-2">
+          {fields.length > 0 && (
+            <Select value={field?.field_id} onValueChange={setFieldId}>
+              <SelectTrigger className="h-8 w-56">
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                {fields.map((f) => (
+                  <SelectItem key={f.field_id} value={f.field_id}>
+                    {f.label}
+                  </SelectItem>
+                ))}
+              </SelectContent>
+            </Select>
+          )}
+          <CSVLink report="fields" />
+        </div>
+      </CardHeader>
+      <CardContent>
+        {!field ? (
+          <p className="text-sm text-muted-foreground">
+            The application has no select, multi-select or number questions.
+          </p>
+        ) : field.answers.length === 0 ? (
+          <p className="text-sm text-muted-foreground">No answers yet.</p>
+        ) : (
+          <div className="max-h-96 overflow-auto rounded-md border">
+            <Table>
+              <TableHeader className="sticky top-0 bg-muted">
+                <TableRow>
+                  <TableHead>Answer</TableHead>
+                  <TableHead className="text-right">Total</TableHead>
+                  {STATUS_COLUMNS.map((s) => (
+                    <TableHead key={s} className="text-right capitalize">
+                      {s}
+                    </TableHead>
+                  ))}
+                </TableRow>
+              </TableHeader>
+              <TableBody>
+                {field.answers.map((a) => (
+                  <TableRow key={a.answer}>
+                    <TableCell className="max-w-64 truncate">
+                      {a.answer}
+                    </TableCell>
+                    <TableCell className="text-right font-medium tabular-nums">
+                      {a.total}
+                    </TableCell>
+                    {STATUS_COLUMNS.map((s) => (
+                      <TableCell key={s} className="text-right tabular-nums">
+                        {a.by_status[s] ?? 0}
+                      </TableCell>
+                    ))}
+                  </TableRow>
+                ))}
+              </TableBody>
+            </Table>
+          </div>
+        )}
+      </CardContent>
+    </Card>
+  );
+}
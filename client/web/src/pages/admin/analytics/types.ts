@@ -0,0 +1,64 @@
+import type { ApplicationStatus } from "@/pages/admin/all-applicants/types";
+
+export interface AnswerBreakdown {
+  answer: string;
+  total: number;
+  by_status: Partial<Record<ApplicationStatus, number>>;
+}
+
+export interface FieldBreakdown {
+  field_id: string;
+  label: string;
+  type: "select" | "multi_select" | "number";
+  answers: AnswerBreakdown[];
+}
+
+export interface FieldBreakdownResult {
+  fields: FieldBreakdown[];
+}
+
+/** One calendar day in `timezone`; dates are "YYYY-MM-DD". */
+export interface DailyApplicationCount {
+  date: string;
+  drafts_created: number;
+  submitted: number;
+  /** Of the drafts started this day, how many have been submitted since. */
+  cohort_submitted: number;
+}
+
+export interface DailyApplicationsResult {
+  timezone: string;
+  days: DailyApplicationCount[];
+}
+
+export interface ApplicationConversion {
+  /** Always submitted + drafts + withdrawn_drafts. */
+  started: number;
+  /** Ever submitted, including applications withdrawn since. */
+  submitted: number;
+  drafts: number;
+  /** Drafts withdrawn without ever being submitted. */
+  withdrawn_drafts: number;
+  conversion_rate: number;
+  median_hours_to_submit: number | null;
+}
+
+export interface DailyReviewCount {
+  date: string;
+  reviews: number;
+  accept: number;
+  reject: number;
+  waitlist: number;
+  reviewers: number;
+}
+
+export interface DailyReviewsResult {
+  timezone: string;
+  days: DailyReviewCount[];
+}
+
+export type AnalyticsReport =
+  | "fields"
+  | "applications/daily"
+  | "applications/conversion"
+  | "reviews/daily";
@@ -1,6 +1,7 @@
 import {
   deleteRequest,
   getRequest,
+  ifMatch,
   postRequest,
   putRequest,
 } from "@/shared/lib/api";
@@ -34,9 +35,16 @@ export async function createFAQ(
 export async function updateFAQ(
   id: string,
   payload: FAQPayload,
+  updatedAt: string,
   signal?: AbortSignal,
 ): Promise<ApiResponse<FAQ>> {
-  return putRequest<FAQ>(`/admin/faq/${id}`, payload, "FAQ", signal);
+  return putRequest<FAQ>(
+    `/admin/faq/${id}`,
+    payload,
+    "FAQ",
+    signal,
+    ifMatch(updatedAt),
+  );
 }
 
 export async function deleteFAQ(
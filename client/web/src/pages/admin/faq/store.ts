@@ -1,6 +1,6 @@
 import { create } from "zustand";
 
-import { errorAlert } from "@/shared/lib/api";
+import { errorAlert, isStaleWrite } from "@/shared/lib/api";
 
 import {
   createFAQ as apiCreateFAQ,
@@ -27,7 +27,7 @@ export interface FAQState {
   deleteFAQ: (id: string) => Promise<boolean>;
 }
 
-export const useFAQStore = create<FAQState>((set) => ({
+export const useFAQStore = create<FAQState>((set, get) => ({
   faqs: [],
   canEdit: false,
   loading: false,
@@ -70,14 +70,21 @@ export const useFAQStore = create<FAQState>((set) => ({
   },
 
   updateFAQ: async (id: string, payload: FAQPayload) => {
+    const loaded = get().faqs.find((f) => f.id === id);
+    if (!loaded) return false;
+
     set({ saving: true });
-    const res = await apiUpdateFAQ(id, payload);
-    if (res.status === 200 && res.data) {
+    const res = await apiUpdateFAQ(id, payload, loaded.updated_at);
+    // A stale write returns the current copy; take it so saving again
+    // overwrites that version knowingly.
+    if ((res.status === 200 || isStaleWrite(res)) && res.data) {
       const updated = res.data;
       set((state) => ({
         faqs: sortByOrder(state.faqs.map((f) => (f.id === id ? updated : f))),
-        saving: false,
       }));
+    }
+    if (res.status === 200) {
+      set({ saving: false });
       return true;
     }
     errorAlert(res);
@@ -1,5 +1,7 @@
 export { default as AllApplicantsPage } from "./all-applicants/AllApplicantsPage";
+export { default as AnalyticsPage } from "./analytics/AnalyticsPage";
 export { default as FAQPage } from "./faq/FAQPage";
+export { default as PoliciesPage } from "./policies/PoliciesPage";
 export { default as ReviewsPage } from "./reviews/ReviewsPage";
 export { default as ScansPage } from "./scans/ScansPage";
 export { default as SchedulePage } from "./schedule/SchedulePage";
@@ -0,0 +1,57 @@
+import { useEffect } from "react";
+
+import { Card, CardContent, CardHeader } from "@/components/ui/card";
+import { Skeleton } from "@/components/ui/skeleton";
+import { useUserStore } from "@/shared/stores";
+
+import { PoliciesTable } from "./components/PoliciesTable";
+import { usePolicyStore } from "./store";
+
+export default function PoliciesPage() {
+  const isSuperAdmin = useUserStore((s) => s.user?.role === "super_admin");
+  const {
+    policies,
+    loading,
+    saving,
+    fetch: loadPolicies,
+    createPolicy,
+    updatePolicy,
+    deletePolicy,
+  } = usePolicyStore();
+
+  useEffect(() => {
+    const controller = new AbortController();
+    loadPolicies(controller.signal);
+    return () => controller.abort();
+  }, [loadPolicies]);
+
+  if (loading && policies.length === 0) {
+    return (
+      <div className="space-y-6 overflow-auto">
+        <Card>
+          <CardHeader>
+            <Skeleton className="h-5 w-32" />
+          </CardHeader>
+          <CardContent className="space-y-3">
+            {[...Array(3)].map((_, i) => (
+              <Skeleton key={i} className="h-14 w-full" />
+            ))}
+          </CardContent>
+        </Card>
+      </div>
+    );
+  }
+
+  return (
+    <div className="flex h-full min-h-0 flex-col overflow-hidden">
+      <PoliciesTable
+        policies={policies}
+        saving={saving}
+        canEdit={isSuperAdmin}
+        onCreatePolicy={createPolicy}
+        onUpdatePolicy={updatePolicy}
+        onDeletePolicy={deletePolicy}
+      />
+    </div>
+  );
+}
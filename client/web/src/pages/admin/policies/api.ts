@@ -0,0 +1,69 @@
+import {
+  deleteRequest,
+  getRequest,
+  ifMatch,
+  postRequest,
+  putRequest,
+} from "@/shared/lib/api";
+import type { ApiResponse } from "@/types";
+
+import type {
+  Policy,
+  PolicyListResponse,
+  PolicyPayload,
+  PolicySignersResponse,
+} from "./types";
+
+export async function fetchPolicies(
+  signal?: AbortSignal,
+): Promise<ApiResponse<PolicyListResponse>> {
+  return getRequest<PolicyListResponse>("/admin/policies", "policies", signal);
+}
+
+export async function fetchPolicySigners(
+  id: string,
+  signal?: AbortSignal,
+): Promise<ApiResponse<PolicySignersResponse>> {
+  return getRequest<PolicySignersResponse>(
+    `/admin/policies/${id}/signatures`,
+    "policy signatures",
+    signal,
+  );
+}
+
+/**
+ * Build the download URL for a policy's signature report as CSV. The browser
+ * downloads it directly, like the analytics reports.
+ */
+export function buildPolicySignersCSVURL(id: string): string {
+  return `/v1/admin/policies/${id}/signatures?format=csv`;
+}
+
+export async function createPolicy(
+  payload: PolicyPayload,
+  signal?: AbortSignal,
+): Promise<ApiResponse<Policy>> {
+  return postRequest<Policy>("/superadmin/policies", payload, "policy", signal);
+}
+
+export async function updatePolicy(
+  id: string,
+  payload: PolicyPayload,
+  updatedAt: string,
+  signal?: AbortSignal,
+): Promise<ApiResponse<Policy>> {
+  return putRequest<Policy>(
+    `/superadmin/policies/${id}`,
+    payload,
+    "policy",
+    signal,
+    ifMatch(updatedAt),
+  );
+}
+
+export async function deletePolicy(
+  id: string,
+  signal?: AbortSignal,
+): Promise<ApiResponse<unknown>> {
+  return deleteRequest<unknown>(`/superadmin/policies/${id}`, "policy", signal);
+}
@@ -0,0 +1,236 @@
+import { Pencil, Plus, Trash2, Users } from "lucide-react";
+import { useState } from "react";
+import { toast } from "sonner";
+
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+} from "@/components/ui/card";
+import { Skeleton } from "@/components/ui/skeleton";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+
+import type { Policy, PolicyPayload } from "../types";
+import { PolicyFormDialog } from "./PolicyFormDialog";
+import { SignaturesDialog } from "./SignaturesDialog";
+
+interface PoliciesTableProps {
+  policies: Policy[];
+  saving: boolean;
+  canEdit: boolean;
+  onCreatePolicy: (payload: PolicyPayload) => Promise<string | null>;
+  onUpdatePolicy: (id: string, payload: PolicyPayload) => Promise<boolean>;
+  onDeletePolicy: (id: string) => Promise<boolean>;
+}
+
+export function PoliciesTable({
+  policies,
+  saving,
+  canEdit,
+  onCreatePolicy,
+  onUpdatePolicy,
+  onDeletePolicy,
+}: PoliciesTableProps) {
+  const [formOpen, setFormOpen] = useState(false);
+  const [editTarget, setEditTarget] = useState<Policy | null>(null);
+  const [deleteTarget, setDeleteTarget] = useState<Policy | null>(null);
+  const [signersTarget, setSignersTarget] = useState<Policy | null>(null);
+
+  const openCreate = () => {
+    setEditTarget(null);
+    setFormOpen(true);
+  };
+
+  const openEdit = (policy: Policy) => {
+    setEditTarget(policy);
+    setFormOpen(true);
+  };
+
+  const handleSubmit = async (payload: PolicyPayload) => {
+    if (editTarget) {
+      const success = await onUpdatePolicy(editTarget.id, payload);
+      if (success) {
+        toast.success("Policy updated");
+        setFormOpen(false);
+      }
+    } else {
+      const id = await onCreatePolicy(payload);
+      if (id) {
+        toast.success("Policy created");
+        setFormOpen(false);
+      }
+    }
+  };
+
+  // A signed policy can't be deleted; the store surfaces that 409.
+  const handleDelete = async () => {
+    if (!deleteTarget) return;
+    const success = await onDeletePolicy(deleteTarget.id);
+    if (success) {
+      toast.success("Policy deleted");
+    }
+    setDeleteTarget(null);
+  };
+
+  return (
+    <>
+      <Card className="overflow-hidden flex flex-col h-full min-h-0">
+        <CardHeader className="shrink-0 flex flex-row items-center justify-between">
+          <CardDescription className="font-light">
+            {policies.length} polic{policies.length === 1 ? "y" : "ies"}{" "}
+            configured. Required policies must be signed before check-in.
+          </CardDescription>
+          <div className="flex items-center gap-2">
+            {saving && <Skeleton className="size-4 rounded-full" />}
+            {canEdit && (
+              <Button size="sm" onClick={openCreate} className="cursor-pointer">
+                <Plus className="mr-1 size-4" />
+                Add Policy
+              </Button>
+            )}
+          </div>
+        </CardHeader>
+        <CardContent className="p-0 flex-1 overflow-hidden">
+          <div className="relative overflow-auto h-full p-6 pt-0 pb-3">
+            {policies.length === 0 ? (
+              <div className="py-12 text-center text-muted-foreground">
+                No policies yet.
+                {canEdit && ' Click "Add Policy" to get started.'}
+              </div>
+            ) : (
+              <Table>
+                <TableHeader className="sticky top-0 bg-card z-10">
+                  <TableRow>
+                    <TableHead className="w-16">Order</TableHead>
+                    <TableHead className="w-72">Title</TableHead>
+                    <TableHead>Text</TableHead>
+                    <TableHead className="w-28" />
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {policies.map((policy) => (
+                    <TableRow key={policy.id} className="[&>td]:py-3">
+                      <TableCell className="tabular-nums">
+                        {policy.display_order}
+                      </TableCell>
+                      <TableCell>
+                        <div className="flex flex-wrap items-center gap-1.5">
+                          <span className="font-medium">{policy.title}</span>
+                          <Badge variant="outline">v{policy.version}</Badge>
+                          {policy.required && <Badge>Required</Badge>}
+                        </div>
+                      </TableCell>
+                      <TableCell>
+                        <span className="text-sm text-muted-foreground line-clamp-2 block max-w-xl whitespace-pre-line">
+                          {policy.body}
+                        </span>
+                      </TableCell>
+                      <TableCell>
+                        <div className="flex items-center justify-end gap-1">
+                          <Button
+                            variant="ghost"
+                            size="icon-sm"
+                            className="cursor-pointer text-muted-foreground"
+                            onClick={() => setSignersTarget(policy)}
+                            title="Signatures"
+                          >
+                            <Users className="size-4" />
+                          </Button>
+                          {canEdit && (
+                            <>
+                              <Button
+                                variant="ghost"
+                                size="icon-sm"
+                                className="cursor-pointer text-muted-foreground"
+                                onClick={() => openEdit(policy)}
+                                title="Edit"
+                              >
+                                <Pencil className="size-4" />
+                              </Button>
+                              <Button
+                                variant="ghost"
+                                size="icon-sm"
+                                className="cursor-pointer text-muted-foreground hover:text-red-500"
+                                onClick={() => setDeleteTarget(policy)}
+                                title="Delete"
+                              >
+                                <Trash2 className="size-4" />
+                              </Button>
+                            </>
+                          )}
+                        </div>
+                      </TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            )}
+          </div>
+        </CardContent>
+      </Card>
+
+      <PolicyFormDialog
+        open={formOpen}
+        onOpenChange={setFormOpen}
+        policy={editTarget}
+        saving={saving}
+        onSubmit={handleSubmit}
+      />
+
+      <SignaturesDialog
+        policy={signersTarget}
+        onOpenChange={(open) => {
+          if (!open) setSignersTarget(null);
+        }}
+      />
+
+      <AlertDialog
+        open={deleteTarget !== null}
+        onOpenChange={(open) => {
+          if (!open) setDeleteTarget(null);
+        }}
+      >
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Delete Policy</AlertDialogTitle>
+            <AlertDialogDescription>
+              Only policies nobody has signed can be deleted. To retire a
+              signed policy, mark it not required instead.
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <AlertDialogFooter>
+            <AlertDialogCancel className="cursor-pointer">
+              Cancel
+            </AlertDialogCancel>
+            <AlertDialogAction
+              className="bg-red-600 hover:bg-red-700 cursor-pointer"
+              onClick={handleDelete}
+            >
+              Delete
+            </AlertDialogAction>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </>
+  );
+}
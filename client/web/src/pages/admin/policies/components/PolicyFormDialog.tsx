@@ -0,0 +1,157 @@
+import { useState } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import {
+  Dialog,
+  DialogContent,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Textarea } from "@/components/ui/textarea";
+
+import type { Policy, PolicyPayload } from "../types";
+
+interface PolicyFormDialogProps {
+  open: boolean;
+  onOpenChange: (open: boolean) => void;
+  policy: Policy | null;
+  saving: boolean;
+  onSubmit: (payload: PolicyPayload) => void;
+}
+
+function PolicyForm({
+  policy,
+  saving,
+  onSubmit,
+  onCancel,
+}: {
+  policy: Policy | null;
+  saving: boolean;
+  onSubmit: (payload: PolicyPayload) => void;
+  onCancel: () => void;
+}) {
+  const [title, setTitle] = useState(policy?.title ?? "");
+  const [body, setBody] = useState(policy?.body ?? "");
+  const [required, setRequired] = useState(policy?.required ?? true);
+  const [displayOrder, setDisplayOrder] = useState(
+    policy?.display_order ?? 0,
+  );
+
+  const bodyChanged = policy !== null && body !== policy.body;
+
+  const handleSubmit = (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!title.trim() || !body.trim()) return;
+
+    onSubmit({
+      title: title.trim(),
+      body,
+      required,
+      display_order: displayOrder,
+    });
+  };
+
+  return (
+    <form onSubmit={handleSubmit} className="space-y-4">
+      <div className="space-y-2">
+        <Label htmlFor="policy-title">Title</Label>
+        <Input
+          id="policy-title"
+          value={title}
+          onChange={(e) => setTitle(e.target.value)}
+          placeholder="Code of Conduct"
+          required
+        />
+      </div>
+      <div className="space-y-2">
+        <Label htmlFor="policy-body">Text</Label>
+        <Textarea
+          id="policy-body"
+          value={body}
+          onChange={(e) => setBody(e.target.value)}
+          rows={12}
+          required
+        />
+        {bodyChanged ? (
+          <p className="text-xs text-amber-700">
+            Saving publishes version {policy.version + 1}. Everyone who signed
+            an earlier version must sign again before check-in.
+          </p>
+        ) : (
+          <p className="text-xs text-muted-foreground">
+            Line breaks are preserved.
+          </p>
+        )}
+      </div>
+      <div className="flex items-center gap-2">
+        <Checkbox
+          id="policy-required"
+          checked={required}
+          onCheckedChange={(checked) => setRequired(checked === true)}
+        />
+        <Label htmlFor="policy-required" className="font-normal">
+          Required before check-in
+        </Label>
+      </div>
+      <div className="space-y-2">
+        <Label htmlFor="policy-order">Display Order</Label>
+        <Input
+          id="policy-order"
+          type="number"
+          min={0}
+          value={displayOrder}
+          onChange={(e) => setDisplayOrder(Number(e.target.value))}
+        />
+      </div>
+      <DialogFooter>
+        <Button
+          type="button"
+          variant="outline"
+          onClick={onCancel}
+          className="cursor-pointer"
+        >
+          Cancel
+        </Button>
+        <Button
+          type="submit"
+          loading={saving}
+          disabled={!title.trim() || !body.trim()}
+          className="cursor-pointer"
+        >
+          {policy ? "Save" : "Create"}
+        </Button>
+      </DialogFooter>
+    </form>
+  );
+}
+
+export function PolicyFormDialog({
+  open,
+  onOpenChange,
+  policy,
+  saving,
+  onSubmit,
+}: PolicyFormDialogProps) {
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="sm:max-w-2xl">
+        <DialogHeader>
+          <DialogTitle>{policy ? "Edit Policy" : "Add Policy"}</DialogTitle>
+        </DialogHeader>
+        {open && (
+          <PolicyForm
+            key={policy?.id ?? "new"}
+            policy={policy}
+            saving={saving}
+            onSubmit={onSubmit}
+            onCancel={() => onOpenChange(false)}
+          />
+        )}
+      </DialogContent>
+    </Dialog>
+  );
+}
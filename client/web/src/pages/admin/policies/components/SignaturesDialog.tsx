@@ -0,0 +1,168 @@
+import { Download } from "lucide-react";
+import { useEffect, useState } from "react";
+
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Skeleton } from "@/components/ui/skeleton";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import { errorAlert } from "@/shared/lib/api";
+
+import { buildPolicySignersCSVURL, fetchPolicySigners } from "../api";
+import type { Policy, PolicySigner } from "../types";
+
+interface SignaturesDialogProps {
+  policy: Policy | null;
+  onOpenChange: (open: boolean) => void;
+}
+
+function signerName(signer: PolicySigner) {
+  const name = [signer.first_name, signer.last_name].filter(Boolean).join(" ");
+  return name || signer.email;
+}
+
+export function SignaturesDialog({
+  policy,
+  onOpenChange,
+}: SignaturesDialogProps) {
+  const [signers, setSigners] = useState<PolicySigner[] | null>(null);
+
+  useEffect(() => {
+    if (!policy) return;
+    const controller = new AbortController();
+    setSigners(null);
+    fetchPolicySigners(policy.id, controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setSigners(res.data.signers);
+      } else {
+        errorAlert(res);
+        setSigners([]);
+      }
+    });
+    return () => controller.abort();
+  }, [policy]);
+
+  const signed = signers?.filter((s) => s.current).length ?? 0;
+
+  return (
+    <Dialog open={policy !== null} onOpenChange={onOpenChange}>
+      <DialogContent className="sm:max-w-4xl">
+        <DialogHeader>
+          <DialogTitle>{policy?.title} Signatures</DialogTitle>
+          <DialogDescription>
+            {signers
+              ? `${signed} of ${signers.length} signed version ${policy?.version}.`
+              : "Loading signatures..."}{" "}
+            Lists accepted applicants and anyone else who signed.
+          </DialogDescription>
+        </DialogHeader>
+        <div className="flex justify-end">
+          {policy && (
+            <Button variant="outline" size="sm" asChild>
+              <a href={buildPolicySignersCSVURL(policy.id)} download>
+                <Download className="size-4" />
+                CSV
+              </a>
+            </Button>
+          )}
+        </div>
+        <div className="max-h-[60vh] overflow-auto">
+          {signers === null ? (
+            <div className="space-y-2">
+              {[...Array(4)].map((_, i) => (
+                <Skeleton key={i} className="h-8 w-full" />
+              ))}
+            </div>
+          ) : signers.length === 0 ? (
+            <p className="py-8 text-center text-sm text-muted-foreground">
+              No accepted applicants or signatures yet.
+            </p>
+          ) : (
+            <Table>
+              <TableHeader className="sticky top-0 bg-background z-10">
+                <TableRow>
+                  <TableHead>Hacker</TableHead>
+                  <TableHead>Signature</TableHead>
+                  <TableHead>Guardian</TableHead>
+                  <TableHead>Signed</TableHead>
+                </TableRow>
+              </TableHeader>
+              <TableBody>
+                {signers.map((signer) => (
+                  <TableRow key={signer.user_id}>
+                    <TableCell>
+                      <div className="font-medium">{signerName(signer)}</div>
+                      <div className="text-xs text-muted-foreground">
+                        {signer.email} · {signer.status}
+                      </div>
+                    </TableCell>
+                    <TableCell>
+                      {signer.version === null ? (
+                        <Badge variant="outline">Not signed</Badge>
+                      ) : (
+                        <div className="flex flex-wrap items-center gap-1.5">
+                          <span>{signer.signed_name}</span>
+                          <Badge
+                            variant={signer.current ? "default" : "outline"}
+                          >
+                            v{signer.version}
+                            {!signer.current && " (outdated)"}
+                          </Badge>
+                        </div>
+                      )}
+                    </TableCell>
+                    <TableCell className="text-sm">
+                      {signer.guardian_name ? (
+                        <>
+                          <div>{signer.guardian_name}</div>
+                          <div className="text-xs text-muted-foreground">
+                            {signer.guardian_email}
+                          </div>
+                          <div className="text-xs text-muted-foreground">
+                            {signer.guardian_confirmed_at
+                              ? "Confirmed"
+                              : "Awaiting confirmation"}
+                          </div>
+                        </>
+                      ) : (
+                        <span className="text-muted-foreground">—</span>
+                      )}
+                    </TableCell>
+                    <TableCell className="text-sm">
+                      {signer.signed_at ? (
+                        <>
+                          <div>
+                            {new Date(signer.signed_at).toLocaleString()}
+                          </div>
+                          <div className="text-xs text-muted-foreground">
+                            {signer.ip_address}
+                          </div>
+                        </>
+                      ) : (
+                        <span className="text-muted-foreground">—</span>
+                      )}
+                    </TableCell>
+                  </TableRow>
+                ))}
+              </TableBody>
+            </Table>
+          )}
+        </div>
+      </DialogContent>
+    </Dialog>
+  );
+}
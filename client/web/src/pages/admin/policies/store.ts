@@ -0,0 +1,98 @@
+import { create } from "zustand";
+
+import { errorAlert, isStaleWrite } from "@/shared/lib/api";
+
+import {
+  createPolicy as apiCreatePolicy,
+  deletePolicy as apiDeletePolicy,
+  fetchPolicies,
+  updatePolicy as apiUpdatePolicy,
+} from "./api";
+import type { Policy, PolicyPayload } from "./types";
+
+function sortByOrder(policies: Policy[]): Policy[] {
+  return [...policies].sort((a, b) => a.display_order - b.display_order);
+}
+
+export interface PolicyState {
+  policies: Policy[];
+  loading: boolean;
+  saving: boolean;
+
+  fetch: (signal?: AbortSignal) => Promise<void>;
+  createPolicy: (payload: PolicyPayload) => Promise<string | null>;
+  updatePolicy: (id: string, payload: PolicyPayload) => Promise<boolean>;
+  deletePolicy: (id: string) => Promise<boolean>;
+}
+
+export const usePolicyStore = create<PolicyState>((set, get) => ({
+  policies: [],
+  loading: false,
+  saving: false,
+
+  fetch: async (signal?: AbortSignal) => {
+    set({ loading: true });
+    const res = await fetchPolicies(signal);
+    if (signal?.aborted) return;
+
+    const policies =
+      res.status === 200 && res.data ? sortByOrder(res.data.policies) : [];
+    set({ policies, loading: false });
+  },
+
+  createPolicy: async (payload: PolicyPayload) => {
+    set({ saving: true });
+    const res = await apiCreatePolicy(payload);
+    if (res.status === 201 && res.data) {
+      const created = res.data;
+      set((state) => ({
+        policies: sortByOrder([...state.policies, created]),
+        saving: false,
+      }));
+      return created.id;
+    }
+    errorAlert(res);
+    set({ saving: false });
+    return null;
+  },
+
+  updatePolicy: async (id: string, payload: PolicyPayload) => {
+    const loaded = get().policies.find((p) => p.id === id);
+    if (!loaded) return false;
+
+    set({ saving: true });
+    const res = await apiUpdatePolicy(id, payload, loaded.updated_at);
+    // A stale write returns the current copy; take it so saving again
+    // overwrites that version knowingly.
+    if ((res.status === 200 || isStaleWrite(res)) && res.data) {
+      const updated = res.data;
+      set((state) => ({
+        policies: sortByOrder(
+          state.policies.map((p) => (p.id === id ? updated : p)),
+        ),
+      }));
+    }
+    if (res.status === 200) {
+      set({ saving: false });
+      return true;
+    }
+    errorAlert(res);
+    set({ saving: false });
+    return false;
+  },
+
+  deletePolicy: async (id: string) => {
+    set({ saving: true });
+    const res = await apiDeletePolicy(id);
+    if (res.status === 204) {
+      set((state) => ({
+        policies: state.policies.filter((p) => p.id !== id),
+        saving: false,
+      }));
+      return true;
+    }
+    errorAlert(res);
+    set({ saving: false });
+    return false;
+  },
+}));
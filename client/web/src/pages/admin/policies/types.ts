@@ -0,0 +1,45 @@
+import type { ApplicationStatus } from "@/types";
+
+export interface Policy {
+  id: string;
+  title: string;
+  body: string;
+  version: number;
+  required: boolean;
+  display_order: number;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface PolicyPayload {
+  title: string;
+  body: string;
+  required: boolean;
+  display_order: number;
+}
+
+export interface PolicyListResponse {
+  policies: Policy[];
+}
+
+export interface PolicySigner {
+  user_id: string;
+  email: string;
+  first_name: string | null;
+  last_name: string | null;
+  status: ApplicationStatus;
+  version: number | null;
+  current: boolean;
+  signed_name: string | null;
+  guardian_name: string | null;
+  guardian_email: string | null;
+  /** Null while a minor's guardian hasn't followed their confirmation link. */
+  guardian_confirmed_at: string | null;
+  ip_address: string | null;
+  signed_at: string | null;
+}
+
+export interface PolicySignersResponse {
+  policy: Policy;
+  signers: PolicySigner[];
+}
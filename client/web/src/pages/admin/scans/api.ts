@@ -19,13 +19,13 @@ export async function fetchScanTypes(
 }
 
 export async function createScan(
-  userId: string,
+  qrToken: string,
   scanType: string,
   signal?: AbortSignal,
 ): Promise<ApiResponse<Scan>> {
   return postRequest<Scan>(
     "/admin/scans",
-    { user_id: userId, scan_type: scanType },
+    { qr_token: qrToken, scan_type: scanType },
     "scan",
     signal,
   );
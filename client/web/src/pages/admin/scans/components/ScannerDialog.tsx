@@ -28,9 +28,9 @@ export function ScannerDialog() {
 
   const handleScan = useCallback(
     (decodedText: string) => {
-      const userId = decodedText.trim();
-      if (!userId) return;
-      performScan(userId);
+      const qrToken = decodedText.trim();
+      if (!qrToken) return;
+      performScan(qrToken);
     },
     [performScan],
   );
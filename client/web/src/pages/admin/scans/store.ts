@@ -31,7 +31,7 @@ export interface ScansState {
 
   fetchTypes: (signal?: AbortSignal) => Promise<void>;
   fetchStats: (signal?: AbortSignal) => Promise<void>;
-  performScan: (userId: string) => Promise<void>;
+  performScan: (qrToken: string) => Promise<void>;
   rebalanceStats: () => Promise<void>;
   saveScanTypes: (
     scanTypes: ScanType[],
@@ -79,13 +79,13 @@ export const useScansStore = create<ScansState>((set, get) => ({
     }
   },
 
-  performScan: async (userId: string) => {
+  performScan: async (qrToken: string) => {
     const { activeScanType, scanning } = get();
     if (!activeScanType || scanning) return;
 
     set({ scanning: true, lastScanResult: null });
 
-    const res = await apiCreateScan(userId, activeScanType.name);
+    const res = await apiCreateScan(qrToken, activeScanType.name);
 
     if (res.status === 201 && res.data) {
       set({
@@ -100,7 +100,9 @@ export const useScansStore = create<ScansState>((set, get) => ({
       get().fetchStats();
     } else {
       let message = res.error || "Failed to create scan";
-      if (res.status === 404) {
+      if (res.status === 400 && res.error?.includes("QR token")) {
+        message = "QR code not recognized — ask the hacker to reopen their pass";
+      } else if (res.status === 404) {
         message = "User not found — QR code not recognized";
       } else if (res.status === 409) {
         message = `Already scanned for ${activeScanType.display_name}`;
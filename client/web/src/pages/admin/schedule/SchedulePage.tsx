@@ -104,6 +104,7 @@ export default function SchedulePage() {
         sessionID: sessionCounterRef.current,
         mode: "create",
         itemID: null,
+        updatedAt: null,
         dayIndex,
         startQuarter: boundedStart,
         endQuarter: boundedEnd,
@@ -133,6 +134,7 @@ export default function SchedulePage() {
         sessionID: sessionCounterRef.current,
         mode: "edit",
         itemID: item.id,
+        updatedAt: item.updatedAt,
         dayIndex: item.dayIndex,
         startQuarter: item.startQuarter,
         endQuarter: item.endQuarter,
@@ -1,6 +1,7 @@
 import {
   deleteRequest,
   getRequest,
+  ifMatch,
   postRequest,
   putRequest,
 } from "@/shared/lib/api";
@@ -56,6 +57,7 @@ export async function createScheduleItem(
 export async function updateScheduleItem(
   id: string,
   payload: CreateSchedulePayload,
+  updatedAt: string,
   signal?: AbortSignal,
 ): Promise<ApiResponse<ScheduleItemResponse>> {
   return putRequest<ScheduleItemResponse>(
@@ -63,6 +65,7 @@ export async function updateScheduleItem(
     payload,
     "schedule item",
     signal,
+    ifMatch(updatedAt),
   );
 }
 
@@ -6,7 +6,7 @@ import {
 } from "react";
 import { toast } from "sonner";
 
-import { errorAlert } from "@/shared/lib/api";
+import { errorAlert, isStaleWrite } from "@/shared/lib/api";
 
 import {
   createScheduleItem,
@@ -63,12 +63,22 @@ export function useScheduleCrud({
       setCreatingItem(true);
       const response =
         session.mode === "edit" && session.itemID
-          ? await updateScheduleItem(session.itemID, payload)
+          ? await updateScheduleItem(
+              session.itemID,
+              payload,
+              session.updatedAt ?? "",
+            )
           : await createScheduleItem(payload);
       setCreatingItem(false);
 
+      // Someone else saved this item first: show their version and close the
+      // composer so it is reopened against it.
+      const stale = isStaleWrite(response);
       const expectedStatus = session.mode === "edit" ? 200 : 201;
-      if (response.status !== expectedStatus || !response.data?.schedule) {
+      if (
+        (response.status !== expectedStatus && !stale) ||
+        !response.data?.schedule
+      ) {
         errorAlert(response);
         return false;
       }
@@ -77,10 +87,17 @@ export function useScheduleCrud({
         [response.data.schedule],
         scheduleDays,
       )[0];
-      if (!mappedItem) {
+      if (stale) {
         toast.error(
-          "Schedule item saved, but it is outside the current date range.",
+          "Someone else changed this item. Their version is shown; reopen it to edit.",
         );
+      }
+      if (!mappedItem) {
+        if (!stale) {
+          toast.error(
+            "Schedule item saved, but it is outside the current date range.",
+          );
+        }
         return true;
       }
 
@@ -100,7 +117,7 @@ export function useScheduleCrud({
           }
           return nextItems;
         });
-        toast.success("Schedule item updated");
+        if (!stale) toast.success("Schedule item updated");
       } else {
         setScheduleItems((current) => [...current, mappedItem]);
         toast.success("Schedule item created");
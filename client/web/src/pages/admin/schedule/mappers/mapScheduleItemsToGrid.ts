@@ -56,6 +56,7 @@ export function mapScheduleItemsToGrid(
         location: item.location,
         details: item.description,
         tags: item.tags ?? [],
+        updatedAt: item.updated_at,
       },
     ];
   });
@@ -13,6 +13,7 @@ export type ScheduleItem = {
   location: string;
   details: string;
   tags: string[];
+  updatedAt: string;
 };
 
 export type SelectionRange = {
@@ -36,6 +37,8 @@ export type ScheduleComposerSession = {
   sessionID: number;
   mode: ScheduleComposerMode;
   itemID: string | null;
+  /** updated_at of the item being edited, sent back as If-Match. */
+  updatedAt: string | null;
   dayIndex: number;
   startQuarter: number;
   endQuarter: number;
@@ -1,6 +1,7 @@
 import {
   deleteRequest,
   getRequest,
+  ifMatch,
   postRequest,
   putRequest,
 } from "@/shared/lib/api";
@@ -24,6 +25,7 @@ export async function createSponsor(
 export async function updateSponsor(
   id: string,
   payload: SponsorPayload,
+  updatedAt: string,
   signal?: AbortSignal,
 ): Promise<ApiResponse<Sponsor>> {
   return putRequest<Sponsor>(
@@ -31,6 +33,7 @@ export async function updateSponsor(
     payload,
     "sponsor",
     signal,
+    ifMatch(updatedAt),
   );
 }
 
@@ -193,11 +193,10 @@ export function SponsorsTable({
       display_order: editDisplayOrder,
     };
 
+    // Failures are surfaced by the store via errorAlert
     const success = await onUpdateSponsor(sponsor.id, payload);
     if (success) {
       toast.success("Sponsor updated");
-    } else {
-      toast.error("Failed to update sponsor");
     }
   }, [
     editingIndex,
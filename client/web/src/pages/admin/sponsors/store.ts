@@ -1,5 +1,7 @@
 import { create } from "zustand";
 
+import { errorAlert, isStaleWrite } from "@/shared/lib/api";
+
 import {
   createSponsor as apiCreateSponsor,
   deleteSponsor as apiDeleteSponsor,
@@ -24,7 +26,7 @@ export interface SponsorsState {
   ) => Promise<{ success: boolean } | null>;
 }
 
-export const useSponsorsStore = create<SponsorsState>((set) => ({
+export const useSponsorsStore = create<SponsorsState>((set, get) => ({
   sponsors: [],
   loading: false,
   saving: false,
@@ -59,18 +61,24 @@ export const useSponsorsStore = create<SponsorsState>((set) => ({
   },
 
   updateSponsor: async (id: string, payload: SponsorPayload) => {
+    const loaded = get().sponsors.find((s) => s.id === id);
+    if (!loaded) return false;
+
     set({ saving: true });
-    const res = await apiUpdateSponsor(id, payload);
-    if (res.status === 200 && res.data) {
+    const res = await apiUpdateSponsor(id, payload, loaded.updated_at);
+    // A stale write returns the current copy; take it so saving again
+    // overwrites that version knowingly.
+    if ((res.status === 200 || isStaleWrite(res)) && res.data) {
       const updated = res.data;
       set((state) => ({
-        sponsors: state.sponsors.map((s) =>
-          s.id === id ? (updated as Sponsor) : s,
-        ),
-        saving: false,
+        sponsors: state.sponsors.map((s) => (s.id === id ? updated : s)),
       }));
+    }
+    if (res.status === 200) {
+      set({ saving: false });
       return true;
     }
+    errorAlert(res);
     set({ saving: false });
     return false;
   },
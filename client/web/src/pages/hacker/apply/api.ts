@@ -1,6 +1,7 @@
 import {
   deleteRequest,
   getRequest,
+  ifMatch,
   patchRequest,
   postRequest,
 } from "@/shared/lib/api";
@@ -23,10 +24,39 @@ export interface ResumeUploadURLResponse {
   resume_path: string;
 }
 
+export interface ApplicationFileUploadURLResponse {
+  upload_url: string;
+  path: string;
+}
+
 export interface ResumeDownloadURLResponse {
   download_url: string;
 }
 
+/**
+ * Whether this hacker can work on their application right now. The countdowns
+ * come from the server clock; in_grace marks a draft finishing after the close.
+ */
+export interface ApplicationsStatus {
+  enabled: boolean;
+  in_grace: boolean;
+  opens_at: string | null;
+  closes_at: string | null;
+  timezone: string;
+  grace_minutes: number;
+  seconds_until_open?: number;
+  seconds_until_close?: number;
+}
+
+export async function fetchApplicationsStatus(): Promise<
+  ApiResponse<ApplicationsStatus>
+> {
+  return getRequest<ApplicationsStatus>(
+    "/applications/enabled",
+    "applications status",
+  );
+}
+
 function unwrapPatchedApplication(
   data: Application | DataEnvelope<Application> | undefined,
 ): Application | undefined {
@@ -37,17 +67,28 @@ function unwrapPatchedApplication(
   return data;
 }
 
+/**
+ * Saves the draft over the copy last loaded at updatedAt. A 409 means it was
+ * saved elsewhere since; data then holds the server's copy.
+ */
 export async function updateMyApplication(
   payload: UpdateApplicationPayload,
+  updatedAt: string,
 ): Promise<ApiResponse<Application>> {
   const res = await patchRequest<Application | DataEnvelope<Application>>(
     "/applications/me",
     payload,
     "application",
+    undefined,
+    ifMatch(updatedAt),
   );
 
   if (res.status !== 200) {
-    return { status: res.status, error: res.error };
+    return {
+      status: res.status,
+      data: unwrapPatchedApplication(res.data),
+      error: res.error,
+    };
   }
 
   const application = unwrapPatchedApplication(res.data);
@@ -71,6 +112,43 @@ export async function requestResumeUploadURL(): Promise<
   );
 }
 
+/** Upload URL for a schema file field; files follow the resume limits. */
+export async function requestApplicationFileUploadURL(
+  fieldId: string,
+): Promise<ApiResponse<ApplicationFileUploadURLResponse>> {
+  return postRequest<ApplicationFileUploadURLResponse>(
+    `/applications/me/files/${encodeURIComponent(fieldId)}/upload-url`,
+    {},
+    "file upload url",
+  );
+}
+
+export async function confirmMyAttendance(): Promise<ApiResponse<Application>> {
+  return postRequest<Application>(
+    "/applications/me/confirm",
+    {},
+    "confirmation",
+  );
+}
+
+export async function declineMyAttendance(): Promise<ApiResponse<Application>> {
+  return postRequest<Application>(
+    "/applications/me/decline",
+    {},
+    "confirmation",
+  );
+}
+
+export async function withdrawMyApplication(
+  reason: string,
+): Promise<ApiResponse<Application>> {
+  return postRequest<Application>(
+    "/applications/me/withdraw",
+    { reason },
+    "withdrawal",
+  );
+}
+
 export async function deleteMyResume(): Promise<ApiResponse<Application>> {
   return deleteRequest<Application>("/applications/me/resume", "resume");
 }
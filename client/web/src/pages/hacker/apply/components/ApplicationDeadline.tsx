@@ -0,0 +1,70 @@
+import { Clock } from "lucide-react";
+
+import { Alert, AlertDescription, AlertTitle } from "@/components/ui/alert";
+
+/** "2d 4h", "3h 12m", "5m 09s" — coarse far out, to the second near the end. */
+export function formatCountdown(ms: number): string {
+  const total = Math.max(0, Math.floor(ms / 1000));
+  const days = Math.floor(total / 86400);
+  const hours = Math.floor((total % 86400) / 3600);
+  const minutes = Math.floor((total % 3600) / 60);
+  const seconds = total % 60;
+  if (days > 0) return `${days}d ${hours}h`;
+  if (hours > 0) return `${hours}h ${minutes}m`;
+  return `${minutes}m ${String(seconds).padStart(2, "0")}s`;
+}
+
+/** The deadline in the hackathon's own timezone, e.g. "Oct 1, 11:59 PM CDT". */
+export function formatDeadline(iso: string, timeZone: string): string {
+  const options: Intl.DateTimeFormatOptions = {
+    month: "short",
+    day: "numeric",
+    hour: "numeric",
+    minute: "2-digit",
+    timeZoneName: "short",
+  };
+  try {
+    return new Intl.DateTimeFormat("en-US", { ...options, timeZone }).format(
+      new Date(iso),
+    );
+  } catch {
+    return new Intl.DateTimeFormat("en-US", options).format(new Date(iso));
+  }
+}
+
+interface ApplicationDeadlineProps {
+  /** Milliseconds left until the application locks. */
+  remainingMs: number;
+  closesAt: string | null;
+  timezone: string;
+  inGrace: boolean;
+}
+
+const DAY_MS = 24 * 60 * 60 * 1000;
+
+/**
+ * Countdown shown above the form. Hidden while the close is more than a day
+ * out, unless the hacker is already finishing in the grace period.
+ */
+export function ApplicationDeadline({
+  remainingMs,
+  closesAt,
+  timezone,
+  inGrace,
+}: ApplicationDeadlineProps) {
+  if (!inGrace && remainingMs > DAY_MS) return null;
+
+  return (
+    <Alert className="mb-6">
+      <Clock className="h-4 w-4" />
+      <AlertTitle>
+        {inGrace ? "Finish your draft" : "Applications close soon"}
+      </AlertTitle>
+      <AlertDescription>
+        {inGrace
+          ? `Applications closed${closesAt ? ` ${formatDeadline(closesAt, timezone)}` : ""}, but you can still finish and submit your draft for ${formatCountdown(remainingMs)}.`
+          : `Applications close in ${formatCountdown(remainingMs)}${closesAt ? ` (${formatDeadline(closesAt, timezone)})` : ""}.`}
+      </AlertDescription>
+    </Alert>
+  );
+}
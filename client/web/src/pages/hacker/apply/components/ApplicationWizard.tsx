@@ -7,7 +7,12 @@ import { useNavigate } from "react-router";
 import { Alert, AlertDescription, AlertTitle } from "@/components/ui/alert";
 import { Input } from "@/components/ui/input";
 import { Skeleton } from "@/components/ui/skeleton";
-import { errorAlert, getRequest, postRequest } from "@/shared/lib/api";
+import {
+  errorAlert,
+  getRequest,
+  isStaleWrite,
+  postRequest,
+} from "@/shared/lib/api";
 import { DEFAULT_FEATURE_FLAGS } from "@/shared/lib/feature-defaults";
 import {
   buildDefaultValues,
@@ -18,7 +23,9 @@ import {
 import type { Application, ApplicationSchemaField } from "@/types";
 
 import {
+  type ApplicationsStatus,
   deleteMyResume as deleteResume,
+  fetchApplicationsStatus,
   MAX_RESUME_SIZE_BYTES as MAX_RESUME_UPLOAD_SIZE_BYTES,
   requestResumeUploadURL as getResumeUploadURL,
   type UpdateApplicationPayload,
@@ -30,6 +37,11 @@ import { ReviewStep } from "../steps/ReviewStep";
 import { SchemaStepRenderer } from "../steps/SchemaStepRenderer";
 import { SponsorInfoStep } from "../steps/SponsorInfoStep";
 import { buildApplicationSchema } from "../validations";
+import {
+  ApplicationDeadline,
+  formatCountdown,
+  formatDeadline,
+} from "./ApplicationDeadline";
 import { StepIndicator } from "./StepIndicator";
 import { StepNavigation } from "./StepNavigation";
 
@@ -41,7 +53,7 @@ const PDF_MIME_TYPE = "application/pdf";
 const MAX_RESUME_SIZE_MB = MAX_RESUME_UPLOAD_SIZE_BYTES / (1024 * 1024);
 const AUTOSAVE_DEBOUNCE_MS = 1200;
 
-type AutosaveState = "idle" | "saving" | "saved" | "error";
+type AutosaveState = "idle" | "saving" | "saved" | "error" | "conflict";
 
 function stepStorageKey(applicationId: string): string {
   return `harp-apply-step:${applicationId}`;
@@ -101,9 +113,19 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
   const [autosaveState, setAutosaveState] = useState<AutosaveState>("idle");
   const [isUploadingResume, setIsUploadingResume] = useState(false);
   const [isDeletingResume, setIsDeletingResume] = useState(false);
+  // Server copy returned when a save lost to one made in another tab
+  const [conflict, setConflict] = useState<Application | null>(null);
   const [applicationsEnabled, setApplicationsEnabled] = useState<boolean>(
     DEFAULT_FEATURE_FLAGS.applicationsEnabled,
   );
+  const [windowStatus, setWindowStatus] = useState<ApplicationsStatus | null>(
+    null,
+  );
+  // Local instants for the server's countdowns, so the device clock's offset
+  // from the server doesn't move them
+  const [opensAtMs, setOpensAtMs] = useState<number | null>(null);
+  const [locksAtMs, setLocksAtMs] = useState<number | null>(null);
+  const [now, setNow] = useState(() => Date.now());
   // Schema is captured once from the initial load; mutation responses
   // (PATCH/DELETE) don't embed it and must not wipe it.
   const [schemaFields, setSchemaFields] = useState<ApplicationSchemaField[]>(
@@ -117,6 +139,30 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
   // Serializes saves so a slow request can't land after (and overwrite) a
   // newer one.
   const saveChain = useRef<Promise<boolean>>(Promise.resolve(true));
+  // updated_at of the copy the form last loaded or saved, sent as If-Match
+  const loadedAt = useRef("");
+
+  const adoptApplication = useCallback((app: Application) => {
+    loadedAt.current = app.updated_at;
+    setApplication(app);
+  }, []);
+
+  const adoptStatus = useCallback((status: ApplicationsStatus) => {
+    const received = Date.now();
+    setWindowStatus(status);
+    setApplicationsEnabled(status.enabled);
+    setOpensAtMs(
+      status.seconds_until_open === undefined
+        ? null
+        : received + status.seconds_until_open * 1000,
+    );
+    setLocksAtMs(
+      status.seconds_until_close === undefined
+        ? null
+        : received + status.seconds_until_close * 1000,
+    );
+    setNow(received);
+  }, []);
 
   // Derive sections from the schema
   const schemaSections = useMemo(
@@ -182,15 +228,12 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
     const loadApplication = async () => {
       const [appRes, enabledRes] = await Promise.all([
         getRequest<Application>("/applications/me", "application"),
-        getRequest<{ enabled: boolean }>(
-          "/applications/enabled",
-          "applications status",
-        ),
+        fetchApplicationsStatus(),
       ]);
 
       if (appRes.status === 200 && appRes.data) {
         const app = appRes.data;
-        setApplication(app);
+        adoptApplication(app);
         const schema = app.application_schema ?? [];
         setSchemaFields(schema);
         const formData = transformApplicationToFormData(app, schema);
@@ -214,13 +257,34 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
       }
 
       if (enabledRes.status === 200 && enabledRes.data) {
-        setApplicationsEnabled(enabledRes.data.enabled);
+        adoptStatus(enabledRes.data);
       }
 
       setLoading(false);
     };
     loadApplication();
-  }, [form]);
+  }, [form, adoptApplication, adoptStatus]);
+
+  // Tick while a countdown is showing
+  useEffect(() => {
+    if (opensAtMs === null && locksAtMs === null) return;
+    const interval = setInterval(() => setNow(Date.now()), 1000);
+    return () => clearInterval(interval);
+  }, [opensAtMs, locksAtMs]);
+
+  // Lock the form at the close, and ask the server again once it opens
+  useEffect(() => {
+    if (locksAtMs !== null && now >= locksAtMs) {
+      setLocksAtMs(null);
+      setApplicationsEnabled(false);
+    }
+    if (opensAtMs !== null && now >= opensAtMs) {
+      setOpensAtMs(null);
+      fetchApplicationsStatus().then((res) => {
+        if (res.status === 200 && res.data) adoptStatus(res.data);
+      });
+    }
+  }, [now, locksAtMs, opensAtMs, adoptStatus]);
 
   // Clamp so the index stays valid if the schema-driven steps ever shrink
   const safeCurrentStep = Math.min(currentStep, steps.length - 1);
@@ -233,19 +297,24 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
         form.getValues(),
         schemaFields,
       );
-      const res = await updateMyApplication(payload);
+      const res = await updateMyApplication(payload, loadedAt.current);
       if (res.status === 200 && res.data) {
-        setApplication(res.data);
+        adoptApplication(res.data);
         setAutosaveState("saved");
         return true;
       }
+      if (isStaleWrite(res) && res.data) {
+        setConflict(res.data);
+        setAutosaveState("conflict");
+        return false;
+      }
       setAutosaveState("error");
       return false;
     };
     const next = saveChain.current.then(run, run);
     saveChain.current = next.catch(() => false);
     return next;
-  }, [form, schemaFields]);
+  }, [form, schemaFields, adoptApplication]);
 
   const cancelPendingAutosave = useCallback(() => {
     if (autosaveTimer.current) {
@@ -262,6 +331,24 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
     }, AUTOSAVE_DEBOUNCE_MS);
   }, [cancelPendingAutosave, saveDraft]);
 
+  // Replace the form with the copy saved elsewhere
+  const loadServerCopy = () => {
+    if (!conflict) return;
+    cancelPendingAutosave();
+    adoptApplication(conflict);
+    form.reset(transformApplicationToFormData(conflict, schemaFields));
+    setConflict(null);
+    setAutosaveState("idle");
+  };
+
+  // Save the form's answers over the copy saved elsewhere
+  const keepMyEdits = () => {
+    if (!conflict) return;
+    adoptApplication(conflict);
+    setConflict(null);
+    void saveDraft();
+  };
+
   // Autosave whenever the user edits a field. Uses form.subscribe rather than
   // form.watch: watch() returns a non-memoizable value that makes the React
   // Compiler skip optimizing this component entirely.
@@ -444,12 +531,16 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
       return;
     }
 
-    const saveRes = await updateMyApplication({
-      resume_path: uploadURLRes.data.resume_path,
-    });
+    const saveRes = await updateMyApplication(
+      { resume_path: uploadURLRes.data.resume_path },
+      loadedAt.current,
+    );
     if (saveRes.status === 200 && saveRes.data) {
-      setApplication(saveRes.data);
+      adoptApplication(saveRes.data);
       setAutosaveState("saved");
+    } else if (isStaleWrite(saveRes) && saveRes.data) {
+      setConflict(saveRes.data);
+      setAutosaveState("conflict");
     } else {
       setApiError(saveRes.error || "Failed to save resume");
       errorAlert(saveRes);
@@ -476,7 +567,7 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
 
     const res = await deleteResume();
     if (res.status === 200 && res.data) {
-      setApplication(res.data);
+      adoptApplication(res.data);
       setAutosaveState("saved");
     } else {
       setApiError(res.error || "Failed to delete resume");
@@ -510,11 +601,14 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
     return (
       <div className="mx-auto max-w-md space-y-4 px-5 py-10 md:max-w-5xl">
         <h1 className="text-3xl font-light tracking-tight text-black">
-          Applications closed
+          {opensAtMs !== null
+            ? "Applications opening soon"
+            : "Applications closed"}
         </h1>
         <p className="text-sm font-light text-[#8A8A8A]">
-          The application portal is not currently accepting submissions. Please
-          check back later.
+          {opensAtMs !== null && windowStatus?.opens_at
+            ? `Applications open in ${formatCountdown(opensAtMs - now)}, on ${formatDeadline(windowStatus.opens_at, windowStatus.timezone)}.`
+            : "The application portal is not currently accepting submissions. Please check back later."}
           {application &&
             application.status === "draft" &&
             " Your draft has been saved and will be here when applications reopen."}
@@ -528,7 +622,9 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
     return (
       <div className="mx-auto max-w-md space-y-4 px-5 py-10 md:max-w-5xl">
         <h1 className="text-3xl font-light tracking-tight text-black">
-          Application submitted
+          {application.status === "withdrawn"
+            ? "Application withdrawn"
+            : "Application submitted"}
         </h1>
         <p className="text-sm font-light text-[#8A8A8A]">
           {application.status === "submitted" &&
@@ -539,6 +635,8 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
             "Unfortunately, your application was not accepted."}
           {application.status === "waitlisted" &&
             "You have been placed on the waitlist."}
+          {application.status === "withdrawn" &&
+            "You withdrew your application."}
         </p>
         <button
           type="button"
@@ -579,6 +677,7 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
         <SponsorInfoStep
           sectionLabel={sectionLabels[section] ?? section}
           fields={fields}
+          schema={schemaFields}
           hasResume={Boolean(application?.resume_path)}
           isUploadingResume={isUploadingResume}
           isDeletingResume={isDeletingResume}
@@ -619,6 +718,7 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
         sectionLabel={sectionLabels[section] ?? section}
         fields={fields}
         header={header}
+        schema={schemaFields}
       />
     );
   };
@@ -650,10 +750,48 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
           </Alert>
         )}
 
+        {locksAtMs !== null && windowStatus && (
+          <ApplicationDeadline
+            remainingMs={locksAtMs - now}
+            closesAt={windowStatus.closes_at}
+            timezone={windowStatus.timezone}
+            inGrace={windowStatus.in_grace}
+          />
+        )}
+
+        {conflict && (
+          <Alert className="mb-6">
+            <AlertCircle className="h-4 w-4" />
+            <AlertTitle>Changed in another tab</AlertTitle>
+            <AlertDescription>
+              <p>
+                Your application was saved somewhere else since this page
+                loaded, so your latest edits weren&apos;t saved.
+              </p>
+              <div className="mt-2 flex gap-4">
+                <button
+                  type="button"
+                  onClick={loadServerCopy}
+                  className="text-sm font-light text-black underline underline-offset-2"
+                >
+                  Load saved copy
+                </button>
+                <button
+                  type="button"
+                  onClick={keepMyEdits}
+                  className="text-sm font-light text-black underline underline-offset-2"
+                >
+                  Keep my edits
+                </button>
+              </div>
+            </AlertDescription>
+          </Alert>
+        )}
+
         <p
           aria-live="polite"
           className={`mb-4 h-4 text-xs font-light ${
-            autosaveState === "error"
+            autosaveState === "error" || autosaveState === "conflict"
               ? "text-red-500"
               : autosaveState === "saved"
                 ? "text-[#09D082]"
@@ -664,6 +802,7 @@ export function ApplicationWizard({ userEmail }: ApplicationWizardProps) {
           {autosaveState === "saved" && "Saved"}
           {autosaveState === "error" &&
             "Couldn't save your changes — check your connection"}
+          {autosaveState === "conflict" && "Not saved"}
         </p>
 
         <FormProvider {...form}>
@@ -1,5 +1,14 @@
-import { ArrowLeft, Check, ChevronDown, X } from "lucide-react";
-import { useCallback, useState } from "react";
+import {
+  ArrowDown,
+  ArrowLeft,
+  ArrowUp,
+  Check,
+  ChevronDown,
+  FileText,
+  Upload,
+  X,
+} from "lucide-react";
+import { type ChangeEvent, useCallback, useRef, useState } from "react";
 import {
   type ControllerRenderProps,
   type FieldValues,
@@ -31,12 +40,24 @@ import {
   PopoverTrigger,
 } from "@/components/ui/popover";
 import { Textarea } from "@/components/ui/textarea";
+import { COUNTRY_CODES } from "@/shared/data/country-codes";
 import { useIsMobile } from "@/shared/hooks";
+import { errorAlert } from "@/shared/lib/api";
 import { getFieldPresets } from "@/shared/lib/field-presets";
-import { renderLabel } from "@/shared/lib/schema-utils";
+import {
+  countryName,
+  renderLabel,
+  resolveVisibleFields,
+} from "@/shared/lib/schema-utils";
 import { cn } from "@/shared/lib/utils";
 import type { ApplicationSchemaField } from "@/types";
 
+import {
+  MAX_RESUME_SIZE_BYTES,
+  requestApplicationFileUploadURL,
+  uploadResumeToSignedURL,
+} from "../api";
+
 type ApplicationFormValues = FieldValues & Record<string, unknown>;
 type FormContext = ReturnType<typeof useFormContext<ApplicationFormValues>>;
 
@@ -59,14 +80,22 @@ interface SchemaStepRendererProps {
   fields: ApplicationSchemaField[];
   /** Extra content rendered before the fields (e.g., read-only email). */
   header?: React.ReactNode;
+  /** The whole schema, so show_if rules can refer to other sections. */
+  schema?: ApplicationSchemaField[];
 }
 
 export function SchemaStepRenderer({
   sectionLabel,
-  fields,
+  fields: sectionFields,
   header,
+  schema,
 }: SchemaStepRendererProps) {
   const form = useFormContext<ApplicationFormValues>();
+  const fields = resolveVisibleFields(
+    sectionFields,
+    schema ?? sectionFields,
+    form.watch(),
+  );
 
   // Index where the trailing run of all-optional fields begins — the point
   // below which everything is optional. Anchoring the "OPTIONAL" divider here
@@ -162,6 +191,130 @@ function SchemaFormField({
       );
     }
 
+    case "email":
+    case "url":
+      return (
+        <FormField
+          control={form.control}
+          name={field.id}
+          render={({ field: formField }) => (
+            <FormItem>
+              <FormLabel className={fieldLabel}>
+                {field.label}
+                {requiredMark}
+              </FormLabel>
+              <FormControl>
+                <Input
+                  className={underlineField}
+                  type={field.type}
+                  inputMode={field.type}
+                  placeholder={
+                    field.type === "email"
+                      ? "name@example.com"
+                      : "https://example.com"
+                  }
+                  {...formField}
+                  value={formField.value ?? ""}
+                />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+      );
+
+    case "date":
+      return (
+        <FormField
+          control={form.control}
+          name={field.id}
+          render={({ field: formField }) => (
+            <FormItem>
+              <FormLabel className={fieldLabel}>
+                {field.label}
+                {requiredMark}
+              </FormLabel>
+              <FormControl>
+                <Input
+                  className={underlineField}
+                  type="date"
+                  min={validation.min as string | undefined}
+                  max={validation.max as string | undefined}
+                  {...formField}
+                  value={formField.value ?? ""}
+                />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+      );
+
+    case "country":
+      return (
+        <FormField
+          control={form.control}
+          name={field.id}
+          render={({ field: formField }) => (
+            <FormItem>
+              <FormLabel className={fieldLabel}>
+                {field.label}
+                {requiredMark}
+              </FormLabel>
+              <SchemaCombobox
+                field={field}
+                formField={formField}
+                options={field.options?.length ? field.options : COUNTRY_CODES}
+                allowOther={false}
+                labelFor={countryName}
+              />
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+      );
+
+    case "ranked_choice":
+      return (
+        <FormField
+          control={form.control}
+          name={field.id}
+          render={({ field: formField }) => (
+            <FormItem>
+              <FormLabel className={fieldLabel}>
+                {field.label}
+                {requiredMark}
+              </FormLabel>
+              <FormDescription className="text-xs font-light">
+                {typeof validation.maxItems === "number"
+                  ? `Rank up to ${validation.maxItems}, most preferred first`
+                  : "Rank in order of preference, most preferred first"}
+              </FormDescription>
+              <RankedChoiceInput field={field} formField={formField} />
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+      );
+
+    case "file":
+      return (
+        <FormField
+          control={form.control}
+          name={field.id}
+          render={({ field: formField }) => (
+            <FormItem>
+              <FormLabel className={fieldLabel}>
+                {field.label}
+                {requiredMark}
+              </FormLabel>
+              <FileUploadInput field={field} formField={formField} />
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+      );
+
     case "phone":
       return (
         <FormField
@@ -260,7 +413,15 @@ function SchemaFormField({
                 {field.label}
                 {requiredMark}
               </FormLabel>
-              <SchemaSelect field={field} formField={formField} />
+              {validation.allowOther === true ? (
+                <SchemaCombobox
+                  field={field}
+                  formField={formField}
+                  options={field.options ?? []}
+                />
+              ) : (
+                <SchemaSelect field={field} formField={formField} />
+              )}
               <FormMessage />
             </FormItem>
           )}
@@ -276,7 +437,9 @@ function SchemaFormField({
             <FormItem>
               <FormLabel className={fieldLabel}>{field.label}</FormLabel>
               <FormDescription className="text-xs font-light">
-                Select all that apply
+                {typeof validation.maxItems === "number"
+                  ? `Select up to ${validation.maxItems}`
+                  : "Select all that apply"}
               </FormDescription>
               <div className="mt-2 grid grid-cols-2 gap-3">
                 {(field.options ?? []).map((opt) => (
@@ -311,6 +474,34 @@ function SchemaFormField({
                   />
                 ))}
               </div>
+              {validation.allowOther === true && (
+                <FormField
+                  control={form.control}
+                  name={field.id}
+                  render={({ field: formField }) => {
+                    const options = field.options ?? [];
+                    const value = (formField.value as string[]) || [];
+                    const listed = value.filter((v) => options.includes(v));
+                    const other = value.find((v) => !options.includes(v));
+                    return (
+                      <FormControl>
+                        <Input
+                          className={underlineField}
+                          placeholder="Other (please specify)"
+                          value={other ?? ""}
+                          onChange={(e) =>
+                            formField.onChange(
+                              e.target.value
+                                ? [...listed, e.target.value]
+                                : listed,
+                            )
+                          }
+                        />
+                      </FormControl>
+                    );
+                  }}
+                />
+              )}
               <FormMessage />
             </FormItem>
           )}
@@ -348,6 +539,184 @@ function SchemaFormField({
   }
 }
 
+/**
+ * Ranks a field's options: picked options are listed in order with controls
+ * to move or drop them, and the rest can be added to the end. The stored value
+ * is the ordered list of picked options.
+ */
+function RankedChoiceInput({
+  field,
+  formField,
+}: {
+  field: ApplicationSchemaField;
+  formField: ControllerRenderProps<ApplicationFormValues>;
+}) {
+  const ranked = (formField.value as string[]) || [];
+  const maxItems = field.validation?.maxItems;
+  const full = typeof maxItems === "number" && ranked.length >= maxItems;
+  const unranked = (field.options ?? []).filter((o) => !ranked.includes(o));
+
+  const move = (from: number, to: number) => {
+    const next = [...ranked];
+    const [item] = next.splice(from, 1);
+    next.splice(to, 0, item);
+    formField.onChange(next);
+  };
+
+  return (
+    <div className="space-y-3">
+      {ranked.length > 0 && (
+        <ol className="space-y-2">
+          {ranked.map((opt, i) => (
+            <li
+              key={opt}
+              className="flex items-center gap-3 border-b border-[#D9D9D9] pb-2 text-sm font-light"
+            >
+              <span className="w-5 text-[#8A8A8A]">{i + 1}.</span>
+              <span className="flex-1 truncate">{opt}</span>
+              <button
+                type="button"
+                aria-label={`Move ${opt} up`}
+                disabled={i === 0}
+                onClick={() => move(i, i - 1)}
+                className="text-[#8A8A8A] hover:text-black disabled:opacity-30"
+              >
+                <ArrowUp className="size-4" />
+              </button>
+              <button
+                type="button"
+                aria-label={`Move ${opt} down`}
+                disabled={i === ranked.length - 1}
+                onClick={() => move(i, i + 1)}
+                className="text-[#8A8A8A] hover:text-black disabled:opacity-30"
+              >
+                <ArrowDown className="size-4" />
+              </button>
+              <button
+                type="button"
+                aria-label={`Remove ${opt}`}
+                onClick={() =>
+                  formField.onChange(ranked.filter((v) => v !== opt))
+                }
+                className="text-[#8A8A8A] hover:text-black"
+              >
+                <X className="size-4" />
+              </button>
+            </li>
+          ))}
+        </ol>
+      )}
+      {!full && unranked.length > 0 && (
+        <div className="flex flex-wrap gap-2">
+          {unranked.map((opt) => (
+            <button
+              key={opt}
+              type="button"
+              onClick={() => formField.onChange([...ranked, opt])}
+              className="rounded-full border border-[#D9D9D9] px-3 py-1 text-sm font-light transition-colors hover:border-black"
+            >
+              {opt}
+            </button>
+          ))}
+        </div>
+      )}
+    </div>
+  );
+}
+
+/**
+ * Uploads a PDF for a file field through a signed URL and stores the object
+ * path as the answer. The server checks the upload when the draft is saved.
+ */
+function FileUploadInput({
+  field,
+  formField,
+}: {
+  field: ApplicationSchemaField;
+  formField: ControllerRenderProps<ApplicationFormValues>;
+}) {
+  const inputRef = useRef<HTMLInputElement | null>(null);
+  const [uploading, setUploading] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+  const path = (formField.value as string) ?? "";
+
+  const handleFile = async (event: ChangeEvent<HTMLInputElement>) => {
+    const file = event.target.files?.[0];
+    event.target.value = "";
+    if (!file) return;
+
+    if (!file.name.toLowerCase().endsWith(".pdf")) {
+      setError("File must be a PDF.");
+      return;
+    }
+    if (file.size > MAX_RESUME_SIZE_BYTES) {
+      setError(
+        `File must be ${MAX_RESUME_SIZE_BYTES / (1024 * 1024)} MB or smaller.`,
+      );
+      return;
+    }
+
+    setError(null);
+    setUploading(true);
+    const urlRes = await requestApplicationFileUploadURL(field.id);
+    if (urlRes.status !== 200 || !urlRes.data) {
+      errorAlert(urlRes);
+      setUploading(false);
+      return;
+    }
+    const uploadRes = await uploadResumeToSignedURL(
+      urlRes.data.upload_url,
+      file,
+    );
+    if (uploadRes.status < 200 || uploadRes.status >= 300) {
+      setError(uploadRes.error ?? "Upload failed.");
+      setUploading(false);
+      return;
+    }
+    formField.onChange(urlRes.data.path);
+    setUploading(false);
+  };
+
+  return (
+    <div className="space-y-2">
+      <input
+        ref={inputRef}
+        type="file"
+        accept="application/pdf,.pdf"
+        onChange={handleFile}
+        className="hidden"
+      />
+      <div className="flex items-center gap-3">
+        {path && (
+          <span className="flex items-center gap-1.5 text-sm font-light">
+            <FileText className="size-4" />
+            PDF uploaded
+          </span>
+        )}
+        <button
+          type="button"
+          disabled={uploading}
+          onClick={() => inputRef.current?.click()}
+          className="flex items-center gap-1.5 text-sm font-light text-[#8A8A8A] transition-colors hover:text-black disabled:opacity-50"
+        >
+          <Upload className="size-4" />
+          {uploading ? "Uploading..." : path ? "Replace" : "Upload PDF"}
+        </button>
+        {path && !uploading && (
+          <button
+            type="button"
+            onClick={() => formField.onChange("")}
+            className="text-sm font-light text-[#8A8A8A] transition-colors hover:text-black"
+          >
+            Remove
+          </button>
+        )}
+      </div>
+      {error && <p className="text-xs font-light text-red-600">{error}</p>}
+    </div>
+  );
+}
+
 /** Strip a value down to its 10 US national digits (drops +1 and formatting). */
 function usNationalDigits(value: string): string {
   return value.replace(/\D/g, "").replace(/^1/, "").slice(0, 10);
@@ -473,10 +842,16 @@ function SchemaCombobox({
   field,
   formField,
   options,
+  allowOther = true,
+  labelFor = (v) => v,
 }: {
   field: ApplicationSchemaField;
   formField: ControllerRenderProps<ApplicationFormValues>;
   options: readonly string[];
+  /** Offer the "Other" free-text escape hatch. */
+  allowOther?: boolean;
+  /** Display text for a stored option, e.g. a country name for its code. */
+  labelFor?: (value: string) => string;
 }) {
   const value = (formField.value as string) ?? "";
   const [open, setOpen] = useState(false);
@@ -485,7 +860,7 @@ function SchemaCombobox({
   // Free-text mode: on first render, infer it from a saved value that isn't a
   // known preset (e.g. a resumed draft or an existing submission).
   const [otherMode, setOtherMode] = useState(
-    () => value !== "" && !options.includes(value),
+    () => allowOther && value !== "" && !options.includes(value),
   );
 
   if (otherMode) {
@@ -533,7 +908,9 @@ function SchemaCombobox({
             )}
           >
             <span className={cn("truncate", !value && "text-sm")}>
-              {value || `Select ${field.label.toLowerCase()}`}
+              {value
+                ? labelFor(value)
+                : `Select ${field.label.toLowerCase()}`}
             </span>
             <ChevronDown
               className={cn(
@@ -570,8 +947,9 @@ function SchemaCombobox({
                 value={value}
                 query={query}
                 setQuery={setQuery}
-                setOtherMode={setOtherMode}
+                setOtherMode={allowOther ? setOtherMode : undefined}
                 setOpen={setOpen}
+                labelFor={labelFor}
                 fullHeight
               />
             </div>
@@ -594,7 +972,9 @@ function SchemaCombobox({
           )}
         >
           <span className={cn("truncate", !value && "text-sm")}>
-            {value || `Select ${field.label.toLowerCase()}`}
+            {value
+              ? labelFor(value)
+              : `Select ${field.label.toLowerCase()}`}
           </span>
           <ChevronDown
             className={cn(
@@ -616,8 +996,9 @@ function SchemaCombobox({
           value={value}
           query={query}
           setQuery={setQuery}
-          setOtherMode={setOtherMode}
+          setOtherMode={allowOther ? setOtherMode : undefined}
           setOpen={setOpen}
+          labelFor={labelFor}
         />
       </PopoverContent>
     </Popover>
@@ -638,6 +1019,7 @@ function ComboboxContent({
   setQuery,
   setOtherMode,
   setOpen,
+  labelFor,
   fullHeight,
 }: {
   field: ApplicationSchemaField;
@@ -646,8 +1028,10 @@ function ComboboxContent({
   value: string;
   query: string;
   setQuery: (q: string) => void;
-  setOtherMode: (v: boolean) => void;
+  /** Omitted when the field has no "Other" escape hatch. */
+  setOtherMode?: (v: boolean) => void;
   setOpen: (v: boolean) => void;
+  labelFor: (value: string) => string;
   /** When true, the option list fills available space (for the mobile Dialog). */
   fullHeight?: boolean;
 }) {
@@ -660,7 +1044,7 @@ function ComboboxContent({
   );
 
   const handleOther = useCallback(() => {
-    setOtherMode(true);
+    setOtherMode?.(true);
     formField.onChange(query);
     setOpen(false);
   }, [setOtherMode, formField, query, setOpen]);
@@ -680,30 +1064,34 @@ function ComboboxContent({
         )}
       >
         <CommandEmpty className="px-5 py-3 text-left text-sm font-light text-white/60">
-          No matches — choose "Other" below to enter it manually.
+          {setOtherMode
+            ? 'No matches — choose "Other" below to enter it manually.'
+            : "No matches."}
         </CommandEmpty>
         <CommandGroup className="p-0">
           {options.map((opt) => (
             <CommandItem
               key={opt}
-              value={opt}
+              value={labelFor(opt)}
               onSelect={() => handleSelect(opt)}
               className="cursor-pointer justify-between rounded-none border-b border-white/[0.08] px-5 py-3.5 text-sm font-light text-white/90 data-[selected=true]:bg-white/[0.07] data-[selected=true]:text-white"
             >
-              <span className="truncate">{opt}</span>
+              <span className="truncate">{labelFor(opt)}</span>
               {opt === value && <Check className="size-4 shrink-0" />}
             </CommandItem>
           ))}
         </CommandGroup>
       </CommandList>
       {/* Outside CommandList so it's never hidden by the search filter. */}
-      <button
-        type="button"
-        className="flex w-full items-center gap-2 border-t border-white/[0.08] px-5 py-3.5 text-left text-sm font-light text-white/70 transition-colors hover:bg-white/[0.07] hover:text-white"
-        onClick={handleOther}
-      >
-        Other (enter manually)
-      </button>
+      {setOtherMode && (
+        <button
+          type="button"
+          className="flex w-full items-center gap-2 border-t border-white/[0.08] px-5 py-3.5 text-left text-sm font-light text-white/70 transition-colors hover:bg-white/[0.07] hover:text-white"
+          onClick={handleOther}
+        >
+          Other (enter manually)
+        </button>
+      )}
     </Command>
   );
 }
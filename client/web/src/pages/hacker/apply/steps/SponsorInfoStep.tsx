@@ -12,6 +12,7 @@ const MAX_RESUME_SIZE_MB = MAX_RESUME_UPLOAD_SIZE_BYTES / (1024 * 1024);
 interface SponsorInfoStepProps {
   sectionLabel: string;
   fields: ApplicationSchemaField[];
+  schema?: ApplicationSchemaField[];
   hasResume: boolean;
   isUploadingResume: boolean;
   isDeletingResume: boolean;
@@ -22,6 +23,7 @@ interface SponsorInfoStepProps {
 export function SponsorInfoStep({
   sectionLabel,
   fields,
+  schema,
   hasResume,
   isUploadingResume,
   isDeletingResume,
@@ -42,7 +44,11 @@ export function SponsorInfoStep({
   return (
     <div className="space-y-7">
       {fields.length > 0 ? (
-        <SchemaStepRenderer sectionLabel={sectionLabel} fields={fields} />
+        <SchemaStepRenderer
+          sectionLabel={sectionLabel}
+          fields={fields}
+          schema={schema}
+        />
       ) : (
         <h1 className="text-3xl font-light tracking-tight text-black">
           {sectionLabel}
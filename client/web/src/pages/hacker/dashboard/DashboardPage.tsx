@@ -81,6 +81,8 @@ function dashboardStatus(application: Application | null): {
       return { label: "In progress", color: "bg-gray-100 text-gray-800" };
     case "submitted":
       return { label: "Under review", color: "bg-white/15" };
+    case "withdrawn":
+      return { label: "Withdrawn", color: "bg-white/15" };
     default:
       // accepted / rejected / waitlisted — never reveal the outcome here
       return {
@@ -28,7 +28,7 @@ import {
 import { Avatar, AvatarFallback, AvatarImage } from "@/components/ui/avatar";
 import { Switch } from "@/components/ui/switch";
 import { useInstallPrompt } from "@/shared/install";
-import { errorAlert, getRequest } from "@/shared/lib/api";
+import { errorAlert, getRequest, isStaleWrite } from "@/shared/lib/api";
 import { usePushSubscription } from "@/shared/push/usePushSubscription";
 import { usePointsConfigStore, useUserStore } from "@/shared/stores";
 import type { Application } from "@/types";
@@ -165,7 +165,7 @@ export default function ProfilePage() {
   const handleResumeFile = async (event: ChangeEvent<HTMLInputElement>) => {
     const file = event.target.files?.[0];
     event.target.value = "";
-    if (!file || resumeBusy) return;
+    if (!file || !application || resumeBusy) return;
 
     const isPDF =
       file.type === PDF_MIME_TYPE ||
@@ -200,11 +200,17 @@ export default function ProfilePage() {
       return;
     }
 
-    const saveRes = await updateMyApplication({
-      resume_path: urlRes.data.resume_path,
-    });
+    const saveRes = await updateMyApplication(
+      { resume_path: urlRes.data.resume_path },
+      application.updated_at,
+    );
     if (saveRes.status === 200 && saveRes.data) {
       setApplication(saveRes.data);
+    } else if (isStaleWrite(saveRes) && saveRes.data) {
+      setApplication(saveRes.data);
+      setResumeError(
+        "Your application changed in another tab. Upload the resume again.",
+      );
     } else {
       setResumeError(saveRes.error || "Failed to save resume");
       errorAlert(saveRes);
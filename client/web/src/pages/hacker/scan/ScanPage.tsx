@@ -6,7 +6,11 @@ import Session from "supertokens-auth-react/recipe/session";
 import { useUserStore } from "@/shared/stores";
 
 import { HackerQR } from "../components/HackerQR";
-import { APPLE_WALLET_PASS_URL, getAppleWalletStatus } from "./api";
+import {
+  APPLE_WALLET_PASS_URL,
+  getAppleWalletStatus,
+  getMyQRToken,
+} from "./api";
 
 function isIOS(): boolean {
   if (typeof navigator === "undefined") {
@@ -20,6 +24,24 @@ export default function ScanPage() {
   const [walletAvailableForUser, setWalletAvailableForUser] = useState<
     string | null
   >(null);
+  const [qrToken, setQRToken] = useState<{
+    userId: string;
+    token: string;
+  } | null>(null);
+
+  useEffect(() => {
+    if (!user?.id) return;
+
+    const userId = user.id;
+    const controller = new AbortController();
+    void getMyQRToken(controller.signal).then((response) => {
+      if (response.status === 200 && response.data) {
+        setQRToken({ userId, token: response.data.token });
+      }
+    });
+
+    return () => controller.abort();
+  }, [user?.id]);
 
   useEffect(() => {
     if (!user?.id || !isIOS()) return;
@@ -58,9 +80,15 @@ export default function ScanPage() {
       </p>
 
       {user?.id ? (
-        <div className="mt-8 rounded-xl border border-[#E5E5E5] p-4 shadow-[0_2px_16px_rgba(0,0,0,0.06)]">
-          <HackerQR value={user.id} size={240} />
-        </div>
+        qrToken?.userId === user.id ? (
+          <div className="mt-8 rounded-xl border border-[#E5E5E5] p-4 shadow-[0_2px_16px_rgba(0,0,0,0.06)]">
+            <HackerQR value={qrToken.token} size={240} />
+          </div>
+        ) : (
+          <p className="mt-8 text-sm font-light text-[#8A8A8A]">
+            Loading your code...
+          </p>
+        )
       ) : (
         <p className="mt-8 text-sm font-light text-[#8A8A8A]">
           Sign in to view your code.
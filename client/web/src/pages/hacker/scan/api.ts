@@ -4,6 +4,14 @@ interface AppleWalletStatus {
   available: boolean;
 }
 
+interface QRTokenResponse {
+  token: string;
+}
+
+export async function getMyQRToken(signal?: AbortSignal) {
+  return getRequest<QRTokenResponse>("/users/me/qr-token", "QR code", signal);
+}
+
 export async function getAppleWalletStatus(signal?: AbortSignal) {
   return getRequest<AppleWalletStatus>(
     "/wallet/apple-pass/status",
@@ -0,0 +1,244 @@
+import { format, parseISO } from "date-fns";
+import { ChevronRight } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { errorAlert } from "@/shared/lib/api";
+import type { MyPolicy } from "@/types";
+
+import { fetchMyPolicies, signPolicy } from "./api";
+
+function policyState(policy: MyPolicy): string {
+  if (policy.current && policy.signature) {
+    return `Signed ${format(parseISO(policy.signature.signed_at), "MMM d")}`;
+  }
+  if (policy.signature?.version === policy.version) {
+    return policy.signature.guardian_name
+      ? "Waiting for guardian"
+      : "Needs guardian";
+  }
+  if (policy.signature) return "Updated, sign again";
+  return policy.required ? "Required" : "Optional";
+}
+
+function SignDialog({
+  policy,
+  guardianRequired,
+  onOpenChange,
+  onSigned,
+}: {
+  policy: MyPolicy | null;
+  guardianRequired: boolean;
+  onOpenChange: (open: boolean) => void;
+  onSigned: () => void;
+}) {
+  const [signedName, setSignedName] = useState("");
+  const [guardianName, setGuardianName] = useState("");
+  const [guardianEmail, setGuardianEmail] = useState("");
+  const [submitting, setSubmitting] = useState(false);
+
+  useEffect(() => {
+    setSignedName("");
+  }, [policy]);
+
+  const canSign =
+    signedName.trim() !== "" &&
+    (!guardianRequired ||
+      (guardianName.trim() !== "" && guardianEmail.trim() !== ""));
+
+  const handleSign = async () => {
+    if (!policy) return;
+    setSubmitting(true);
+    const res = await signPolicy(policy.id, {
+      version: policy.version,
+      signed_name: signedName.trim(),
+      ...(guardianRequired && {
+        guardian_name: guardianName.trim(),
+        guardian_email: guardianEmail.trim(),
+      }),
+    });
+    setSubmitting(false);
+    if (res.status === 201) {
+      toast.success(
+        guardianRequired
+          ? `Signed ${policy.title}. We emailed ${guardianName.trim()} to confirm.`
+          : `Signed ${policy.title}`,
+      );
+      onSigned();
+    } else {
+      // A 409 means the text changed while it was open; reloading shows the
+      // new version.
+      errorAlert(res);
+      if (res.status === 409) onSigned();
+    }
+  };
+
+  const alreadySigned = policy?.current ?? false;
+  const signature = policy?.signature ?? null;
+  const pendingGuardian =
+    !alreadySigned &&
+    signature?.version === policy?.version &&
+    signature?.guardian_name
+      ? signature
+      : null;
+
+  return (
+    <Dialog open={policy !== null} onOpenChange={onOpenChange}>
+      <DialogContent className="sm:max-w-lg">
+        <DialogHeader>
+          <DialogTitle>{policy?.title}</DialogTitle>
+          <DialogDescription>Version {policy?.version}</DialogDescription>
+        </DialogHeader>
+        <div className="max-h-[45vh] overflow-auto rounded-md border border-[#E5E5E5] p-3 text-sm font-light whitespace-pre-wrap text-black">
+          {policy?.body}
+        </div>
+        {alreadySigned && policy?.signature ? (
+          <p className="text-xs font-light text-[#8A8A8A]">
+            Signed as {policy.signature.signed_name} on{" "}
+            {format(parseISO(policy.signature.signed_at), "MMM d, yyyy")}.
+          </p>
+        ) : (
+          <div className="space-y-3">
+            <div className="space-y-1.5">
+              <Label htmlFor="policy-signed-name">
+                Type your full name to sign
+              </Label>
+              <Input
+                id="policy-signed-name"
+                value={signedName}
+                onChange={(e) => setSignedName(e.target.value)}
+                maxLength={200}
+              />
+            </div>
+            {pendingGuardian && (
+              <p className="text-xs font-light text-[#8A8A8A]">
+                Waiting for {pendingGuardian.guardian_name} to confirm from
+                the link we sent to {pendingGuardian.guardian_email}. Sign
+                again to correct their email or send a new link.
+              </p>
+            )}
+            {guardianRequired && (
+              <>
+                <p className="text-xs font-light text-[#8A8A8A]">
+                  You're under 18, so a parent or guardian must agree too.
+                  We'll email them a link to confirm.
+                </p>
+                <div className="space-y-1.5">
+                  <Label htmlFor="policy-guardian-name">
+                    Parent or guardian's full name
+                  </Label>
+                  <Input
+                    id="policy-guardian-name"
+                    value={guardianName}
+                    onChange={(e) => setGuardianName(e.target.value)}
+                    maxLength={200}
+                  />
+                </div>
+                <div className="space-y-1.5">
+                  <Label htmlFor="policy-guardian-email">
+                    Parent or guardian's email
+                  </Label>
+                  <Input
+                    id="policy-guardian-email"
+                    type="email"
+                    value={guardianEmail}
+                    onChange={(e) => setGuardianEmail(e.target.value)}
+                  />
+                </div>
+              </>
+            )}
+          </div>
+        )}
+        {!alreadySigned && (
+          <DialogFooter>
+            <Button
+              onClick={handleSign}
+              disabled={submitting || !canSign}
+              className="h-10 w-full rounded-full bg-black text-sm font-normal text-white hover:bg-black/85"
+            >
+              I agree
+            </Button>
+          </DialogFooter>
+        )}
+      </DialogContent>
+    </Dialog>
+  );
+}
+
+export function PoliciesCard() {
+  const [policies, setPolicies] = useState<MyPolicy[]>([]);
+  const [guardianRequired, setGuardianRequired] = useState(false);
+  const [selected, setSelected] = useState<MyPolicy | null>(null);
+  const [reload, setReload] = useState(0);
+
+  useEffect(() => {
+    const controller = new AbortController();
+    fetchMyPolicies(controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setPolicies(res.data.policies);
+        setGuardianRequired(res.data.guardian_required);
+      } else {
+        errorAlert(res);
+      }
+    });
+    return () => controller.abort();
+  }, [reload]);
+
+  if (policies.length === 0) return null;
+
+  const outstanding = policies.filter((p) => p.required && !p.current).length;
+
+  return (
+    <section className="mt-5 rounded-xl border border-[#E5E5E5] px-5 py-4">
+      <p className="text-sm font-normal text-black">Waivers & policies</p>
+      <p className="mt-1 text-xs font-light text-[#8A8A8A]">
+        {outstanding > 0
+          ? `Sign ${outstanding} more before check-in.`
+          : "You're all set for check-in."}
+      </p>
+      <ul className="mt-3 divide-y divide-[#F0F0F0]">
+        {policies.map((policy) => (
+          <li key={policy.id}>
+            <button
+              type="button"
+              onClick={() => setSelected(policy)}
+              className="flex w-full items-center justify-between gap-4 py-2.5 text-left"
+            >
+              <span className="text-sm font-light text-black">
+                {policy.title}
+              </span>
+              <span className="flex items-center gap-1 text-xs font-light text-[#8A8A8A]">
+                {policyState(policy)}
+                <ChevronRight className="size-4" strokeWidth={1.5} />
+              </span>
+            </button>
+          </li>
+        ))}
+      </ul>
+
+      <SignDialog
+        policy={selected}
+        guardianRequired={guardianRequired}
+        onOpenChange={(open) => {
+          if (!open) setSelected(null);
+        }}
+        onSigned={() => {
+          setSelected(null);
+          setReload((n) => n + 1);
+        }}
+      />
+    </section>
+  );
+}
@@ -0,0 +1,167 @@
+import { useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Input } from "@/components/ui/input";
+import { Textarea } from "@/components/ui/textarea";
+import { errorAlert } from "@/shared/lib/api";
+import type { MyProjectResponse, Project } from "@/types";
+
+import { fetchMyProject, saveMyProject } from "./api";
+
+function formatTimestamp(iso: string): string {
+  return new Date(iso).toLocaleString(undefined, {
+    month: "short",
+    day: "numeric",
+    hour: "numeric",
+    minute: "2-digit",
+  });
+}
+
+export function ProjectCard() {
+  const [loading, setLoading] = useState(true);
+  const [config, setConfig] = useState<MyProjectResponse | null>(null);
+  const [submitting, setSubmitting] = useState(false);
+  const [title, setTitle] = useState("");
+  const [description, setDescription] = useState("");
+  const [repoUrl, setRepoUrl] = useState("");
+  const [demoUrl, setDemoUrl] = useState("");
+  const [tracks, setTracks] = useState<string[]>([]);
+
+  const fill = (project: Project | null) => {
+    setTitle(project?.title ?? "");
+    setDescription(project?.description ?? "");
+    setRepoUrl(project?.repo_url ?? "");
+    setDemoUrl(project?.demo_url ?? "");
+    setTracks(project?.tracks ?? []);
+  };
+
+  useEffect(() => {
+    const controller = new AbortController();
+    fetchMyProject(controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      // 404 means the hacker is not on a team yet; TeamCard covers that.
+      if (res.status === 200 && res.data) {
+        setConfig(res.data);
+        fill(res.data.project);
+      } else if (res.status !== 404) {
+        errorAlert(res);
+      }
+      setLoading(false);
+    });
+    return () => controller.abort();
+  }, []);
+
+  const handleSave = async () => {
+    setSubmitting(true);
+    const res = await saveMyProject({
+      title: title.trim(),
+      description: description.trim(),
+      repo_url: repoUrl.trim() || null,
+      demo_url: demoUrl.trim() || null,
+      tracks,
+    });
+    setSubmitting(false);
+    if (res.status === 200 && res.data) {
+      setConfig(res.data);
+      fill(res.data.project);
+      toast.success("Project saved");
+    } else if (res.status === 403) {
+      errorAlert(res, "Project submissions are closed");
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const toggleTrack = (track: string, checked: boolean) => {
+    setTracks((prev) =>
+      checked ? [...prev, track] : prev.filter((t) => t !== track),
+    );
+  };
+
+  if (loading || !config) return null;
+
+  const { project, editable, submission_deadline } = config;
+
+  return (
+    <section className="mt-5 rounded-xl border border-[#E5E5E5] px-5 py-4">
+      <div className="flex items-baseline justify-between gap-4">
+        <p className="text-sm font-normal text-black">Project</p>
+        {submission_deadline && (
+          <p className="text-xs font-light text-[#8A8A8A]">
+            {editable ? "Due" : "Closed"} {formatTimestamp(submission_deadline)}
+          </p>
+        )}
+      </div>
+      <p className="mt-1 text-xs font-light text-[#8A8A8A]">
+        {project
+          ? `Last updated ${formatTimestamp(project.updated_at)}. Any teammate can edit it.`
+          : "Submit your team's project for judging. Any teammate can edit it."}
+      </p>
+
+      <div className="mt-4 space-y-3">
+        <Input
+          value={title}
+          onChange={(e) => setTitle(e.target.value)}
+          placeholder="Project title"
+          maxLength={120}
+          disabled={!editable}
+        />
+        <Textarea
+          value={description}
+          onChange={(e) => setDescription(e.target.value)}
+          placeholder="What did you build?"
+          maxLength={5000}
+          rows={4}
+          disabled={!editable}
+        />
+        <Input
+          type="url"
+          value={repoUrl}
+          onChange={(e) => setRepoUrl(e.target.value)}
+          placeholder="Repository URL"
+          disabled={!editable}
+        />
+        <Input
+          type="url"
+          value={demoUrl}
+          onChange={(e) => setDemoUrl(e.target.value)}
+          placeholder="Demo URL (optional)"
+          disabled={!editable}
+        />
+      </div>
+
+      {config.tracks.length > 0 && (
+        <div className="mt-4 space-y-2">
+          <p className="text-xs font-light text-[#8A8A8A]">Tracks</p>
+          {config.tracks.map((track) => (
+            <label
+              key={track}
+              className="flex cursor-pointer items-center gap-2 text-sm font-light text-black"
+            >
+              <Checkbox
+                checked={tracks.includes(track)}
+                onCheckedChange={(checked) =>
+                  toggleTrack(track, checked === true)
+                }
+                disabled={!editable}
+              />
+              {track}
+            </label>
+          ))}
+        </div>
+      )}
+
+      {editable && (
+        <Button
+          onClick={handleSave}
+          disabled={submitting || !title.trim() || !description.trim()}
+          className="mt-4 h-10 w-full rounded-full bg-black text-sm font-normal text-white hover:bg-black/85"
+        >
+          {project ? "Save changes" : "Submit project"}
+        </Button>
+      )}
+    </section>
+  );
+}
@@ -2,16 +2,37 @@ import { format, parseISO } from "date-fns";
 import { ChevronLeft, ChevronRight, Eye } from "lucide-react";
 import { useEffect, useState } from "react";
 import { useLocation, useNavigate } from "react-router";
+import { toast } from "sonner";
 
 import { CelebrationEffect } from "@/components/CelebrationEffect";
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
 import { Button } from "@/components/ui/button";
 import { Skeleton } from "@/components/ui/skeleton";
 import { errorAlert, getRequest } from "@/shared/lib/api";
 import { resolveResumeSectionId } from "@/shared/lib/schema-utils";
-import type { Application, ApplicationStatus } from "@/types";
+import type {
+  Application,
+  ApplicationStatus,
+  ApplicationTimelineEntry,
+} from "@/types";
 
+import { confirmMyAttendance, declineMyAttendance } from "../apply/api";
 import { ApplicationSummary } from "../apply/components/ApplicationSummary";
 import { ResumePreviewDialog } from "../apply/components/ResumePreviewDialog";
+import { fetchMyTimeline } from "./api";
+import { PoliciesCard } from "./PoliciesCard";
+import { ProjectCard } from "./ProjectCard";
+import { TeamCard } from "./TeamCard";
+import { WithdrawCard } from "./WithdrawCard";
 
 const STATUS_LABELS: Record<ApplicationStatus, string> = {
   draft: "In progress",
@@ -19,6 +40,7 @@ const STATUS_LABELS: Record<ApplicationStatus, string> = {
   accepted: "Accepted",
   rejected: "Not accepted",
   waitlisted: "Waitlisted",
+  withdrawn: "Withdrawn",
 };
 
 const STATUS_MESSAGES: Record<ApplicationStatus, string> = {
@@ -30,6 +52,8 @@ const STATUS_MESSAGES: Record<ApplicationStatus, string> = {
     "Thank you for applying. Unfortunately, we cannot accept your application at this time.",
   waitlisted:
     "Your application is on the waitlist. We'll notify you if a spot becomes available.",
+  withdrawn:
+    "You withdrew your application. Thanks for letting us know, and we hope to see you at a future event.",
 };
 
 // Muted, desaturated tints so the pill reads as an outcome without shouting
@@ -40,8 +64,107 @@ const STATUS_PILL_COLORS: Record<ApplicationStatus, string> = {
   accepted: "bg-[#5A7D63]",
   rejected: "bg-[#8F5F5A]",
   waitlisted: "bg-[#8A7444]",
+  withdrawn: "bg-[#7A7973]",
 };
 
+function AttendanceCard({
+  application,
+  onUpdated,
+}: {
+  application: Application;
+  onUpdated: (application: Application) => void;
+}) {
+  const [submitting, setSubmitting] = useState(false);
+  const [declineOpen, setDeclineOpen] = useState(false);
+  const confirmation = application.confirmation_status;
+  const deadline = application.confirmation_deadline
+    ? parseISO(application.confirmation_deadline)
+    : null;
+
+  const respond = async (attending: boolean) => {
+    setSubmitting(true);
+    const res = attending
+      ? await confirmMyAttendance()
+      : await declineMyAttendance();
+    setSubmitting(false);
+    if (res.status === 200 && res.data) {
+      // Mutation responses omit the embedded schema, so keep the one we have.
+      onUpdated({ ...application, ...res.data });
+      toast.success(attending ? "Spot confirmed" : "Spot released");
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  let message: string;
+  switch (confirmation) {
+    case "confirmed":
+      message = "You're confirmed. See you there!";
+      break;
+    case "declined":
+      message = "You declined your spot.";
+      break;
+    case "expired":
+      message = "The confirmation deadline passed and your spot was released.";
+      break;
+    default:
+      message = deadline
+        ? `Confirm your spot by ${format(deadline, "MMM d, yyyy h:mm a")} or it will be offered to someone on the waitlist.`
+        : "Let us know whether you can make it.";
+  }
+
+  return (
+    <section className="mt-5 rounded-xl border border-[#E5E5E5] px-5 py-4">
+      <p className="text-sm font-normal text-black">Attendance</p>
+      <p className="mt-1 text-xs font-light text-[#8A8A8A]">{message}</p>
+      {(confirmation === "pending" || confirmation === "confirmed") && (
+        <div className="mt-4 flex gap-3">
+          {confirmation === "pending" && (
+            <Button
+              onClick={() => respond(true)}
+              disabled={submitting}
+              className="h-10 flex-1 rounded-full bg-black text-sm font-normal text-white hover:bg-black/85"
+            >
+              I'll be there
+            </Button>
+          )}
+          <Button
+            variant="outline"
+            onClick={() => setDeclineOpen(true)}
+            disabled={submitting}
+            className="h-10 flex-1 rounded-full text-sm font-normal"
+          >
+            {confirmation === "pending" ? "I can't make it" : "Release my spot"}
+          </Button>
+        </div>
+      )}
+
+      <AlertDialog open={declineOpen} onOpenChange={setDeclineOpen}>
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Give up your spot?</AlertDialogTitle>
+            <AlertDialogDescription>
+              Your spot will be offered to someone on the waitlist. This can't
+              be undone.
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <AlertDialogFooter>
+            <AlertDialogCancel className="cursor-pointer">
+              Keep my spot
+            </AlertDialogCancel>
+            <AlertDialogAction
+              className="cursor-pointer bg-red-600 hover:bg-red-700"
+              onClick={() => respond(false)}
+            >
+              Release my spot
+            </AlertDialogAction>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </section>
+  );
+}
+
 function DetailRow({ label, value }: { label: string; value: string }) {
   return (
     <div className="flex items-baseline justify-between gap-4 py-2.5">
@@ -51,6 +174,41 @@ function DetailRow({ label, value }: { label: string; value: string }) {
   );
 }
 
+// Refetched whenever the status changes on this page, such as on withdrawal.
+function HistorySection({ status }: { status: ApplicationStatus }) {
+  const [timeline, setTimeline] = useState<ApplicationTimelineEntry[]>([]);
+
+  useEffect(() => {
+    const controller = new AbortController();
+    fetchMyTimeline(controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setTimeline(res.data.timeline);
+      }
+    });
+    return () => controller.abort();
+  }, [status]);
+
+  if (timeline.length === 0) return null;
+
+  return (
+    <section className="mt-5">
+      <h2 className="mb-1 text-xs font-light tracking-widest text-[#8A8A8A] uppercase">
+        History
+      </h2>
+      <div className="divide-y divide-[#F0F0F0]">
+        {timeline.map((entry, i) => (
+          <DetailRow
+            key={`${entry.changed_at}-${i}`}
+            label={format(parseISO(entry.changed_at), "MMM d, yyyy h:mm a")}
+            value={STATUS_LABELS[entry.status]}
+          />
+        ))}
+      </div>
+    </section>
+  );
+}
+
 export default function StatusPage() {
   const navigate = useNavigate();
   const location = useLocation();
@@ -166,6 +324,17 @@ export default function StatusPage() {
         <CelebrationEffect id={application.id} type="accepted" />
       )}
 
+      {application.status === "accepted" && application.confirmation_status && (
+        <AttendanceCard application={application} onUpdated={setApplication} />
+      )}
+
+      {application.status === "accepted" && <PoliciesCard />}
+
+      {application.status !== "rejected" &&
+        application.status !== "withdrawn" && <TeamCard />}
+
+      {application.status === "accepted" && <ProjectCard />}
+
       {/* Details */}
       <section className="mt-5">
         <h2 className="mb-1 text-xs font-light tracking-widest text-[#8A8A8A] uppercase">
@@ -187,6 +356,12 @@ export default function StatusPage() {
               value={format(parseISO(application.submitted_at), "MMM d, yyyy")}
             />
           )}
+          {application.withdrawn_at && (
+            <DetailRow
+              label="Withdrawn"
+              value={format(parseISO(application.withdrawn_at), "MMM d, yyyy")}
+            />
+          )}
           <DetailRow
             label="Created"
             value={format(parseISO(application.created_at), "MMM d, yyyy")}
@@ -194,6 +369,8 @@ export default function StatusPage() {
         </div>
       </section>
 
+      <HistorySection status={application.status} />
+
       {/* Resume quick view */}
       {hasResume && (
         <ResumePreviewDialog
@@ -257,6 +434,12 @@ export default function StatusPage() {
           Continue application
         </Button>
       )}
+
+      {(application.status === "draft" ||
+        application.status === "submitted" ||
+        application.status === "accepted") && (
+        <WithdrawCard application={application} onUpdated={setApplication} />
+      )}
     </div>
   );
 }
@@ -0,0 +1,233 @@
+import { useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { errorAlert } from "@/shared/lib/api";
+import type { Team } from "@/types";
+
+import {
+  createTeam,
+  fetchMyTeam,
+  inviteToTeam,
+  joinTeam,
+  leaveTeam,
+} from "./api";
+
+function memberName(member: Team["members"][number]): string {
+  const name = [member.first_name, member.last_name].filter(Boolean).join(" ");
+  return name || member.email;
+}
+
+export function TeamCard() {
+  const [loading, setLoading] = useState(true);
+  const [team, setTeam] = useState<Team | null>(null);
+  const [sizeMax, setSizeMax] = useState(0);
+  const [submitting, setSubmitting] = useState(false);
+  const [leaveOpen, setLeaveOpen] = useState(false);
+  const [teamName, setTeamName] = useState("");
+  const [joinCode, setJoinCode] = useState("");
+  const [inviteEmail, setInviteEmail] = useState("");
+
+  useEffect(() => {
+    const controller = new AbortController();
+    fetchMyTeam(controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setTeam(res.data.team);
+        setSizeMax(res.data.team_size_max);
+      } else {
+        errorAlert(res);
+      }
+      setLoading(false);
+    });
+    return () => controller.abort();
+  }, []);
+
+  const handleCreate = async () => {
+    setSubmitting(true);
+    const res = await createTeam(teamName.trim());
+    setSubmitting(false);
+    if (res.status === 201 && res.data) {
+      setTeam(res.data.team);
+      setSizeMax(res.data.team_size_max);
+      setTeamName("");
+      toast.success("Team created");
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const handleJoin = async () => {
+    setSubmitting(true);
+    const res = await joinTeam(joinCode.trim());
+    setSubmitting(false);
+    if (res.status === 200 && res.data) {
+      setTeam(res.data.team);
+      setSizeMax(res.data.team_size_max);
+      setJoinCode("");
+      toast.success(`Joined ${res.data.team?.name}`);
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const handleInvite = async () => {
+    if (!team) return;
+    setSubmitting(true);
+    const res = await inviteToTeam(inviteEmail.trim());
+    setSubmitting(false);
+    if (res.status === 201 && res.data) {
+      setTeam({ ...team, invites: [...team.invites, res.data.invite] });
+      setInviteEmail("");
+      toast.success("Invite sent");
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const handleLeave = async () => {
+    setSubmitting(true);
+    const res = await leaveTeam();
+    setSubmitting(false);
+    if (res.status === 204) {
+      setTeam(null);
+      toast.success("You left the team");
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  if (loading) return null;
+
+  if (!team) {
+    return (
+      <section className="mt-5 rounded-xl border border-[#E5E5E5] px-5 py-4">
+        <p className="text-sm font-normal text-black">Team</p>
+        <p className="mt-1 text-xs font-light text-[#8A8A8A]">
+          Teams of up to {sizeMax} can be reviewed together. Start one or join
+          with a code from a teammate.
+        </p>
+        <div className="mt-4 flex gap-3">
+          <Input
+            value={teamName}
+            onChange={(e) => setTeamName(e.target.value)}
+            placeholder="Team name"
+            maxLength={64}
+          />
+          <Button
+            onClick={handleCreate}
+            disabled={submitting || !teamName.trim()}
+            className="rounded-full bg-black text-sm font-normal text-white hover:bg-black/85"
+          >
+            Create
+          </Button>
+        </div>
+        <div className="mt-3 flex gap-3">
+          <Input
+            value={joinCode}
+            onChange={(e) => setJoinCode(e.target.value.toUpperCase())}
+            placeholder="Join code"
+            maxLength={8}
+          />
+          <Button
+            variant="outline"
+            onClick={handleJoin}
+            disabled={submitting || joinCode.trim().length !== 8}
+            className="rounded-full text-sm font-normal"
+          >
+            Join
+          </Button>
+        </div>
+      </section>
+    );
+  }
+
+  const full = team.members.length >= sizeMax;
+
+  return (
+    <section className="mt-5 rounded-xl border border-[#E5E5E5] px-5 py-4">
+      <div className="flex items-baseline justify-between gap-4">
+        <p className="text-sm font-normal text-black">{team.name}</p>
+        <p className="text-xs font-light text-[#8A8A8A]">
+          {team.members.length}/{sizeMax} members
+        </p>
+      </div>
+      <p className="mt-1 text-xs font-light text-[#8A8A8A]">
+        Join code: <span className="font-mono text-black">{team.join_code}</span>
+      </p>
+
+      <ul className="mt-3 divide-y divide-[#F0F0F0]">
+        {team.members.map((m) => (
+          <li key={m.user_id} className="py-2 text-sm font-light text-black">
+            {memberName(m)}
+          </li>
+        ))}
+        {team.invites.map((inv) => (
+          <li key={inv.id} className="py-2 text-sm font-light text-[#8A8A8A]">
+            {inv.email} (invited)
+          </li>
+        ))}
+      </ul>
+
+      {!full && (
+        <div className="mt-3 flex gap-3">
+          <Input
+            type="email"
+            value={inviteEmail}
+            onChange={(e) => setInviteEmail(e.target.value)}
+            placeholder="Teammate's email"
+          />
+          <Button
+            onClick={handleInvite}
+            disabled={submitting || !inviteEmail.trim()}
+            className="rounded-full bg-black text-sm font-normal text-white hover:bg-black/85"
+          >
+            Invite
+          </Button>
+        </div>
+      )}
+
+      <Button
+        variant="outline"
+        onClick={() => setLeaveOpen(true)}
+        disabled={submitting}
+        className="mt-4 h-10 w-full rounded-full text-sm font-normal"
+      >
+        Leave team
+      </Button>
+
+      <AlertDialog open={leaveOpen} onOpenChange={setLeaveOpen}>
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Leave {team.name}?</AlertDialogTitle>
+            <AlertDialogDescription>
+              You can rejoin later with the join code if there is still room.
+              {team.members.length === 1 &&
+                " You are the last member, so the team will be deleted."}
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <AlertDialogFooter>
+            <AlertDialogCancel className="cursor-pointer">Stay</AlertDialogCancel>
+            <AlertDialogAction
+              className="cursor-pointer bg-red-600 hover:bg-red-700"
+              onClick={handleLeave}
+            >
+              Leave team
+            </AlertDialogAction>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </section>
+  );
+}
@@ -0,0 +1,96 @@
+import { useState } from "react";
+import { toast } from "sonner";
+
+import {
+  AlertDialog,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
+import { Button } from "@/components/ui/button";
+import { Textarea } from "@/components/ui/textarea";
+import { errorAlert } from "@/shared/lib/api";
+import type { Application } from "@/types";
+
+import { withdrawMyApplication } from "../apply/api";
+
+const MAX_REASON_LENGTH = 1000;
+
+export function WithdrawCard({
+  application,
+  onUpdated,
+}: {
+  application: Application;
+  onUpdated: (application: Application) => void;
+}) {
+  const [open, setOpen] = useState(false);
+  const [reason, setReason] = useState("");
+  const [submitting, setSubmitting] = useState(false);
+
+  const handleWithdraw = async () => {
+    setSubmitting(true);
+    const res = await withdrawMyApplication(reason.trim());
+    setSubmitting(false);
+    if (res.status === 200 && res.data) {
+      // Mutation responses omit the embedded schema, so keep the one we have.
+      onUpdated({ ...application, ...res.data });
+      setOpen(false);
+      toast.success("Application withdrawn");
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  return (
+    <section className="mt-5 rounded-xl border border-[#E5E5E5] px-5 py-4">
+      <p className="text-sm font-normal text-black">Can't make it?</p>
+      <p className="mt-1 text-xs font-light text-[#8A8A8A]">
+        Withdraw your application to take yourself out of consideration. Your
+        account and history stay, but this can't be undone.
+      </p>
+      <Button
+        variant="outline"
+        onClick={() => setOpen(true)}
+        className="mt-4 h-10 w-full rounded-full text-sm font-normal"
+      >
+        Withdraw application
+      </Button>
+
+      <AlertDialog open={open} onOpenChange={setOpen}>
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Withdraw your application?</AlertDialogTitle>
+            <AlertDialogDescription>
+              {application.status === "accepted"
+                ? "Your spot will be offered to someone on the waitlist."
+                : "Your application won't be reviewed."}{" "}
+              You won't be able to reapply for this event.
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <Textarea
+            value={reason}
+            onChange={(e) => setReason(e.target.value)}
+            maxLength={MAX_REASON_LENGTH}
+            placeholder="Let us know why you're withdrawing"
+            className="min-h-24"
+          />
+          <AlertDialogFooter>
+            <AlertDialogCancel className="cursor-pointer" disabled={submitting}>
+              Keep my application
+            </AlertDialogCancel>
+            <Button
+              className="cursor-pointer bg-red-600 hover:bg-red-700"
+              onClick={handleWithdraw}
+              disabled={submitting || reason.trim() === ""}
+            >
+              {submitting ? "Withdrawing..." : "Withdraw"}
+            </Button>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </section>
+  );
+}
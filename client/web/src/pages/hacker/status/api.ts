@@ -0,0 +1,88 @@
+import {
+  deleteRequest,
+  getRequest,
+  postRequest,
+  putRequest,
+} from "@/shared/lib/api";
+import type {
+  ApiResponse,
+  ApplicationTimelineResponse,
+  MyPoliciesResponse,
+  MyProjectResponse,
+  PolicySignature,
+  SaveProjectPayload,
+  SignPolicyPayload,
+  TeamInvite,
+  TeamResponse,
+} from "@/types";
+
+export async function fetchMyTimeline(
+  signal?: AbortSignal,
+): Promise<ApiResponse<ApplicationTimelineResponse>> {
+  return getRequest<ApplicationTimelineResponse>(
+    "/applications/me/timeline",
+    "application timeline",
+    signal,
+  );
+}
+
+export async function fetchMyTeam(
+  signal?: AbortSignal,
+): Promise<ApiResponse<TeamResponse>> {
+  return getRequest<TeamResponse>("/teams/me", "team", signal);
+}
+
+export async function createTeam(
+  name: string,
+): Promise<ApiResponse<TeamResponse>> {
+  return postRequest<TeamResponse>("/teams", { name }, "team");
+}
+
+export async function joinTeam(
+  code: string,
+): Promise<ApiResponse<TeamResponse>> {
+  return postRequest<TeamResponse>("/teams/join", { code }, "team");
+}
+
+export async function inviteToTeam(
+  email: string,
+): Promise<ApiResponse<{ invite: TeamInvite }>> {
+  return postRequest<{ invite: TeamInvite }>(
+    "/teams/me/invites",
+    { email },
+    "team invite",
+  );
+}
+
+export async function leaveTeam(): Promise<ApiResponse<void>> {
+  return deleteRequest<void>("/teams/me", "team");
+}
+
+export async function fetchMyProject(
+  signal?: AbortSignal,
+): Promise<ApiResponse<MyProjectResponse>> {
+  return getRequest<MyProjectResponse>("/projects/me", "project", signal);
+}
+
+export async function saveMyProject(
+  payload: SaveProjectPayload,
+): Promise<ApiResponse<MyProjectResponse>> {
+  return putRequest<MyProjectResponse>("/projects/me", payload, "project");
+}
+
+export async function fetchMyPolicies(
+  signal?: AbortSignal,
+): Promise<ApiResponse<MyPoliciesResponse>> {
+  return getRequest<MyPoliciesResponse>("/policies", "policies", signal);
+}
+
+export async function signPolicy(
+  id: string,
+  payload: SignPolicyPayload,
+): Promise<ApiResponse<PolicySignature>> {
+  return postRequest<PolicySignature>(
+    `/policies/${id}/sign`,
+    payload,
+    "policy signature",
+  );
+}
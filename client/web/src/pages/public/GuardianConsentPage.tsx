@@ -0,0 +1,116 @@
+import { format, parseISO } from "date-fns";
+import { useEffect, useState } from "react";
+import { useParams } from "react-router";
+
+import { Button } from "@/components/ui/button";
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+import type { GuardianConsent } from "@/types";
+
+import { confirmGuardianConsent, fetchGuardianConsent } from "./api";
+
+type ConsentStatus = "loading" | "ready" | "confirming" | "invalid" | "error";
+
+export default function GuardianConsentPage() {
+  const { token = "" } = useParams();
+  const [status, setStatus] = useState<ConsentStatus>("loading");
+  const [consent, setConsent] = useState<GuardianConsent | null>(null);
+  const [error, setError] = useState("");
+
+  useEffect(() => {
+    const controller = new AbortController();
+    fetchGuardianConsent(token, controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setConsent(res.data);
+        setStatus("ready");
+      } else if (res.status === 404) {
+        setStatus("invalid");
+      } else {
+        setError(res.error ?? "Failed to load this confirmation.");
+        setStatus("error");
+      }
+    });
+    return () => controller.abort();
+  }, [token]);
+
+  const handleConfirm = async () => {
+    setStatus("confirming");
+    let res = await confirmGuardianConsent(token);
+    // Already confirmed, e.g. from another tab; show when.
+    if (res.status === 409) res = await fetchGuardianConsent(token);
+    if (res.status === 200 && res.data) {
+      setConsent(res.data);
+      setStatus("ready");
+    } else if (res.status === 404) {
+      setStatus("invalid");
+    } else {
+      setError(res.error ?? "Failed to confirm.");
+      setStatus("error");
+    }
+  };
+
+  return (
+    <div className="min-h-screen bg-linear-to-b from-gray-50 to-gray-100 flex items-center justify-center p-4">
+      <Card className="w-full max-w-lg">
+        {status === "loading" && (
+          <CardHeader className="text-center">
+            <CardDescription>Loading…</CardDescription>
+          </CardHeader>
+        )}
+        {status === "invalid" && (
+          <CardHeader className="text-center">
+            <CardTitle>Link no longer valid</CardTitle>
+            <CardDescription>
+              This link was replaced by a newer one, or never existed. Check
+              for a more recent email.
+            </CardDescription>
+          </CardHeader>
+        )}
+        {status === "error" && (
+          <CardHeader className="text-center">
+            <CardTitle>Something went wrong</CardTitle>
+            <CardDescription>{error}</CardDescription>
+          </CardHeader>
+        )}
+        {(status === "ready" || status === "confirming") && consent && (
+          <>
+            <CardHeader>
+              <CardTitle>{consent.policy_title}</CardTitle>
+              <CardDescription>
+                Signed by {consent.signed_name} on{" "}
+                {format(parseISO(consent.signed_at), "MMM d, yyyy")}, naming{" "}
+                {consent.guardian_name} as their parent or guardian.
+              </CardDescription>
+            </CardHeader>
+            <CardContent className="space-y-4">
+              <div className="max-h-[45vh] overflow-auto rounded-md border p-3 text-sm whitespace-pre-wrap">
+                {consent.policy_body}
+              </div>
+              {consent.confirmed_at ? (
+                <p className="text-sm text-muted-foreground">
+                  You confirmed this on{" "}
+                  {format(parseISO(consent.confirmed_at), "MMM d, yyyy")}.
+                  Thank you.
+                </p>
+              ) : (
+                <Button
+                  className="w-full"
+                  onClick={handleConfirm}
+                  disabled={status === "confirming"}
+                >
+                  I am {consent.signed_name}'s parent or guardian and I agree
+                </Button>
+              )}
+            </CardContent>
+          </>
+        )}
+      </Card>
+    </div>
+  );
+}
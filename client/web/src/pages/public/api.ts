@@ -0,0 +1,23 @@
+import { getRequest, postRequest } from "@/shared/lib/api";
+import type { ApiResponse, GuardianConsent } from "@/types";
+
+export async function fetchGuardianConsent(
+  token: string,
+  signal?: AbortSignal,
+): Promise<ApiResponse<GuardianConsent>> {
+  return getRequest<GuardianConsent>(
+    `/guardian-consent/${encodeURIComponent(token)}`,
+    "confirmation",
+    signal,
+  );
+}
+
+export async function confirmGuardianConsent(
+  token: string,
+): Promise<ApiResponse<GuardianConsent>> {
+  return postRequest<GuardianConsent>(
+    `/guardian-consent/${encodeURIComponent(token)}`,
+    {},
+    "confirmation",
+  );
+}
@@ -1,4 +1,5 @@
 export { default as AuthCallbackPage } from "./AuthCallbackPage";
 export { default as AuthOAuthCallbackPage } from "./AuthOAuthCallbackPage";
 export { default as AuthVerifyPage } from "./AuthVerifyPage";
+export { default as GuardianConsentPage } from "./GuardianConsentPage";
 export { default as LoginPage } from "./LoginPage";
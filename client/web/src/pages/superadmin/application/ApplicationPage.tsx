@@ -22,6 +22,7 @@ import {
 import { Skeleton } from "@/components/ui/skeleton";
 
 import { ApplicationPreview } from "./components/ApplicationPreview";
+import { SchemaHistoryDialog } from "./components/SchemaHistoryDialog";
 import { SchemaEditor } from "./components/SchemaEditor";
 import { useApplicationSchemaStore } from "./store";
 
@@ -82,7 +83,8 @@ export default function ApplicationPage() {
                     </AlertDialogTitle>
                     <AlertDialogDescription>
                       This will affect <strong>all</strong> hacker applications.
-                      Are you sure you want to save these changes?
+                      The current schema stays available as a version, so
+                      earlier applications can be remapped onto this one.
                     </AlertDialogDescription>
                   </AlertDialogHeader>
                   <AlertDialogFooter>
@@ -98,6 +100,8 @@ export default function ApplicationPage() {
                   </AlertDialogFooter>
                 </AlertDialogContent>
               </AlertDialog>
+
+              <SchemaHistoryDialog />
             </div>
           )}
         </CardContent>
@@ -1,10 +1,49 @@
-import { getRequest, putRequest } from "@/shared/lib/api";
+import { getRequest, postRequest, putRequest } from "@/shared/lib/api";
 import type { ApiResponse, ApplicationSchemaField } from "@/types";
 
 interface ApplicationSchemaResponse {
   fields: ApplicationSchemaField[];
 }
 
+interface SaveApplicationSchemaResponse {
+  fields: ApplicationSchemaField[];
+  version: number;
+}
+
+export interface ApplicationSchemaVersion {
+  version: number;
+  field_count: number;
+  created_by: string | null;
+  created_at: string;
+}
+
+interface ApplicationSchemaVersionsResponse {
+  versions: ApplicationSchemaVersion[];
+}
+
+export interface SchemaFieldChange {
+  id: string;
+  changes: string[];
+  before: ApplicationSchemaField;
+  after: ApplicationSchemaField;
+}
+
+export interface ApplicationSchemaDiff {
+  from_version: number;
+  to_version: number;
+  added: ApplicationSchemaField[];
+  removed: ApplicationSchemaField[];
+  changed: SchemaFieldChange[];
+  /** Removed field ID to the added field that most likely replaced it. */
+  suggested_mapping: Record<string, string>;
+}
+
+export interface RemapResponsesResult {
+  from_version: number;
+  to_version: number;
+  applications: number;
+}
+
 export async function fetchApplicationSchema(
   signal?: AbortSignal,
 ): Promise<ApiResponse<ApplicationSchemaResponse>> {
@@ -17,10 +56,43 @@ export async function fetchApplicationSchema(
 
 export async function saveApplicationSchema(
   fields: ApplicationSchemaField[],
-): Promise<ApiResponse<ApplicationSchemaResponse>> {
-  return putRequest<ApplicationSchemaResponse>(
+): Promise<ApiResponse<SaveApplicationSchemaResponse>> {
+  return putRequest<SaveApplicationSchemaResponse>(
     "/superadmin/settings/application-schema",
     { fields },
     "application schema",
   );
 }
+
+export async function fetchSchemaVersions(
+  signal?: AbortSignal,
+): Promise<ApiResponse<ApplicationSchemaVersionsResponse>> {
+  return getRequest<ApplicationSchemaVersionsResponse>(
+    "/superadmin/settings/application-schema/versions",
+    "schema versions",
+    signal,
+  );
+}
+
+export async function fetchSchemaDiff(
+  from: number,
+  to: number,
+  signal?: AbortSignal,
+): Promise<ApiResponse<ApplicationSchemaDiff>> {
+  return getRequest<ApplicationSchemaDiff>(
+    `/superadmin/settings/application-schema/diff?from=${from}&to=${to}`,
+    "schema diff",
+    signal,
+  );
+}
+
+export async function remapResponses(
+  version: number,
+  mapping: Record<string, string>,
+): Promise<ApiResponse<RemapResponsesResult>> {
+  return postRequest<RemapResponsesResult>(
+    `/superadmin/settings/application-schema/versions/${version}/remap`,
+    { mapping },
+    "response remap",
+  );
+}
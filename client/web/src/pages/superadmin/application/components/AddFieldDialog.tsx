@@ -22,20 +22,14 @@ import {
 import { Switch } from "@/components/ui/switch";
 import type { ApplicationSchemaField, FieldType } from "@/types";
 
-import { FIELD_TYPE_LABELS } from "../constants";
+import {
+  FIELD_TYPE_LABELS,
+  FIELD_TYPES,
+  OPTION_FIELD_TYPES,
+} from "../constants";
 import { useApplicationSchemaStore } from "../store";
 import { OptionsEditor } from "./OptionsEditor";
 
-const FIELD_TYPES: FieldType[] = [
-  "text",
-  "number",
-  "textarea",
-  "select",
-  "multi_select",
-  "checkbox",
-  "phone",
-];
-
 interface AddFieldDialogProps {
   defaultSection?: string;
 }
@@ -52,7 +46,7 @@ export function AddFieldDialog({ defaultSection }: AddFieldDialogProps) {
   const sections = useApplicationSchemaStore((s) => s.sections);
   const addField = useApplicationSchemaStore((s) => s.addField);
 
-  const hasOptions = type === "select" || type === "multi_select";
+  const hasOptions = OPTION_FIELD_TYPES.includes(type);
 
   const reset = () => {
     setSection(defaultSection ?? sections[0]?.id ?? "");
@@ -6,7 +6,7 @@ import {
   renderLabel,
   type SectionDef,
 } from "@/shared/lib/schema-utils";
-import type { ApplicationSchemaField } from "@/types";
+import type { ApplicationSchemaField, FieldType } from "@/types";
 
 const RESUME_PREVIEW_MAX_MB = 5;
 
@@ -113,22 +113,27 @@ function PreviewResumeCard() {
   );
 }
 
+const PREVIEW_PLACEHOLDERS: Partial<Record<FieldType, string>> = {
+  phone: "+1 (202) 555-1234",
+  number: "0",
+  email: "name@example.com",
+  url: "https://...",
+  date: "mm/dd/yyyy",
+};
+
 function renderField(field: ApplicationSchemaField) {
   switch (field.type) {
     case "text":
     case "phone":
     case "number":
+    case "email":
+    case "url":
+    case "date":
       return (
         <PreviewField
           key={field.id}
           label={field.label}
-          placeholder={
-            field.type === "phone"
-              ? "+1 (202) 555-1234"
-              : field.type === "number"
-                ? "0"
-                : "Enter..."
-          }
+          placeholder={PREVIEW_PLACEHOLDERS[field.type] ?? "Enter..."}
           required={field.required}
         />
       );
@@ -142,6 +147,7 @@ function renderField(field: ApplicationSchemaField) {
         />
       );
     case "select":
+    case "country":
       return (
         <PreviewField
           key={field.id}
@@ -150,6 +156,34 @@ function renderField(field: ApplicationSchemaField) {
           required={field.required}
         />
       );
+    case "file":
+      return (
+        <PreviewField
+          key={field.id}
+          label={field.label}
+          placeholder="Upload PDF"
+          required={field.required}
+        />
+      );
+    case "ranked_choice":
+      return (
+        <div key={field.id} className="space-y-1.5">
+          <label className="text-xs font-medium text-gray-700">
+            {field.label}
+            {field.required && <span className="text-gray-400 ml-1">*</span>}
+            <span className="text-gray-400 ml-1 font-normal">
+              — rank in order of preference
+            </span>
+          </label>
+          <ol className="space-y-1 text-xs text-gray-500">
+            {(field.options ?? []).map((option, i) => (
+              <li key={option}>
+                {i + 1}. {option}
+              </li>
+            ))}
+          </ol>
+        </div>
+      );
     case "multi_select":
       return (
         <div key={field.id} className="space-y-1.5">
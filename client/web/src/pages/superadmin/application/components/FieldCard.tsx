@@ -16,8 +16,14 @@ import {
 import { Switch } from "@/components/ui/switch";
 import type { ApplicationSchemaField, FieldType } from "@/types";
 
-import { FIELD_TYPE_LABELS, TYPE_COLORS } from "../constants";
+import {
+  FIELD_TYPE_LABELS,
+  FIELD_TYPES,
+  OPTION_FIELD_TYPES,
+  TYPE_COLORS,
+} from "../constants";
 import { OptionsEditor } from "./OptionsEditor";
+import { ValidationEditor } from "./ValidationEditor";
 
 interface FieldCardProps {
   field: ApplicationSchemaField;
@@ -28,16 +34,6 @@ interface FieldCardProps {
   isLast: boolean;
 }
 
-const FIELD_TYPES: FieldType[] = [
-  "text",
-  "number",
-  "textarea",
-  "select",
-  "multi_select",
-  "checkbox",
-  "phone",
-];
-
 export function FieldCard({
   field,
   onUpdate,
@@ -47,9 +43,7 @@ export function FieldCard({
   isLast,
 }: FieldCardProps) {
   const [detailsOpen, setDetailsOpen] = useState(false);
-  const hasOptions = field.type === "select" || field.type === "multi_select";
-  const hasValidation =
-    field.validation && Object.keys(field.validation).length > 0;
+  const hasOptions = OPTION_FIELD_TYPES.includes(field.type);
 
   return (
     <div className="rounded-md border p-3 space-y-3">
@@ -135,18 +129,17 @@ export function FieldCard({
             <Select
               value={field.type}
               onValueChange={(value: FieldType) => {
+                // Validation settings are per type, so they never carry over.
                 const updates: Partial<ApplicationSchemaField> = {
                   type: value,
+                  validation: undefined,
                 };
                 // Clear options if switching away from select types
-                if (value !== "select" && value !== "multi_select") {
+                if (!OPTION_FIELD_TYPES.includes(value)) {
                   updates.options = undefined;
                 }
                 // Add empty options array if switching to select types
-                if (
-                  (value === "select" || value === "multi_select") &&
-                  !field.options
-                ) {
+                if (OPTION_FIELD_TYPES.includes(value) && !field.options) {
                   updates.options = [""];
                 }
                 onUpdate(updates);
@@ -177,95 +170,10 @@ export function FieldCard({
             />
           )}
 
-          {/* Validation fields */}
-          {field.type === "textarea" && (
-            <div className="space-y-1.5">
-              <label className="text-xs font-medium text-muted-foreground">
-                Max Length
-              </label>
-              <Input
-                type="number"
-                value={
-                  (field.validation?.maxLength as number | undefined) ?? ""
-                }
-                onChange={(e) => {
-                  const val = e.target.value
-                    ? parseInt(e.target.value, 10)
-                    : undefined;
-                  onUpdate({
-                    validation: val
-                      ? { ...field.validation, maxLength: val }
-                      : Object.fromEntries(
-                          Object.entries(field.validation ?? {}).filter(
-                            ([k]) => k !== "maxLength",
-                          ),
-                        ),
-                  });
-                }}
-                placeholder="e.g. 1000"
-                className="h-8 text-sm"
-              />
-            </div>
-          )}
-
-          {field.type === "number" && (
-            <div className="grid grid-cols-2 gap-2">
-              <div className="space-y-1.5">
-                <label className="text-xs font-medium text-muted-foreground">
-                  Min
-                </label>
-                <Input
-                  type="number"
-                  value={(field.validation?.min as number | undefined) ?? ""}
-                  onChange={(e) => {
-                    const val = e.target.value
-                      ? parseInt(e.target.value, 10)
-                      : undefined;
-                    const next = { ...field.validation };
-                    if (val !== undefined) next.min = val;
-                    else delete next.min;
-                    onUpdate({ validation: next });
-                  }}
-                  placeholder="Min"
-                  className="h-8 text-sm"
-                />
-              </div>
-              <div className="space-y-1.5">
-                <label className="text-xs font-medium text-muted-foreground">
-                  Max
-                </label>
-                <Input
-                  type="number"
-                  value={(field.validation?.max as number | undefined) ?? ""}
-                  onChange={(e) => {
-                    const val = e.target.value
-                      ? parseInt(e.target.value, 10)
-                      : undefined;
-                    const next = { ...field.validation };
-                    if (val !== undefined) next.max = val;
-                    else delete next.max;
-                    onUpdate({ validation: next });
-                  }}
-                  placeholder="Max"
-                  className="h-8 text-sm"
-                />
-              </div>
-            </div>
-          )}
-
-          {/* Show existing validation as read-only if type doesn't have dedicated editors */}
-          {hasValidation &&
-            field.type !== "textarea" &&
-            field.type !== "number" && (
-              <div className="space-y-1.5">
-                <label className="text-xs font-medium text-muted-foreground">
-                  Validation
-                </label>
-                <p className="text-xs text-muted-foreground">
-                  {JSON.stringify(field.validation)}
-                </p>
-              </div>
-            )}
+          <ValidationEditor
+            field={field}
+            onChange={(validation) => onUpdate({ validation })}
+          />
         </CollapsibleContent>
       </Collapsible>
     </div>
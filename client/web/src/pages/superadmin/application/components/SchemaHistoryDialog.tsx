@@ -0,0 +1,303 @@
+import { History } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogHeader,
+  DialogTitle,
+  DialogTrigger,
+} from "@/components/ui/dialog";
+import { Label } from "@/components/ui/label";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Skeleton } from "@/components/ui/skeleton";
+import { errorAlert } from "@/shared/lib/api";
+import type { ApplicationSchemaField } from "@/types";
+
+import {
+  type ApplicationSchemaDiff,
+  type ApplicationSchemaVersion,
+  fetchSchemaDiff,
+  fetchSchemaVersions,
+  remapResponses,
+} from "../api";
+import { FIELD_TYPE_LABELS } from "../constants";
+
+/** Select value for a removed field whose answers keep their old ID. */
+const KEEP = "__keep__";
+
+function FieldLine({ field }: { field: ApplicationSchemaField }) {
+  return (
+    <div className="flex items-center gap-2 text-sm">
+      <span className="truncate">{field.label || field.id}</span>
+      <code className="text-xs text-muted-foreground">{field.id}</code>
+      <Badge variant="outline" className="ml-auto text-[10px] shrink-0">
+        {FIELD_TYPE_LABELS[field.type] ?? field.type}
+      </Badge>
+    </div>
+  );
+}
+
+export function SchemaHistoryDialog() {
+  const [open, setOpen] = useState(false);
+  const [versions, setVersions] = useState<ApplicationSchemaVersion[]>([]);
+  const [from, setFrom] = useState<number | null>(null);
+  const [to, setTo] = useState<number | null>(null);
+  const [diff, setDiff] = useState<ApplicationSchemaDiff | null>(null);
+  const [mapping, setMapping] = useState<Record<string, string>>({});
+  const [remapping, setRemapping] = useState(false);
+
+  useEffect(() => {
+    if (!open) return;
+    const controller = new AbortController();
+    fetchSchemaVersions(controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        const list = res.data.versions;
+        setVersions(list);
+        setTo(list[0]?.version ?? null);
+        setFrom(list[1]?.version ?? list[0]?.version ?? null);
+      } else {
+        errorAlert(res);
+      }
+    });
+    return () => controller.abort();
+  }, [open]);
+
+  useEffect(() => {
+    if (from === null || to === null) return;
+    const controller = new AbortController();
+    setDiff(null);
+    fetchSchemaDiff(from, to, controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setDiff(res.data);
+        setMapping(res.data.suggested_mapping ?? {});
+      } else {
+        errorAlert(res);
+      }
+    });
+    return () => controller.abort();
+  }, [from, to]);
+
+  const latest = versions[0]?.version;
+  const canRemap = diff !== null && to === latest && from !== latest;
+
+  const handleRemap = async () => {
+    if (from === null) return;
+    setRemapping(true);
+    const res = await remapResponses(from, mapping);
+    setRemapping(false);
+    if (res.status === 200 && res.data) {
+      toast.success(
+        `Moved ${res.data.applications} application(s) to version ${res.data.to_version}`,
+      );
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const versionSelect = (
+    label: string,
+    value: number | null,
+    onChange: (v: number) => void,
+  ) => (
+    <div className="space-y-1.5 flex-1">
+      <Label className="text-xs text-muted-foreground">{label}</Label>
+      <Select
+        value={value === null ? undefined : String(value)}
+        onValueChange={(v) => onChange(Number(v))}
+      >
+        <SelectTrigger className="h-8 text-sm cursor-pointer">
+          <SelectValue placeholder="Version" />
+        </SelectTrigger>
+        <SelectContent>
+          {versions.map((v) => (
+            <SelectItem
+              key={v.version}
+              value={String(v.version)}
+              className="cursor-pointer"
+            >
+              v{v.version} · {new Date(v.created_at).toLocaleDateString()} ·{" "}
+              {v.field_count} fields
+            </SelectItem>
+          ))}
+        </SelectContent>
+      </Select>
+    </div>
+  );
+
+  return (
+    <Dialog open={open} onOpenChange={setOpen}>
+      <DialogTrigger asChild>
+        <Button variant="outline" className="w-full cursor-pointer">
+          <History className="size-4 mr-2" />
+          Version History
+        </Button>
+      </DialogTrigger>
+      <DialogContent className="sm:max-w-2xl max-h-[85vh] overflow-y-auto">
+        <DialogHeader>
+          <DialogTitle>Schema Version History</DialogTitle>
+          <DialogDescription>
+            Every save is kept as a version. Compare two versions, and move
+            applications written against an older one onto the latest.
+          </DialogDescription>
+        </DialogHeader>
+
+        {versions.length === 0 ? (
+          <p className="text-sm text-muted-foreground py-4">
+            No versions saved yet.
+          </p>
+        ) : (
+          <div className="space-y-4">
+            <div className="flex gap-3">
+              {versionSelect("From", from, setFrom)}
+              {versionSelect("To", to, setTo)}
+            </div>
+
+            {diff === null ? (
+              <div className="space-y-2">
+                <Skeleton className="h-6 w-full" />
+                <Skeleton className="h-6 w-full" />
+              </div>
+            ) : (
+              <div className="space-y-4">
+                {diff.added.length === 0 &&
+                  diff.removed.length === 0 &&
+                  diff.changed.length === 0 && (
+                    <p className="text-sm text-muted-foreground">
+                      These versions have the same fields.
+                    </p>
+                  )}
+
+                {diff.added.length > 0 && (
+                  <div className="space-y-1.5">
+                    <h4 className="text-sm font-semibold text-green-700">
+                      Added
+                    </h4>
+                    {diff.added.map((f) => (
+                      <FieldLine key={f.id} field={f} />
+                    ))}
+                  </div>
+                )}
+
+                {diff.removed.length > 0 && (
+                  <div className="space-y-1.5">
+                    <h4 className="text-sm font-semibold text-red-700">
+                      Removed
+                    </h4>
+                    {diff.removed.map((f) => (
+                      <FieldLine key={f.id} field={f} />
+                    ))}
+                  </div>
+                )}
+
+                {diff.changed.length > 0 && (
+                  <div className="space-y-1.5">
+                    <h4 className="text-sm font-semibold text-amber-700">
+                      Changed
+                    </h4>
+                    {diff.changed.map((c) => (
+                      <div key={c.id} className="space-y-1">
+                        <FieldLine field={c.after} />
+                        <div className="flex flex-wrap gap-1">
+                          {c.changes.map((name) => (
+                            <Badge
+                              key={name}
+                              variant="secondary"
+                              className="text-[10px]"
+                            >
+                              {name}
+                            </Badge>
+                          ))}
+                        </div>
+                      </div>
+                    ))}
+                  </div>
+                )}
+
+                {canRemap && (
+                  <div className="space-y-3 border-t pt-4">
+                    <div>
+                      <h4 className="text-sm font-semibold">
+                        Remap v{from} responses to v{to}
+                      </h4>
+                      <p className="text-xs text-muted-foreground">
+                        Choose the new field that replaced each removed one.
+                        Unmapped answers are kept under their old ID.
+                      </p>
+                    </div>
+                    {diff.removed.map((f) => {
+                      const targets = diff.added.filter(
+                        (a) => a.type === f.type,
+                      );
+                      return (
+                        <div key={f.id} className="flex items-center gap-3">
+                          <code className="text-xs flex-1 truncate">
+                            {f.id}
+                          </code>
+                          <span className="text-muted-foreground">→</span>
+                          <Select
+                            value={mapping[f.id] ?? KEEP}
+                            onValueChange={(v) =>
+                              setMapping((prev) => {
+                                const next = { ...prev };
+                                if (v === KEEP) delete next[f.id];
+                                else next[f.id] = v;
+                                return next;
+                              })
+                            }
+                          >
+                            <SelectTrigger
+                              className="h-8 text-sm flex-1 cursor-pointer"
+                            >
+                              <SelectValue />
+                            </SelectTrigger>
+                            <SelectContent>
+                              <SelectItem
+                                value={KEEP}
+                                className="cursor-pointer"
+                              >
+                                Keep old ID
+                              </SelectItem>
+                              {targets.map((a) => (
+                                <SelectItem
+                                  key={a.id}
+                                  value={a.id}
+                                  className="cursor-pointer"
+                                >
+                                  {a.id}
+                                </SelectItem>
+                              ))}
+                            </SelectContent>
+                          </Select>
+                        </div>
+                      );
+                    })}
+                    <Button
+                      onClick={handleRemap}
+                      loading={remapping}
+                      className="w-full cursor-pointer"
+                    >
+                      Move v{from} applications to v{to}
+                    </Button>
+                  </div>
+                )}
+              </div>
+            )}
+          </div>
+        )}
+      </DialogContent>
+    </Dialog>
+  );
+}
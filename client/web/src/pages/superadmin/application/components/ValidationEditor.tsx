@@ -0,0 +1,110 @@
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Switch } from "@/components/ui/switch";
+import type { ApplicationSchemaField } from "@/types";
+
+import { VALIDATION_KEYS } from "../constants";
+
+interface ValidationEditorProps {
+  field: ApplicationSchemaField;
+  onChange: (validation: Record<string, unknown> | undefined) => void;
+}
+
+const SETTING_LABELS: Record<string, string> = {
+  minLength: "Min Length",
+  maxLength: "Max Length",
+  pattern: "Pattern (regex)",
+  min: "Min",
+  max: "Max",
+  minItems: "Min Choices",
+  maxItems: "Max Choices",
+};
+
+/** Pairs shown side by side; anything else takes a full row. */
+const PAIRS = [
+  ["minLength", "maxLength"],
+  ["min", "max"],
+  ["minItems", "maxItems"],
+];
+
+export function ValidationEditor({ field, onChange }: ValidationEditorProps) {
+  const keys = VALIDATION_KEYS[field.type];
+  if (keys.length === 0) return null;
+
+  const validation = field.validation ?? {};
+
+  const set = (key: string, value: unknown) => {
+    const next = { ...validation };
+    if (value === undefined || value === "" || value === false) {
+      delete next[key];
+    } else {
+      next[key] = value;
+    }
+    onChange(Object.keys(next).length > 0 ? next : undefined);
+  };
+
+  const renderSetting = (key: string) => {
+    const id = `${field.id}-${key}`;
+
+    if (key === "allowOther") {
+      return (
+        <div key={key} className="flex items-center gap-2">
+          <Switch
+            id={id}
+            checked={validation.allowOther === true}
+            onCheckedChange={(checked) => set(key, checked)}
+            className="cursor-pointer"
+          />
+          <Label htmlFor={id} className="text-xs cursor-pointer">
+            Allow &quot;Other (please specify)&quot;
+          </Label>
+        </div>
+      );
+    }
+
+    const isDate = field.type === "date";
+    const isText = key === "pattern";
+    return (
+      <div key={key} className="space-y-1.5">
+        <label
+          htmlFor={id}
+          className="text-xs font-medium text-muted-foreground"
+        >
+          {SETTING_LABELS[key]}
+        </label>
+        <Input
+          id={id}
+          type={isText ? "text" : isDate ? "date" : "number"}
+          value={(validation[key] as string | number | undefined) ?? ""}
+          onChange={(e) => {
+            const raw = e.target.value;
+            if (isText || isDate || raw === "") {
+              set(key, raw);
+              return;
+            }
+            const num = Number(raw);
+            if (!Number.isNaN(num)) set(key, num);
+          }}
+          placeholder={isText ? "e.g. ^[A-Za-z ]+$" : undefined}
+          className="h-8 text-sm"
+        />
+      </div>
+    );
+  };
+
+  const pairs = PAIRS.filter(
+    ([lo, hi]) => keys.includes(lo) && keys.includes(hi),
+  );
+  const paired = new Set(pairs.flat());
+  return (
+    <div className="space-y-3">
+      {pairs.map(([lo, hi]) => (
+        <div key={lo} className="grid grid-cols-2 gap-2">
+          {renderSetting(lo)}
+          {renderSetting(hi)}
+        </div>
+      ))}
+      {keys.filter((k) => !paired.has(k)).map(renderSetting)}
+    </div>
+  );
+}
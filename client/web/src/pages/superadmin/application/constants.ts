@@ -8,6 +8,12 @@ export const FIELD_TYPE_LABELS: Record<FieldType, string> = {
   multi_select: "Multi Select",
   checkbox: "Checkbox",
   phone: "Phone",
+  email: "Email",
+  url: "Link",
+  date: "Date",
+  country: "Country",
+  ranked_choice: "Ranked Choice",
+  file: "File Upload",
 };
 
 export const TYPE_COLORS: Record<FieldType, string> = {
@@ -18,4 +24,39 @@ export const TYPE_COLORS: Record<FieldType, string> = {
   multi_select: "bg-orange-50 text-orange-700 border-orange-200",
   checkbox: "bg-pink-50 text-pink-700 border-pink-200",
   phone: "bg-cyan-50 text-cyan-700 border-cyan-200",
+  email: "bg-sky-50 text-sky-700 border-sky-200",
+  url: "bg-indigo-50 text-indigo-700 border-indigo-200",
+  date: "bg-violet-50 text-violet-700 border-violet-200",
+  country: "bg-teal-50 text-teal-700 border-teal-200",
+  ranked_choice: "bg-rose-50 text-rose-700 border-rose-200",
+  file: "bg-stone-50 text-stone-700 border-stone-200",
+};
+
+export const FIELD_TYPES = Object.keys(FIELD_TYPE_LABELS) as FieldType[];
+
+/** Types whose answers are picked from the field's options. */
+export const OPTION_FIELD_TYPES: FieldType[] = [
+  "select",
+  "multi_select",
+  "ranked_choice",
+];
+
+/**
+ * Validation settings each type accepts; the server rejects any others.
+ * Keep in sync with fieldValidationKeys in cmd/api/application_fields.go.
+ */
+export const VALIDATION_KEYS: Record<FieldType, string[]> = {
+  text: ["minLength", "maxLength", "pattern"],
+  textarea: ["minLength", "maxLength", "pattern"],
+  phone: ["minLength", "maxLength", "pattern"],
+  email: ["maxLength", "pattern"],
+  url: ["maxLength", "pattern"],
+  number: ["min", "max"],
+  date: ["min", "max"],
+  select: ["allowOther"],
+  multi_select: ["minItems", "maxItems", "allowOther"],
+  ranked_choice: ["minItems", "maxItems"],
+  country: [],
+  checkbox: [],
+  file: [],
 };
@@ -6,6 +6,7 @@ import { deriveSections, type SectionDef } from "@/shared/lib/schema-utils";
 import type { ApplicationSchemaField } from "@/types";
 
 import { fetchApplicationSchema, saveApplicationSchema } from "./api";
+import { OPTION_FIELD_TYPES } from "./constants";
 
 interface ApplicationSchemaState {
   fields: ApplicationSchemaField[];
@@ -93,7 +94,7 @@ export const useApplicationSchemaStore = create<ApplicationSchemaState>(
 
       const missingOptions = fields.find(
         (f) =>
-          (f.type === "select" || f.type === "multi_select") &&
+          OPTION_FIELD_TYPES.includes(f.type) &&
           (!f.options || f.options.length === 0),
       );
       if (missingOptions) {
@@ -108,7 +109,9 @@ export const useApplicationSchemaStore = create<ApplicationSchemaState>(
       if (res.status === 200 && res.data) {
         const saved = res.data.fields;
         set({ fields: saved, sections: buildSections(saved), saving: false });
-        toast.success("Application schema saved");
+        toast.success(
+          `Application schema saved as version ${res.data.version}`,
+        );
       } else {
         errorAlert(res);
         set({ saving: false });
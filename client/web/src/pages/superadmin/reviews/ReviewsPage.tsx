@@ -2,6 +2,8 @@ import {
   ArrowDown,
   ClipboardCheck,
   ClipboardList,
+  Copy,
+  Gavel,
   Mail,
   Minus,
   Plus,
@@ -52,10 +54,15 @@ import {
 } from "@/shared/lib/api";
 import { useUserStore } from "@/shared/stores/user";
 
+import { fetchLatestDuplicateScan, scanDuplicateApplications } from "./api";
+import { DecisionRulesDialog } from "./components/DecisionRulesDialog";
 import { ReviewsTable } from "./components/ReviewsTable";
 import { ReviewStatusTabs } from "./components/ReviewStatusTabs";
 import { SendEmailsDialog } from "./components/SendEmailsDialog";
 import { useReviewApplicationsStore } from "./store";
+import type { DuplicateScan } from "./types";
+
+const SCAN_POLL_INTERVAL_MS = 3000;
 
 export default function ReviewsPage() {
   const navigate = useNavigate();
@@ -68,6 +75,12 @@ export default function ReviewsPage() {
   const [confirmOpen, setConfirmOpen] = useState(false);
   const [reviewAssignmentEnabled, setReviewAssignmentEnabled] = useState(true);
   const [togglingAssignment, setTogglingAssignment] = useState(false);
+  const [duplicateScan, setDuplicateScan] = useState<DuplicateScan | null>(
+    null,
+  );
+  const [startingScan, setStartingScan] = useState(false);
+  // The scan started from this page, announced once it finishes.
+  const announcedScanId = useRef<string | null>(null);
 
   const triggerAssignedPageRefresh = refreshAssignedPage(
     (state: AssignedState) => state.triggerRefresh,
@@ -88,6 +101,7 @@ export default function ReviewsPage() {
   const fetchStats = useReviewApplicationsStore((s) => s.fetchStats);
 
   const [sendEmailsOpen, setSendEmailsOpen] = useState(false);
+  const [decisionRulesOpen, setDecisionRulesOpen] = useState(false);
   const [searchInput, setSearchInput] = useState(currentSearch);
   const [selectedApplicationId, setSelectedApplicationId] = useState<
     string | null
@@ -138,6 +152,49 @@ export default function ReviewsPage() {
     return () => controller.abort();
   }, [fetchApplications, fetchStats]);
 
+  useEffect(() => {
+    const controller = new AbortController();
+    fetchLatestDuplicateScan(controller.signal).then((res) => {
+      if (res.status === 200 && res.data) setDuplicateScan(res.data.scan);
+    });
+    return () => controller.abort();
+  }, []);
+
+  // Scans run in the background; refresh until the latest one finishes.
+  const scanRunning = duplicateScan?.status === "running";
+  useEffect(() => {
+    if (!scanRunning) return;
+    const id = setInterval(async () => {
+      const res = await fetchLatestDuplicateScan();
+      if (res.status === 200 && res.data) setDuplicateScan(res.data.scan);
+    }, SCAN_POLL_INTERVAL_MS);
+    return () => clearInterval(id);
+  }, [scanRunning]);
+
+  useEffect(() => {
+    if (!duplicateScan || duplicateScan.id !== announcedScanId.current) return;
+    if (duplicateScan.status === "running") return;
+    announcedScanId.current = null;
+    if (duplicateScan.status === "failed") {
+      toast.error(
+        duplicateScan.error
+          ? `Duplicate scan failed: ${duplicateScan.error}`
+          : "Duplicate scan failed",
+      );
+      return;
+    }
+    const { applications, flagged_applications, resumes_unreadable } =
+      duplicateScan;
+    toast.success(
+      `Flagged ${flagged_applications} of ${applications} applications as likely duplicates`,
+    );
+    if (resumes_unreadable > 0) {
+      toast.warning(
+        `${resumes_unreadable} resume(s) couldn't be read and were left out of the resume comparison`,
+      );
+    }
+  }, [duplicateScan]);
+
   // Debounced search
   const isFirstRender = useRef(true);
   useEffect(() => {
@@ -172,6 +229,11 @@ export default function ReviewsPage() {
     [fetchApplications],
   );
 
+  const handleDecisionsApplied = useCallback(() => {
+    fetchApplications();
+    fetchStats();
+  }, [fetchApplications, fetchStats]);
+
   const handleNextPage = useCallback(() => {
     if (nextCursor) {
       fetchApplications({ cursor: nextCursor });
@@ -220,6 +282,19 @@ export default function ReviewsPage() {
     setAssigning(false);
   }
 
+  async function handleScanDuplicates() {
+    setStartingScan(true);
+    const res = await scanDuplicateApplications();
+    if (res.status === 202 && res.data?.scan) {
+      announcedScanId.current = res.data.scan.id;
+      setDuplicateScan(res.data.scan);
+      toast.success("Duplicate scan started.");
+    } else {
+      errorAlert(res);
+    }
+    setStartingScan(false);
+  }
+
   async function handleToggleAssignmentEnabled(enabled: boolean) {
     if (!currentUser) return;
     setTogglingAssignment(true);
@@ -242,8 +317,8 @@ export default function ReviewsPage() {
 
   if (loading) {
     return (
-      <div className="grid grid-cols-1 gap-4 md:grid-cols-3">
-        {[...Array(3)].map((_, i) => (
+      <div className="grid grid-cols-1 gap-4 md:grid-cols-4">
+        {[...Array(4)].map((_, i) => (
           <Card key={i} className="animate-pulse">
             <CardHeader>
               <div className="h-4 w-24 rounded bg-muted" />
@@ -257,7 +332,7 @@ export default function ReviewsPage() {
 
   return (
     <div className="flex flex-col gap-3 h-full min-h-0">
-      <div className="shrink-0 grid grid-cols-1 gap-4 md:grid-cols-3">
+      <div className="shrink-0 grid grid-cols-1 gap-4 md:grid-cols-4">
         {/* Reviews Per Application */}
         <Card className="@container/card">
           <CardHeader>
@@ -346,6 +421,26 @@ export default function ReviewsPage() {
             </Button>
           </CardHeader>
         </Card>
+
+        {/* Duplicate Scan */}
+        <Card className="@container/card">
+          <CardHeader>
+            <div className="flex items-center justify-between">
+              <CardDescription>Duplicate Scan</CardDescription>
+              <Copy className="size-5 text-muted-foreground" />
+            </div>
+            <CardTitle className="text-xl font-semibold">Scan</CardTitle>
+            <Button
+              onClick={handleScanDuplicates}
+              loading={startingScan || scanRunning}
+              variant="outline"
+              className="w-full cursor-pointer"
+              size="sm"
+            >
+              {scanRunning ? "Scanning..." : "Flag Duplicates"}
+            </Button>
+          </CardHeader>
+        </Card>
       </div>
 
       {/* Applications Table Section */}
@@ -413,6 +508,15 @@ export default function ReviewsPage() {
                   <ClipboardCheck className="size-3.5" />
                   Start Grading
                 </Button>
+                <Button
+                  variant="outline"
+                  size="sm"
+                  className="cursor-pointer font-light"
+                  onClick={() => setDecisionRulesOpen(true)}
+                >
+                  <Gavel className="size-3.5" />
+                  Decision Rules
+                </Button>
                 <Button
                   variant="outline"
                   size="sm"
@@ -461,6 +565,12 @@ export default function ReviewsPage() {
         stats={stats}
       />
 
+      <DecisionRulesDialog
+        open={decisionRulesOpen}
+        onOpenChange={setDecisionRulesOpen}
+        onApplied={handleDecisionsApplied}
+      />
+
       <AlertDialog open={confirmOpen} onOpenChange={setConfirmOpen}>
         <AlertDialogContent>
           <AlertDialogHeader>
@@ -1,8 +1,12 @@
 import type { ApplicationStatus } from "@/pages/admin/all-applicants/types";
-import { getRequest, postRequest } from "@/shared/lib/api";
+import { getRequest, postRequest, putRequest } from "@/shared/lib/api";
 
 import type {
   DecisionEmailStatsResponse,
+  DecisionRule,
+  DecisionRulesResponse,
+  DecisionRunResult,
+  DuplicateScanResponse,
   SendDecisionEmailsPayload,
   SendDecisionEmailsResponse,
 } from "./types";
@@ -40,3 +44,51 @@ export async function sendDecisionEmails(payload: SendDecisionEmailsPayload) {
     "send decision emails",
   );
 }
+
+export async function fetchLatestDuplicateScan(signal?: AbortSignal) {
+  return getRequest<DuplicateScanResponse>(
+    "/superadmin/applications/duplicates/scan",
+    "duplicate scan",
+    signal,
+  );
+}
+
+export async function scanDuplicateApplications() {
+  return postRequest<DuplicateScanResponse>(
+    "/superadmin/applications/duplicates/scan",
+    {},
+    "duplicate scan",
+  );
+}
+
+export async function fetchDecisionRules(signal?: AbortSignal) {
+  return getRequest<DecisionRulesResponse>(
+    "/superadmin/settings/decision-rules",
+    "decision rules",
+    signal,
+  );
+}
+
+export async function saveDecisionRules(rules: DecisionRule[]) {
+  return putRequest<DecisionRulesResponse>(
+    "/superadmin/settings/decision-rules",
+    { rules },
+    "decision rules",
+  );
+}
+
+export async function previewDecisions() {
+  return postRequest<DecisionRunResult>(
+    "/superadmin/applications/decisions/preview",
+    {},
+    "decision preview",
+  );
+}
+
+export async function applyDecisions() {
+  return postRequest<DecisionRunResult>(
+    "/superadmin/applications/decisions/apply",
+    {},
+    "apply decisions",
+  );
+}
@@ -0,0 +1,617 @@
+import { ArrowDown, ArrowUp, Plus, Trash2, X } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Skeleton } from "@/components/ui/skeleton";
+import { fetchApplicationFilterFields } from "@/pages/admin/all-applicants/api";
+import type { ApplicationFilterField } from "@/pages/admin/all-applicants/types";
+import {
+  formatQuotaBreach,
+  getStatusColor,
+} from "@/pages/admin/all-applicants/utils";
+import { errorAlert } from "@/shared/lib/api";
+
+import {
+  applyDecisions,
+  fetchDecisionRules,
+  previewDecisions,
+  saveDecisionRules,
+} from "../api";
+import type {
+  DecidedStatus,
+  DecisionCondition,
+  DecisionConditionOp,
+  DecisionRule,
+  DecisionRunResult,
+} from "../types";
+import { DECIDED_STATUSES } from "../types";
+
+type FieldKind = "votes" | "number" | "select" | "multi_select";
+
+interface RuleField {
+  id: string;
+  label: string;
+  kind: FieldKind;
+  options?: string[];
+}
+
+const METRIC_FIELDS: RuleField[] = [
+  { id: "accept_votes", label: "Accept votes", kind: "votes" },
+  { id: "reject_votes", label: "Reject votes", kind: "votes" },
+  { id: "waitlist_votes", label: "Waitlist votes", kind: "votes" },
+  { id: "ai_percent", label: "AI percent", kind: "number" },
+];
+
+const OP_LABELS: Record<DecisionConditionOp, string> = {
+  eq: "=",
+  neq: "≠",
+  lt: "<",
+  lte: "≤",
+  gt: ">",
+  gte: "≥",
+  in: "is one of",
+  contains: "includes",
+  empty: "is empty",
+  not_empty: "is not empty",
+};
+
+const OPS_BY_KIND: Record<FieldKind, DecisionConditionOp[]> = {
+  votes: ["eq", "neq", "lt", "lte", "gt", "gte"],
+  number: ["eq", "neq", "lt", "lte", "gt", "gte", "empty", "not_empty"],
+  select: ["eq", "neq", "in", "empty", "not_empty"],
+  multi_select: ["contains", "empty", "not_empty"],
+};
+
+function toRuleField(f: ApplicationFilterField): RuleField {
+  const kind: FieldKind =
+    f.type === "number"
+      ? "number"
+      : f.type === "multi_select"
+        ? "multi_select"
+        : "select";
+  return { id: `f.${f.id}`, label: f.label, kind, options: f.options };
+}
+
+/** Reads a typed value back into the shape the op expects. */
+function parseValue(
+  kind: FieldKind,
+  op: DecisionConditionOp,
+  raw: string,
+): DecisionCondition["value"] {
+  if (op === "empty" || op === "not_empty") return undefined;
+  const numeric = kind === "votes" || kind === "number";
+  const toValue = (s: string) => (numeric && s !== "" ? Number(s) : s);
+  if (op === "in") {
+    return raw
+      .split(",")
+      .map((s) => s.trim())
+      .filter(Boolean)
+      .map(toValue);
+  }
+  return toValue(raw.trim());
+}
+
+function formatValue(value: DecisionCondition["value"]) {
+  if (value === undefined) return "";
+  return Array.isArray(value) ? value.join(", ") : String(value);
+}
+
+function newRule(index: number): DecisionRule {
+  return {
+    name: `Rule ${index + 1}`,
+    status: "accepted",
+    conditions: [{ field: "accept_votes", op: "gte", value: 2 }],
+  };
+}
+
+interface DecisionRulesDialogProps {
+  open: boolean;
+  onOpenChange: (open: boolean) => void;
+  onApplied: () => void;
+}
+
+export function DecisionRulesDialog({
+  open,
+  onOpenChange,
+  onApplied,
+}: DecisionRulesDialogProps) {
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="flex h-[92vh] w-full flex-col gap-0 p-0 sm:max-w-4xl">
+        {/* Remount on each open so rules and previews start fresh */}
+        {open && (
+          <DecisionRulesContent
+            onClose={() => onOpenChange(false)}
+            onApplied={onApplied}
+          />
+        )}
+      </DialogContent>
+    </Dialog>
+  );
+}
+
+function DecisionRulesContent({
+  onClose,
+  onApplied,
+}: {
+  onClose: () => void;
+  onApplied: () => void;
+}) {
+  const [rules, setRules] = useState<DecisionRule[]>([]);
+  const [fields, setFields] = useState<RuleField[]>(METRIC_FIELDS);
+  const [loading, setLoading] = useState(true);
+  const [dirty, setDirty] = useState(false);
+  const [saving, setSaving] = useState(false);
+  const [previewing, setPreviewing] = useState(false);
+  const [applying, setApplying] = useState(false);
+  const [confirmOpen, setConfirmOpen] = useState(false);
+  const [preview, setPreview] = useState<DecisionRunResult | null>(null);
+  // Raw text of each value input, keyed "rule.condition", so partly typed
+  // lists and numbers aren't reformatted while editing.
+  const [drafts, setDrafts] = useState<Record<string, string>>({});
+
+  useEffect(() => {
+    const controller = new AbortController();
+    Promise.all([
+      fetchDecisionRules(controller.signal),
+      fetchApplicationFilterFields(controller.signal),
+    ]).then(([rulesRes, fieldsRes]) => {
+      if (controller.signal.aborted) return;
+      if (rulesRes.status === 200 && rulesRes.data) {
+        setRules(rulesRes.data.rules);
+      } else {
+        errorAlert(rulesRes);
+      }
+      if (fieldsRes.status === 200 && fieldsRes.data) {
+        const schemaFields = fieldsRes.data.fields.map(toRuleField);
+        setFields([...METRIC_FIELDS, ...schemaFields]);
+      }
+      setLoading(false);
+    });
+    return () => controller.abort();
+  }, []);
+
+  const fieldById = (id: string) =>
+    fields.find((f) => f.id === id) ?? {
+      id,
+      label: id,
+      kind: "select" as FieldKind,
+    };
+
+  const update = (next: DecisionRule[]) => {
+    setRules(next);
+    setDirty(true);
+    setPreview(null);
+  };
+
+  const updateRule = (i: number, patch: Partial<DecisionRule>) =>
+    update(rules.map((r, j) => (j === i ? { ...r, ...patch } : r)));
+
+  const updateCondition = (
+    i: number,
+    k: number,
+    patch: Partial<DecisionCondition>,
+  ) =>
+    updateRule(i, {
+      conditions: rules[i].conditions.map((c, j) =>
+        j === k ? { ...c, ...patch } : c,
+      ),
+    });
+
+  const moveRule = (i: number, by: number) => {
+    const next = [...rules];
+    [next[i], next[i + by]] = [next[i + by], next[i]];
+    setDrafts({});
+    update(next);
+  };
+
+  const removeRule = (i: number) => {
+    setDrafts({});
+    update(rules.filter((_, j) => j !== i));
+  };
+
+  const removeCondition = (i: number, k: number) => {
+    setDrafts({});
+    updateRule(i, {
+      conditions: rules[i].conditions.filter((_, j) => j !== k),
+    });
+  };
+
+  const handleSave = async () => {
+    setSaving(true);
+    const res = await saveDecisionRules(rules);
+    setSaving(false);
+    if (res.status === 200 && res.data) {
+      setRules(res.data.rules);
+      setDirty(false);
+      toast.success("Decision rules saved");
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const handlePreview = async () => {
+    setPreviewing(true);
+    const res = await previewDecisions();
+    setPreviewing(false);
+    if (res.status === 200 && res.data) {
+      setPreview(res.data);
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  const handleApply = async () => {
+    setConfirmOpen(false);
+    setApplying(true);
+    const res = await applyDecisions();
+    setApplying(false);
+    if (res.status === 200 && res.data) {
+      toast.success(`Decided ${res.data.decisions.length} application(s)`);
+      const over = res.data.quota_breaches.map(formatQuotaBreach).join(", ");
+      if (over) toast.warning(`Over quota: ${over}`);
+      onApplied();
+      onClose();
+    } else {
+      errorAlert(res);
+    }
+  };
+
+  return (
+    <>
+      <DialogHeader className="border-b p-6">
+        <DialogTitle>Decision Rules</DialogTitle>
+        <DialogDescription>
+          Rules decide submitted applications on their review votes, AI
+          percent and answers. They're tried in order and the first whose
+          conditions all hold sets the status; applications no rule matches
+          stay submitted.
+        </DialogDescription>
+      </DialogHeader>
+
+      <div className="flex-1 space-y-4 overflow-auto p-6">
+        {loading ? (
+          <Skeleton className="h-32 w-full" />
+        ) : (
+          <>
+            {rules.length === 0 && (
+              <p className="text-sm text-muted-foreground">
+                No rules yet. Add one to start deciding applications
+                automatically.
+              </p>
+            )}
+            {rules.map((rule, i) => (
+              <div key={i} className="space-y-3 rounded-md border p-3">
+                <div className="flex items-center gap-2">
+                  <span className="w-6 text-sm text-muted-foreground">
+                    {i + 1}.
+                  </span>
+                  <Input
+                    value={rule.name}
+                    onChange={(e) => updateRule(i, { name: e.target.value })}
+                    maxLength={100}
+                    className="h-8 flex-1"
+                  />
+                  <Select
+                    value={rule.status}
+                    onValueChange={(v) =>
+                      updateRule(i, { status: v as DecidedStatus })
+                    }
+                  >
+                    <SelectTrigger className="h-8 w-36">
+                      <SelectValue />
+                    </SelectTrigger>
+                    <SelectContent>
+                      {DECIDED_STATUSES.map((s) => (
+                        <SelectItem key={s} value={s}>
+                          {s}
+                        </SelectItem>
+                      ))}
+                    </SelectContent>
+                  </Select>
+                  <Button
+                    variant="ghost"
+                    size="icon-sm"
+                    className="cursor-pointer"
+                    aria-label="Move up"
+                    disabled={i === 0}
+                    onClick={() => moveRule(i, -1)}
+                  >
+                    <ArrowUp className="size-4" />
+                  </Button>
+                  <Button
+                    variant="ghost"
+                    size="icon-sm"
+                    className="cursor-pointer"
+                    aria-label="Move down"
+                    disabled={i === rules.length - 1}
+                    onClick={() => moveRule(i, 1)}
+                  >
+                    <ArrowDown className="size-4" />
+                  </Button>
+                  <Button
+                    variant="ghost"
+                    size="icon-sm"
+                    className="cursor-pointer"
+                    aria-label={`Delete ${rule.name}`}
+                    onClick={() => removeRule(i)}
+                  >
+                    <Trash2 className="size-4 text-muted-foreground" />
+                  </Button>
+                </div>
+
+                {rule.conditions.map((c, k) => {
+                  const field = fieldById(c.field);
+                  const ops = OPS_BY_KIND[field.kind];
+                  const numeric =
+                    field.kind === "votes" || field.kind === "number";
+                  const key = `${i}.${k}`;
+                  return (
+                    <div key={k} className="flex items-center gap-2 pl-8">
+                      <span className="w-10 text-xs text-muted-foreground">
+                        {k === 0 ? "if" : "and"}
+                      </span>
+                      <Select
+                        value={c.field}
+                        onValueChange={(id) => {
+                          const kind = fieldById(id).kind;
+                          setDrafts((d) => {
+                            const next = { ...d };
+                            delete next[key];
+                            return next;
+                          });
+                          updateCondition(i, k, {
+                            field: id,
+                            op: OPS_BY_KIND[kind][0],
+                            value: undefined,
+                          });
+                        }}
+                      >
+                        <SelectTrigger className="h-8 w-48">
+                          <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                          {fields.map((f) => (
+                            <SelectItem key={f.id} value={f.id}>
+                              {f.label}
+                            </SelectItem>
+                          ))}
+                        </SelectContent>
+                      </Select>
+                      <Select
+                        value={c.op}
+                        onValueChange={(v) => {
+                          const op = v as DecisionConditionOp;
+                          const raw = drafts[key] ?? formatValue(c.value);
+                          updateCondition(i, k, {
+                            op,
+                            value: parseValue(field.kind, op, raw),
+                          });
+                        }}
+                      >
+                        <SelectTrigger className="h-8 w-32">
+                          <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                          {ops.map((op) => (
+                            <SelectItem key={op} value={op}>
+                              {OP_LABELS[op]}
+                            </SelectItem>
+                          ))}
+                        </SelectContent>
+                      </Select>
+                      {c.op !== "empty" && c.op !== "not_empty" && (
+                        <Input
+                          value={drafts[key] ?? formatValue(c.value)}
+                          onChange={(e) => {
+                            const raw = e.target.value;
+                            setDrafts((d) => ({ ...d, [key]: raw }));
+                            updateCondition(i, k, {
+                              value: parseValue(field.kind, c.op, raw),
+                            });
+                          }}
+                          placeholder={
+                            c.op === "in"
+                              ? "Comma-separated values"
+                              : field.options?.slice(0, 2).join(", ")
+                          }
+                          type={numeric && c.op !== "in" ? "number" : "text"}
+                          className="h-8 flex-1"
+                        />
+                      )}
+                      <Button
+                        variant="ghost"
+                        size="icon-sm"
+                        className="cursor-pointer"
+                        aria-label="Remove condition"
+                        disabled={rule.conditions.length === 1}
+                        onClick={() => removeCondition(i, k)}
+                      >
+                        <X className="size-4 text-muted-foreground" />
+                      </Button>
+                    </div>
+                  );
+                })}
+                <Button
+                  variant="ghost"
+                  size="sm"
+                  className="ml-8 cursor-pointer"
+                  onClick={() =>
+                    updateRule(i, {
+                      conditions: [
+                        ...rule.conditions,
+                        { field: "reject_votes", op: "eq", value: 0 },
+                      ],
+                    })
+                  }
+                >
+                  <Plus className="size-3.5" />
+                  Condition
+                </Button>
+              </div>
+            ))}
+            <Button
+              variant="outline"
+              size="sm"
+              className="cursor-pointer"
+              onClick={() => update([...rules, newRule(rules.length)])}
+            >
+              <Plus className="size-3.5" />
+              Add Rule
+            </Button>
+          </>
+        )}
+
+        {preview && (
+          <div className="space-y-3 border-t pt-4">
+            <div className="flex flex-wrap items-center gap-2 text-sm">
+              {DECIDED_STATUSES.map((s) => (
+                <Badge key={s} className={getStatusColor(s)}>
+                  {preview.counts[s]} {s}
+                </Badge>
+              ))}
+              <span className="text-muted-foreground">
+                {preview.undecided} stay submitted
+              </span>
+            </div>
+            {preview.quota_breaches.map((b) => (
+              <p
+                key={`${b.quota_id}:${b.answer ?? ""}`}
+                className={
+                  b.hard ? "text-xs text-red-600" : "text-xs text-amber-600"
+                }
+              >
+                {b.hard ? "Blocked by quota" : "Over soft quota"}{" "}
+                {formatQuotaBreach(b)}
+              </p>
+            ))}
+            {preview.decisions.length > 0 && (
+              <div className="max-h-72 overflow-auto rounded-md border">
+                <table className="w-full text-sm">
+                  <thead className="sticky top-0 bg-muted text-left">
+                    <tr>
+                      <th className="px-3 py-1.5 font-medium">Applicant</th>
+                      <th className="px-3 py-1.5 font-medium">Votes</th>
+                      <th className="px-3 py-1.5 font-medium">AI %</th>
+                      <th className="px-3 py-1.5 font-medium">Rule</th>
+                      <th className="px-3 py-1.5 font-medium">Status</th>
+                    </tr>
+                  </thead>
+                  <tbody className="divide-y">
+                    {preview.decisions.map((d) => (
+                      <tr key={d.application_id}>
+                        <td className="max-w-56 truncate px-3 py-1.5">
+                          {[d.first_name, d.last_name]
+                            .filter(Boolean)
+                            .join(" ") || d.email}
+                        </td>
+                        <td className="px-3 py-1.5 tabular-nums">
+                          {d.accept_votes}/{d.reject_votes}/
+                          {d.waitlist_votes}
+                        </td>
+                        <td className="px-3 py-1.5 tabular-nums">
+                          {d.ai_percent ?? "–"}
+                        </td>
+                        <td className="px-3 py-1.5">{d.rule}</td>
+                        <td className="px-3 py-1.5">
+                          <Badge className={getStatusColor(d.status)}>
+                            {d.status}
+                          </Badge>
+                        </td>
+                      </tr>
+                    ))}
+                  </tbody>
+                </table>
+              </div>
+            )}
+          </div>
+        )}
+      </div>
+
+      <DialogFooter className="border-t p-4">
+        <Button
+          variant="outline"
+          onClick={handleSave}
+          loading={saving}
+          disabled={!dirty}
+          className="cursor-pointer"
+        >
+          Save Rules
+        </Button>
+        <Button
+          variant="outline"
+          onClick={handlePreview}
+          loading={previewing}
+          disabled={dirty || loading}
+          title={dirty ? "Save the rules to preview them" : undefined}
+          className="cursor-pointer"
+        >
+          Preview
+        </Button>
+        <Button
+          onClick={() => setConfirmOpen(true)}
+          loading={applying}
+          disabled={
+            dirty ||
+            !preview ||
+            preview.decisions.length === 0 ||
+            preview.quota_breaches.some((b) => b.hard)
+          }
+          className="cursor-pointer"
+        >
+          Apply Decisions
+        </Button>
+      </DialogFooter>
+
+      <AlertDialog open={confirmOpen} onOpenChange={setConfirmOpen}>
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Apply decisions?</AlertDialogTitle>
+            <AlertDialogDescription>
+              The rules run again on the current votes and set the status of
+              every submitted application they match. The preview showed{" "}
+              {preview?.decisions.length ?? 0} decision(s). Decision emails
+              aren't sent until you send them.
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <AlertDialogFooter>
+            <AlertDialogCancel className="cursor-pointer">
+              Cancel
+            </AlertDialogCancel>
+            <AlertDialogAction onClick={handleApply} className="cursor-pointer">
+              Apply
+            </AlertDialogAction>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </>
+  );
+}
@@ -1,13 +1,21 @@
+import type { QuotaBreach } from "@/pages/admin/all-applicants/types";
 import { patchRequest } from "@/shared/lib/api";
 import type { ApiResponse, Application } from "@/types";
 
+interface SetStatusResult {
+  application: Application;
+  /** Soft quotas the change took past their cap. */
+  quota_warnings?: QuotaBreach[];
+}
+
 export async function setApplicationStatus(
   id: string,
   status: "accepted" | "rejected" | "waitlisted",
-): Promise<ApiResponse<{ application: Application }>> {
-  return patchRequest<{ application: Application }>(
+  reason?: string,
+): Promise<ApiResponse<SetStatusResult>> {
+  return patchRequest<SetStatusResult>(
     `/superadmin/applications/${id}/status`,
-    { status },
+    { status, reason },
     "application status",
   );
 }
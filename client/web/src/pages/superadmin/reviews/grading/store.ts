@@ -11,6 +11,7 @@ import type {
   ApplicationStatus,
   FetchParams,
 } from "@/pages/admin/all-applicants/types";
+import { formatQuotaBreach } from "@/pages/admin/all-applicants/utils";
 import { fetchReviewNotes } from "@/pages/admin/reviews/api";
 import type { ReviewNote } from "@/pages/admin/reviews/types";
 import type { Application } from "@/types";
@@ -187,6 +188,10 @@ export const useGradingStore = create<GradingState>((set, get) => ({
       set({ applications: updated, grading: false });
 
       toast.success(`Application ${status}`);
+      const over = (res.data?.quota_warnings ?? [])
+        .map(formatQuotaBreach)
+        .join(", ");
+      if (over) toast.warning(`Over quota: ${over}`);
 
       // Auto-advance to next
       if (currentIndex < updated.length - 1) {
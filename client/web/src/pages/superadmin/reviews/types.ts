@@ -1,4 +1,7 @@
-import type { ApplicationStatus } from "@/pages/admin/all-applicants/types";
+import type {
+  ApplicationStatus,
+  QuotaBreach,
+} from "@/pages/admin/all-applicants/types";
 
 /** Statuses a decision email can be sent to. Draft and submitted have no decision. */
 export type DecidedStatus = Extract<
@@ -46,3 +49,81 @@ export interface SendDecisionEmailsResponse {
   queued: number;
   skipped: number;
 }
+
+export type DuplicateScanStatus = "running" | "ready" | "failed";
+
+export interface DuplicateScan {
+  id: string;
+  status: DuplicateScanStatus;
+  applications: number;
+  flagged_applications: number;
+  flags: number;
+  /** Resumes that couldn't be downloaded and were skipped. */
+  resumes_unreadable: number;
+  error: string | null;
+  created_by: string | null;
+  completed_at: string | null;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface DuplicateScanResponse {
+  /** Null when no scan has run for the active hackathon. */
+  scan: DuplicateScan | null;
+}
+
+export type DecisionConditionOp =
+  | "eq"
+  | "neq"
+  | "lt"
+  | "lte"
+  | "gt"
+  | "gte"
+  | "in"
+  | "contains"
+  | "empty"
+  | "not_empty";
+
+/**
+ * Tests accept_votes, reject_votes, waitlist_votes, ai_percent, or a schema
+ * answer as "f.<field id>".
+ */
+export interface DecisionCondition {
+  field: string;
+  op: DecisionConditionOp;
+  value?: string | number | (string | number)[];
+}
+
+/** Gives status when all conditions hold. The first matching rule wins. */
+export interface DecisionRule {
+  name: string;
+  status: DecidedStatus;
+  conditions: DecisionCondition[];
+}
+
+export interface DecisionRulesResponse {
+  rules: DecisionRule[];
+}
+
+export interface ApplicationDecision {
+  application_id: string;
+  email: string;
+  first_name: string | null;
+  last_name: string | null;
+  accept_votes: number;
+  reject_votes: number;
+  waitlist_votes: number;
+  ai_percent: number | null;
+  status: DecidedStatus;
+  rule: string;
+}
+
+export interface DecisionRunResult {
+  dry_run: boolean;
+  bulk_id?: string;
+  counts: Record<DecidedStatus, number>;
+  /** Submitted applications no rule matched; they stay submitted. */
+  undecided: number;
+  decisions: ApplicationDecision[];
+  quota_breaches: QuotaBreach[];
+}
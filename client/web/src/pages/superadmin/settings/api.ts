@@ -2,30 +2,46 @@ import { getRequest, postRequest, putRequest } from "@/shared/lib/api";
 import type { ApiResponse } from "@/types";
 
 import type {
-  DateSettingResult,
+  AdmissionQuota,
+  AdmissionQuotasResult,
+  ApplicationsToggleResult,
+  ApplicationWindow,
   EmailSettingResult,
   FromNameResult,
+  HackathonArchiveListResult,
+  HackathonArchiveResult,
   HackathonDateRangeResult,
+  HackathonListResult,
   HackathonNameResult,
+  HackathonResult,
+  HackathonStatsResult,
   HackerPackURLResult,
   MealGroupsResult,
   MealGroupStatsResult,
   OnboardingStatus,
   PointsEnabledResult,
   PointsNameResult,
+  QuotaUsageResult,
   ResetHackathonOptions,
   ResetHackathonResult,
+  RestoreHackathonArchiveResult,
+  ResumeBookDownloadURLResult,
+  ResumeBookListResult,
+  ResumeBookResult,
 } from "./types";
 
 // Partial: omitted domains default to false server-side, which lets targeted
 // callers (e.g. "clear the schedule") name only what they mean to reset.
+// skipArchive resets without an archive; the server only honours it when no
+// object storage is configured.
 export async function resetHackathon(
   options: Partial<ResetHackathonOptions>,
+  skipArchive = false,
 ): Promise<ApiResponse<ResetHackathonResult>> {
-  return postRequest<ResetHackathonResult>(
-    "/superadmin/reset-hackathon",
-    options,
-  );
+  return postRequest<ResetHackathonResult>("/superadmin/reset-hackathon", {
+    ...options,
+    skip_archive: skipArchive,
+  });
 }
 
 export async function fetchMealGroups(
@@ -58,6 +74,36 @@ export async function fetchMealGroupStats(
   );
 }
 
+export async function fetchAdmissionQuotas(
+  signal?: AbortSignal,
+): Promise<ApiResponse<AdmissionQuotasResult>> {
+  return getRequest<AdmissionQuotasResult>(
+    "/superadmin/settings/quotas",
+    "admission quotas",
+    signal,
+  );
+}
+
+export async function saveAdmissionQuotas(
+  quotas: AdmissionQuota[],
+): Promise<ApiResponse<AdmissionQuotasResult>> {
+  return putRequest<AdmissionQuotasResult>(
+    "/superadmin/settings/quotas",
+    { quotas },
+    "admission quotas",
+  );
+}
+
+export async function fetchQuotaUsage(
+  signal?: AbortSignal,
+): Promise<ApiResponse<QuotaUsageResult>> {
+  return getRequest<QuotaUsageResult>(
+    "/admin/applications/quotas",
+    "quota usage",
+    signal,
+  );
+}
+
 export async function fetchHackerPackURL(
   signal?: AbortSignal,
 ): Promise<ApiResponse<HackerPackURLResult>> {
@@ -189,23 +235,34 @@ export async function updateFromName(
   );
 }
 
-export async function fetchApplicationDueDate(
+export async function fetchApplicationWindow(
   signal?: AbortSignal,
-): Promise<ApiResponse<DateSettingResult>> {
-  return getRequest<DateSettingResult>(
-    "/superadmin/settings/application-due-date",
-    "application due date",
+): Promise<ApiResponse<ApplicationWindow>> {
+  return getRequest<ApplicationWindow>(
+    "/superadmin/settings/application-window",
+    "application window",
     signal,
   );
 }
 
-export async function updateApplicationDueDate(
-  date: string,
-): Promise<ApiResponse<DateSettingResult>> {
-  return postRequest<DateSettingResult>(
-    "/superadmin/settings/application-due-date",
-    { date },
-    "application due date",
+export async function updateApplicationWindow(
+  window: ApplicationWindow,
+): Promise<ApiResponse<ApplicationWindow>> {
+  return postRequest<ApplicationWindow>(
+    "/superadmin/settings/application-window",
+    window,
+    "application window",
+  );
+}
+
+// The manual toggle alone; /applications/enabled also folds in the window.
+export async function fetchApplicationsToggle(
+  signal?: AbortSignal,
+): Promise<ApiResponse<ApplicationsToggleResult>> {
+  return getRequest<ApplicationsToggleResult>(
+    "/superadmin/settings/applications-enabled",
+    "applications status",
+    signal,
   );
 }
 
@@ -230,3 +287,104 @@ export async function updatePointsEnabled(
     "points system enabled",
   );
 }
+
+export async function fetchResumeBooks(
+  signal?: AbortSignal,
+): Promise<ApiResponse<ResumeBookListResult>> {
+  return getRequest<ResumeBookListResult>(
+    "/superadmin/resume-books",
+    "resume books",
+    signal,
+  );
+}
+
+export async function createResumeBook(
+  optInField: string,
+): Promise<ApiResponse<ResumeBookResult>> {
+  return postRequest<ResumeBookResult>(
+    "/superadmin/resume-books",
+    { opt_in_field: optInField },
+    "resume book",
+  );
+}
+
+export async function fetchResumeBookDownloadURL(
+  id: string,
+): Promise<ApiResponse<ResumeBookDownloadURLResult>> {
+  return getRequest<ResumeBookDownloadURLResult>(
+    `/superadmin/resume-books/${id}/download-url`,
+    "resume book download link",
+  );
+}
+
+export async function fetchHackathons(
+  signal?: AbortSignal,
+): Promise<ApiResponse<HackathonListResult>> {
+  return getRequest<HackathonListResult>(
+    "/superadmin/hackathons",
+    "hackathons",
+    signal,
+  );
+}
+
+export async function fetchHackathonStats(
+  signal?: AbortSignal,
+): Promise<ApiResponse<HackathonStatsResult>> {
+  return getRequest<HackathonStatsResult>(
+    "/superadmin/hackathons/stats",
+    "hackathon comparison",
+    signal,
+  );
+}
+
+export async function createHackathon(payload: {
+  name: string;
+  starts_on: string | null;
+  ends_on: string | null;
+}): Promise<ApiResponse<HackathonResult>> {
+  return postRequest<HackathonResult>(
+    "/superadmin/hackathons",
+    payload,
+    "hackathon",
+  );
+}
+
+export async function activateHackathon(
+  id: string,
+): Promise<ApiResponse<HackathonResult>> {
+  return postRequest<HackathonResult>(
+    `/superadmin/hackathons/${id}/activate`,
+    {},
+    "active hackathon",
+  );
+}
+
+export async function fetchHackathonArchives(
+  signal?: AbortSignal,
+): Promise<ApiResponse<HackathonArchiveListResult>> {
+  return getRequest<HackathonArchiveListResult>(
+    "/superadmin/hackathons/archives",
+    "archives",
+    signal,
+  );
+}
+
+export async function createHackathonArchive(): Promise<
+  ApiResponse<HackathonArchiveResult>
+> {
+  return postRequest<HackathonArchiveResult>(
+    "/superadmin/hackathons/archives",
+    {},
+    "archive",
+  );
+}
+
+export async function restoreHackathonArchive(
+  id: string,
+): Promise<ApiResponse<RestoreHackathonArchiveResult>> {
+  return postRequest<RestoreHackathonArchiveResult>(
+    `/superadmin/hackathons/archives/${id}/restore`,
+    {},
+    "archive restore",
+  );
+}
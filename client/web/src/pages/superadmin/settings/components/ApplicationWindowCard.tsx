@@ -0,0 +1,170 @@
+import { useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { errorAlert } from "@/shared/lib/api";
+import { getLocalTimeZoneLabel } from "@/shared/lib/datetime";
+
+import { fetchApplicationWindow, updateApplicationWindow } from "../api";
+
+// "YYYY-MM-DDTHH:mm" in the viewer's zone, as a datetime-local input wants.
+function toInputValue(iso: string | null): string {
+  if (!iso) return "";
+  const d = new Date(iso);
+  const local = new Date(d.getTime() - d.getTimezoneOffset() * 60_000);
+  return local.toISOString().slice(0, 16);
+}
+
+function fromInputValue(value: string): string | null {
+  return value ? new Date(value).toISOString() : null;
+}
+
+/**
+ * Edits when applications open and close on their own. The manual toggle above
+ * still has to be on; the window only narrows when it applies.
+ */
+export function ApplicationWindowCard() {
+  const [opensAt, setOpensAt] = useState("");
+  const [closesAt, setClosesAt] = useState("");
+  const [timezone, setTimezone] = useState(getLocalTimeZoneLabel().iana);
+  const [graceMinutes, setGraceMinutes] = useState("0");
+  const [loading, setLoading] = useState(true);
+  const [saving, setSaving] = useState(false);
+
+  useEffect(() => {
+    const controller = new AbortController();
+    fetchApplicationWindow(controller.signal).then((res) => {
+      if (controller.signal.aborted) return;
+      if (res.status === 200 && res.data) {
+        setOpensAt(toInputValue(res.data.opens_at));
+        setClosesAt(toInputValue(res.data.closes_at));
+        if (res.data.opens_at || res.data.closes_at) {
+          setTimezone(res.data.timezone);
+        }
+        setGraceMinutes(String(res.data.grace_minutes));
+      } else {
+        errorAlert(res);
+      }
+      setLoading(false);
+    });
+    return () => controller.abort();
+  }, []);
+
+  const grace = Number(graceMinutes);
+  const validationError =
+    opensAt && closesAt && new Date(opensAt) >= new Date(closesAt)
+      ? "Applications must open before they close."
+      : !Number.isInteger(grace) || grace < 0
+        ? "Grace period must be a whole number of minutes."
+        : !timezone.trim()
+          ? "Timezone is required."
+          : null;
+
+  async function handleSave() {
+    if (validationError) {
+      toast.error(validationError);
+      return;
+    }
+    setSaving(true);
+    const res = await updateApplicationWindow({
+      opens_at: fromInputValue(opensAt),
+      closes_at: fromInputValue(closesAt),
+      timezone: timezone.trim(),
+      grace_minutes: grace,
+    });
+    setSaving(false);
+    if (res.status === 200) {
+      toast.success("Application window saved.");
+    } else {
+      errorAlert(res);
+    }
+  }
+
+  const disabled = loading || saving;
+
+  return (
+    <div className="bg-zinc-900 rounded-md p-4 space-y-4">
+      <div className="space-y-1">
+        <p className="text-sm font-medium text-zinc-100">Application Window</p>
+        <p className="text-xs text-zinc-500">
+          While submissions are enabled, applications open and close at these
+          times. Leave a time blank to keep that side open. Times are entered
+          in {getLocalTimeZoneLabel().label}.
+        </p>
+      </div>
+
+      <div className="grid grid-cols-1 gap-3 md:grid-cols-2">
+        <div className="space-y-1.5">
+          <Label htmlFor="window-opens" className="text-zinc-300">
+            Opens
+          </Label>
+          <Input
+            id="window-opens"
+            type="datetime-local"
+            value={opensAt}
+            disabled={disabled}
+            onChange={(e) => setOpensAt(e.target.value)}
+            className="border-zinc-800 bg-zinc-950 text-zinc-100"
+          />
+        </div>
+        <div className="space-y-1.5">
+          <Label htmlFor="window-closes" className="text-zinc-300">
+            Closes
+          </Label>
+          <Input
+            id="window-closes"
+            type="datetime-local"
+            value={closesAt}
+            disabled={disabled}
+            onChange={(e) => setClosesAt(e.target.value)}
+            className="border-zinc-800 bg-zinc-950 text-zinc-100"
+          />
+        </div>
+        <div className="space-y-1.5">
+          <Label htmlFor="window-timezone" className="text-zinc-300">
+            Shown to hackers in
+          </Label>
+          <Input
+            id="window-timezone"
+            placeholder="America/Chicago"
+            value={timezone}
+            disabled={disabled}
+            onChange={(e) => setTimezone(e.target.value)}
+            className="border-zinc-800 bg-zinc-950 text-zinc-100 placeholder:text-zinc-600"
+          />
+        </div>
+        <div className="space-y-1.5">
+          <Label htmlFor="window-grace" className="text-zinc-300">
+            Grace period (minutes)
+          </Label>
+          <Input
+            id="window-grace"
+            type="number"
+            min={0}
+            value={graceMinutes}
+            disabled={disabled}
+            onChange={(e) => setGraceMinutes(e.target.value)}
+            className="border-zinc-800 bg-zinc-950 text-zinc-100"
+          />
+          <p className="text-xs text-zinc-500">
+            Hackers with a draft started before the close can keep editing and
+            submit for this long after it.
+          </p>
+        </div>
+      </div>
+
+      <div className="flex items-center justify-between gap-3">
+        <p className="text-xs text-red-400">{validationError ?? ""}</p>
+        <Button
+          onClick={handleSave}
+          disabled={disabled || !!validationError}
+          className="cursor-pointer bg-white text-black hover:bg-zinc-200"
+        >
+          {saving ? "Saving..." : "Save window"}
+        </Button>
+      </div>
+    </div>
+  );
+}
@@ -27,6 +27,7 @@ import { ScrollArea } from "@/components/ui/scroll-area";
 import { errorAlert } from "@/shared/lib/api";
 import {
   formatPickerDate,
+  getLocalTimeZoneLabel,
   parseDateOnly,
   startOfDay,
   toDateKey,
@@ -34,21 +35,21 @@ import {
 import { cn } from "@/shared/lib/utils";
 
 import {
-  fetchApplicationDueDate,
+  fetchApplicationWindow,
   fetchContactEmail,
   fetchFromEmail,
   fetchFromName,
   fetchHackathonDateRange,
   fetchHackathonName,
   resetHackathon,
-  updateApplicationDueDate,
+  updateApplicationWindow,
   updateContactEmail,
   updateFromEmail,
   updateFromName,
   updateHackathonDateRange,
   updateHackathonName,
 } from "../api";
-import type { OnboardingValues } from "../types";
+import type { ApplicationWindow, OnboardingValues } from "../types";
 
 const MS_PER_DAY = 24 * 60 * 60 * 1000;
 const EMAIL_PATTERN = /^[^\s@]+@[^\s@]+\.[^\s@]+$/;
@@ -63,6 +64,23 @@ const EMPTY_VALUES: OnboardingValues = {
   from_name: "",
 };
 
+// The due date is the last local day applications are accepted, so the window
+// closes at the following midnight.
+function dueDateFromWindow(window: ApplicationWindow | undefined): string {
+  if (!window?.closes_at) return "";
+  return toDateKey(new Date(new Date(window.closes_at).getTime() - 1));
+}
+
+function closesAtFromDueDate(dueDate: string): string | null {
+  const day = parseDateOnly(dueDate);
+  if (!day) return null;
+  return new Date(
+    day.getFullYear(),
+    day.getMonth(),
+    day.getDate() + 1,
+  ).toISOString();
+}
+
 interface OnboardingDialogProps {
   open: boolean;
   onOpenChange: (open: boolean) => void;
@@ -147,6 +165,9 @@ export function OnboardingDialog({
     startDate: string;
     endDate: string;
   } | null>(null);
+  const [savedWindow, setSavedWindow] = useState<ApplicationWindow | null>(
+    null,
+  );
   const [loading, setLoading] = useState(true);
   const [saving, setSaving] = useState(false);
   const [confirmDateChangeOpen, setConfirmDateChangeOpen] = useState(false);
@@ -165,21 +186,22 @@ export function OnboardingDialog({
     const load = async () => {
       setLoading(true);
       setSavedDateRange(null);
-      const [name, range, appDue, contact, from, fromName] = await Promise.all([
-        fetchHackathonName(controller.signal),
-        fetchHackathonDateRange(controller.signal),
-        fetchApplicationDueDate(controller.signal),
-        fetchContactEmail(controller.signal),
-        fetchFromEmail(controller.signal),
-        fetchFromName(controller.signal),
-      ]);
+      const [name, range, appWindow, contact, from, fromName] =
+        await Promise.all([
+          fetchHackathonName(controller.signal),
+          fetchHackathonDateRange(controller.signal),
+          fetchApplicationWindow(controller.signal),
+          fetchContactEmail(controller.signal),
+          fetchFromEmail(controller.signal),
+          fetchFromName(controller.signal),
+        ]);
       if (controller.signal.aborted) return;
 
       setValues({
         hackathon_name: name.data?.name ?? "",
         start_date: range.data?.start_date ?? "",
         end_date: range.data?.end_date ?? "",
-        application_due_date: appDue.data?.date ?? "",
+        application_due_date: dueDateFromWindow(appWindow.data),
         contact_email: contact.data?.email ?? "",
         from_email: from.data?.email ?? "",
         from_name: fromName.data?.name ?? "",
@@ -192,6 +214,7 @@ export function OnboardingDialog({
             }
           : null,
       );
+      setSavedWindow(appWindow.data ?? null);
       setLoading(false);
     };
 
@@ -262,7 +285,14 @@ export function OnboardingDialog({
     const responses = await Promise.all([
       updateHackathonName(values.hackathon_name.trim()),
       updateHackathonDateRange(values.start_date, values.end_date),
-      updateApplicationDueDate(values.application_due_date),
+      updateApplicationWindow({
+        opens_at: savedWindow?.opens_at ?? null,
+        timezone: savedWindow?.closes_at
+          ? savedWindow.timezone
+          : getLocalTimeZoneLabel().iana,
+        grace_minutes: savedWindow?.grace_minutes ?? 0,
+        closes_at: closesAtFromDueDate(values.application_due_date),
+      }),
       updateContactEmail(values.contact_email.trim()),
       updateFromEmail(values.from_email.trim()),
       updateFromName(values.from_name.trim()),
@@ -3,6 +3,9 @@
 import {
   AlertTriangle,
   BookOpen,
+  CalendarRange,
+  FileArchive,
+  Gauge,
   Rocket,
   ShieldCheck,
   UtensilsCrossed,
@@ -22,24 +25,33 @@ import {
 import { ScrollArea } from "@/components/ui/scroll-area";
 import { cn } from "@/shared/lib/utils";
 
+import EventsTab from "../tabs/EventsTab";
 import HackathonTab from "../tabs/HackathonTab";
 import HackerPackTab from "../tabs/HackerPackTab";
 import MealGroupsTab from "../tabs/MealGroupsTab";
 import PermissionsTab from "../tabs/PermissionsTab";
+import QuotasTab from "../tabs/QuotasTab";
 import { ResetHackathonCard } from "../tabs/ResetHackathonCard";
+import ResumeBooksTab from "../tabs/ResumeBooksTab";
 
 type SettingsTab =
   | "hackathon"
   | "permissions"
   | "meal-groups"
+  | "quotas"
   | "hacker-pack"
+  | "resume-books"
+  | "events"
   | "reset";
 
 const settingsTabs = [
   { id: "hackathon" as const, label: "Hackathon", icon: Rocket },
   { id: "permissions" as const, label: "Permissions", icon: ShieldCheck },
   { id: "meal-groups" as const, label: "Meal Groups", icon: UtensilsCrossed },
+  { id: "quotas" as const, label: "Quotas", icon: Gauge },
   { id: "hacker-pack" as const, label: "Hacker Pack", icon: BookOpen },
+  { id: "resume-books" as const, label: "Resume Books", icon: FileArchive },
+  { id: "events" as const, label: "Events", icon: CalendarRange },
   { id: "reset" as const, label: "Danger Zone", icon: AlertTriangle },
 ];
 
@@ -97,7 +109,10 @@ export function SettingsDialog({ trigger }: SettingsDialogProps) {
                 {activeTab === "hackathon" && <HackathonTab />}
                 {activeTab === "permissions" && <PermissionsTab />}
                 {activeTab === "meal-groups" && <MealGroupsTab />}
+                {activeTab === "quotas" && <QuotasTab />}
                 {activeTab === "hacker-pack" && <HackerPackTab />}
+                {activeTab === "resume-books" && <ResumeBooksTab />}
+                {activeTab === "events" && <EventsTab />}
                 {activeTab === "reset" && <ResetHackathonCard />}
               </div>
             </ScrollArea>
@@ -0,0 +1,358 @@
+import { useCallback, useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { errorAlert } from "@/shared/lib/api";
+
+import {
+  activateHackathon,
+  createHackathon,
+  createHackathonArchive,
+  fetchHackathonArchives,
+  fetchHackathons,
+  fetchHackathonStats,
+  restoreHackathonArchive,
+} from "../api";
+import type { Hackathon, HackathonArchive, HackathonStats } from "../types";
+
+const MAX_NAME_LENGTH = 100;
+
+const STAT_COLUMNS: { key: keyof HackathonStats; label: string }[] = [
+  { key: "applications", label: "Applied" },
+  { key: "accepted", label: "Accepted" },
+  { key: "confirmed", label: "Confirmed" },
+  { key: "checked_in", label: "Checked In" },
+  { key: "teams", label: "Teams" },
+  { key: "projects", label: "Projects" },
+  { key: "sponsors", label: "Sponsors" },
+];
+
+function formatSize(bytes: number): string {
+  if (bytes < 1024 * 1024) {
+    return `${Math.max(1, Math.round(bytes / 1024))} KB`;
+  }
+  return `${(bytes / (1024 * 1024)).toFixed(1)} MB`;
+}
+
+function formatDates(h: Hackathon): string {
+  if (h.starts_on && h.ends_on) return `${h.starts_on} to ${h.ends_on}`;
+  return h.starts_on ?? h.ends_on ?? "No dates";
+}
+
+export default function EventsTab() {
+  const [hackathons, setHackathons] = useState<Hackathon[]>([]);
+  const [stats, setStats] = useState<HackathonStats[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [name, setName] = useState("");
+  const [startsOn, setStartsOn] = useState("");
+  const [endsOn, setEndsOn] = useState("");
+  const [creating, setCreating] = useState(false);
+  const [activatingId, setActivatingId] = useState<string | null>(null);
+  const [archives, setArchives] = useState<HackathonArchive[]>([]);
+  const [archiving, setArchiving] = useState(false);
+  const [restoringId, setRestoringId] = useState<string | null>(null);
+
+  const load = useCallback(async (signal?: AbortSignal) => {
+    const [listRes, statsRes, archivesRes] = await Promise.all([
+      fetchHackathons(signal),
+      fetchHackathonStats(signal),
+      fetchHackathonArchives(signal),
+    ]);
+    if (signal?.aborted) return;
+    if (listRes.status === 200 && listRes.data) {
+      setHackathons(listRes.data.hackathons);
+    } else {
+      errorAlert(listRes);
+    }
+    if (statsRes.status === 200 && statsRes.data) {
+      setStats(statsRes.data.hackathons);
+    } else {
+      errorAlert(statsRes);
+    }
+    // Without object storage there is nothing to list; that is not an error.
+    if (archivesRes.status === 200 && archivesRes.data) {
+      setArchives(archivesRes.data.archives);
+    } else if (archivesRes.status !== 503) {
+      errorAlert(archivesRes);
+    }
+    setLoading(false);
+  }, []);
+
+  useEffect(() => {
+    const controller = new AbortController();
+    load(controller.signal);
+    return () => controller.abort();
+  }, [load]);
+
+  async function create() {
+    setCreating(true);
+    const res = await createHackathon({
+      name: name.trim(),
+      starts_on: startsOn || null,
+      ends_on: endsOn || null,
+    });
+    if (res.status === 201 && res.data) {
+      toast.success(`Created ${res.data.hackathon.name}.`);
+      setName("");
+      setStartsOn("");
+      setEndsOn("");
+      await load();
+    } else {
+      errorAlert(res);
+    }
+    setCreating(false);
+  }
+
+  async function activate(h: Hackathon) {
+    if (
+      !window.confirm(
+        `Switch the portal to ${h.name}? The current event will be archived.`,
+      )
+    ) {
+      return;
+    }
+    setActivatingId(h.id);
+    const res = await activateHackathon(h.id);
+    if (res.status === 200 && res.data) {
+      toast.success(`${res.data.hackathon.name} is now the active event.`);
+      await load();
+    } else {
+      errorAlert(res);
+    }
+    setActivatingId(null);
+  }
+
+  async function archive() {
+    setArchiving(true);
+    const res = await createHackathonArchive();
+    if (res.status === 201 && res.data) {
+      setArchives((prev) => [res.data!.archive, ...prev]);
+      toast.success("Archive saved.");
+    } else {
+      errorAlert(res);
+    }
+    setArchiving(false);
+  }
+
+  async function restore(a: HackathonArchive) {
+    if (
+      !window.confirm(
+        "Restore this archive into the active event? The event must have no applications, walk-ins, teams, scans, schedule, notifications, sponsors or FAQs.",
+      )
+    ) {
+      return;
+    }
+    setRestoringId(a.id);
+    const res = await restoreHackathonArchive(a.id);
+    if (res.status === 200 && res.data) {
+      const total = Object.values(res.data.restored).reduce(
+        (sum, n) => sum + n,
+        0,
+      );
+      const resumes = res.data.resumes_restored;
+      toast.success(
+        resumes > 0
+          ? `Restored ${total} rows; copying back ${resumes} resume file${resumes === 1 ? "" : "s"}.`
+          : `Restored ${total} rows.`,
+      );
+      await load();
+    } else {
+      errorAlert(res);
+    }
+    setRestoringId(null);
+  }
+
+  return (
+    <div className="space-y-4">
+      <h3 className="text-lg text-zinc-100">Events</h3>
+      <p className="text-sm text-zinc-400">
+        Each hackathon is its own event cycle. Only the active event is served
+        by the portal; archived events keep their data for comparison.
+      </p>
+
+      <div className="bg-zinc-900 rounded-md p-4 space-y-4">
+        <div className="grid grid-cols-3 gap-3">
+          <div className="space-y-2">
+            <Label
+              htmlFor="event-name"
+              className="text-sm font-medium text-zinc-100"
+            >
+              Name
+            </Label>
+            <Input
+              id="event-name"
+              value={name}
+              onChange={(e) => setName(e.target.value)}
+              placeholder="HackUTD 2027"
+              maxLength={MAX_NAME_LENGTH}
+              className="h-8 border-zinc-700 bg-zinc-800 text-sm font-light text-zinc-100"
+            />
+          </div>
+          <div className="space-y-2">
+            <Label
+              htmlFor="event-starts-on"
+              className="text-sm font-medium text-zinc-100"
+            >
+              Starts
+            </Label>
+            <Input
+              id="event-starts-on"
+              type="date"
+              value={startsOn}
+              onChange={(e) => setStartsOn(e.target.value)}
+              className="h-8 border-zinc-700 bg-zinc-800 text-sm font-light text-zinc-100"
+            />
+          </div>
+          <div className="space-y-2">
+            <Label
+              htmlFor="event-ends-on"
+              className="text-sm font-medium text-zinc-100"
+            >
+              Ends
+            </Label>
+            <Input
+              id="event-ends-on"
+              type="date"
+              value={endsOn}
+              min={startsOn || undefined}
+              onChange={(e) => setEndsOn(e.target.value)}
+              className="h-8 border-zinc-700 bg-zinc-800 text-sm font-light text-zinc-100"
+            />
+          </div>
+        </div>
+
+        <Button
+          onClick={create}
+          disabled={creating || !name.trim()}
+          className="cursor-pointer bg-white text-black hover:bg-zinc-200"
+        >
+          {creating ? "Creating..." : "Create Event"}
+        </Button>
+      </div>
+
+      <div className="bg-zinc-900 rounded-md p-4 space-y-3">
+        <span className="text-sm font-medium text-zinc-100">All Events</span>
+        {loading ? (
+          <p className="text-xs text-zinc-500">Loading...</p>
+        ) : (
+          <ul className="space-y-2">
+            {hackathons.map((h) => (
+              <li
+                key={h.id}
+                className="flex items-center justify-between gap-4 text-sm"
+              >
+                <div className="min-w-0">
+                  <p className="text-zinc-100">{h.name}</p>
+                  <p className="truncate text-xs text-zinc-500">
+                    {formatDates(h)}
+                  </p>
+                </div>
+                {h.is_active ? (
+                  <span className="text-xs text-green-400">Active</span>
+                ) : (
+                  <Button
+                    variant="outline"
+                    size="sm"
+                    onClick={() => activate(h)}
+                    disabled={activatingId !== null}
+                    className="cursor-pointer"
+                  >
+                    {activatingId === h.id ? "Switching..." : "Activate"}
+                  </Button>
+                )}
+              </li>
+            ))}
+          </ul>
+        )}
+      </div>
+
+      {stats.length > 1 && (
+        <div className="bg-zinc-900 rounded-md p-4 space-y-3">
+          <span className="text-sm font-medium text-zinc-100">Comparison</span>
+          <div className="overflow-x-auto">
+            <table className="w-full text-sm">
+              <thead>
+                <tr className="text-left text-xs text-zinc-500">
+                  <th className="py-1 pr-4 font-normal">Event</th>
+                  {STAT_COLUMNS.map((c) => (
+                    <th key={c.key} className="py-1 pr-4 font-normal">
+                      {c.label}
+                    </th>
+                  ))}
+                </tr>
+              </thead>
+              <tbody>
+                {stats.map((s) => (
+                  <tr key={s.hackathon_id} className="text-zinc-100">
+                    <td className="py-1 pr-4">{s.name}</td>
+                    {STAT_COLUMNS.map((c) => (
+                      <td key={c.key} className="py-1 pr-4 tabular-nums">
+                        {s[c.key]}
+                      </td>
+                    ))}
+                  </tr>
+                ))}
+              </tbody>
+            </table>
+          </div>
+        </div>
+      )}
+
+      <div className="bg-zinc-900 rounded-md p-4 space-y-3">
+        <div className="flex items-center justify-between">
+          <div>
+            <span className="text-sm font-medium text-zinc-100">Archives</span>
+            <p className="text-xs text-zinc-500">
+              Taken automatically before every reset. Resume files are not
+              included.
+            </p>
+          </div>
+          <Button
+            variant="outline"
+            size="sm"
+            onClick={archive}
+            disabled={archiving}
+            className="cursor-pointer"
+          >
+            {archiving ? "Archiving..." : "Archive Now"}
+          </Button>
+        </div>
+
+        {archives.length === 0 ? (
+          <p className="text-xs text-zinc-500">No archives yet.</p>
+        ) : (
+          <ul className="space-y-2">
+            {archives.map((a) => (
+              <li
+                key={a.id}
+                className="flex items-center justify-between gap-4 text-sm"
+              >
+                <div className="min-w-0">
+                  <p className="text-zinc-100">
+                    {new Date(a.created_at).toLocaleString()}
+                  </p>
+                  <p className="truncate text-xs text-zinc-500">
+                    {hackathons.find((h) => h.id === a.hackathon_id)?.name ??
+                      a.hackathon_id}{" "}
+                    &middot; {formatSize(a.size_bytes)}
+                  </p>
+                </div>
+                <Button
+                  variant="outline"
+                  size="sm"
+                  onClick={() => restore(a)}
+                  disabled={restoringId !== null}
+                  className="cursor-pointer"
+                >
+                  {restoringId === a.id ? "Restoring..." : "Restore"}
+                </Button>
+              </li>
+            ))}
+          </ul>
+        )}
+      </div>
+    </div>
+  );
+}
@@ -21,7 +21,7 @@ import type { OnboardingStatus } from "../types";
 const CHECKLIST: { key: keyof OnboardingStatus; label: string }[] = [
   { key: "hackathon_name", label: "Hackathon name" },
   { key: "hackathon_date_range", label: "Hackathon dates" },
-  { key: "application_due_date", label: "Applications due" },
+  { key: "application_window", label: "Applications close" },
   { key: "contact_email", label: "Contact email" },
   { key: "from_email", label: "Sender email" },
 ];
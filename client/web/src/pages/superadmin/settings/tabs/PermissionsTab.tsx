@@ -21,6 +21,9 @@ import {
 } from "@/shared/lib/api";
 import { DEFAULT_FEATURE_FLAGS } from "@/shared/lib/feature-defaults";
 
+import { fetchApplicationsToggle } from "../api";
+import { ApplicationWindowCard } from "../components/ApplicationWindowCard";
+
 interface PermissionToggleProps {
   id: string;
   label: string;
@@ -82,10 +85,7 @@ export default function PermissionsTab() {
     async function fetchSettings() {
       const [applicationsRes, scheduleRes, sponsorRes, faqRes] =
         await Promise.all([
-          getRequest<{ enabled: boolean }>(
-            "/applications/enabled",
-            "applications enabled",
-          ),
+          fetchApplicationsToggle(),
           getRequest<{ enabled: boolean }>(
             "/superadmin/settings/admin-schedule-edit-toggle",
             "admin schedule edit toggle",
@@ -236,12 +236,14 @@ export default function PermissionsTab() {
       <PermissionToggle
         id="applications-toggle"
         label="Application Submissions"
-        description="When enabled, hackers can submit their applications."
+        description="When enabled, hackers can submit their applications during the window below."
         checked={applicationsEnabled}
         disabled={loading || applicationsSaving}
         onCheckedChange={handleApplicationsToggle}
       />
 
+      <ApplicationWindowCard />
+
       <PermissionToggle
         id="admin-schedule-edit-toggle"
         label="Admin Schedule Editing"
@@ -0,0 +1,353 @@
+import { Gauge, Plus, RefreshCw, Trash2 } from "lucide-react";
+import { useCallback, useEffect, useMemo, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Progress } from "@/components/ui/progress";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Switch } from "@/components/ui/switch";
+import { errorAlert } from "@/shared/lib/api";
+import type { ApplicationSchemaField } from "@/types";
+
+import { fetchApplicationSchema } from "../../application/api";
+import {
+  fetchAdmissionQuotas,
+  fetchQuotaUsage,
+  saveAdmissionQuotas,
+} from "../api";
+import type { AdmissionQuota, QuotaUsage } from "../types";
+
+const MAX_QUOTAS = 50;
+const POLL_INTERVAL_MS = 30_000;
+// Select has no empty value, so "everyone" stands in for no field.
+const EVERYONE = "__everyone";
+
+// Hackers can give several answers to these, so only a given answer can be
+// capped.
+const LIST_TYPES = new Set(["multi_select", "ranked_choice"]);
+
+function nextQuotaID(quotas: AdmissionQuota[]) {
+  const ids = new Set(quotas.map((q) => q.id));
+  let n = quotas.length + 1;
+  while (ids.has(`quota-${n}`)) n++;
+  return `quota-${n}`;
+}
+
+interface UsageBarProps {
+  label: string;
+  count: number;
+  max: number;
+}
+
+function UsageBar({ label, count, max }: UsageBarProps) {
+  const percent = max > 0 ? Math.min(100, (count / max) * 100) : 100;
+  return (
+    <div className="space-y-1">
+      <div className="flex justify-between text-xs">
+        <span className="truncate text-zinc-400">{label}</span>
+        <span className={count > max ? "text-red-400" : "text-zinc-300"}>
+          {count} / {max}
+        </span>
+      </div>
+      <Progress
+        value={percent}
+        className={
+          count >= max
+            ? "bg-zinc-800 [&>div]:bg-red-500"
+            : "bg-zinc-800 [&>div]:bg-green-500"
+        }
+      />
+    </div>
+  );
+}
+
+export default function QuotasTab() {
+  const [quotas, setQuotas] = useState<AdmissionQuota[]>([]);
+  const [usage, setUsage] = useState<QuotaUsage[]>([]);
+  const [fields, setFields] = useState<ApplicationSchemaField[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [saving, setSaving] = useState(false);
+
+  const loadUsage = useCallback(async (signal?: AbortSignal) => {
+    const res = await fetchQuotaUsage(signal);
+    if (signal?.aborted) return;
+    if (res.status === 200 && res.data) {
+      setUsage(res.data.quotas);
+    }
+  }, []);
+
+  useEffect(() => {
+    const controller = new AbortController();
+
+    async function load() {
+      const [quotasRes, schemaRes] = await Promise.all([
+        fetchAdmissionQuotas(controller.signal),
+        fetchApplicationSchema(controller.signal),
+        loadUsage(controller.signal),
+      ]);
+      if (controller.signal.aborted) return;
+
+      if (quotasRes.status === 200 && quotasRes.data) {
+        setQuotas(quotasRes.data.quotas);
+      } else {
+        errorAlert(quotasRes);
+      }
+      if (schemaRes.status === 200 && schemaRes.data) {
+        setFields(schemaRes.data.fields.filter((f) => f.type !== "file"));
+      } else {
+        errorAlert(schemaRes);
+      }
+      setLoading(false);
+    }
+
+    load();
+    return () => controller.abort();
+  }, [loadUsage]);
+
+  useEffect(() => {
+    const id = setInterval(() => loadUsage(), POLL_INTERVAL_MS);
+    return () => clearInterval(id);
+  }, [loadUsage]);
+
+  const validationError = useMemo(() => {
+    for (const q of quotas) {
+      if (q.label.trim().length === 0) {
+        return "Quota labels cannot be empty.";
+      }
+      if (!Number.isInteger(q.max) || q.max < 0) {
+        return `${q.label}: the cap must be a whole number.`;
+      }
+      const field = fields.find((f) => f.id === q.field);
+      if (field && LIST_TYPES.has(field.type) && !q.value?.trim()) {
+        return `${q.label}: pick the answer to cap.`;
+      }
+    }
+    return null;
+  }, [quotas, fields]);
+
+  function update(index: number, patch: Partial<AdmissionQuota>) {
+    setQuotas((prev) =>
+      prev.map((q, i) => (i === index ? { ...q, ...patch } : q)),
+    );
+  }
+
+  function handleAdd() {
+    setQuotas((prev) => [
+      ...prev,
+      { id: nextQuotaID(prev), label: "", field: "", max: 0, hard: false },
+    ]);
+  }
+
+  function handleRemove(index: number) {
+    setQuotas((prev) => prev.filter((_, i) => i !== index));
+  }
+
+  async function handleSave() {
+    if (validationError) {
+      toast.error(validationError);
+      return;
+    }
+
+    setSaving(true);
+    const res = await saveAdmissionQuotas(
+      quotas.map((q) => ({
+        ...q,
+        value: q.field && q.value?.trim() ? q.value.trim() : undefined,
+      })),
+    );
+    if (res.status === 200 && res.data) {
+      setQuotas(res.data.quotas);
+      await loadUsage();
+      toast.success("Admission quotas saved.");
+    } else {
+      errorAlert(res);
+    }
+    setSaving(false);
+  }
+
+  return (
+    <div className="space-y-4">
+      <h3 className="text-lg text-zinc-100">Admission Quotas</h3>
+      <p className="text-sm text-zinc-400">
+        Cap how many accepted hackers hold a seat, overall or by an application
+        answer. Hard quotas block acceptances past the cap, including waitlist
+        promotion; soft quotas only warn.
+      </p>
+
+      <div className="bg-zinc-900 rounded-md p-4 space-y-3">
+        <div className="flex items-center justify-between">
+          <div className="space-y-1">
+            <Label className="text-sm font-medium text-zinc-100">Usage</Label>
+            <p className="text-xs text-zinc-500">
+              Seats held by accepted hackers who are pending or confirmed.
+            </p>
+          </div>
+          <Button
+            variant="ghost"
+            size="icon"
+            onClick={() => loadUsage()}
+            aria-label="Refresh usage"
+            className="cursor-pointer text-zinc-400"
+          >
+            <RefreshCw className="size-4" />
+          </Button>
+        </div>
+
+        {usage.length === 0 ? (
+          <p className="text-xs text-zinc-500">No quotas configured.</p>
+        ) : (
+          usage.map((u) => (
+            <div key={u.id} className="space-y-2">
+              {u.answers ? (
+                <>
+                  <p className="text-xs font-medium text-zinc-300">
+                    {u.label} · each answer
+                  </p>
+                  {u.answers.length === 0 ? (
+                    <p className="text-xs text-zinc-500">No seats held yet.</p>
+                  ) : (
+                    u.answers.slice(0, 5).map((a) => (
+                      <UsageBar
+                        key={a.value}
+                        label={a.value}
+                        count={a.count}
+                        max={u.max}
+                      />
+                    ))
+                  )}
+                </>
+              ) : (
+                <UsageBar label={u.label} count={u.count} max={u.max} />
+              )}
+            </div>
+          ))
+        )}
+      </div>
+
+      <div className="bg-zinc-900 rounded-md p-4 space-y-4">
+        <div className="flex items-start justify-between gap-4">
+          <div className="space-y-1">
+            <Label className="text-sm font-medium text-zinc-100">Quotas</Label>
+            <p className="text-xs text-zinc-500">
+              Leave the answer blank to cap every answer separately, such as
+              one cap per university.
+            </p>
+          </div>
+          <Gauge className="size-5 text-zinc-500" />
+        </div>
+
+        {quotas.map((q, index) => {
+          const field = fields.find((f) => f.id === q.field);
+          const listField = !!field && LIST_TYPES.has(field.type);
+          return (
+            <div key={q.id} className="flex items-center gap-2">
+              <Input
+                value={q.label}
+                onChange={(e) => update(index, { label: e.target.value })}
+                disabled={loading || saving}
+                maxLength={100}
+                placeholder="Label"
+                className="border-zinc-800 bg-zinc-950 text-zinc-100"
+              />
+              <Select
+                value={q.field || EVERYONE}
+                onValueChange={(v) =>
+                  update(index, {
+                    field: v === EVERYONE ? "" : v,
+                    value: undefined,
+                  })
+                }
+                disabled={loading || saving}
+              >
+                <SelectTrigger className="w-48 shrink-0 border-zinc-800 bg-zinc-950 text-zinc-100">
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value={EVERYONE}>Everyone</SelectItem>
+                  {fields.map((f) => (
+                    <SelectItem key={f.id} value={f.id}>
+                      {f.label}
+                    </SelectItem>
+                  ))}
+                </SelectContent>
+              </Select>
+              <Input
+                value={q.value ?? ""}
+                onChange={(e) => update(index, { value: e.target.value })}
+                disabled={loading || saving || !q.field}
+                maxLength={200}
+                placeholder={listField ? "Answer" : "Each answer"}
+                className="w-40 shrink-0 border-zinc-800 bg-zinc-950 text-zinc-100"
+              />
+              <Input
+                type="number"
+                min={0}
+                value={q.max}
+                onChange={(e) =>
+                  update(index, { max: Number(e.target.value) })
+                }
+                disabled={loading || saving}
+                aria-label="Cap"
+                className="w-24 shrink-0 border-zinc-800 bg-zinc-950 text-zinc-100"
+              />
+              <div className="flex shrink-0 items-center gap-2">
+                <Switch
+                  id={`quota-hard-${q.id}`}
+                  checked={q.hard}
+                  onCheckedChange={(hard) => update(index, { hard })}
+                  disabled={loading || saving}
+                />
+                <Label
+                  htmlFor={`quota-hard-${q.id}`}
+                  className="text-xs text-zinc-400"
+                >
+                  Hard
+                </Label>
+              </div>
+              <Button
+                variant="ghost"
+                size="icon"
+                onClick={() => handleRemove(index)}
+                disabled={loading || saving}
+                aria-label="Remove quota"
+                className="shrink-0 text-zinc-400 hover:bg-zinc-800 hover:text-red-400"
+              >
+                <Trash2 className="size-4" />
+              </Button>
+            </div>
+          );
+        })}
+
+        <Button
+          variant="outline"
+          onClick={handleAdd}
+          disabled={loading || saving || quotas.length >= MAX_QUOTAS}
+          className="w-full border-zinc-800 bg-zinc-950 text-zinc-300 hover:bg-zinc-900 hover:text-zinc-100"
+        >
+          <Plus className="size-4" />
+          Add Quota
+        </Button>
+
+        {validationError ? (
+          <p className="text-xs text-red-400">{validationError}</p>
+        ) : null}
+
+        <Button
+          onClick={handleSave}
+          disabled={loading || saving || !!validationError}
+          className="cursor-pointer bg-white text-black hover:bg-zinc-200"
+        >
+          {saving ? "Saving..." : "Save Quotas"}
+        </Button>
+      </div>
+    </div>
+  );
+}
@@ -34,7 +34,7 @@ const RESET_ITEMS: {
   {
     id: "reset_applications",
     label: "Applications",
-    desc: "Deletes all hacker applications, reviews, walk-in queue entries, and resume files.",
+    desc: "Deletes all hacker applications, reviews, walk-in queue entries, and resume files (moved into the archive).",
   },
   {
     id: "reset_scans",
@@ -132,7 +132,17 @@ export function ResetHackathonCard() {
 
     setLoading(true);
     try {
-      const res = await resetHackathon(options);
+      let res = await resetHackathon(options);
+
+      // 503 means there is no object storage to archive into.
+      if (
+        res.status === 503 &&
+        window.confirm(
+          "No object storage is configured, so no archive can be taken. Reset anyway? This cannot be undone.",
+        )
+      ) {
+        res = await resetHackathon(options, true);
+      }
 
       if (res.error) {
         toast.error(res.error);
@@ -141,8 +151,10 @@ export function ResetHackathonCard() {
 
       const resumes = res.data?.resumes_deleted ?? 0;
       const notes = [
+        res.data?.archive_id ? `archived as ${res.data.archive_id}` : null,
+        res.data?.archive_skipped ? "no archive was taken" : null,
         resumes > 0
-          ? `removing ${resumes} resume file${resumes === 1 ? "" : "s"} from storage`
+          ? `moving ${resumes} resume file${resumes === 1 ? "" : "s"} into the archive`
           : null,
         // Closing applications is a side effect of the config reset that a
         // super admin has to know about — it silently takes the public form down.
@@ -0,0 +1,205 @@
+import { Download, RefreshCw } from "lucide-react";
+import { useCallback, useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { Label } from "@/components/ui/label";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { errorAlert } from "@/shared/lib/api";
+import type { ApplicationSchemaField } from "@/types";
+
+import { fetchApplicationSchema } from "../../application/api";
+import {
+  createResumeBook,
+  fetchResumeBookDownloadURL,
+  fetchResumeBooks,
+} from "../api";
+import type { ResumeBook } from "../types";
+
+const POLL_INTERVAL_MS = 5000;
+
+function describe(book: ResumeBook): string {
+  switch (book.status) {
+    case "building":
+      return "Building...";
+    case "ready":
+      return book.skipped_count > 0
+        ? `${book.resume_count} resumes (${book.skipped_count} missing)`
+        : `${book.resume_count} resumes`;
+    case "failed":
+      return book.error ? `Failed: ${book.error}` : "Failed";
+  }
+}
+
+export default function ResumeBooksTab() {
+  const [fields, setFields] = useState<ApplicationSchemaField[]>([]);
+  const [optInField, setOptInField] = useState("");
+  const [books, setBooks] = useState<ResumeBook[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [creating, setCreating] = useState(false);
+
+  const loadBooks = useCallback(async (signal?: AbortSignal) => {
+    const res = await fetchResumeBooks(signal);
+    if (signal?.aborted) return;
+    if (res.status === 200 && res.data) {
+      setBooks(res.data.resume_books);
+    } else {
+      errorAlert(res);
+    }
+  }, []);
+
+  useEffect(() => {
+    const controller = new AbortController();
+    async function load() {
+      const [schemaRes] = await Promise.all([
+        fetchApplicationSchema(controller.signal),
+        loadBooks(controller.signal),
+      ]);
+      if (controller.signal.aborted) return;
+      if (schemaRes.status === 200 && schemaRes.data) {
+        const checkboxes = schemaRes.data.fields.filter(
+          (f) => f.type === "checkbox",
+        );
+        setFields(checkboxes);
+        if (checkboxes.length > 0) setOptInField(checkboxes[0].id);
+      } else {
+        errorAlert(schemaRes);
+      }
+      setLoading(false);
+    }
+    load();
+    return () => controller.abort();
+  }, [loadBooks]);
+
+  // Builds run in the background; refresh until none are in progress.
+  const building = books.some((b) => b.status === "building");
+  useEffect(() => {
+    if (!building) return;
+    const id = setInterval(() => loadBooks(), POLL_INTERVAL_MS);
+    return () => clearInterval(id);
+  }, [building, loadBooks]);
+
+  async function create() {
+    setCreating(true);
+    const res = await createResumeBook(optInField);
+    if (res.status === 202 && res.data) {
+      setBooks((prev) => [res.data!.resume_book, ...prev]);
+      toast.success("Resume book build started.");
+    } else {
+      errorAlert(res);
+    }
+    setCreating(false);
+  }
+
+  async function download(book: ResumeBook) {
+    const res = await fetchResumeBookDownloadURL(book.id);
+    if (res.status === 200 && res.data) {
+      window.open(res.data.download_url, "_blank", "noopener");
+    } else {
+      errorAlert(res);
+    }
+  }
+
+  return (
+    <div className="space-y-4">
+      <h3 className="text-lg text-zinc-100">Resume Books</h3>
+      <p className="text-sm text-zinc-400">
+        Bundle the resumes of accepted, checked-in hackers who opted in into a
+        ZIP for sponsors, with an index spreadsheet.
+      </p>
+
+      <div className="bg-zinc-900 rounded-md p-4 space-y-4">
+        <div className="space-y-2">
+          <Label
+            htmlFor="resume-book-opt-in"
+            className="text-sm font-medium text-zinc-100"
+          >
+            Opt-in Question
+          </Label>
+          <Select
+            value={optInField}
+            onValueChange={setOptInField}
+            disabled={loading || fields.length === 0}
+          >
+            <SelectTrigger
+              id="resume-book-opt-in"
+              className="border-zinc-800 bg-zinc-950 text-zinc-100"
+            >
+              <SelectValue placeholder="No checkbox questions in the form" />
+            </SelectTrigger>
+            <SelectContent>
+              {fields.map((f) => (
+                <SelectItem key={f.id} value={f.id}>
+                  {f.label}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </div>
+
+        <Button
+          onClick={create}
+          disabled={loading || creating || !optInField}
+          className="cursor-pointer bg-white text-black hover:bg-zinc-200"
+        >
+          {creating ? "Starting..." : "Build Resume Book"}
+        </Button>
+      </div>
+
+      <div className="bg-zinc-900 rounded-md p-4 space-y-3">
+        <div className="flex items-center justify-between">
+          <span className="text-sm font-medium text-zinc-100">
+            Recent Builds
+          </span>
+          <Button
+            variant="ghost"
+            size="icon"
+            onClick={() => loadBooks()}
+            className="cursor-pointer text-zinc-400"
+          >
+            <RefreshCw className="size-4" />
+          </Button>
+        </div>
+
+        {books.length === 0 ? (
+          <p className="text-xs text-zinc-500">No resume books yet.</p>
+        ) : (
+          <ul className="space-y-2">
+            {books.map((book) => (
+              <li
+                key={book.id}
+                className="flex items-center justify-between gap-4 text-sm"
+              >
+                <div className="min-w-0">
+                  <p className="text-zinc-100">
+                    {new Date(book.created_at).toLocaleString()}
+                  </p>
+                  <p className="truncate text-xs text-zinc-500">
+                    {describe(book)}
+                  </p>
+                </div>
+                {book.status === "ready" && (
+                  <Button
+                    variant="outline"
+                    size="sm"
+                    onClick={() => download(book)}
+                    className="cursor-pointer"
+                  >
+                    <Download className="size-4" />
+                    Download
+                  </Button>
+                )}
+              </li>
+            ))}
+          </ul>
+        )}
+      </div>
+    </div>
+  );
+}
@@ -12,6 +12,8 @@ export interface ResetHackathonOptions {
 
 export interface ResetHackathonResult extends ResetHackathonOptions {
   resumes_deleted: number;
+  archive_id?: string;
+  archive_skipped: boolean;
 }
 
 export interface MealGroupsResult {
@@ -22,6 +24,37 @@ export interface MealGroupStatsResult {
   stats: Record<string, number>;
 }
 
+// A quota with no field caps everyone. With a field and a value it caps
+// hackers giving that answer; with a field and no value it caps each answer
+// separately. Hard quotas block acceptances past max, soft ones warn.
+export interface AdmissionQuota {
+  id: string;
+  label: string;
+  field: string;
+  value?: string;
+  max: number;
+  hard: boolean;
+}
+
+export interface AdmissionQuotasResult {
+  quotas: AdmissionQuota[];
+}
+
+export interface QuotaAnswerCount {
+  value: string;
+  count: number;
+}
+
+// count is the fullest answer's for a per-answer quota.
+export interface QuotaUsage extends AdmissionQuota {
+  count: number;
+  answers?: QuotaAnswerCount[];
+}
+
+export interface QuotaUsageResult {
+  quotas: QuotaUsage[];
+}
+
 export interface HackerPackURLResult {
   url: string;
 }
@@ -46,9 +79,17 @@ export interface FromNameResult {
   name: string;
 }
 
-export interface DateSettingResult {
-  date: string;
-  configured: boolean;
+// Instants are RFC3339 UTC; timezone is the IANA zone they're shown in.
+// A null bound leaves that side of the window open.
+export interface ApplicationWindow {
+  opens_at: string | null;
+  closes_at: string | null;
+  timezone: string;
+  grace_minutes: number;
+}
+
+export interface ApplicationsToggleResult {
+  enabled: boolean;
 }
 
 export interface HackathonDateRangeResult {
@@ -60,7 +101,7 @@ export interface HackathonDateRangeResult {
 export interface OnboardingStatus {
   hackathon_name: boolean;
   hackathon_date_range: boolean;
-  application_due_date: boolean;
+  application_window: boolean;
   contact_email: boolean;
   from_email: boolean;
   complete: boolean;
@@ -76,3 +117,90 @@ export interface OnboardingValues {
   from_email: string;
   from_name: string;
 }
+
+export type ResumeBookStatus = "building" | "ready" | "failed";
+
+export interface ResumeBook {
+  id: string;
+  status: ResumeBookStatus;
+  opt_in_field: string;
+  resume_count: number;
+  skipped_count: number;
+  error: string | null;
+  created_by: string | null;
+  completed_at: string | null;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ResumeBookListResult {
+  resume_books: ResumeBook[];
+}
+
+export interface ResumeBookResult {
+  resume_book: ResumeBook;
+}
+
+export interface ResumeBookDownloadURLResult {
+  download_url: string;
+}
+
+export interface Hackathon {
+  id: string;
+  name: string;
+  starts_on: string | null;
+  ends_on: string | null;
+  is_active: boolean;
+  archived_at: string | null;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface HackathonListResult {
+  hackathons: Hackathon[];
+}
+
+export interface HackathonResult {
+  hackathon: Hackathon;
+}
+
+export interface HackathonStats {
+  hackathon_id: string;
+  name: string;
+  starts_on: string | null;
+  is_active: boolean;
+  applications: number;
+  accepted: number;
+  rejected: number;
+  waitlisted: number;
+  confirmed: number;
+  checked_in: number;
+  teams: number;
+  projects: number;
+  sponsors: number;
+}
+
+export interface HackathonStatsResult {
+  hackathons: HackathonStats[];
+}
+
+export interface HackathonArchive {
+  id: string;
+  hackathon_id: string;
+  size_bytes: number;
+  created_at: string;
+}
+
+export interface HackathonArchiveListResult {
+  archives: HackathonArchive[];
+}
+
+export interface HackathonArchiveResult {
+  archive: HackathonArchive;
+}
+
+export interface RestoreHackathonArchiveResult {
+  archive_id: string;
+  restored: Record<string, number>;
+  resumes_restored: number;
+}
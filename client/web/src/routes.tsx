@@ -8,6 +8,7 @@ import {
   AuthCallbackPage,
   AuthOAuthCallbackPage,
   AuthVerifyPage,
+  GuardianConsentPage,
   LoginPage,
 } from "@/pages/public";
 import { RequireAdmin, RequireAuth, RequireSuperAdmin } from "@/shared/auth";
@@ -17,6 +18,9 @@ const AdminLayout = lazy(() => import("@/layouts/AdminLayout"));
 const AllApplicantsPage = lazy(
   () => import("@/pages/admin/all-applicants/AllApplicantsPage"),
 );
+const AnalyticsPage = lazy(
+  () => import("@/pages/admin/analytics/AnalyticsPage"),
+);
 const ReviewsPage = lazy(() => import("@/pages/admin/reviews/ReviewsPage"));
 const SchedulePage = lazy(() => import("@/pages/admin/schedule/SchedulePage"));
 const ScansPage = lazy(() => import("@/pages/admin/scans/ScansPage"));
@@ -66,6 +70,9 @@ const AdminGradingPage = lazy(
 );
 const SponsorsPage = lazy(() => import("@/pages/admin/sponsors/SponsorsPage"));
 const FAQAdminPage = lazy(() => import("@/pages/admin/faq/FAQPage"));
+const PoliciesPage = lazy(
+  () => import("@/pages/admin/policies/PoliciesPage"),
+);
 
 export const router = createBrowserRouter([
   {
@@ -89,6 +96,10 @@ export const router = createBrowserRouter([
         path: "/auth/callback/google",
         element: <AuthOAuthCallbackPage />,
       },
+      {
+        path: "/guardian-consent/:token",
+        element: <GuardianConsentPage />,
+      },
 
       // Hacker routes with shared layout (bottom nav mobile / sidebar desktop)
       {
@@ -199,6 +210,14 @@ export const router = createBrowserRouter([
               </Suspense>
             ),
           },
+          {
+            path: "analytics",
+            element: (
+              <Suspense fallback={<PageLoader />}>
+                <AnalyticsPage />
+              </Suspense>
+            ),
+          },
           {
             path: "scans",
             element: (
@@ -247,6 +266,14 @@ export const router = createBrowserRouter([
               </Suspense>
             ),
           },
+          {
+            path: "policies",
+            element: (
+              <Suspense fallback={<PageLoader />}>
+                <PoliciesPage />
+              </Suspense>
+            ),
+          },
           // Super Admin routes (nested under admin layout, guarded individually)
           {
             path: "sa/user-management",
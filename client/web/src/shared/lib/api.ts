@@ -96,6 +96,7 @@ export async function putRequest<T>(
   body: unknown,
   errorContext?: string,
   signal?: AbortSignal,
+  headers?: Record<string, string>,
 ): Promise<ApiResponse<T>> {
   try {
     const response = await fetch(`${buildUrl(endpoint)}`, {
@@ -103,6 +104,7 @@ export async function putRequest<T>(
       credentials: "include",
       headers: {
         "Content-Type": "application/json",
+        ...headers,
       },
       body: JSON.stringify(body),
       signal,
@@ -112,7 +114,8 @@ export async function putRequest<T>(
 
     return {
       status: response.status,
-      data: response.ok ? json?.data : undefined,
+      // A stale write's 409 carries the current copy
+      data: response.ok || response.status === 409 ? json?.data : undefined,
       error: !response.ok
         ? json?.error || `Failed to update ${errorContext || endpoint}`
         : undefined,
@@ -136,6 +139,7 @@ export async function patchRequest<T>(
   body: unknown,
   errorContext?: string,
   signal?: AbortSignal,
+  headers?: Record<string, string>,
 ): Promise<ApiResponse<T>> {
   try {
     const response = await fetch(`${buildUrl(endpoint)}`, {
@@ -143,6 +147,7 @@ export async function patchRequest<T>(
       credentials: "include",
       headers: {
         "Content-Type": "application/json",
+        ...headers,
       },
       body: JSON.stringify(body),
       signal,
@@ -152,7 +157,8 @@ export async function patchRequest<T>(
 
     return {
       status: response.status,
-      data: response.ok ? json?.data : undefined,
+      // A stale write's 409 carries the current copy
+      data: response.ok || response.status === 409 ? json?.data : undefined,
       error: !response.ok
         ? json?.error || `Failed to update ${errorContext || endpoint}`
         : undefined,
@@ -206,6 +212,19 @@ export async function deleteRequest<T>(
   }
 }
 
+/**
+ * If-Match header for editing a record last loaded at updatedAt. The server's
+ * ETags are the quoted updated_at, so any fetched copy can be sent back.
+ */
+export function ifMatch(updatedAt: string): Record<string, string> {
+  return { "If-Match": `"${updatedAt}"` };
+}
+
+/** True when a write was rejected because the record changed since loading. */
+export function isStaleWrite(res: ApiResponse): boolean {
+  return res.status === 409 && res.data !== undefined;
+}
+
 /**
  * Display error toast to user
  */
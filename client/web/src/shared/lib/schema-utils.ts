@@ -1,7 +1,7 @@
 import { createElement, type ReactNode } from "react";
 import { z } from "zod";
 
-import type { ApplicationSchemaField } from "@/types";
+import type { ApplicationSchemaField, FieldCondition } from "@/types";
 
 /** Well-known section labels for backward compatibility with data that lacks section_label. */
 const DEFAULT_SECTION_LABELS: Record<string, string> = {
@@ -103,17 +103,188 @@ export function getResponseValue<T>(
   return val as T;
 }
 
+function isEmptyAnswer(val: unknown): boolean {
+  if (val === undefined || val === null) return true;
+  if (typeof val === "string") return val.trim() === "";
+  if (Array.isArray(val)) return val.length === 0;
+  return false;
+}
+
+/**
+ * Resolves show_if/require_if rules against the current answers. Mirrors the
+ * server: an answer to a hidden field counts as absent.
+ */
+export function createFieldRules(
+  fields: ApplicationSchemaField[],
+  values: Record<string, unknown>,
+) {
+  const byId = new Map(fields.map((f) => [f.id, f]));
+  const visible = new Map<string, boolean>();
+
+  function holds(c: FieldCondition): boolean {
+    const val = values[c.field];
+    const present = isVisible(c.field) && !isEmptyAnswer(val);
+
+    switch (c.op) {
+      case "empty":
+        return !present;
+      case "not_empty":
+        return present;
+      case "neq":
+        return !present || val !== c.value;
+    }
+    if (!present) return false;
+
+    switch (c.op) {
+      case "eq":
+        return val === c.value;
+      case "in":
+        return Array.isArray(c.value) && c.value.includes(val as never);
+      case "contains":
+        return Array.isArray(val) && val.includes(c.value);
+      case "lt":
+      case "lte":
+      case "gt":
+      case "gte": {
+        const n = typeof val === "number" ? val : Number(val);
+        if (typeof c.value !== "number" || Number.isNaN(n)) return false;
+        if (c.op === "lt") return n < c.value;
+        if (c.op === "lte") return n <= c.value;
+        if (c.op === "gt") return n > c.value;
+        return n >= c.value;
+      }
+    }
+    return false;
+  }
+
+  function isVisible(id: string): boolean {
+    const cached = visible.get(id);
+    if (cached !== undefined) return cached;
+    const field = byId.get(id);
+    if (!field) return true;
+
+    // Hidden while resolving, so a rule loop cannot recurse forever.
+    visible.set(id, false);
+    const v = !field.show_if || holds(field.show_if);
+    visible.set(id, v);
+    return v;
+  }
+
+  function isRequired(field: ApplicationSchemaField): boolean {
+    return field.required || (!!field.require_if && holds(field.require_if));
+  }
+
+  return { isVisible, isRequired };
+}
+
+/**
+ * The fields to render for the current answers, with required resolved from
+ * require_if.
+ */
+export function resolveVisibleFields(
+  fields: ApplicationSchemaField[],
+  allFields: ApplicationSchemaField[],
+  values: Record<string, unknown>,
+): ApplicationSchemaField[] {
+  const rules = createFieldRules(allFields, values);
+  return fields
+    .filter((f) => rules.isVisible(f.id))
+    .map((f) => ({ ...f, required: rules.isRequired(f) }));
+}
+
+const countryNames = new Intl.DisplayNames(["en"], { type: "region" });
+
+/** English name for an ISO 3166-1 alpha-2 code, or the code if unknown. */
+export function countryName(code: string): string {
+  try {
+    return countryNames.of(code) ?? code;
+  } catch {
+    return code;
+  }
+}
+
+/**
+ * Patterns are checked by the server with Go's RE2 syntax. The few RE2 forms
+ * JavaScript rejects, such as inline (?i) flags, are left to the server.
+ * Like the server, the pattern must match the whole answer.
+ */
+function compilePattern(pattern: unknown): RegExp | undefined {
+  if (typeof pattern !== "string") return undefined;
+  try {
+    return new RegExp(`^(?:${pattern})$`);
+  } catch {
+    return undefined;
+  }
+}
+
+/**
+ * Apply minLength/maxLength/pattern to a string schema. Empty optional values
+ * skip these checks, matching the server.
+ */
+function withStringRules(
+  s: z.ZodString,
+  field: ApplicationSchemaField,
+): z.ZodType {
+  const validation = field.validation ?? {};
+  let checked = s;
+  if (typeof validation.minLength === "number") {
+    const min = validation.minLength;
+    checked = checked.refine(
+      (v) => !v || [...v].length >= min,
+      `Must be at least ${min} characters`,
+    );
+  }
+  if (typeof validation.maxLength === "number") {
+    const max = validation.maxLength;
+    checked = checked.refine(
+      (v) => [...v].length <= max,
+      `Must be at most ${max} characters`,
+    );
+  }
+  const pattern = compilePattern(validation.pattern);
+  if (pattern) {
+    checked = checked.refine(
+      (v) => !v || pattern.test(v),
+      `${stripLabelLinks(field.label)} is not in the expected format`,
+    );
+  }
+
+  if (field.required) return checked;
+  return checked.optional().default("");
+}
+
+/** minItems/maxItems for list answers. */
+function withItemRules(
+  field: ApplicationSchemaField,
+  a: z.ZodArray<z.ZodString>,
+): z.ZodType {
+  const validation = field.validation ?? {};
+  let arr = a;
+  if (typeof validation.minItems === "number") {
+    const min = validation.minItems;
+    arr = arr.refine(
+      (v) => v.length === 0 || v.length >= min,
+      `Choose at least ${min}`,
+    );
+  }
+  if (typeof validation.maxItems === "number") {
+    arr = arr.max(validation.maxItems, `Choose at most ${validation.maxItems}`);
+  }
+  if (field.required) {
+    return arr.min(1, `${field.label} is required`);
+  }
+  return arr.optional().default([]);
+}
+
 /** Build a Zod schema for a single field based on its ApplicationSchemaField definition. */
 function buildFieldZod(field: ApplicationSchemaField): z.ZodType {
   const validation = field.validation ?? {};
+  const required = z.string().min(1, `${field.label} is required`);
 
   switch (field.type) {
-    case "text": {
-      if (field.required) {
-        return z.string().min(1, `${field.label} is required`);
-      }
-      return z.string().optional().default("");
-    }
+    case "text":
+    case "textarea":
+      return withStringRules(field.required ? required : z.string(), field);
     case "phone": {
       // Stored canonically as +1 followed by 10 US digits (see PhoneInput).
       const usPhone = /^\+1\d{10}$/;
@@ -130,6 +301,30 @@ function buildFieldZod(field: ApplicationSchemaField): z.ZodType {
         .default("")
         .refine((v) => !v || usPhone.test(v), msg);
     }
+    case "email":
+      return withStringRules(
+        (field.required ? required : z.string()).refine(
+          (v) => !v || z.email().safeParse(v).success,
+          "Enter a valid email address",
+        ),
+        field,
+      );
+    case "url":
+      return withStringRules(
+        (field.required ? required : z.string()).refine(
+          (v) => !v || /^https?:\/\/\S+$/i.test(v),
+          "Enter a link starting with http:// or https://",
+        ),
+        field,
+      );
+    case "date": {
+      const min = validation.min as string | undefined;
+      const max = validation.max as string | undefined;
+      const s = (field.required ? required : z.string())
+        .refine((v) => !v || !min || v >= min, `Must be on or after ${min}`)
+        .refine((v) => !v || !max || v <= max, `Must be on or before ${max}`);
+      return field.required ? s : s.optional().default("");
+    }
     case "number": {
       let n = z.coerce.number({ message: `${field.label} is required` });
       if (typeof validation.min === "number")
@@ -139,21 +334,15 @@ function buildFieldZod(field: ApplicationSchemaField): z.ZodType {
       if (field.required && typeof validation.min !== "number") n = n.min(0);
       return n;
     }
-    case "textarea": {
-      let s = z.string();
-      if (field.required) s = s.min(1, `${field.label} is required`);
-      if (typeof validation.maxLength === "number")
-        s = s.max(validation.maxLength as number);
-      return s;
-    }
-    case "select": {
-      if (field.required) {
-        return z.string().min(1, `${field.label} is required`);
-      }
+    case "select":
+    case "country":
+    case "file": {
+      if (field.required) return required;
       return z.string().optional().default("");
     }
     case "multi_select":
-      return z.array(z.string()).optional().default([]);
+    case "ranked_choice":
+      return withItemRules(field, z.array(z.string()));
     case "checkbox":
       if (field.required) {
         return z.literal(true, {
@@ -168,14 +357,37 @@ function buildFieldZod(field: ApplicationSchemaField): z.ZodType {
 
 /**
  * Build a Zod object schema from an array of ApplicationSchemaField definitions.
- * Returns a z.object() with one key per field.
+ * Returns a z.object() with one key per field. Fields with show_if/require_if
+ * are validated as optional, and their required check runs once the whole
+ * form is known.
  */
 export function buildZodSchema(fields: ApplicationSchemaField[]) {
   const shape: Record<string, z.ZodType> = {};
+  const conditional: ApplicationSchemaField[] = [];
   for (const field of fields) {
-    shape[field.id] = buildFieldZod(field);
+    if (field.show_if || field.require_if) {
+      conditional.push(field);
+      shape[field.id] = buildFieldZod({ ...field, required: false });
+    } else {
+      shape[field.id] = buildFieldZod(field);
+    }
   }
-  return z.object(shape);
+  if (conditional.length === 0) return z.object(shape);
+
+  return z.object(shape).superRefine((values, ctx) => {
+    const rules = createFieldRules(fields, values);
+    for (const field of conditional) {
+      if (!rules.isVisible(field.id) || !rules.isRequired(field)) continue;
+      const val = values[field.id];
+      if (isEmptyAnswer(val) || (field.type === "checkbox" && val !== true)) {
+        ctx.addIssue({
+          code: "custom",
+          path: [field.id],
+          message: `${stripLabelLinks(field.label)} is required`,
+        });
+      }
+    }
+  });
 }
 
 /** Build default form values from schema fields. */
@@ -189,6 +401,7 @@ export function buildDefaultValues(
         defaults[field.id] = 0;
         break;
       case "multi_select":
+      case "ranked_choice":
         defaults[field.id] = [];
         break;
       case "checkbox":
@@ -212,6 +425,17 @@ export function formatResponseValue(
   if (field.type === "multi_select" && Array.isArray(value)) {
     return value.length > 0 ? value.join(", ") : "None";
   }
+  if (field.type === "ranked_choice" && Array.isArray(value)) {
+    return value.length > 0
+      ? value.map((v, i) => `${i + 1}. ${v}`).join(", ")
+      : "None";
+  }
+  if (field.type === "country") {
+    return countryName(String(value));
+  }
+  if (field.type === "file") {
+    return "PDF uploaded";
+  }
   if (field.type === "checkbox") {
     return value ? "Yes" : "No";
   }
@@ -7,7 +7,32 @@ export type FieldType =
   | "select"
   | "multi_select"
   | "checkbox"
-  | "phone";
+  | "phone"
+  | "email"
+  | "url"
+  | "date"
+  | "country"
+  | "ranked_choice"
+  | "file";
+
+export type ConditionOp =
+  | "eq"
+  | "neq"
+  | "lt"
+  | "lte"
+  | "gt"
+  | "gte"
+  | "in"
+  | "contains"
+  | "empty"
+  | "not_empty";
+
+/** A rule on another field's answer, evaluated the same way by the server. */
+export interface FieldCondition {
+  field: string;
+  op: ConditionOp;
+  value?: string | number | boolean | (string | number | boolean)[];
+}
 
 export interface ApplicationSchemaField {
   id: string;
@@ -20,6 +45,10 @@ export interface ApplicationSchemaField {
   display_order: number;
   options?: string[];
   validation?: Record<string, unknown>;
+  /** Hide the field unless this holds; hidden answers are discarded. */
+  show_if?: FieldCondition;
+  /** Require an optional field while this holds. */
+  require_if?: FieldCondition;
 }
 
 export type ApplicationStatus =
@@ -27,7 +56,10 @@ export type ApplicationStatus =
   | "submitted"
   | "accepted"
   | "rejected"
-  | "waitlisted";
+  | "waitlisted"
+  | "withdrawn";
+
+export type ConfirmationStatus = "pending" | "confirmed" | "declined" | "expired";
 
 export type ReviewVote = "accept" | "waitlist" | "reject";
 
@@ -86,6 +118,8 @@ export interface Application {
   points?: number;
   resume_path: string | null;
   ai_percent: number | null;
+  /** Schema version the responses are keyed against. */
+  schema_version: number | null;
   accept_votes: number;
   reject_votes: number;
   waitlist_votes: number;
@@ -94,6 +128,192 @@ export interface Application {
   submitted_at: string | null;
   created_at: string;
   updated_at: string;
+  /** Set only while status is accepted. */
+  confirmation_status: ConfirmationStatus | null;
+  /** Null when there is no confirmation deadline. */
+  confirmation_deadline: string | null;
+  confirmation_responded_at: string | null;
+  /** Set only once status is withdrawn. */
+  withdrawn_from: ApplicationStatus | null;
+  withdrawn_at: string | null;
+  withdrawal_reason: string | null;
+  /** Duplicate detection flags; present on admin reads when any exist. */
+  flags?: ApplicationFlag[];
+  /** Status changes, oldest first; present on admin reads when any exist. */
+  timeline?: ApplicationStatusEvent[];
+}
+
+export type ApplicationStatusEventSource =
+  | "submit"
+  | "admin"
+  | "bulk"
+  | "rules"
+  | "withdraw"
+  | "waitlist_promotion"
+  | "walk_in";
+
+export interface ApplicationStatusEvent {
+  id: string;
+  application_id: string;
+  /** Null when the change created the application. */
+  from_status: ApplicationStatus | null;
+  to_status: ApplicationStatus;
+  source: ApplicationStatusEventSource;
+  reason: string | null;
+  /** Null for automatic changes or once the actor is deleted. */
+  actor_id: string | null;
+  actor_email: string | null;
+  bulk_id: string | null;
+  changed_at: string;
+}
+
+export type ApplicationFlagReason =
+  | "duplicate_phone"
+  | "duplicate_name_birthdate"
+  | "duplicate_resume"
+  | "disposable_email";
+
+/** A status change as the hacker sees it, without who made it or why. */
+export interface ApplicationTimelineEntry {
+  status: ApplicationStatus;
+  changed_at: string;
+}
+
+export interface ApplicationTimelineResponse {
+  timeline: ApplicationTimelineEntry[];
+}
+
+export interface ApplicationFlag {
+  application_id: string;
+  reason: ApplicationFlagReason;
+  /** The application this one duplicates; null for disposable_email. */
+  related_application_id: string | null;
+  related_email: string | null;
+  detail: string;
+  created_at: string;
+}
+
+export interface TeamMember {
+  user_id: string;
+  email: string;
+  first_name: string | null;
+  last_name: string | null;
+  application_id: string | null;
+  application_status: ApplicationStatus | null;
+  joined_at: string;
+}
+
+export interface TeamInvite {
+  id: string;
+  email: string;
+  invited_by: string | null;
+  created_at: string;
+}
+
+export interface Team {
+  id: string;
+  name: string;
+  join_code: string;
+  created_by: string | null;
+  created_at: string;
+  updated_at: string;
+  members: TeamMember[];
+  invites: TeamInvite[];
+}
+
+export interface TeamResponse {
+  /** Null when the hacker is not on a team. */
+  team: Team | null;
+  team_size_max: number;
+}
+
+export interface Project {
+  id: string;
+  team_id: string;
+  team_name: string;
+  title: string;
+  description: string;
+  repo_url: string | null;
+  demo_url: string | null;
+  tracks: string[];
+  submitted_by: string | null;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface MyProjectResponse {
+  /** Null until the team submits a project. */
+  project: Project | null;
+  submission_deadline: string | null;
+  tracks: string[];
+  editable: boolean;
+}
+
+export interface SaveProjectPayload {
+  title: string;
+  description: string;
+  repo_url: string | null;
+  demo_url: string | null;
+  tracks: string[];
+}
+
+export interface PolicySignature {
+  id: string;
+  policy_id: string;
+  version: number;
+  user_id: string;
+  signed_name: string;
+  ip_address: string;
+  user_agent: string;
+  guardian_name: string | null;
+  guardian_email: string | null;
+  /** Null until the guardian follows the link emailed to them. */
+  guardian_confirmed_at: string | null;
+  signed_at: string;
+}
+
+export interface MyPolicy {
+  id: string;
+  title: string;
+  body: string;
+  /** Latest version; signing must name it. */
+  version: number;
+  required: boolean;
+  display_order: number;
+  created_at: string;
+  updated_at: string;
+  /** Newest version the user signed, or null if never signed. */
+  signature: PolicySignature | null;
+  /** Whether the signature covers the latest version. */
+  current: boolean;
+}
+
+export interface MyPoliciesResponse {
+  policies: MyPolicy[];
+  /**
+   * Set for minors, who must add a parent or guardian when signing. Their
+   * signatures only count once the guardian confirms by email.
+   */
+  guardian_required: boolean;
+}
+
+export interface SignPolicyPayload {
+  version: number;
+  signed_name: string;
+  guardian_name?: string;
+  guardian_email?: string;
+}
+
+export interface GuardianConsent {
+  signature_id: string;
+  policy_title: string;
+  /** The policy text at the version the minor signed. */
+  policy_body: string;
+  version: number;
+  signed_name: string;
+  guardian_name: string;
+  signed_at: string;
+  confirmed_at: string | null;
 }
 
 export interface ScheduleItem {
@@ -182,5 +402,10 @@ export interface ApplicationStats {
   rejected: number;
   waitlisted: number;
   draft: number;
+  withdrawn: number;
   acceptance_rate: number;
+  confirmation_pending: number;
+  confirmed: number;
+  declined: number;
+  expired: number;
 }
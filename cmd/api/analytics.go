@@ -0,0 +1,385 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hackutd/portal/internal/spreadsheet"
+	"github.com/hackutd/portal/internal/store"
+)
+
+// analyticsFieldTypes are the schema field types the field breakdown covers.
+// Their answers come from a short list, so counting each one is meaningful.
+var analyticsFieldTypes = map[string]bool{
+	"select":       true,
+	"multi_select": true,
+	"number":       true,
+}
+
+type AnswerBreakdown struct {
+	Answer   string                          `json:"answer"`
+	Total    int                             `json:"total"`
+	ByStatus map[store.ApplicationStatus]int `json:"by_status"`
+}
+
+type FieldBreakdown struct {
+	FieldID string            `json:"field_id"`
+	Label   string            `json:"label"`
+	Type    string            `json:"type"`
+	Answers []AnswerBreakdown `json:"answers"`
+}
+
+type FieldBreakdownResponse struct {
+	Fields []FieldBreakdown `json:"fields"`
+}
+
+type DailyApplicationsResponse struct {
+	Timezone string                        `json:"timezone"`
+	Days     []store.DailyApplicationCount `json:"days"`
+}
+
+type DailyReviewsResponse struct {
+	Timezone string                   `json:"timezone"`
+	Days     []store.DailyReviewCount `json:"days"`
+}
+
+// analyticsFormat reads the format query parameter, json by default.
+func analyticsFormat(r *http.Request) (string, error) {
+	switch f := r.URL.Query().Get("format"); f {
+	case "", "json":
+		return "json", nil
+	case "csv":
+		return f, nil
+	default:
+		return "", errors.New("format must be 'json' or 'csv'")
+	}
+}
+
+// analyticsTimezone returns the zone daily reports bucket days in: the
+// timezone query parameter, or else the application window's. On failure it
+// has already written the error response.
+func (app *application) analyticsTimezone(w http.ResponseWriter, r *http.Request) (string, bool) {
+	tz := r.URL.Query().Get("timezone")
+	if tz == "" {
+		window, err := app.store.Settings.GetApplicationWindow(r.Context())
+		if err != nil {
+			app.internalServerError(w, r, err)
+			return "", false
+		}
+		tz = window.Timezone
+	}
+
+	if _, err := time.LoadLocation(tz); err != nil {
+		app.badRequestResponse(w, r, fmt.Errorf("unknown timezone %q", tz))
+		return "", false
+	}
+	return tz, true
+}
+
+// writeAnalyticsCSV sends rows as a CSV download named after the report.
+func (app *application) writeAnalyticsCSV(w http.ResponseWriter, r *http.Request, report string, rows [][]string) {
+	filename := fmt.Sprintf("%s-%s.csv", report, time.Now().UTC().Format("2006-01-02"))
+	w.Header().Set("Content-Type", spreadsheet.FormatCSV.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+
+	sheet := spreadsheet.NewCSVWriter(w)
+	for _, row := range rows {
+		if err := sheet.WriteRow(row); err != nil {
+			app.logger.Errorw("analytics report aborted", "report", report, "error", err)
+			return
+		}
+	}
+	if err := sheet.Close(); err != nil {
+		app.logger.Errorw("analytics report aborted", "report", report, "error", err)
+	}
+}
+
+// buildFieldBreakdowns groups answer counts under their fields in form
+// order. Choice fields list every option in order, counted or not, then any
+// other answers given, most common first; number fields list answers in
+// numeric order.
+func buildFieldBreakdowns(fields []store.ApplicationSchemaField, counts []store.FieldAnswerCount) []FieldBreakdown {
+	byField := map[string]map[string]*AnswerBreakdown{}
+	for _, c := range counts {
+		answers := byField[c.FieldID]
+		if answers == nil {
+			answers = map[string]*AnswerBreakdown{}
+			byField[c.FieldID] = answers
+		}
+		a := answers[c.Answer]
+		if a == nil {
+			a = &AnswerBreakdown{Answer: c.Answer, ByStatus: map[store.ApplicationStatus]int{}}
+			answers[c.Answer] = a
+		}
+		a.ByStatus[c.Status] += c.Count
+		a.Total += c.Count
+	}
+
+	breakdowns := make([]FieldBreakdown, 0, len(fields))
+	for _, f := range fields {
+		answers := byField[f.ID]
+		b := FieldBreakdown{FieldID: f.ID, Label: f.Label, Type: f.Type, Answers: []AnswerBreakdown{}}
+
+		for _, opt := range f.Options {
+			if a, ok := answers[opt]; ok {
+				b.Answers = append(b.Answers, *a)
+				delete(answers, opt)
+			} else {
+				b.Answers = append(b.Answers, AnswerBreakdown{Answer: opt, ByStatus: map[store.ApplicationStatus]int{}})
+			}
+		}
+
+		rest := make([]AnswerBreakdown, 0, len(answers))
+		for _, a := range answers {
+			rest = append(rest, *a)
+		}
+		if f.Type == "number" {
+			slices.SortFunc(rest, func(x, y AnswerBreakdown) int {
+				xf, _ := strconv.ParseFloat(x.Answer, 64)
+				yf, _ := strconv.ParseFloat(y.Answer, 64)
+				return cmp.Or(cmp.Compare(xf, yf), cmp.Compare(x.Answer, y.Answer))
+			})
+		} else {
+			slices.SortFunc(rest, func(x, y AnswerBreakdown) int {
+				return cmp.Or(cmp.Compare(y.Total, x.Total), cmp.Compare(x.Answer, y.Answer))
+			})
+		}
+		b.Answers = append(b.Answers, rest...)
+
+		breakdowns = append(breakdowns, b)
+	}
+	return breakdowns
+}
+
+// getFieldBreakdownHandler breaks application answers down by status
+//
+//	@Summary		Get answer breakdown by status (Admin)
+//	@Description	Counts the active hackathon's answers to every select, multi-select and number field, per application status. Multi-select options are counted separately, so an application can count under several.
+//	@Tags			admin/analytics
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			format	query		string	false	"Response format"	Enums(json, csv)	default(json)
+//	@Success		200		{object}	FieldBreakdownResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/analytics/fields [get]
+func (app *application) getFieldBreakdownHandler(w http.ResponseWriter, r *http.Request) {
+	format, err := analyticsFormat(r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	var fields []store.ApplicationSchemaField
+	var targets []store.AnalyticsField
+	for _, f := range exportSchemaColumns(schema) {
+		if analyticsFieldTypes[f.Type] {
+			fields = append(fields, f)
+			targets = append(targets, store.AnalyticsField{ID: f.ID, List: f.Type == "multi_select"})
+		}
+	}
+
+	counts, err := app.store.Analytics.FieldBreakdown(r.Context(), targets)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+	breakdowns := buildFieldBreakdowns(fields, counts)
+
+	if format == "csv" {
+		header := []string{"Field", "Answer", "Total"}
+		for _, s := range store.ApplicationStatuses {
+			header = append(header, string(s))
+		}
+		rows := [][]string{header}
+		for _, b := range breakdowns {
+			for _, a := range b.Answers {
+				row := []string{b.Label, a.Answer, strconv.Itoa(a.Total)}
+				for _, s := range store.ApplicationStatuses {
+					row = append(row, strconv.Itoa(a.ByStatus[s]))
+				}
+				rows = append(rows, row)
+			}
+		}
+		app.writeAnalyticsCSV(w, r, "application-answers", rows)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, FieldBreakdownResponse{Fields: breakdowns}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getDailyApplicationsHandler returns applications started and submitted per day
+//
+//	@Summary		Get daily application activity (Admin)
+//	@Description	Returns, for every day from the first application started to the last submitted, how many drafts were started, how many applications were submitted, and how many of that day's drafts have been submitted since. Days are calendar days in the given timezone.
+//	@Tags			admin/analytics
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			format		query		string	false	"Response format"	Enums(json, csv)	default(json)
+//	@Param			timezone	query		string	false	"IANA timezone to bucket days in (default: the application window's)"
+//	@Success		200			{object}	DailyApplicationsResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/analytics/applications/daily [get]
+func (app *application) getDailyApplicationsHandler(w http.ResponseWriter, r *http.Request) {
+	format, err := analyticsFormat(r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+	tz, ok := app.analyticsTimezone(w, r)
+	if !ok {
+		return
+	}
+
+	days, err := app.store.Analytics.DailyApplications(r.Context(), tz)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if format == "csv" {
+		rows := [][]string{{"Date", "Drafts Started", "Submitted", "Drafts Since Submitted", "Draft Conversion %"}}
+		for _, d := range days {
+			rate := ""
+			if d.DraftsCreated > 0 {
+				rate = strconv.FormatFloat(float64(d.CohortSubmitted)/float64(d.DraftsCreated)*100, 'f', 1, 64)
+			}
+			rows = append(rows, []string{
+				d.Date, strconv.Itoa(d.DraftsCreated), strconv.Itoa(d.Submitted), strconv.Itoa(d.CohortSubmitted), rate,
+			})
+		}
+		app.writeAnalyticsCSV(w, r, "applications-daily", rows)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, DailyApplicationsResponse{Timezone: tz, Days: days}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getConversionHandler returns the draft to submitted conversion rate
+//
+//	@Summary		Get application conversion (Admin)
+//	@Description	Returns how many applications were started and submitted in the active hackathon, the conversion rate as a percentage, and the median hours from starting to submitting. submitted includes applications withdrawn after submitting; drafts withdrawn without ever being submitted are counted in withdrawn_drafts, so started = submitted + drafts + withdrawn_drafts.
+//	@Tags			admin/analytics
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			format	query		string	false	"Response format"	Enums(json, csv)	default(json)
+//	@Success		200		{object}	store.ApplicationConversion
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/analytics/applications/conversion [get]
+func (app *application) getConversionHandler(w http.ResponseWriter, r *http.Request) {
+	format, err := analyticsFormat(r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	conversion, err := app.store.Analytics.Conversion(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if format == "csv" {
+		median := ""
+		if conversion.MedianHoursToSubmit != nil {
+			median = strconv.FormatFloat(*conversion.MedianHoursToSubmit, 'f', 1, 64)
+		}
+		app.writeAnalyticsCSV(w, r, "applications-conversion", [][]string{
+			{"Started", "Submitted", "Drafts", "Withdrawn Drafts", "Conversion %", "Median Hours To Submit"},
+			{
+				strconv.Itoa(conversion.Started),
+				strconv.Itoa(conversion.Submitted),
+				strconv.Itoa(conversion.Drafts),
+				strconv.Itoa(conversion.WithdrawnDrafts),
+				strconv.FormatFloat(conversion.ConversionRate, 'f', 1, 64),
+				median,
+			},
+		})
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, conversion); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getDailyReviewsHandler returns review throughput per day
+//
+//	@Summary		Get daily review throughput (Admin)
+//	@Description	Returns the review votes cast on the active hackathon's applications per day, by vote, with how many reviewers voted. Days without votes are left out. Days are calendar days in the given timezone.
+//	@Tags			admin/analytics
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			format		query		string	false	"Response format"	Enums(json, csv)	default(json)
+//	@Param			timezone	query		string	false	"IANA timezone to bucket days in (default: the application window's)"
+//	@Success		200			{object}	DailyReviewsResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/analytics/reviews/daily [get]
+func (app *application) getDailyReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	format, err := analyticsFormat(r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+	tz, ok := app.analyticsTimezone(w, r)
+	if !ok {
+		return
+	}
+
+	days, err := app.store.Analytics.DailyReviews(r.Context(), tz)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if format == "csv" {
+		rows := [][]string{{"Date", "Reviews", "Accept", "Reject", "Waitlist", "Reviewers"}}
+		for _, d := range days {
+			rows = append(rows, []string{
+				d.Date,
+				strconv.Itoa(d.Reviews),
+				strconv.Itoa(d.Accept),
+				strconv.Itoa(d.Reject),
+				strconv.Itoa(d.Waitlist),
+				strconv.Itoa(d.Reviewers),
+			})
+		}
+		app.writeAnalyticsCSV(w, r, "reviews-daily", rows)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, DailyReviewsResponse{Timezone: tz, Days: days}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
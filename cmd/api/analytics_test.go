@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildFieldBreakdowns(t *testing.T) {
+	fields := []store.ApplicationSchemaField{
+		{ID: "shirt", Label: "Shirt size", Type: "select", Options: []string{"S", "M", "L"}},
+		{ID: "age", Label: "Age", Type: "number"},
+	}
+	counts := []store.FieldAnswerCount{
+		{FieldID: "age", Answer: "9", Status: store.StatusSubmitted, Count: 1},
+		{FieldID: "age", Answer: "21", Status: store.StatusAccepted, Count: 4},
+		{FieldID: "shirt", Answer: "L", Status: store.StatusAccepted, Count: 2},
+		{FieldID: "shirt", Answer: "L", Status: store.StatusRejected, Count: 1},
+		{FieldID: "shirt", Answer: "XXL", Status: store.StatusSubmitted, Count: 1},
+	}
+
+	breakdowns := buildFieldBreakdowns(fields, counts)
+	require.Len(t, breakdowns, 2)
+
+	shirt := breakdowns[0]
+	answers := make([]string, len(shirt.Answers))
+	for i, a := range shirt.Answers {
+		answers[i] = a.Answer
+	}
+	assert.Equal(t, []string{"S", "M", "L", "XXL"}, answers, "options in order, then other answers")
+	assert.Equal(t, 0, shirt.Answers[0].Total)
+	assert.Equal(t, 3, shirt.Answers[2].Total)
+	assert.Equal(t, 2, shirt.Answers[2].ByStatus[store.StatusAccepted])
+
+	age := breakdowns[1]
+	require.Len(t, age.Answers, 2)
+	assert.Equal(t, "9", age.Answers[0].Answer, "numbers sort numerically")
+	assert.Equal(t, "21", age.Answers[1].Answer)
+}
+
+func TestGetFieldBreakdown(t *testing.T) {
+	schema := []store.ApplicationSchemaField{
+		{ID: "bio", Label: "Bio", Type: "textarea"},
+		{ID: "diet", Label: "Diet", Type: "multi_select", Options: []string{"Vegan"}},
+	}
+
+	t.Run("should only break down choice and number fields", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationSchema").Return(schema, nil).Once()
+		mockAnalytics := app.store.Analytics.(*store.MockAnalyticsStore)
+		mockAnalytics.On("FieldBreakdown", []store.AnalyticsField{{ID: "diet", List: true}}).
+			Return([]store.FieldAnswerCount{{FieldID: "diet", Answer: "Vegan", Status: store.StatusAccepted, Count: 3}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.getFieldBreakdownHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var envelope struct {
+			Data FieldBreakdownResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+		require.Len(t, envelope.Data.Fields, 1)
+		assert.Equal(t, 3, envelope.Data.Fields[0].Answers[0].Total)
+
+		mockAnalytics.AssertExpectations(t)
+	})
+
+	t.Run("should write a csv with a column per status", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationSchema").Return(schema, nil).Once()
+		app.store.Analytics.(*store.MockAnalyticsStore).On("FieldBreakdown", []store.AnalyticsField{{ID: "diet", List: true}}).
+			Return([]store.FieldAnswerCount{{FieldID: "diet", Answer: "Vegan", Status: store.StatusAccepted, Count: 3}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?format=csv", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.getFieldBreakdownHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+
+		records, err := csv.NewReader(strings.NewReader(rr.Body.String())).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, []string{"Field", "Answer", "Total", "draft", "submitted", "accepted", "waitlisted", "rejected", "withdrawn"}, records[0])
+		assert.Equal(t, []string{"Diet", "Vegan", "3", "0", "0", "3", "0", "0", "0"}, records[1])
+	})
+
+	t.Run("should return 400 for an unknown format", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		req, err := http.NewRequest(http.MethodGet, "/?format=pdf", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.getFieldBreakdownHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetDailyApplications(t *testing.T) {
+	days := []store.DailyApplicationCount{{Date: "2026-09-01", DraftsCreated: 4, Submitted: 1, CohortSubmitted: 3}}
+
+	t.Run("should default to the application window's timezone", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationWindow").
+			Return(store.ApplicationWindow{Timezone: "America/Chicago"}, nil).Once()
+		mockAnalytics := app.store.Analytics.(*store.MockAnalyticsStore)
+		mockAnalytics.On("DailyApplications", "America/Chicago").Return(days, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.getDailyApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockAnalytics.AssertExpectations(t)
+	})
+
+	t.Run("should write the cohort conversion to csv", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.store.Analytics.(*store.MockAnalyticsStore).On("DailyApplications", "UTC").Return(days, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?format=csv&timezone=UTC", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.getDailyApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		records, err := csv.NewReader(strings.NewReader(rr.Body.String())).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, []string{"2026-09-01", "4", "1", "3", "75.0"}, records[1])
+	})
+
+	t.Run("should return 400 for an unknown timezone", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		req, err := http.NewRequest(http.MethodGet, "/?timezone=Mars/Olympus", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.getDailyApplicationsHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/mailer"
+	"github.com/hackutd/portal/internal/qrtoken"
 	"github.com/hackutd/portal/internal/ratelimiter"
 	"github.com/hackutd/portal/internal/store"
 	"github.com/supertokens/supertokens-golang/supertokens"
@@ -29,6 +30,7 @@ type application struct {
 	mailer            mailer.Client
 	gcsClient         gcs.Client
 	appleWalletPasses appleWalletPassGenerator
+	qrTokens          *qrtoken.Signer
 	rateLimiter       ratelimiter.Limiter
 	dispatcherCancel  context.CancelFunc
 }
@@ -47,6 +49,7 @@ type config struct {
 	publicCORSOrigin string
 	vapid            vapidConfig
 	appleWallet      appleWalletConfig
+	qrToken          qrTokenConfig
 }
 
 type vapidConfig struct {
@@ -72,10 +75,20 @@ type basicConfig struct {
 	user string
 	pass string
 }
+
+// gcsConfig selects the object storage backend. backend is "gcs", "local" or
+// "s3"; empty disables storage unless bucketName is set.
 type gcsConfig struct {
-	bucketName string
+	backend       string
+	bucketName    string
+	localDir      string
+	signingSecret string
+	s3            gcs.S3Config
 }
 
+// storagePathPrefix is where the local storage backend serves its signed URLs
+const storagePathPrefix = "/v1/storage"
+
 type dbConfig struct {
 	addr         string
 	maxOpenConns int
@@ -89,14 +102,25 @@ const swaggerTagsSorter = `(a, b) => {
 		"auth",
 		"public",
 		"hackers",
+		"teams",
+		"projects",
+		"guardians",
 		"admin/applications",
 		"admin/reviews",
+		"admin/teams",
+		"admin/judging",
 		"admin/scans",
 		"admin/schedule",
 		"admin/sponsors",
 		"admin/faq",
+		"admin/policies",
 		"superadmin/applications",
+		"superadmin/audit",
 		"superadmin/emails",
+		"superadmin/hackathons",
+		"superadmin/judging",
+		"superadmin/policies",
+		"superadmin/resume-books",
 		"superadmin/settings",
 		"superadmin/users"
 	];
@@ -126,8 +150,9 @@ func (app *application) mount() http.Handler {
 	if len(allowedOrigins) > 0 {
 		r.Use(cors.Handler(cors.Options{
 			AllowedOrigins:   allowedOrigins,
-			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders:   append([]string{"Content-Type", "X-API-Key"}, supertokens.GetAllCORSHeaders()...),
+			AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+			AllowedHeaders:   append([]string{"Content-Type", "If-Match", "X-API-Key", "X-Goog-Content-Length-Range"}, supertokens.GetAllCORSHeaders()...),
+			ExposedHeaders:   []string{"ETag"},
 			AllowCredentials: true,
 		}))
 	}
@@ -150,9 +175,20 @@ func (app *application) mount() http.Handler {
 			r.Get("/faq", app.getPublicFAQHandler)
 		})
 
+		// Signed upload and download URLs when objects are stored on local
+		// disk; the signature is the only credential.
+		if h, ok := app.gcsClient.(http.Handler); ok {
+			r.Handle("/storage/*", http.StripPrefix(storagePathPrefix, h))
+		}
+
 		// Auth endpoints not handled by SuperTokens
 		r.Get("/auth/check-email", app.checkEmailAuthMethodHandler)
 		r.With(app.AuthRequiredMiddleware).Get("/auth/me", app.getCurrentUserHandler)
+
+		// Guardian confirmation links; the token in the path is the only
+		// credential.
+		r.Get("/guardian-consent/{token}", app.getGuardianConsentHandler)
+		r.Post("/guardian-consent/{token}", app.confirmGuardianConsentHandler)
 		// Basic auth
 		r.With(app.BasicAuthMiddleware).Get("/health", app.healthCheckHandler)
 		r.With(app.BasicAuthMiddleware).Get("/debug/vars", expvar.Handler().ServeHTTP)
@@ -180,10 +216,13 @@ func (app *application) mount() http.Handler {
 			r.Get("/schedule", app.getHackerScheduleHandler)
 			r.Get("/schedule/date-range", app.getHackerScheduleDateRange)
 			r.Get("/faq", app.getHackerFAQHandler)
+			r.Get("/policies", app.getMyPoliciesHandler)
+			r.Post("/policies/{policyID}/sign", app.signPolicyHandler)
 			r.Get("/hacker-pack", app.getHackerPackHandler)
 			r.Get("/points-config", app.getPointsConfigHandler)
 			r.Get("/hackathon-config", app.getHackathonConfigHandler)
 			r.Delete("/users/me", app.deleteMyAccountHandler)
+			r.Get("/users/me/qr-token", app.getMyQRTokenHandler)
 			r.Get("/wallet/apple-pass/status", app.getAppleWalletStatusHandler)
 			r.Get("/wallet/apple-pass", app.getAppleWalletPassHandler)
 
@@ -193,6 +232,13 @@ func (app *application) mount() http.Handler {
 				// Viewing your own resume is allowed in any status,
 				// even after applications close.
 				r.Get("/me/resume-url", app.getMyResumeDownloadURLHandler)
+				r.Get("/me/timeline", app.getMyApplicationTimelineHandler)
+				// Accepted hackers RSVP after applications have closed.
+				r.Post("/me/confirm", app.confirmApplicationHandler)
+				r.Post("/me/decline", app.declineApplicationHandler)
+				// Withdrawing stays open after the window closes so accepted
+				// hackers can release their seat.
+				r.Post("/me/withdraw", app.withdrawApplicationHandler)
 
 				r.Group(func(r chi.Router) {
 					r.Use(app.ApplicationsEnabledMiddleware)
@@ -200,9 +246,23 @@ func (app *application) mount() http.Handler {
 					r.Post("/me/submit", app.submitApplicationHandler)
 					r.Post("/me/resume-upload-url", app.generateResumeUploadURLHandler)
 					r.Delete("/me/resume", app.deleteResumeHandler)
+					r.Post("/me/files/{fieldID}/upload-url", app.generateApplicationFileUploadURLHandler)
 				})
 			})
 
+			r.Route("/teams", func(r chi.Router) {
+				r.Post("/", app.createTeamHandler)
+				r.Post("/join", app.joinTeamHandler)
+				r.Get("/me", app.getMyTeamHandler)
+				r.Delete("/me", app.leaveTeamHandler)
+				r.Post("/me/invites", app.inviteTeamMemberHandler)
+			})
+
+			r.Route("/projects", func(r chi.Router) {
+				r.Get("/me", app.getMyProjectHandler)
+				r.Put("/me", app.saveMyProjectHandler)
+			})
+
 			r.Group(func(r chi.Router) {
 				r.Use(app.RequireRoleMiddleware(store.RoleAdmin))
 				// Admin routes
@@ -212,14 +272,34 @@ func (app *application) mount() http.Handler {
 					r.Route("/applications", func(r chi.Router) {
 						r.Get("/", app.listApplicationsHandler)
 						r.Get("/stats", app.getApplicationStatsHandler)
+						r.Get("/export", app.exportApplicationsHandler)
+						r.Get("/filters", app.listApplicationFilterFieldsHandler)
+						r.Get("/quotas", app.getQuotaUsageHandler)
 						r.Get("/{applicationID}", app.getApplication)
 						r.Get("/{applicationID}/resume-url", app.getResumeDownloadURLHandler)
+						r.Get("/{applicationID}/files/{fieldID}/url", app.getApplicationFileDownloadURLHandler)
 
 						// Assigned Applications
 						r.Get("/{applicationID}/notes", app.getApplicationNotes)
 						r.Put("/{applicationID}/ai-percent", app.setAIPercent)
 					})
 
+					// Analytics
+					r.Route("/analytics", func(r chi.Router) {
+						r.Get("/fields", app.getFieldBreakdownHandler)
+						r.Get("/applications/daily", app.getDailyApplicationsHandler)
+						r.Get("/applications/conversion", app.getConversionHandler)
+						r.Get("/reviews/daily", app.getDailyReviewsHandler)
+					})
+
+					// Saved list views
+					r.Route("/views", func(r chi.Router) {
+						r.Get("/", app.listSavedViewsHandler)
+						r.Post("/", app.createSavedViewHandler)
+						r.Put("/{viewID}", app.updateSavedViewHandler)
+						r.Delete("/{viewID}", app.deleteSavedViewHandler)
+					})
+
 					// Reviews
 					r.Route("/reviews", func(r chi.Router) {
 						r.Get("/pending", app.getPendingReviews)
@@ -228,6 +308,19 @@ func (app *application) mount() http.Handler {
 						r.Get("/completed", app.getCompletedReviews)
 					})
 
+					// Teams
+					r.Route("/teams", func(r chi.Router) {
+						r.Get("/", app.listTeamsHandler)
+						r.Get("/{teamID}", app.getTeamHandler)
+					})
+
+					// Projects and judging
+					r.Get("/projects", app.listProjectsHandler)
+					r.Route("/judging", func(r chi.Router) {
+						r.Get("/assignments", app.getMyJudgingAssignments)
+						r.Put("/assignments/{judgingID}", app.submitJudgingScores)
+					})
+
 					// Scans
 					r.Route("/scans", func(r chi.Router) {
 						r.Post("/", app.createScanHandler)
@@ -260,6 +353,7 @@ func (app *application) mount() http.Handler {
 							r.Put("/{sponsorID}", app.updateSponsorHandler)
 							r.Delete("/{sponsorID}", app.deleteSponsorHandler)
 							r.Put("/{sponsorID}/logo", app.uploadLogoHandler)
+							r.Post("/{sponsorID}/logo-upload-url", app.generateLogoUploadURLHandler)
 						})
 					})
 
@@ -275,6 +369,12 @@ func (app *application) mount() http.Handler {
 							r.Delete("/{faqID}", app.deleteFAQHandler)
 						})
 					})
+
+					// Waivers and policies
+					r.Route("/policies", func(r chi.Router) {
+						r.Get("/", app.listPoliciesHandler)
+						r.Get("/{policyID}/signatures", app.getPolicySignersHandler)
+					})
 				})
 			})
 
@@ -283,11 +383,28 @@ func (app *application) mount() http.Handler {
 				// Super admin routes
 				r.Route("/superadmin", func(r chi.Router) {
 					r.Post("/reset-hackathon", app.resetHackathonHandler)
+					r.Get("/audit-events", app.listAuditEventsHandler)
+
+					// Event cycles
+					r.Route("/hackathons", func(r chi.Router) {
+						r.Get("/", app.listHackathonsHandler)
+						r.Post("/", app.createHackathonHandler)
+						r.Get("/stats", app.getHackathonStatsHandler)
+						r.Post("/{hackathonID}/activate", app.activateHackathonHandler)
+						r.Get("/{hackathonID}/settings", app.getHackathonSettingsHandler)
+						r.Get("/archives", app.listHackathonArchivesHandler)
+						r.Post("/archives", app.createHackathonArchiveHandler)
+						r.Post("/archives/{archiveID}/restore", app.restoreHackathonArchiveHandler)
+					})
 
 					// Configs
 					r.Route("/settings", func(r chi.Router) {
 						r.Get("/application-schema", app.getApplicationSchema)
 						r.Put("/application-schema", app.updateApplicationSchema)
+						r.Get("/application-schema/versions", app.listApplicationSchemaVersions)
+						r.Get("/application-schema/versions/{version}", app.getApplicationSchemaVersion)
+						r.Post("/application-schema/versions/{version}/remap", app.remapApplicationResponses)
+						r.Get("/application-schema/diff", app.getApplicationSchemaDiff)
 						r.Get("/reviews-per-app", app.getReviewsPerApp)
 						r.Post("/reviews-per-app", app.setReviewsPerApp)
 						r.Put("/review-assignment-toggle", app.setReviewAssignmentToggle)
@@ -299,6 +416,12 @@ func (app *application) mount() http.Handler {
 						r.Post("/admin-faq-edit-toggle", app.setAdminFAQEditToggle)
 						r.Get("/hackathon-date-range", app.getHackathonDateRange)
 						r.Post("/hackathon-date-range", app.setHackathonDateRange)
+						r.Get("/rsvp", app.getRSVPConfig)
+						r.Post("/rsvp", app.setRSVPConfig)
+						r.Get("/team-size", app.getTeamSizeMax)
+						r.Post("/team-size", app.setTeamSizeMax)
+						r.Get("/judging", app.getJudgingConfig)
+						r.Post("/judging", app.setJudgingConfig)
 						r.Get("/hacker-pack-url", app.getHackerPackURL)
 						r.Post("/hacker-pack-url", app.setHackerPackURL)
 						r.Post("/points-name", app.setPointsName)
@@ -312,16 +435,28 @@ func (app *application) mount() http.Handler {
 						r.Post("/from-email", app.setFromEmail)
 						r.Get("/from-name", app.getFromName)
 						r.Post("/from-name", app.setFromName)
-						r.Get("/application-due-date", app.getApplicationDueDate)
-						r.Post("/application-due-date", app.setApplicationDueDate)
+						r.Get("/application-window", app.getApplicationWindow)
+						r.Post("/application-window", app.setApplicationWindow)
 						r.Get("/onboarding-status", app.getOnboardingStatus)
 						r.Put("/scan-types", app.updateScanTypesHandler)
 						r.Get("/meal-groups", app.getMealGroups)
 						r.Put("/meal-groups", app.updateMealGroups)
 						r.Get("/meal-groups/stats", app.getMealGroupStats)
+						r.Get("/decision-rules", app.getDecisionRules)
+						r.Put("/decision-rules", app.setDecisionRules)
+						r.Get("/quotas", app.getAdmissionQuotas)
+						r.Put("/quotas", app.setAdmissionQuotas)
+						r.Get("/applications-enabled", app.getApplicationsToggle)
 						r.Put("/applications-enabled", app.setApplicationsEnabled)
 					})
 
+					r.Route("/policies", func(r chi.Router) {
+						r.Post("/", app.createPolicyHandler)
+						r.Put("/{policyID}", app.updatePolicyHandler)
+						r.Delete("/{policyID}", app.deletePolicyHandler)
+						r.Get("/{policyID}/versions", app.listPolicyVersionsHandler)
+					})
+
 					r.Route("/walk-ins", func(r chi.Router) {
 						r.Get("/", app.getWalkInsHandler)
 						r.Post("/promote", app.promoteWalkInsHandler)
@@ -330,9 +465,26 @@ func (app *application) mount() http.Handler {
 					r.Route("/applications", func(r chi.Router) {
 						r.Post("/assign", app.batchAssignReviews)
 						r.Get("/emails", app.getApplicantEmailsByStatusHandler)
+						r.Get("/duplicates/scan", app.getLatestDuplicateScanHandler)
+						r.Post("/duplicates/scan", app.scanApplicationDuplicatesHandler)
+						r.Post("/status/bulk", app.bulkSetApplicationStatusHandler)
+						r.Post("/decisions/preview", app.previewDecisionsHandler)
+						r.Post("/decisions/apply", app.applyDecisionsHandler)
 						r.Patch("/{applicationID}/status", app.setApplicationStatus)
 					})
 
+					r.Route("/judging", func(r chi.Router) {
+						r.Post("/assign", app.batchAssignJudges)
+						r.Get("/rankings", app.getJudgingRankings)
+					})
+
+					// Sponsor resume books
+					r.Route("/resume-books", func(r chi.Router) {
+						r.Get("/", app.listResumeBooksHandler)
+						r.Post("/", app.createResumeBookHandler)
+						r.Get("/{bookID}/download-url", app.getResumeBookDownloadURLHandler)
+					})
+
 					// Outbound decision emails
 					r.Route("/emails", func(r chi.Router) {
 						r.Get("/decisions/stats", app.getDecisionEmailStatsHandler)
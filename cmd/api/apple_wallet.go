@@ -25,7 +25,7 @@ type appleWalletConfig struct {
 }
 
 type appleWalletPassGenerator interface {
-	Generate(userID, email string) ([]byte, error)
+	Generate(userID, email, qrToken string) ([]byte, error)
 }
 
 type appleWalletStatusResponse struct {
@@ -127,7 +127,13 @@ func (app *application) getAppleWalletPassHandler(w http.ResponseWriter, r *http
 		return
 	}
 
-	pass, err := app.appleWalletPasses.Generate(user.ID, user.Email)
+	qrToken, err := app.issueQRToken(r.Context(), user.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	pass, err := app.appleWalletPasses.Generate(user.ID, user.Email, qrToken)
 	if err != nil {
 		app.internalServerError(w, r, fmt.Errorf("generate Apple Wallet pass: %w", err))
 		return
@@ -14,11 +14,13 @@ type fakeAppleWalletPassGenerator struct {
 	err       error
 	userID    string
 	userEmail string
+	qrToken   string
 }
 
-func (f *fakeAppleWalletPassGenerator) Generate(userID, email string) ([]byte, error) {
+func (f *fakeAppleWalletPassGenerator) Generate(userID, email, qrToken string) ([]byte, error) {
 	f.userID = userID
 	f.userEmail = email
+	f.qrToken = qrToken
 	return f.pass, f.err
 }
 
@@ -55,10 +57,8 @@ func TestGetAppleWalletStatusHandler(t *testing.T) {
 
 func TestGetAppleWalletPassHandler(t *testing.T) {
 	generator := &fakeAppleWalletPassGenerator{pass: []byte("signed pass")}
-	app := &application{
-		logger:            zap.NewNop().Sugar(),
-		appleWalletPasses: generator,
-	}
+	app := newTestApplication(t)
+	app.appleWalletPasses = generator
 	response := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodGet, "/wallet/apple-pass", nil)
 	request = setUserContext(request, newTestUser())
@@ -78,6 +78,13 @@ func TestGetAppleWalletPassHandler(t *testing.T) {
 	if generator.userID != "user-1" || generator.userEmail != "hacker@test.com" {
 		t.Errorf("Generate() called with %q, %q", generator.userID, generator.userEmail)
 	}
+	claims, err := app.qrTokens.Verify(generator.qrToken, testHackathonID)
+	if err != nil {
+		t.Fatalf("pass QR token does not verify: %v", err)
+	}
+	if claims.UserID != "user-1" {
+		t.Errorf("pass QR token is for %q, want user-1", claims.UserID)
+	}
 }
 
 func TestGetAppleWalletPassHandlerUnavailable(t *testing.T) {
@@ -92,11 +99,9 @@ func TestGetAppleWalletPassHandlerUnavailable(t *testing.T) {
 }
 
 func TestGetAppleWalletPassHandlerGenerationFailure(t *testing.T) {
-	app := &application{
-		logger: zap.NewNop().Sugar(),
-		appleWalletPasses: &fakeAppleWalletPassGenerator{
-			err: errors.New("signing failed"),
-		},
+	app := newTestApplication(t)
+	app.appleWalletPasses = &fakeAppleWalletPassGenerator{
+		err: errors.New("signing failed"),
 	}
 	response := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodGet, "/wallet/apple-pass", nil)
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+// validateSchemaConditions checks the show_if and require_if rules of a schema
+// before it is saved: every rule must point at another field that exists,
+// carry a value its operator can use, and show_if rules must not form a loop.
+func validateSchemaConditions(fields []store.ApplicationSchemaField) error {
+	byID := make(map[string]*store.ApplicationSchemaField, len(fields))
+	for i := range fields {
+		byID[fields[i].ID] = &fields[i]
+	}
+
+	for _, f := range fields {
+		rules := []struct {
+			name string
+			c    *store.FieldCondition
+		}{{"show_if", f.ShowIf}, {"require_if", f.RequireIf}}
+
+		for _, rule := range rules {
+			name, c := rule.name, rule.c
+			if c == nil {
+				continue
+			}
+			if c.Field == f.ID {
+				return fmt.Errorf("%s %s cannot refer to the field itself", f.ID, name)
+			}
+			if _, ok := byID[c.Field]; !ok {
+				return fmt.Errorf("%s %s refers to unknown field %q", f.ID, name, c.Field)
+			}
+			if err := checkConditionValue(c); err != nil {
+				return fmt.Errorf("%s %s: %w", f.ID, name, err)
+			}
+		}
+	}
+
+	// Visibility follows show_if from field to field, so a loop would leave
+	// it undefined.
+	for _, f := range fields {
+		seen := map[string]bool{f.ID: true}
+		for c := f.ShowIf; c != nil; c = byID[c.Field].ShowIf {
+			if seen[c.Field] {
+				return fmt.Errorf("%s show_if forms a loop through %s", f.ID, c.Field)
+			}
+			seen[c.Field] = true
+		}
+	}
+
+	return nil
+}
+
+func checkConditionValue(c *store.FieldCondition) error {
+	switch c.Op {
+	case "lt", "lte", "gt", "gte":
+		if _, ok := c.Value.(float64); !ok {
+			return fmt.Errorf("%s needs a numeric value", c.Op)
+		}
+	case "in":
+		list, ok := c.Value.([]interface{})
+		if !ok || len(list) == 0 {
+			return fmt.Errorf("in needs a non-empty list value")
+		}
+		for _, v := range list {
+			if !isScalar(v) {
+				return fmt.Errorf("in values must be strings, numbers or booleans")
+			}
+		}
+	case "eq", "neq", "contains":
+		if !isScalar(c.Value) {
+			return fmt.Errorf("%s needs a string, number or boolean value", c.Op)
+		}
+	case "empty", "not_empty":
+		if c.Value != nil {
+			return fmt.Errorf("%s takes no value", c.Op)
+		}
+	}
+	return nil
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case string, float64, bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// fieldRules resolves the conditions of a schema against one set of
+// responses. A hidden field's answer counts as absent, so rules that depend
+// on it never hold.
+type fieldRules struct {
+	byID      map[string]*store.ApplicationSchemaField
+	responses map[string]interface{}
+	visible   map[string]bool
+}
+
+func newFieldRules(schema []store.ApplicationSchemaField, responses map[string]interface{}) *fieldRules {
+	byID := make(map[string]*store.ApplicationSchemaField, len(schema))
+	for i := range schema {
+		byID[schema[i].ID] = &schema[i]
+	}
+	return &fieldRules{byID: byID, responses: responses, visible: make(map[string]bool, len(schema))}
+}
+
+// isVisible reports whether the field is shown. Answers to fields outside the
+// schema are left alone, as they were before conditions existed.
+func (fr *fieldRules) isVisible(id string) bool {
+	if v, ok := fr.visible[id]; ok {
+		return v
+	}
+	f, ok := fr.byID[id]
+	if !ok {
+		return true
+	}
+
+	// Mark the field hidden while resolving it, so a loop that slipped past
+	// validateSchemaConditions hides the fields instead of recursing forever.
+	fr.visible[id] = false
+	v := f.ShowIf == nil || fr.holds(f.ShowIf)
+	fr.visible[id] = v
+	return v
+}
+
+// isRequired reports whether a visible field must be answered.
+func (fr *fieldRules) isRequired(f store.ApplicationSchemaField) bool {
+	return f.Required || (f.RequireIf != nil && fr.holds(f.RequireIf))
+}
+
+func (fr *fieldRules) holds(c *store.FieldCondition) bool {
+	val, present := fr.responses[c.Field]
+	if !fr.isVisible(c.Field) || isEmpty(val) {
+		present = false
+	}
+
+	switch c.Op {
+	case "empty":
+		return !present
+	case "not_empty":
+		return present
+	case "neq":
+		return !present || !scalarEqual(val, c.Value)
+	}
+	if !present {
+		return false
+	}
+
+	switch c.Op {
+	case "eq":
+		return scalarEqual(val, c.Value)
+	case "in":
+		list, _ := c.Value.([]interface{})
+		for _, v := range list {
+			if scalarEqual(val, v) {
+				return true
+			}
+		}
+		return false
+	case "contains":
+		list, ok := val.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, v := range list {
+			if scalarEqual(v, c.Value) {
+				return true
+			}
+		}
+		return false
+	case "lt", "lte", "gt", "gte":
+		n, ok := val.(float64)
+		want, ok2 := c.Value.(float64)
+		if !ok || !ok2 {
+			return false
+		}
+		switch c.Op {
+		case "lt":
+			return n < want
+		case "lte":
+			return n <= want
+		case "gt":
+			return n > want
+		default:
+			return n >= want
+		}
+	}
+	return false
+}
+
+// scalarEqual compares two decoded JSON values, treating lists and objects as
+// never equal rather than letting == panic on them.
+func scalarEqual(a, b interface{}) bool {
+	if !isScalar(a) || !isScalar(b) {
+		return false
+	}
+	return a == b
+}
+
+// pruneHiddenResponses drops the answers of fields that are hidden for these
+// responses and reports whether anything was removed.
+func pruneHiddenResponses(schema []store.ApplicationSchemaField, responses map[string]interface{}) bool {
+	rules := newFieldRules(schema, responses)
+
+	pruned := false
+	for _, f := range schema {
+		if _, ok := responses[f.ID]; ok && !rules.isVisible(f.ID) {
+			delete(responses, f.ID)
+			pruned = true
+		}
+	}
+
+	return pruned
+}
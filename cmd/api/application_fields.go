@@ -0,0 +1,317 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	// maxPatternLength keeps admin-supplied regular expressions small; RE2 has
+	// no catastrophic backtracking, but compile cost still grows with size.
+	maxPatternLength = 500
+	// maxOtherLength caps the free text entered for an "other" option.
+	maxOtherLength = 200
+)
+
+// fieldValidationKeys lists the validation settings each field type accepts.
+// A type missing from the map is not a field type.
+var fieldValidationKeys = map[string][]string{
+	"text":          {"minLength", "maxLength", "pattern"},
+	"textarea":      {"minLength", "maxLength", "pattern"},
+	"phone":         {"minLength", "maxLength", "pattern"},
+	"email":         {"maxLength", "pattern"},
+	"url":           {"maxLength", "pattern"},
+	"number":        {"min", "max"},
+	"date":          {"min", "max"},
+	"select":        {"allowOther"},
+	"multi_select":  {"minItems", "maxItems", "allowOther"},
+	"ranked_choice": {"minItems", "maxItems"},
+	"country":       {},
+	"checkbox":      {},
+	"file":          {},
+}
+
+// validateFieldConfig checks a schema field's type, options and validation
+// settings before the schema is saved, so a bad config is reported to the
+// super admin rather than surfacing later as applicants' validation errors.
+func validateFieldConfig(f store.ApplicationSchemaField) error {
+	allowed, ok := fieldValidationKeys[f.Type]
+	if !ok {
+		return fmt.Errorf("%s has unknown type %q", f.ID, f.Type)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(f.Validation)) {
+		if !slices.Contains(allowed, key) {
+			return fmt.Errorf("%s: %s fields do not support %s", f.ID, f.Type, key)
+		}
+		if err := checkValidationValue(f.Type, key, f.Validation[key]); err != nil {
+			return fmt.Errorf("%s: %w", f.ID, err)
+		}
+	}
+
+	for _, pair := range [][2]string{{"minLength", "maxLength"}, {"minItems", "maxItems"}, {"min", "max"}} {
+		lo, hasLo := f.Validation[pair[0]]
+		hi, hasHi := f.Validation[pair[1]]
+		if !hasLo || !hasHi {
+			continue
+		}
+		// Dates are compared as YYYY-MM-DD strings, which order like dates.
+		if s, ok := lo.(string); ok && s > hi.(string) {
+			return fmt.Errorf("%s: %s is after %s", f.ID, pair[0], pair[1])
+		}
+		if n, ok := lo.(float64); ok && n > hi.(float64) {
+			return fmt.Errorf("%s: %s is greater than %s", f.ID, pair[0], pair[1])
+		}
+	}
+
+	switch f.Type {
+	case "select", "multi_select", "ranked_choice", "country":
+		seen := make(map[string]bool, len(f.Options))
+		for _, o := range f.Options {
+			if seen[o] {
+				return fmt.Errorf("%s has duplicate option %q", f.ID, o)
+			}
+			seen[o] = true
+			if f.Type == "country" && Validate.Var(o, "iso3166_1_alpha2") != nil {
+				return fmt.Errorf("%s option %q is not an ISO 3166-1 alpha-2 country code", f.ID, o)
+			}
+		}
+	default:
+		if len(f.Options) > 0 {
+			return fmt.Errorf("%s: %s fields do not take options", f.ID, f.Type)
+		}
+	}
+
+	if f.Type == "ranked_choice" {
+		if len(f.Options) < 2 {
+			return fmt.Errorf("%s needs at least two options to rank", f.ID)
+		}
+		if n, ok := f.Validation["minItems"].(float64); ok && int(n) > len(f.Options) {
+			return fmt.Errorf("%s: minItems is more than the number of options", f.ID)
+		}
+	}
+
+	return nil
+}
+
+func checkValidationValue(fieldType, key string, v interface{}) error {
+	switch key {
+	case "minLength", "maxLength", "minItems", "maxItems":
+		n, ok := v.(float64)
+		if !ok || n < 0 || n != float64(int(n)) {
+			return fmt.Errorf("%s must be a non-negative whole number", key)
+		}
+	case "min", "max":
+		if fieldType == "date" {
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%s must be a date in YYYY-MM-DD form", key)
+			}
+			if _, err := time.Parse(dateLayout, s); err != nil {
+				return fmt.Errorf("%s must be a date in YYYY-MM-DD form", key)
+			}
+			return nil
+		}
+		if _, ok := v.(float64); !ok {
+			return fmt.Errorf("%s must be a number", key)
+		}
+	case "pattern":
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return fmt.Errorf("pattern must be a non-empty string")
+		}
+		if len(s) > maxPatternLength {
+			return fmt.Errorf("pattern must be at most %d characters", maxPatternLength)
+		}
+		if _, err := regexp.Compile(s); err != nil {
+			return fmt.Errorf("pattern is not a valid regular expression: %v", err)
+		}
+	case "allowOther":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("allowOther must be true or false")
+		}
+	}
+	return nil
+}
+
+// validateFieldValue checks a present, non-empty answer against its field and
+// returns the problems found. The field's config is assumed to have passed
+// validateFieldConfig, though settings of the wrong shape are ignored rather
+// than trusted.
+func validateFieldValue(field store.ApplicationSchemaField, val interface{}) []string {
+	var errs []string
+
+	switch field.Type {
+	case "text", "textarea", "phone", "email", "url":
+		s, ok := val.(string)
+		if !ok {
+			return []string{field.ID + " must be a string"}
+		}
+		errs = append(errs, checkLength(field, s)...)
+		// The pattern must match the whole answer, as an HTML pattern
+		// attribute would, not just some substring of it.
+		if p, ok := field.Validation["pattern"].(string); ok {
+			if re, err := regexp.Compile(`^(?:` + p + `)$`); err == nil && !re.MatchString(s) {
+				errs = append(errs, field.ID+" is not in the expected format")
+			}
+		}
+		switch field.Type {
+		case "email":
+			if Validate.Var(s, "email") != nil {
+				errs = append(errs, field.ID+" must be a valid email address")
+			}
+		case "url":
+			if Validate.Var(s, "http_url") != nil {
+				errs = append(errs, field.ID+" must be an http or https URL")
+			}
+		}
+
+	case "number":
+		n, ok := val.(float64)
+		if !ok {
+			return []string{field.ID + " must be a number"}
+		}
+		if mv, ok := field.Validation["min"].(float64); ok && n < mv {
+			errs = append(errs, fmt.Sprintf("%s must be at least %v", field.ID, mv))
+		}
+		if mv, ok := field.Validation["max"].(float64); ok && n > mv {
+			errs = append(errs, fmt.Sprintf("%s must be at most %v", field.ID, mv))
+		}
+
+	case "date":
+		s, ok := val.(string)
+		if !ok {
+			return []string{field.ID + " must be a string"}
+		}
+		if _, err := time.Parse(dateLayout, s); err != nil {
+			return []string{field.ID + " must be a date in YYYY-MM-DD form"}
+		}
+		if mv, ok := field.Validation["min"].(string); ok && s < mv {
+			errs = append(errs, fmt.Sprintf("%s must be on or after %s", field.ID, mv))
+		}
+		if mv, ok := field.Validation["max"].(string); ok && s > mv {
+			errs = append(errs, fmt.Sprintf("%s must be on or before %s", field.ID, mv))
+		}
+
+	case "country":
+		s, ok := val.(string)
+		if !ok {
+			return []string{field.ID + " must be a string"}
+		}
+		if Validate.Var(s, "iso3166_1_alpha2") != nil {
+			errs = append(errs, field.ID+" must be an ISO 3166-1 alpha-2 country code")
+		} else if len(field.Options) > 0 && !containsString(field.Options, s) {
+			errs = append(errs, field.ID+" has invalid option: "+s)
+		}
+
+	case "select":
+		s, ok := val.(string)
+		if !ok {
+			return []string{field.ID + " must be a string"}
+		}
+		if len(field.Options) > 0 && !containsString(field.Options, s) {
+			if allowsOther(field) {
+				errs = append(errs, checkOther(field, s)...)
+			} else {
+				errs = append(errs, field.ID+" has invalid option: "+s)
+			}
+		}
+
+	case "multi_select", "ranked_choice":
+		items, itemErrs := stringItems(field, val)
+		if itemErrs != nil {
+			return itemErrs
+		}
+		others := 0
+		for _, s := range items {
+			if len(field.Options) == 0 || containsString(field.Options, s) {
+				continue
+			}
+			if field.Type == "multi_select" && allowsOther(field) && others == 0 {
+				others++
+				errs = append(errs, checkOther(field, s)...)
+				continue
+			}
+			errs = append(errs, field.ID+" has invalid option: "+s)
+		}
+		if n, ok := field.Validation["minItems"].(float64); ok && float64(len(items)) < n {
+			errs = append(errs, fmt.Sprintf("%s needs at least %d choices", field.ID, int(n)))
+		}
+		if n, ok := field.Validation["maxItems"].(float64); ok && float64(len(items)) > n {
+			errs = append(errs, fmt.Sprintf("%s allows at most %d choices", field.ID, int(n)))
+		}
+
+	case "file":
+		// Ownership and the stored object are checked by the handler, which
+		// knows the applicant; here the answer only has to be a path.
+		if _, ok := val.(string); !ok {
+			return []string{field.ID + " must be a string"}
+		}
+
+	case "checkbox":
+		if _, ok := val.(bool); !ok {
+			return []string{field.ID + " must be a boolean"}
+		}
+	}
+
+	return errs
+}
+
+func checkLength(field store.ApplicationSchemaField, s string) []string {
+	var errs []string
+	n := float64(utf8.RuneCountInString(s))
+	if ml, ok := field.Validation["minLength"].(float64); ok && n < ml {
+		errs = append(errs, fmt.Sprintf("%s is shorter than min length of %d", field.ID, int(ml)))
+	}
+	if ml, ok := field.Validation["maxLength"].(float64); ok && n > ml {
+		errs = append(errs, fmt.Sprintf("%s exceeds max length of %d", field.ID, int(ml)))
+	}
+	return errs
+}
+
+// stringItems decodes a list answer, rejecting non-strings and, since an
+// option can only be picked or ranked once, duplicates.
+func stringItems(field store.ApplicationSchemaField, val interface{}) ([]string, []string) {
+	arr, ok := val.([]interface{})
+	if !ok {
+		return nil, []string{field.ID + " must be an array"}
+	}
+	items := make([]string, 0, len(arr))
+	seen := make(map[string]bool, len(arr))
+	for _, item := range arr {
+		s, ok := item.(string)
+		if !ok {
+			return nil, []string{field.ID + " array items must be strings"}
+		}
+		if seen[s] {
+			return nil, []string{field.ID + " repeats choice: " + s}
+		}
+		seen[s] = true
+		items = append(items, s)
+	}
+	return items, nil
+}
+
+func allowsOther(field store.ApplicationSchemaField) bool {
+	other, _ := field.Validation["allowOther"].(bool)
+	return other
+}
+
+// checkOther validates the free text given in place of a listed option.
+func checkOther(field store.ApplicationSchemaField, s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return []string{field.ID + " other answer must not be blank"}
+	}
+	if utf8.RuneCountInString(s) > maxOtherLength {
+		return []string{fmt.Sprintf("%s other answer exceeds max length of %d", field.ID, maxOtherLength)}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+func TestValidateFieldConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		field store.ApplicationSchemaField
+		valid bool
+	}{
+		{"text with pattern and lengths", store.ApplicationSchemaField{ID: "f", Type: "text", Validation: map[string]interface{}{"pattern": `^[A-Z]`, "minLength": float64(2), "maxLength": float64(10)}}, true},
+		{"date bounds", store.ApplicationSchemaField{ID: "f", Type: "date", Validation: map[string]interface{}{"min": "2000-01-01", "max": "2010-12-31"}}, true},
+		{"ranked choice", store.ApplicationSchemaField{ID: "f", Type: "ranked_choice", Options: []string{"a", "b", "c"}, Validation: map[string]interface{}{"minItems": float64(3)}}, true},
+		{"select with other", store.ApplicationSchemaField{ID: "f", Type: "select", Options: []string{"a"}, Validation: map[string]interface{}{"allowOther": true}}, true},
+		{"country subset", store.ApplicationSchemaField{ID: "f", Type: "country", Options: []string{"US", "CA"}}, true},
+		{"unknown type", store.ApplicationSchemaField{ID: "f", Type: "color"}, false},
+		{"key not for type", store.ApplicationSchemaField{ID: "f", Type: "number", Validation: map[string]interface{}{"maxLength": float64(5)}}, false},
+		{"bad pattern", store.ApplicationSchemaField{ID: "f", Type: "text", Validation: map[string]interface{}{"pattern": `(`}}, false},
+		{"fractional length", store.ApplicationSchemaField{ID: "f", Type: "textarea", Validation: map[string]interface{}{"maxLength": 1.5}}, false},
+		{"min above max", store.ApplicationSchemaField{ID: "f", Type: "number", Validation: map[string]interface{}{"min": float64(10), "max": float64(1)}}, false},
+		{"malformed date", store.ApplicationSchemaField{ID: "f", Type: "date", Validation: map[string]interface{}{"min": "01/01/2000"}}, false},
+		{"minItems above maxItems", store.ApplicationSchemaField{ID: "f", Type: "multi_select", Options: []string{"a", "b"}, Validation: map[string]interface{}{"minItems": float64(2), "maxItems": float64(1)}}, false},
+		{"ranked choice without options", store.ApplicationSchemaField{ID: "f", Type: "ranked_choice", Options: []string{"a"}}, false},
+		{"duplicate options", store.ApplicationSchemaField{ID: "f", Type: "select", Options: []string{"a", "a"}}, false},
+		{"invalid country option", store.ApplicationSchemaField{ID: "f", Type: "country", Options: []string{"USA"}}, false},
+		{"options on text", store.ApplicationSchemaField{ID: "f", Type: "text", Options: []string{"a"}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateFieldConfig(tc.field)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateFieldValue(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		field store.ApplicationSchemaField
+		value interface{}
+		valid bool
+	}{
+		{"email", store.ApplicationSchemaField{ID: "f", Type: "email"}, "jane@example.com", true},
+		{"bad email", store.ApplicationSchemaField{ID: "f", Type: "email"}, "jane@", false},
+		{"email pattern", store.ApplicationSchemaField{ID: "f", Type: "email", Validation: map[string]interface{}{"pattern": `.+@utdallas\.edu`}}, "jane@utdallas.edu", true},
+		{"email outside pattern", store.ApplicationSchemaField{ID: "f", Type: "email", Validation: map[string]interface{}{"pattern": `.+@utdallas\.edu`}}, "jane@example.com", false},
+		{"pattern matching a substring", store.ApplicationSchemaField{ID: "f", Type: "text", Validation: map[string]interface{}{"pattern": `\d{5}`}}, "zip 75080 or so", false},
+		{"url", store.ApplicationSchemaField{ID: "f", Type: "url"}, "https://github.com/jane", true},
+		{"non-http url", store.ApplicationSchemaField{ID: "f", Type: "url"}, "ftp://example.com", false},
+		{"date in range", store.ApplicationSchemaField{ID: "f", Type: "date", Validation: map[string]interface{}{"max": "2008-10-17"}}, "2004-02-29", true},
+		{"date after max", store.ApplicationSchemaField{ID: "f", Type: "date", Validation: map[string]interface{}{"max": "2008-10-17"}}, "2009-01-01", false},
+		{"impossible date", store.ApplicationSchemaField{ID: "f", Type: "date"}, "2023-02-30", false},
+		{"country", store.ApplicationSchemaField{ID: "f", Type: "country"}, "MX", true},
+		{"country outside options", store.ApplicationSchemaField{ID: "f", Type: "country", Options: []string{"US"}}, "MX", false},
+		{"min length", store.ApplicationSchemaField{ID: "f", Type: "textarea", Validation: map[string]interface{}{"minLength": float64(5)}}, "héllo", true},
+		{"too short", store.ApplicationSchemaField{ID: "f", Type: "textarea", Validation: map[string]interface{}{"minLength": float64(5)}}, "hey", false},
+		{"select other", store.ApplicationSchemaField{ID: "f", Type: "select", Options: []string{"a"}, Validation: map[string]interface{}{"allowOther": true}}, "something else", true},
+		{"select other not allowed", store.ApplicationSchemaField{ID: "f", Type: "select", Options: []string{"a"}}, "something else", false},
+		{"multi select one other", store.ApplicationSchemaField{ID: "f", Type: "multi_select", Options: []string{"a", "b"}, Validation: map[string]interface{}{"allowOther": true}}, []interface{}{"a", "mine"}, true},
+		{"multi select two others", store.ApplicationSchemaField{ID: "f", Type: "multi_select", Options: []string{"a", "b"}, Validation: map[string]interface{}{"allowOther": true}}, []interface{}{"mine", "yours"}, false},
+		{"multi select max items", store.ApplicationSchemaField{ID: "f", Type: "multi_select", Options: []string{"a", "b", "c"}, Validation: map[string]interface{}{"maxItems": float64(2)}}, []interface{}{"a", "b", "c"}, false},
+		{"ranked choice", store.ApplicationSchemaField{ID: "f", Type: "ranked_choice", Options: []string{"a", "b", "c"}}, []interface{}{"c", "a", "b"}, true},
+		{"ranked choice repeat", store.ApplicationSchemaField{ID: "f", Type: "ranked_choice", Options: []string{"a", "b", "c"}}, []interface{}{"a", "a"}, false},
+		{"ranked choice too few", store.ApplicationSchemaField{ID: "f", Type: "ranked_choice", Options: []string{"a", "b", "c"}, Validation: map[string]interface{}{"minItems": float64(3)}}, []interface{}{"a", "b"}, false},
+		{"file", store.ApplicationSchemaField{ID: "f", Type: "file"}, "application-files/u/f/abc.pdf", true},
+		{"file not a path", store.ApplicationSchemaField{ID: "f", Type: "file"}, float64(1), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateFieldValue(tc.field, tc.value)
+			if tc.valid {
+				assert.Empty(t, errs)
+			} else {
+				assert.NotEmpty(t, errs)
+			}
+		})
+	}
+}
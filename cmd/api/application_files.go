@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
+	"github.com/hackutd/portal/internal/store"
+)
+
+type ApplicationFileUploadURLResponse struct {
+	UploadURL string `json:"upload_url"`
+	// Path is the answer to store for the field once the upload finishes.
+	Path string `json:"path"`
+}
+
+// applicationFilesPrefix holds every upload answering a file field.
+const applicationFilesPrefix = "application-files/"
+
+// applicationFilePrefix is where a hacker's uploads for one file field live.
+func applicationFilePrefix(userID, fieldID string) string {
+	return applicationFilesPrefix + userID + "/" + fieldID + "/"
+}
+
+// findFileField returns the schema's file field with the given ID.
+func findFileField(schema []store.ApplicationSchemaField, fieldID string) (store.ApplicationSchemaField, bool) {
+	for _, f := range schema {
+		if f.ID == fieldID && f.Type == "file" {
+			return f, true
+		}
+	}
+	return store.ApplicationSchemaField{}, false
+}
+
+// checkUploadedFiles verifies the answers to file fields that changed in this
+// save: each must be the hacker's own upload for that field and within the
+// upload limits. Unchanged answers were checked when they were first saved.
+func (app *application) checkUploadedFiles(ctx context.Context, userID string, schema []store.ApplicationSchemaField, previous, responses map[string]interface{}) error {
+	for _, f := range schema {
+		if f.Type != "file" {
+			continue
+		}
+		path, ok := responses[f.ID].(string)
+		if !ok || path == "" || path == previous[f.ID] {
+			continue
+		}
+		if !strings.HasPrefix(path, applicationFilePrefix(userID, f.ID)) || !strings.HasSuffix(path, ".pdf") {
+			return fmt.Errorf("%w: invalid path for %s", gcs.ErrUploadRejected, f.ID)
+		}
+		if err := app.checkUploadedPDF(ctx, path, f.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateApplicationFileUploadURLHandler returns a signed upload URL for a file field
+//
+//	@Summary		Generate application file upload URL
+//	@Description	Generates a signed upload URL for one of the application schema's file fields. Files are PDFs under the same limits as resumes. Save the returned path as the field's answer once the upload finishes. Application must be in draft status.
+//	@Tags			hackers
+//	@Produce		json
+//	@Param			fieldID	path		string	true	"Schema field ID"
+//	@Success		200		{object}	ApplicationFileUploadURLResponse
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Failure		503		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/applications/me/files/{fieldID}/upload-url [post]
+func (app *application) generateApplicationFileUploadURLHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("missing user in context"))
+		return
+	}
+
+	application, err := app.store.Application.GetByUserID(r.Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("application not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if application.Status != store.StatusDraft {
+		app.conflictResponse(w, r, errors.New("cannot update submitted application"))
+		return
+	}
+
+	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	field, ok := findFileField(schema, chi.URLParam(r, "fieldID"))
+	if !ok {
+		app.notFoundResponse(w, r, errors.New("file field not found"))
+		return
+	}
+
+	if app.gcsClient == nil {
+		app.logger.Warnw("application file upload url requested but gcs is not configured", "user_id", user.ID)
+		writeJSONError(w, http.StatusServiceUnavailable, "file uploads are not configured")
+		return
+	}
+
+	randomID, err := randomHex(randomResumeObjectIDBytes)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	objectPath := applicationFilePrefix(user.ID, field.ID) + randomID + ".pdf"
+
+	uploadURL, err := app.gcsClient.GenerateUploadURL(r.Context(), objectPath)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ApplicationFileUploadURLResponse{
+		UploadURL: uploadURL,
+		Path:      objectPath,
+	}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getApplicationFileDownloadURLHandler returns a signed download URL for a file answer
+//
+//	@Summary		Get application file download URL (Admin)
+//	@Description	Generates a signed download URL for the file an application gave for one of the schema's file fields.
+//	@Tags			admin/applications
+//	@Produce		json
+//	@Param			applicationID	path		string	true	"Application ID"
+//	@Param			fieldID			path		string	true	"Schema field ID"
+//	@Success		200				{object}	ResumeDownloadURLResponse
+//	@Failure		401				{object}	object{error=string}
+//	@Failure		403				{object}	object{error=string}
+//	@Failure		404				{object}	object{error=string}
+//	@Failure		500				{object}	object{error=string}
+//	@Failure		503				{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/applications/{applicationID}/files/{fieldID}/url [get]
+func (app *application) getApplicationFileDownloadURLHandler(w http.ResponseWriter, r *http.Request) {
+	application, err := app.store.Application.GetByID(r.Context(), chi.URLParam(r, "applicationID"))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("application not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	fieldID := chi.URLParam(r, "fieldID")
+	var responses map[string]interface{}
+	if err := json.Unmarshal(application.Responses, &responses); err != nil {
+		app.notFoundResponse(w, r, errors.New("file not found"))
+		return
+	}
+	// The path prefix ties the answer to this applicant and field, so a path
+	// copied from another application is never signed.
+	path, ok := responses[fieldID].(string)
+	if !ok || !strings.HasPrefix(path, applicationFilePrefix(application.UserID, fieldID)) {
+		app.notFoundResponse(w, r, errors.New("file not found"))
+		return
+	}
+
+	if app.gcsClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "file downloads are not configured")
+		return
+	}
+
+	downloadURL, err := app.gcsClient.GenerateDownloadURL(r.Context(), path)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ResumeDownloadURLResponse{
+		DownloadURL: downloadURL,
+	}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/gcs"
+	"github.com/hackutd/portal/internal/store"
+)
+
+func newFileUploadRequest(t *testing.T, user *store.User, fieldID string) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, user)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("fieldID", fieldID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestGenerateApplicationFileUploadURL(t *testing.T) {
+	app := newTestApplication(t)
+	mockApps := app.store.Application.(*store.MockApplicationStore)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+	mockGCS := app.gcsClient.(*gcs.MockClient)
+	schema := []store.ApplicationSchemaField{
+		{ID: "portfolio", Type: "file", Label: "Portfolio"},
+		{ID: "github", Type: "url", Label: "GitHub"},
+	}
+
+	t.Run("should generate an upload url under the field's prefix", func(t *testing.T) {
+		user := newTestUser()
+		application := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+		prefix := "application-files/" + user.ID + "/portfolio/"
+
+		mockApps.On("GetByUserID", user.ID).Return(application, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockGCS.On("GenerateUploadURL", mock.Anything, mock.MatchedBy(func(path string) bool {
+			return strings.HasPrefix(path, prefix) && strings.HasSuffix(path, ".pdf")
+		})).Return("https://upload.example.com", nil).Once()
+
+		rr := executeRequest(newFileUploadRequest(t, user, "portfolio"), http.HandlerFunc(app.generateApplicationFileUploadURLHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data ApplicationFileUploadURLResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		assert.Equal(t, "https://upload.example.com", body.Data.UploadURL)
+		assert.True(t, strings.HasPrefix(body.Data.Path, prefix))
+
+		mockApps.AssertExpectations(t)
+		mockSettings.AssertExpectations(t)
+		mockGCS.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 for a field that is not a file field", func(t *testing.T) {
+		user := newTestUser()
+		application := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+
+		mockApps.On("GetByUserID", user.ID).Return(application, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+
+		rr := executeRequest(newFileUploadRequest(t, user, "github"), http.HandlerFunc(app.generateApplicationFileUploadURLHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestUpdateApplicationFileAnswer(t *testing.T) {
+	app := newTestApplication(t)
+	mockApps := app.store.Application.(*store.MockApplicationStore)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+	mockGCS := app.gcsClient.(*gcs.MockClient)
+	schema := []store.ApplicationSchemaField{
+		{ID: "portfolio", Type: "file", Label: "Portfolio"},
+	}
+
+	newPatch := func(t *testing.T, user *store.User, path string) *http.Request {
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"responses": {"portfolio": "`+path+`"}}`))
+		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(time.Time{}))
+		return setUserContext(req, user)
+	}
+
+	t.Run("should accept the applicant's own upload", func(t *testing.T) {
+		user := newTestUser()
+		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+		path := "application-files/" + user.ID + "/portfolio/abc.pdf"
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockGCS.On("StatObject", mock.Anything, path).
+			Return(&gcs.ObjectAttrs{Size: 1024, ContentType: "application/pdf"}, nil).Once()
+		mockApps.On("Update", mock.AnythingOfType("*store.Application")).Return(nil).Once()
+		app.store.Scans.(*store.MockScansStore).On("GetTotalPointsByUserID", user.ID).Return(0, nil).Once()
+
+		rr := executeRequest(newPatch(t, user, path), http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		mockGCS.AssertExpectations(t)
+	})
+
+	t.Run("should not re-check an unchanged answer", func(t *testing.T) {
+		user := newTestUser()
+		path := "application-files/" + user.ID + "/portfolio/def.pdf"
+		existing := &store.Application{
+			ID:        "app-1",
+			UserID:    user.ID,
+			Status:    store.StatusDraft,
+			Responses: json.RawMessage(`{"portfolio":"` + path + `"}`),
+		}
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockApps.On("Update", mock.AnythingOfType("*store.Application")).Return(nil).Once()
+		app.store.Scans.(*store.MockScansStore).On("GetTotalPointsByUserID", user.ID).Return(0, nil).Once()
+
+		rr := executeRequest(newPatch(t, user, path), http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		mockGCS.AssertNotCalled(t, "StatObject", mock.Anything, path)
+	})
+
+	t.Run("should reject another user's upload", func(t *testing.T) {
+		user := newTestUser()
+		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+
+		rr := executeRequest(newPatch(t, user, "application-files/someone-else/portfolio/abc.pdf"), http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+// responseFilterPrefix marks a query parameter as a filter on a schema answer:
+// f.<field>=<value> for select, country and multi_select fields, and
+// f.<field>.min / f.<field>.max for number fields.
+const responseFilterPrefix = "f."
+
+// ApplicationFilterField is a schema field the list can be filtered on.
+type ApplicationFilterField struct {
+	ID      string   `json:"id"`
+	Label   string   `json:"label"`
+	Type    string   `json:"type"`
+	Options []string `json:"options,omitempty"`
+}
+
+type ApplicationFilterFieldsResponse struct {
+	Fields []ApplicationFilterField `json:"fields"`
+	// TextSearch is false when the schema has no textarea answers to search.
+	TextSearch bool `json:"text_search"`
+}
+
+// listApplicationFilterFieldsHandler describes the schema answer filters
+//
+//	@Summary		List application filter fields (Admin)
+//	@Description	Returns the application schema fields the list and export endpoints can filter on with f.<field> parameters, in form order, and whether text_search is available.
+//	@Tags			admin/applications
+//	@Produce		json
+//	@Success		200	{object}	ApplicationFilterFieldsResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/applications/filters [get]
+func (app *application) listApplicationFilterFieldsHandler(w http.ResponseWriter, r *http.Request) {
+	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	response := ApplicationFilterFieldsResponse{Fields: []ApplicationFilterField{}}
+	for _, f := range exportSchemaColumns(schema) {
+		switch f.Type {
+		case "select", "country", "multi_select", "number":
+			response.Fields = append(response.Fields, ApplicationFilterField{
+				ID: f.ID, Label: f.Label, Type: f.Type, Options: f.Options,
+			})
+		case "textarea":
+			response.TextSearch = true
+		}
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// parseStructuredFilters reads the has_resume, ai_percent_min/max,
+// points_min/max, submitted_from/to and meal_group query parameters.
+func parseStructuredFilters(query url.Values, filters *store.ApplicationListFilters) error {
+	if hasResumeStr := query.Get("has_resume"); hasResumeStr != "" {
+		hasResume, err := strconv.ParseBool(hasResumeStr)
+		if err != nil {
+			return errors.New("has_resume must be true or false")
+		}
+		filters.HasResume = &hasResume
+	}
+
+	var err error
+	if filters.AIPercentMin, filters.AIPercentMax, err = parseIntRange(query, "ai_percent", 0, 100); err != nil {
+		return err
+	}
+	if filters.PointsMin, filters.PointsMax, err = parseIntRange(query, "points", 0, 1_000_000); err != nil {
+		return err
+	}
+
+	for _, bound := range []struct {
+		param string
+		dest  **time.Time
+	}{
+		{"submitted_from", &filters.SubmittedFrom},
+		{"submitted_to", &filters.SubmittedTo},
+	} {
+		if v := query.Get(bound.param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return fmt.Errorf("%s must be an RFC 3339 timestamp", bound.param)
+			}
+			*bound.dest = &t
+		}
+	}
+	if filters.SubmittedFrom != nil && filters.SubmittedTo != nil && filters.SubmittedFrom.After(*filters.SubmittedTo) {
+		return errors.New("submitted_from must not be after submitted_to")
+	}
+
+	if mealGroup := query.Get("meal_group"); mealGroup != "" {
+		filters.MealGroup = &mealGroup
+	}
+
+	return nil
+}
+
+// parseIntRange reads <name>_min and <name>_max, each within [lo, hi].
+func parseIntRange(query url.Values, name string, lo, hi int) (*int, *int, error) {
+	var bounds [2]*int
+	for i, suffix := range []string{"_min", "_max"} {
+		v := query.Get(name + suffix)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < lo || n > hi {
+			return nil, nil, fmt.Errorf("%s%s must be a whole number between %d and %d", name, suffix, lo, hi)
+		}
+		bounds[i] = &n
+	}
+	if bounds[0] != nil && bounds[1] != nil && *bounds[0] > *bounds[1] {
+		return nil, nil, fmt.Errorf("%s_min must not exceed %s_max", name, name)
+	}
+	return bounds[0], bounds[1], nil
+}
+
+// needsSchemaFilters reports whether the query filters on schema answers, so
+// the list handler only loads the schema when it has to.
+func needsSchemaFilters(query url.Values) bool {
+	if query.Get("text_search") != "" {
+		return true
+	}
+	for key := range query {
+		if strings.HasPrefix(key, responseFilterPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSchemaFilters reads the f.* answer filters and text_search, checking
+// each against the field it names. Full-text search covers every textarea
+// field in the schema.
+func parseSchemaFilters(query url.Values, schema []store.ApplicationSchemaField, filters *store.ApplicationListFilters) error {
+	fields := make(map[string]store.ApplicationSchemaField, len(schema))
+	for _, f := range schema {
+		fields[f.ID] = f
+	}
+
+	var ranges []*store.ResponseFilter
+	rangeFor := func(fieldID string) *store.ResponseFilter {
+		for _, r := range ranges {
+			if r.FieldID == fieldID {
+				return r
+			}
+		}
+		r := &store.ResponseFilter{FieldID: fieldID, Op: store.ResponseFilterRange}
+		ranges = append(ranges, r)
+		return r
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(query)) {
+		name, ok := strings.CutPrefix(key, responseFilterPrefix)
+		if !ok {
+			continue
+		}
+		value := query.Get(key)
+		if value == "" {
+			continue
+		}
+
+		if field, ok := fields[name]; ok {
+			var op store.ResponseFilterOp
+			switch field.Type {
+			case "select", "country":
+				op = store.ResponseFilterEquals
+			case "multi_select":
+				op = store.ResponseFilterContains
+			case "number":
+				return fmt.Errorf("%s: filter number fields with .min and .max", key)
+			default:
+				return fmt.Errorf("%s: %s fields can't be filtered by answer", key, field.Type)
+			}
+			filters.Responses = append(filters.Responses, store.ResponseFilter{FieldID: field.ID, Op: op, Value: value})
+			continue
+		}
+
+		fieldID, bound, ok := cutRangeSuffix(name)
+		field, known := fields[fieldID]
+		if !ok || !known {
+			return fmt.Errorf("%s: unknown application field", key)
+		}
+		if field.Type != "number" {
+			return fmt.Errorf("%s: only number fields have ranges", key)
+		}
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be a number", key)
+		}
+
+		r := rangeFor(fieldID)
+		if bound == "min" {
+			r.Min = &n
+		} else {
+			r.Max = &n
+		}
+	}
+	for _, r := range ranges {
+		if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+			return fmt.Errorf("%s%s.min must not exceed its .max", responseFilterPrefix, r.FieldID)
+		}
+		filters.Responses = append(filters.Responses, *r)
+	}
+
+	if text := strings.TrimSpace(query.Get("text_search")); text != "" {
+		if len(text) > 200 {
+			return errors.New("text_search must be at most 200 characters")
+		}
+		for _, f := range schema {
+			if f.Type == "textarea" {
+				filters.TextFields = append(filters.TextFields, f.ID)
+			}
+		}
+		if len(filters.TextFields) == 0 {
+			return errors.New("text_search needs a textarea field in the application schema")
+		}
+		filters.TextQuery = &text
+	}
+
+	return nil
+}
+
+func cutRangeSuffix(name string) (string, string, bool) {
+	for _, bound := range []string{"min", "max"} {
+		if fieldID, ok := strings.CutSuffix(name, "."+bound); ok {
+			return fieldID, bound, true
+		}
+	}
+	return "", "", false
+}
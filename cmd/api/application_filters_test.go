@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+func TestParseStructuredFilters(t *testing.T) {
+	t.Run("should parse every filter", func(t *testing.T) {
+		query, err := url.ParseQuery("has_resume=true&ai_percent_min=10&ai_percent_max=60&points_max=40" +
+			"&submitted_from=2026-09-01T00:00:00Z&submitted_to=2026-09-30T23:59:59Z&meal_group=B")
+		require.NoError(t, err)
+
+		var filters store.ApplicationListFilters
+		require.NoError(t, parseStructuredFilters(query, &filters))
+
+		require.NotNil(t, filters.HasResume)
+		assert.True(t, *filters.HasResume)
+		assert.Equal(t, 10, *filters.AIPercentMin)
+		assert.Equal(t, 60, *filters.AIPercentMax)
+		assert.Nil(t, filters.PointsMin)
+		assert.Equal(t, 40, *filters.PointsMax)
+		assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), *filters.SubmittedFrom)
+		assert.Equal(t, "B", *filters.MealGroup)
+	})
+
+	for _, tc := range []struct {
+		name  string
+		query string
+	}{
+		{"invalid has_resume", "has_resume=sometimes"},
+		{"ai percent above 100", "ai_percent_max=101"},
+		{"inverted points range", "points_min=50&points_max=10"},
+		{"date without a time", "submitted_from=2026-09-01"},
+		{"inverted submitted range", "submitted_from=2026-10-01T00:00:00Z&submitted_to=2026-09-01T00:00:00Z"},
+	} {
+		t.Run("should reject "+tc.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tc.query)
+			require.NoError(t, err)
+
+			var filters store.ApplicationListFilters
+			assert.Error(t, parseStructuredFilters(query, &filters))
+		})
+	}
+}
+
+func TestParseSchemaFilters(t *testing.T) {
+	schema := []store.ApplicationSchemaField{
+		{ID: "level_of_study", Type: "select"},
+		{ID: "dietary", Type: "multi_select"},
+		{ID: "age", Type: "number"},
+		{ID: "why", Type: "textarea"},
+		{ID: "first_name", Type: "text"},
+	}
+
+	t.Run("should resolve filters by field type", func(t *testing.T) {
+		query, err := url.ParseQuery("f.level_of_study=Graduate&f.dietary=Vegan&f.age.min=18&f.age.max=25&text_search=%22machine+learning%22")
+		require.NoError(t, err)
+
+		var filters store.ApplicationListFilters
+		require.NoError(t, parseSchemaFilters(query, schema, &filters))
+
+		lo, hi := 18.0, 25.0
+		assert.Equal(t, []store.ResponseFilter{
+			{FieldID: "dietary", Op: store.ResponseFilterContains, Value: "Vegan"},
+			{FieldID: "level_of_study", Op: store.ResponseFilterEquals, Value: "Graduate"},
+			{FieldID: "age", Op: store.ResponseFilterRange, Min: &lo, Max: &hi},
+		}, filters.Responses)
+		assert.Equal(t, `"machine learning"`, *filters.TextQuery)
+		assert.Equal(t, []string{"why"}, filters.TextFields)
+	})
+
+	for _, tc := range []struct {
+		name  string
+		query string
+	}{
+		{"an unknown field", "f.shoe_size=10"},
+		{"an exact number", "f.age=20"},
+		{"a text field", "f.first_name=Jane"},
+		{"a range on a select", "f.level_of_study.min=1"},
+		{"a non-numeric bound", "f.age.min=old"},
+		{"an inverted range", "f.age.min=30&f.age.max=20"},
+	} {
+		t.Run("should reject "+tc.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tc.query)
+			require.NoError(t, err)
+
+			var filters store.ApplicationListFilters
+			assert.Error(t, parseSchemaFilters(query, schema, &filters))
+		})
+	}
+
+	t.Run("should reject text search without a textarea", func(t *testing.T) {
+		var filters store.ApplicationListFilters
+		err := parseSchemaFilters(url.Values{"text_search": {"robots"}}, schema[:1], &filters)
+		assert.Error(t, err)
+	})
+}
+
+func TestListApplicationFilterFields(t *testing.T) {
+	app := newTestApplication(t)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+	mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{
+		{ID: "age", Type: "number", Label: "Age", DisplayOrder: 2},
+		{ID: "why", Type: "textarea", Label: "Why?", DisplayOrder: 3},
+		{ID: "first_name", Type: "text", Label: "First Name", DisplayOrder: 0},
+		{ID: "level_of_study", Type: "select", Label: "Level of Study", DisplayOrder: 1, Options: []string{"Undergraduate", "Graduate"}},
+	}, nil).Once()
+
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.listApplicationFilterFieldsHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+
+	var body struct {
+		Data ApplicationFilterFieldsResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Equal(t, ApplicationFilterFieldsResponse{
+		Fields: []ApplicationFilterField{
+			{ID: "level_of_study", Label: "Level of Study", Type: "select", Options: []string{"Undergraduate", "Graduate"}},
+			{ID: "age", Label: "Age", Type: "number"},
+		},
+		TextSearch: true,
+	}, body.Data)
+}
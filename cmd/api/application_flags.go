@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+const duplicateScanTimeout = 10 * time.Minute
+
+// disposableEmailDomains are throwaway inbox providers. Nobody applies to a
+// hackathon they mean to attend with one.
+var disposableEmailDomains = map[string]bool{
+	"10minutemail.com":  true,
+	"burnermail.io":     true,
+	"discard.email":     true,
+	"dispostable.com":   true,
+	"emailfake.com":     true,
+	"emailondeck.com":   true,
+	"fakeinbox.com":     true,
+	"getnada.com":       true,
+	"guerrillamail.com": true,
+	"guerrillamail.net": true,
+	"inboxkitten.com":   true,
+	"luxusmail.org":     true,
+	"mail.tm":           true,
+	"maildrop.cc":       true,
+	"mailinator.com":    true,
+	"mailnesia.com":     true,
+	"mintemail.com":     true,
+	"moakt.com":         true,
+	"mohmal.com":        true,
+	"mytemp.email":      true,
+	"sharklasers.com":   true,
+	"spamgourmet.com":   true,
+	"temp-mail.org":     true,
+	"tempinbox.com":     true,
+	"tempmail.com":      true,
+	"tempmail.net":      true,
+	"tempr.email":       true,
+	"throwawaymail.com": true,
+	"trashmail.com":     true,
+	"yopmail.com":       true,
+}
+
+type DuplicateScanResponse struct {
+	Scan *store.DuplicateScan `json:"scan"`
+}
+
+// normalizePhone reduces a phone number to its digits, dropping the US
+// country code, so "+1 (555) 123-4567" and "555.123.4567" compare equal.
+// Returns "" for anything too short to be a real number.
+func normalizePhone(phone string) string {
+	var b strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	digits := b.String()
+	if len(digits) == 11 && digits[0] == '1' {
+		digits = digits[1:]
+	}
+	if len(digits) < 7 {
+		return ""
+	}
+	return digits
+}
+
+// normalizeName lowercases a name and drops everything but letters and
+// single spaces between words.
+func normalizeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// emailDomain returns the lowercased domain of an email address.
+func emailDomain(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+// birthdateField picks the schema's birthdate: the first date field whose ID
+// or label mentions birth. Returns "" when the form doesn't ask for one.
+func birthdateField(schema []store.ApplicationSchemaField) string {
+	for _, f := range schema {
+		if f.Type != "date" {
+			continue
+		}
+		if strings.Contains(strings.ToLower(f.ID), "birth") || strings.Contains(strings.ToLower(f.Label), "birth") {
+			return f.ID
+		}
+	}
+	return ""
+}
+
+func responseString(responses map[string]any, key string) string {
+	if key == "" {
+		return ""
+	}
+	s, _ := responses[key].(string)
+	return strings.TrimSpace(s)
+}
+
+// detectApplicationFlags compares candidates pairwise on normalized phone,
+// name and birthdate, and resume hash, and checks each email against
+// disposable domains. A duplicate is flagged on both applications.
+func detectApplicationFlags(candidates []store.DuplicateCandidate, birthdateFieldID string) []store.ApplicationFlag {
+	type group struct {
+		reason store.ApplicationFlagReason
+		detail string
+		ids    []string
+	}
+	groups := map[string]*group{}
+	var order []string
+	add := func(reason store.ApplicationFlagReason, key, detail, id string) {
+		k := string(reason) + "\x00" + key
+		g, ok := groups[k]
+		if !ok {
+			g = &group{reason: reason, detail: detail}
+			groups[k] = g
+			order = append(order, k)
+		}
+		g.ids = append(g.ids, id)
+	}
+
+	var flags []store.ApplicationFlag
+	for _, c := range candidates {
+		var responses map[string]any
+		if len(c.Responses) > 0 {
+			_ = json.Unmarshal(c.Responses, &responses)
+		}
+
+		if phone := normalizePhone(responseString(responses, "phone")); phone != "" {
+			add(store.FlagReasonDuplicatePhone, phone, "phone "+phone, c.ApplicationID)
+		}
+
+		name := normalizeName(responseString(responses, "first_name") + " " + responseString(responses, "last_name"))
+		birthdate := responseString(responses, birthdateFieldID)
+		if t, err := time.Parse("2006-01-02", birthdate); err == nil {
+			birthdate = t.Format("2006-01-02")
+		}
+		if strings.Contains(name, " ") && birthdate != "" {
+			add(store.FlagReasonDuplicateNameBirthdate, name+"\x00"+birthdate,
+				fmt.Sprintf("%s, born %s", name, birthdate), c.ApplicationID)
+		}
+
+		if c.ResumeHash != nil {
+			add(store.FlagReasonDuplicateResume, *c.ResumeHash, "identical resume file", c.ApplicationID)
+		}
+
+		if domain := emailDomain(c.Email); disposableEmailDomains[domain] {
+			flags = append(flags, store.ApplicationFlag{
+				ApplicationID: c.ApplicationID,
+				Reason:        store.FlagReasonDisposableEmail,
+				Detail:        domain,
+			})
+		}
+	}
+
+	for _, k := range order {
+		g := groups[k]
+		for _, id := range g.ids {
+			for _, other := range g.ids {
+				if other == id {
+					continue
+				}
+				related := other
+				flags = append(flags, store.ApplicationFlag{
+					ApplicationID:        id,
+					Reason:               g.reason,
+					RelatedApplicationID: &related,
+					Detail:               g.detail,
+				})
+			}
+		}
+	}
+
+	return flags
+}
+
+// hashResumes fills in ResumeHash for candidates whose resume changed since
+// the last pass, caching each new hash. Returns how many resumes couldn't be
+// read; those are skipped rather than failing the pass.
+func (app *application) hashResumes(ctx context.Context, candidates []store.DuplicateCandidate) (int, error) {
+	if app.gcsClient == nil {
+		return 0, nil
+	}
+
+	unreadable := 0
+	for i := range candidates {
+		c := &candidates[i]
+		if c.ResumePath == nil || c.ResumeHash != nil {
+			continue
+		}
+
+		hash, err := app.hashObject(ctx, *c.ResumePath)
+		if err != nil {
+			if ctx.Err() != nil {
+				return unreadable, ctx.Err()
+			}
+			app.logger.Warnw("failed to hash resume", "application_id", c.ApplicationID, "error", err)
+			unreadable++
+			continue
+		}
+
+		if err := app.store.ApplicationFlags.SetResumeHash(ctx, c.ApplicationID, *c.ResumePath, hash); err != nil {
+			return unreadable, err
+		}
+		c.ResumeHash = &hash
+	}
+
+	return unreadable, nil
+}
+
+func (app *application) hashObject(ctx context.Context, objectPath string) (string, error) {
+	rc, err := app.gcsClient.ReadObject(ctx, objectPath)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, rc); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// runDuplicateScan runs the detection pass, replacing the active hackathon's
+// flags, and fills in scan's counts.
+func (app *application) runDuplicateScan(ctx context.Context, scan *store.DuplicateScan) error {
+	candidates, err := app.store.ApplicationFlags.ListDuplicateCandidates(ctx)
+	if err != nil {
+		return err
+	}
+
+	schema, err := app.store.Settings.GetApplicationSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	unreadable, err := app.hashResumes(ctx, candidates)
+	if err != nil {
+		return err
+	}
+
+	flags := detectApplicationFlags(candidates, birthdateField(schema))
+	if err := app.store.ApplicationFlags.Replace(ctx, flags); err != nil {
+		return err
+	}
+
+	flagged := map[string]bool{}
+	for _, f := range flags {
+		flagged[f.ApplicationID] = true
+	}
+
+	scan.Applications = len(candidates)
+	scan.FlaggedApplications = len(flagged)
+	scan.Flags = len(flags)
+	scan.ResumesUnreadable = unreadable
+	return nil
+}
+
+// finishDuplicateScan runs a duplicate scan and records the outcome on its
+// row. It runs detached from the request that started it, so it uses its own
+// context.
+func (app *application) finishDuplicateScan(scan store.DuplicateScan) {
+	ctx, cancel := context.WithTimeout(context.Background(), duplicateScanTimeout)
+	defer cancel()
+
+	if err := app.runDuplicateScan(ctx, &scan); err != nil {
+		app.logger.Errorw("duplicate scan failed", "duplicate_scan_id", scan.ID, "error", err)
+		// The scan context may be what expired; record the failure regardless.
+		if err := app.store.ApplicationFlags.FailScan(context.Background(), scan.ID, err.Error()); err != nil {
+			app.logger.Errorw("failed to mark duplicate scan failed", "duplicate_scan_id", scan.ID, "error", err)
+		}
+		return
+	}
+
+	if err := app.store.ApplicationFlags.CompleteScan(ctx, &scan); err != nil {
+		app.logger.Errorw("failed to mark duplicate scan ready", "duplicate_scan_id", scan.ID, "error", err)
+		return
+	}
+
+	app.logger.Infow("duplicate scan ready", "duplicate_scan_id", scan.ID,
+		"applications", scan.Applications, "flags", scan.Flags, "resumes_unreadable", scan.ResumesUnreadable)
+}
+
+// scanApplicationDuplicatesHandler starts flagging likely duplicate applications
+//
+//	@Summary		Scan for duplicate applications (Super Admin)
+//	@Description	Starts a background job that compares every submitted application in the active hackathon on normalized phone number, name and birthdate, and resume file hash, and checks applicant emails against disposable domains. Replaces the flags from the previous scan. Resumes that can't be downloaded are left out of the resume comparison. Poll the latest scan until it is ready.
+//	@Tags			superadmin/applications
+//	@Produce		json
+//	@Success		202	{object}	DuplicateScanResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		409	{object}	object{error=string}	"A scan is already running"
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/applications/duplicates/scan [post]
+func (app *application) scanApplicationDuplicatesHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	scan := &store.DuplicateScan{CreatedBy: &user.ID}
+	if err := app.store.ApplicationFlags.CreateScan(r.Context(), scan); err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, errors.New("a duplicate scan is already running"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionApplicationDuplicateScan, store.AuditTargetApplication, "", nil, scan)
+
+	go app.finishDuplicateScan(*scan)
+
+	if err := app.jsonResponse(w, http.StatusAccepted, DuplicateScanResponse{Scan: scan}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getLatestDuplicateScanHandler returns the most recent duplicate scan
+//
+//	@Summary		Get latest duplicate scan (Super Admin)
+//	@Description	Returns the active hackathon's most recent duplicate scan with its status and, once ready, its counts. The scan is null when none has run.
+//	@Tags			superadmin/applications
+//	@Produce		json
+//	@Success		200	{object}	DuplicateScanResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/applications/duplicates/scan [get]
+func (app *application) getLatestDuplicateScanHandler(w http.ResponseWriter, r *http.Request) {
+	scan, err := app.store.ApplicationFlags.LatestScan(r.Context())
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, DuplicateScanResponse{Scan: scan}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/gcs"
+	"github.com/hackutd/portal/internal/store"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+	}{
+		{"+1 (555) 123-4567", "5551234567"},
+		{"555.123.4567", "5551234567"},
+		{"+44 20 7946 0958", "442079460958"},
+		{"12345", ""},
+		{"", ""},
+	} {
+		assert.Equal(t, tc.want, normalizePhone(tc.in), tc.in)
+	}
+}
+
+func TestDetectApplicationFlags(t *testing.T) {
+	hash := "abc123"
+	candidate := func(id, email, responses string, resumeHash *string) store.DuplicateCandidate {
+		return store.DuplicateCandidate{ApplicationID: id, Email: email, Responses: json.RawMessage(responses), ResumeHash: resumeHash}
+	}
+
+	candidates := []store.DuplicateCandidate{
+		candidate("a1", "jane@utdallas.edu", `{"first_name":"Jane","last_name":"Doe","phone":"+1 (555) 123-4567","date_of_birth":"2004-02-29"}`, &hash),
+		candidate("a2", "jane.doe@gmail.com", `{"first_name":" jane ","last_name":"DOE","phone":"555-123-4567","date_of_birth":"2004-02-29"}`, nil),
+		candidate("a3", "burner@mailinator.com", `{"first_name":"Sam","last_name":"Lee","phone":"972 555 0000"}`, &hash),
+		candidate("a4", "alex@utdallas.edu", `{"first_name":"Jane","last_name":"Doe","date_of_birth":"2003-01-01"}`, nil),
+	}
+
+	flags := detectApplicationFlags(candidates, "date_of_birth")
+
+	type pair struct {
+		id, related string
+		reason      store.ApplicationFlagReason
+	}
+	var got []pair
+	for _, f := range flags {
+		related := ""
+		if f.RelatedApplicationID != nil {
+			related = *f.RelatedApplicationID
+		}
+		got = append(got, pair{f.ApplicationID, related, f.Reason})
+	}
+
+	assert.ElementsMatch(t, []pair{
+		{"a1", "a2", store.FlagReasonDuplicatePhone},
+		{"a2", "a1", store.FlagReasonDuplicatePhone},
+		{"a1", "a2", store.FlagReasonDuplicateNameBirthdate},
+		{"a2", "a1", store.FlagReasonDuplicateNameBirthdate},
+		{"a1", "a3", store.FlagReasonDuplicateResume},
+		{"a3", "a1", store.FlagReasonDuplicateResume},
+		{"a3", "", store.FlagReasonDisposableEmail},
+	}, got)
+}
+
+func TestBirthdateField(t *testing.T) {
+	assert.Equal(t, "dob", birthdateField([]store.ApplicationSchemaField{
+		{ID: "graduation", Type: "date", Label: "Graduation Date"},
+		{ID: "dob", Type: "date", Label: "Date of Birth"},
+	}))
+	assert.Equal(t, "", birthdateField([]store.ApplicationSchemaField{
+		{ID: "birth_city", Type: "text", Label: "City of birth"},
+	}))
+}
+
+func TestFinishDuplicateScan(t *testing.T) {
+	t.Run("should hash new resumes and replace flags", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockFlags := app.store.ApplicationFlags.(*store.MockApplicationFlagsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockGCS := app.gcsClient.(*gcs.MockClient)
+
+		p1, p2, p3 := "resumes/u1.pdf", "resumes/u2.pdf", "resumes/u3.pdf"
+		mockFlags.On("ListDuplicateCandidates").Return([]store.DuplicateCandidate{
+			{ApplicationID: "a1", Email: "one@example.com", Responses: json.RawMessage(`{}`), ResumePath: &p1},
+			{ApplicationID: "a2", Email: "two@example.com", Responses: json.RawMessage(`{}`), ResumePath: &p2},
+			{ApplicationID: "a3", Email: "three@example.com", Responses: json.RawMessage(`{}`), ResumePath: &p3},
+		}, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{}, nil).Once()
+		mockGCS.On("ReadObject", mock.Anything, p1).Return(io.NopCloser(strings.NewReader("same pdf")), nil).Once()
+		mockGCS.On("ReadObject", mock.Anything, p2).Return(io.NopCloser(strings.NewReader("same pdf")), nil).Once()
+		mockGCS.On("ReadObject", mock.Anything, p3).Return(nil, errors.New("object not found")).Once()
+		mockFlags.On("SetResumeHash", "a1", p1, mock.Anything).Return(nil).Once()
+		mockFlags.On("SetResumeHash", "a2", p2, mock.Anything).Return(nil).Once()
+		mockFlags.On("Replace", mock.MatchedBy(func(flags []store.ApplicationFlag) bool {
+			return len(flags) == 2 && flags[0].Reason == store.FlagReasonDuplicateResume
+		})).Return(nil).Once()
+		mockFlags.On("CompleteScan", mock.MatchedBy(func(scan *store.DuplicateScan) bool {
+			return scan.ID == "scan-1" && scan.Applications == 3 && scan.FlaggedApplications == 2 &&
+				scan.Flags == 2 && scan.ResumesUnreadable == 1
+		})).Return(nil).Once()
+
+		app.finishDuplicateScan(store.DuplicateScan{ID: "scan-1", Status: store.DuplicateScanRunning})
+
+		mockFlags.AssertExpectations(t)
+		mockGCS.AssertExpectations(t)
+	})
+
+	t.Run("should skip resumes without object storage", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.gcsClient = nil
+		mockFlags := app.store.ApplicationFlags.(*store.MockApplicationFlagsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		path := "resumes/u1.pdf"
+		mockFlags.On("ListDuplicateCandidates").Return([]store.DuplicateCandidate{
+			{ApplicationID: "a1", Email: "one@example.com", Responses: json.RawMessage(`{}`), ResumePath: &path},
+		}, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{}, nil).Once()
+		mockFlags.On("Replace", []store.ApplicationFlag(nil)).Return(nil).Once()
+		mockFlags.On("CompleteScan", mock.Anything).Return(nil).Once()
+
+		app.finishDuplicateScan(store.DuplicateScan{ID: "scan-1", Status: store.DuplicateScanRunning})
+
+		mockFlags.AssertExpectations(t)
+	})
+
+	t.Run("should record a failed pass", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockFlags := app.store.ApplicationFlags.(*store.MockApplicationFlagsStore)
+
+		mockFlags.On("ListDuplicateCandidates").Return(nil, errors.New("connection reset")).Once()
+		mockFlags.On("FailScan", "scan-1", "connection reset").Return(nil).Once()
+
+		app.finishDuplicateScan(store.DuplicateScan{ID: "scan-1", Status: store.DuplicateScanRunning})
+
+		mockFlags.AssertExpectations(t)
+		mockFlags.AssertNotCalled(t, "CompleteScan", mock.Anything)
+	})
+}
+
+func TestScanApplicationDuplicatesHandler(t *testing.T) {
+	app := newTestApplication(t)
+	mockFlags := app.store.ApplicationFlags.(*store.MockApplicationFlagsStore)
+
+	mockFlags.On("CreateScan", mock.MatchedBy(func(scan *store.DuplicateScan) bool {
+		return scan.CreatedBy != nil && *scan.CreatedBy == "superadmin-1"
+	})).Run(func(args mock.Arguments) {
+		scan := args.Get(0).(*store.DuplicateScan)
+		scan.ID = "scan-1"
+		scan.Status = store.DuplicateScanRunning
+	}).Return(nil).Once()
+
+	// The pass runs in the background; let it fail fast and wait for it.
+	finished := make(chan struct{})
+	mockFlags.On("ListDuplicateCandidates").Return(nil, errors.New("connection reset")).Once()
+	mockFlags.On("FailScan", "scan-1", mock.Anything).Return(nil).Once().
+		Run(func(mock.Arguments) { close(finished) })
+
+	req, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.scanApplicationDuplicatesHandler))
+	checkResponseCode(t, http.StatusAccepted, rr.Code)
+
+	var body struct {
+		Data DuplicateScanResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	require.NotNil(t, body.Data.Scan)
+	assert.Equal(t, "scan-1", body.Data.Scan.ID)
+	assert.Equal(t, store.DuplicateScanRunning, body.Data.Scan.Status)
+
+	<-finished
+	mockFlags.AssertExpectations(t)
+}
+
+func TestScanApplicationDuplicatesHandlerWhileRunning(t *testing.T) {
+	app := newTestApplication(t)
+	mockFlags := app.store.ApplicationFlags.(*store.MockApplicationFlagsStore)
+	mockFlags.On("CreateScan", mock.Anything).Return(store.ErrConflict).Once()
+
+	req, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.scanApplicationDuplicatesHandler))
+	checkResponseCode(t, http.StatusConflict, rr.Code)
+
+	mockFlags.AssertExpectations(t)
+	mockFlags.AssertNotCalled(t, "ListDuplicateCandidates")
+}
+
+func TestGetLatestDuplicateScanHandler(t *testing.T) {
+	app := newTestApplication(t)
+	app.store.ApplicationFlags.(*store.MockApplicationFlagsStore).
+		On("LatestScan").Return(nil, store.ErrNotFound).Once()
+
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.getLatestDuplicateScanHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"data":{"scan":null}}`, rr.Body.String())
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+type BulkSetStatusPayload struct {
+	Status store.ApplicationStatus `json:"status" validate:"required,oneof=accepted rejected waitlisted"`
+	// ApplicationIDs and Filters are exclusive ways to pick the applications.
+	// Filters takes the same parameters as the admin application list.
+	ApplicationIDs []string            `json:"application_ids" validate:"omitempty,max=5000,dive,uuid"`
+	Filters        map[string][]string `json:"filters"`
+	// Reason is recorded on each application's status timeline.
+	Reason string `json:"reason" validate:"max=1000"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// bulkSetApplicationStatusHandler sets the final status on many applications
+//
+//	@Summary		Bulk set application status (Super Admin)
+//	@Description	Sets the final status on the listed applications, or on every application in the active hackathon matching the filters, in one transaction. Drafts and withdrawn applications are skipped. Changed applications have their decision email marked unsent. With dry_run the counts and a sample of affected applications are returned without changing anything.
+//	@Tags			superadmin/applications
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		BulkSetStatusPayload	true	"New status and the applications to set it on"
+//	@Success		200		{object}	store.BulkStatusResult
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string}	"A hard admission quota is full"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/applications/status/bulk [post]
+func (app *application) bulkSetApplicationStatusHandler(w http.ResponseWriter, r *http.Request) {
+	var payload BulkSetStatusPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	payload.Reason = strings.TrimSpace(payload.Reason)
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if (payload.ApplicationIDs == nil) == (payload.Filters == nil) {
+		app.badRequestResponse(w, r, errors.New("exactly one of application_ids or filters is required"))
+		return
+	}
+
+	var target store.BulkStatusTarget
+	if payload.ApplicationIDs != nil {
+		if len(payload.ApplicationIDs) == 0 {
+			app.badRequestResponse(w, r, errors.New("application_ids must not be empty"))
+			return
+		}
+		target.IDs = payload.ApplicationIDs
+	} else {
+		query := url.Values(payload.Filters)
+		if query.Has("hackathon_id") {
+			app.badRequestResponse(w, r, errors.New("only applications in the active hackathon can be changed"))
+			return
+		}
+
+		filters, err := parseApplicationListFilters(query)
+		if err != nil {
+			app.badRequestResponse(w, r, err)
+			return
+		}
+		if needsSchemaFilters(query) {
+			schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+			if err != nil {
+				app.internalServerError(w, r, err)
+				return
+			}
+			if err := parseSchemaFilters(query, schema, &filters); err != nil {
+				app.badRequestResponse(w, r, err)
+				return
+			}
+		}
+		target.Filters = filters
+	}
+
+	user := getUserFromContext(r.Context())
+
+	result, err := app.store.Application.BulkSetStatus(r.Context(), target, payload.Status, user.ID, payload.Reason, payload.DryRun)
+	if err != nil {
+		var quotaErr *store.QuotaExceededError
+		if errors.As(err, &quotaErr) {
+			app.conflictResponse(w, r, err)
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if !payload.DryRun && result.Affected > 0 {
+		app.recordAudit(r, store.AuditActionApplicationBulkStatus, store.AuditTargetApplication, "", nil, map[string]any{
+			"bulk_id":  result.BulkID,
+			"status":   payload.Status,
+			"affected": result.Affected,
+		})
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, result); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testApplicationID = "3e8a1f2b-7c4d-4a5e-9b6f-0d1c2e3f4a5b"
+
+func TestBulkSetApplicationStatus(t *testing.T) {
+	newRequest := func(t *testing.T, body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		return setUserContext(req, newSuperAdminUser())
+	}
+
+	t.Run("should preview a filtered change without auditing", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		status := store.StatusSubmitted
+		flagged := false
+		target := store.BulkStatusTarget{Filters: store.ApplicationListFilters{Status: &status, Flagged: &flagged}}
+		mockApps.On("BulkSetStatus", target, store.StatusRejected, "superadmin-1", "", true).
+			Return(&store.BulkStatusResult{DryRun: true, Matched: 3, Affected: 3}, nil).Once()
+
+		body := `{"status":"rejected","dry_run":true,"filters":{"status":["submitted"],"flagged":["false"]}}`
+		rr := executeRequest(newRequest(t, body), http.HandlerFunc(app.bulkSetApplicationStatusHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		assert.Empty(t, recordedAuditEvents(app))
+	})
+
+	t.Run("should set status on listed applications and audit it", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		ids := []string{testApplicationID}
+		mockApps.On("BulkSetStatus", store.BulkStatusTarget{IDs: ids}, store.StatusAccepted, "superadmin-1", "Sponsor pass", false).
+			Return(&store.BulkStatusResult{BulkID: "bulk-1", Matched: 1, Affected: 1}, nil).Once()
+
+		body := `{"status":"accepted","reason":" Sponsor pass ","application_ids":["` + testApplicationID + `"]}`
+		rr := executeRequest(newRequest(t, body), http.HandlerFunc(app.bulkSetApplicationStatusHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Equal(t, store.AuditActionApplicationBulkStatus, events[0].Action)
+	})
+
+	for _, tc := range []struct {
+		name, body string
+	}{
+		{"neither ids nor filters", `{"status":"accepted"}`},
+		{"both ids and filters", `{"status":"accepted","application_ids":["` + testApplicationID + `"],"filters":{}}`},
+		{"empty ids", `{"status":"accepted","application_ids":[]}`},
+		{"an invalid id", `{"status":"accepted","application_ids":["app-1"]}`},
+		{"a status admins can't set", `{"status":"withdrawn","filters":{}}`},
+		{"an invalid filter", `{"status":"accepted","filters":{"status":["maybe"]}}`},
+		{"another hackathon", `{"status":"accepted","filters":{"hackathon_id":["` + testApplicationID + `"]}}`},
+	} {
+		t.Run("should return 400 for "+tc.name, func(t *testing.T) {
+			app := newTestApplication(t)
+
+			rr := executeRequest(newRequest(t, tc.body), http.HandlerFunc(app.bulkSetApplicationStatusHandler))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+// TimelineEntry is a status change as the hacker sees it. Who made the
+// change, how, and the reason they gave stay with the admins.
+type TimelineEntry struct {
+	Status    store.ApplicationStatus `json:"status"`
+	ChangedAt time.Time               `json:"changed_at"`
+}
+
+type ApplicationTimelineResponse struct {
+	Timeline []TimelineEntry `json:"timeline"`
+}
+
+// redactTimeline strips events down to what the hacker may see. Admins often
+// revise a decision before it settles, so each run of consecutive admin, bulk
+// or rules changes collapses to the status it ended on, and a run that ended
+// where it started is left out.
+func redactTimeline(events []store.ApplicationStatusEvent) []TimelineEntry {
+	timeline := []TimelineEntry{}
+	for i := 0; i < len(events); i++ {
+		first := events[i]
+		for isAdminDecision(first.Source) && i+1 < len(events) && isAdminDecision(events[i+1].Source) {
+			i++
+		}
+		last := events[i]
+		if isAdminDecision(first.Source) && first.FromStatus != nil && *first.FromStatus == last.ToStatus {
+			continue
+		}
+		timeline = append(timeline, TimelineEntry{Status: last.ToStatus, ChangedAt: last.ChangedAt})
+	}
+	return timeline
+}
+
+// isAdminDecision reports whether source is a status change the admins made
+// rather than the hacker or the event itself.
+func isAdminDecision(source store.StatusEventSource) bool {
+	switch source {
+	case store.StatusEventAdmin, store.StatusEventBulk, store.StatusEventRules:
+		return true
+	}
+	return false
+}
+
+// getMyApplicationTimelineHandler returns the authenticated hacker's status timeline
+//
+//	@Summary		Get my application timeline
+//	@Description	Returns the status changes of the authenticated hacker's application, oldest first, with only the status and when it changed. Consecutive admin decisions collapse to the one that stuck, and decisions that were reversed are left out. Works in any application status.
+//	@Tags			hackers
+//	@Produce		json
+//	@Success		200	{object}	ApplicationTimelineResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		404	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/applications/me/timeline [get]
+func (app *application) getMyApplicationTimelineHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	application, err := app.store.Application.GetByUserID(r.Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("application not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	events, err := app.store.Application.ListStatusEvents(r.Context(), application.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ApplicationTimelineResponse{Timeline: redactTimeline(events)}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMyApplicationTimeline(t *testing.T) {
+	app := newTestApplication(t)
+	mockApps := app.store.Application.(*store.MockApplicationStore)
+
+	newRequest := func(t *testing.T, user *store.User) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		return setUserContext(req, user)
+	}
+
+	t.Run("should leave out the actor and reason", func(t *testing.T) {
+		user := newTestUser()
+		submitted, actor, reason := store.StatusSubmitted, "superadmin-1", "Low effort answers"
+		submittedAt := time.Date(2026, 9, 1, 18, 30, 0, 0, time.UTC)
+		decidedAt := time.Date(2026, 9, 12, 15, 0, 0, 0, time.UTC)
+		events := []store.ApplicationStatusEvent{
+			{ID: "event-1", ApplicationID: "app-1", ToStatus: store.StatusSubmitted, Source: store.StatusEventSubmit, ActorID: &user.ID, ChangedAt: submittedAt},
+			{ID: "event-2", ApplicationID: "app-1", FromStatus: &submitted, ToStatus: store.StatusWaitlisted, Source: store.StatusEventAdmin, ActorID: &actor, Reason: &reason, ChangedAt: decidedAt},
+		}
+		mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID}, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return(events, nil).Once()
+
+		rr := executeRequest(newRequest(t, user), http.HandlerFunc(app.getMyApplicationTimelineHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		assert.JSONEq(t, `{"data":{"timeline":[
+			{"status":"submitted","changed_at":"2026-09-01T18:30:00Z"},
+			{"status":"waitlisted","changed_at":"2026-09-12T15:00:00Z"}
+		]}}`, rr.Body.String())
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should collapse revised decisions to the one that stuck", func(t *testing.T) {
+		user := newTestUser()
+		submitted, accepted, rejected := store.StatusSubmitted, store.StatusAccepted, store.StatusRejected
+		day := func(d int) time.Time { return time.Date(2026, 9, d, 12, 0, 0, 0, time.UTC) }
+		events := []store.ApplicationStatusEvent{
+			{ID: "event-1", ToStatus: store.StatusSubmitted, Source: store.StatusEventSubmit, ChangedAt: day(1)},
+			{ID: "event-2", FromStatus: &submitted, ToStatus: store.StatusAccepted, Source: store.StatusEventBulk, ChangedAt: day(2)},
+			{ID: "event-3", FromStatus: &accepted, ToStatus: store.StatusRejected, Source: store.StatusEventAdmin, ChangedAt: day(3)},
+			{ID: "event-4", FromStatus: &rejected, ToStatus: store.StatusWaitlisted, Source: store.StatusEventRules, ChangedAt: day(4)},
+		}
+		mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID}, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return(events, nil).Once()
+
+		rr := executeRequest(newRequest(t, user), http.HandlerFunc(app.getMyApplicationTimelineHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		assert.JSONEq(t, `{"data":{"timeline":[
+			{"status":"submitted","changed_at":"2026-09-01T12:00:00Z"},
+			{"status":"waitlisted","changed_at":"2026-09-04T12:00:00Z"}
+		]}}`, rr.Body.String())
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should leave out a reversed decision", func(t *testing.T) {
+		user := newTestUser()
+		submitted, accepted := store.StatusSubmitted, store.StatusAccepted
+		day := func(d int) time.Time { return time.Date(2026, 9, d, 12, 0, 0, 0, time.UTC) }
+		events := []store.ApplicationStatusEvent{
+			{ID: "event-1", ToStatus: store.StatusSubmitted, Source: store.StatusEventSubmit, ChangedAt: day(1)},
+			{ID: "event-2", FromStatus: &submitted, ToStatus: store.StatusAccepted, Source: store.StatusEventAdmin, ChangedAt: day(2)},
+			{ID: "event-3", FromStatus: &accepted, ToStatus: store.StatusSubmitted, Source: store.StatusEventAdmin, ChangedAt: day(3)},
+			{ID: "event-4", FromStatus: &submitted, ToStatus: store.StatusWithdrawn, Source: store.StatusEventWithdraw, ChangedAt: day(4)},
+		}
+		mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID}, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return(events, nil).Once()
+
+		rr := executeRequest(newRequest(t, user), http.HandlerFunc(app.getMyApplicationTimelineHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		assert.JSONEq(t, `{"data":{"timeline":[
+			{"status":"submitted","changed_at":"2026-09-01T12:00:00Z"},
+			{"status":"withdrawn","changed_at":"2026-09-04T12:00:00Z"}
+		]}}`, rr.Body.String())
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 without an application", func(t *testing.T) {
+		user := newTestUser()
+		mockApps.On("GetByUserID", user.ID).Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newRequest(t, user), http.HandlerFunc(app.getMyApplicationTimelineHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+// ApplicationsEnabledResponse tells a hacker whether they can work on their
+// application and how long until that changes. The countdowns are taken from
+// the server clock so a skewed device clock doesn't move the deadline.
+type ApplicationsEnabledResponse struct {
+	Enabled bool `json:"enabled"`
+	// InGrace is set while a draft started before the close is being
+	// finished after it.
+	InGrace           bool       `json:"in_grace"`
+	OpensAt           *time.Time `json:"opens_at"`
+	ClosesAt          *time.Time `json:"closes_at"`
+	Timezone          string     `json:"timezone"`
+	GraceMinutes      int        `json:"grace_minutes"`
+	SecondsUntilOpen  *int64     `json:"seconds_until_open,omitempty"`
+	SecondsUntilClose *int64     `json:"seconds_until_close,omitempty"`
+}
+
+type ApplicationWindowResponse struct {
+	store.ApplicationWindow
+}
+
+func secondsUntil(now, t time.Time) *int64 {
+	s := int64(t.Sub(now).Seconds())
+	return &s
+}
+
+// graceEnd returns when drafts started before the window closed lose access.
+func graceEnd(window store.ApplicationWindow) time.Time {
+	return window.ClosesAt.Add(time.Duration(window.GraceMinutes) * time.Minute)
+}
+
+// applicationAvailability works out whether a hacker can work on their
+// application at now. Applications are open while the manual toggle is on and
+// now falls inside the window. draftStarted is when the hacker's draft was
+// created, nil if they have none; it only matters during the grace period.
+func applicationAvailability(enabled bool, window store.ApplicationWindow, now time.Time, draftStarted *time.Time) ApplicationsEnabledResponse {
+	resp := ApplicationsEnabledResponse{
+		OpensAt:      window.OpensAt,
+		ClosesAt:     window.ClosesAt,
+		Timezone:     window.Timezone,
+		GraceMinutes: window.GraceMinutes,
+	}
+	if !enabled {
+		return resp
+	}
+
+	if window.OpensAt != nil && now.Before(*window.OpensAt) {
+		resp.SecondsUntilOpen = secondsUntil(now, *window.OpensAt)
+		return resp
+	}
+
+	if window.ClosesAt == nil {
+		resp.Enabled = true
+		return resp
+	}
+
+	if now.Before(*window.ClosesAt) {
+		resp.Enabled = true
+		resp.SecondsUntilClose = secondsUntil(now, *window.ClosesAt)
+		return resp
+	}
+
+	if end := graceEnd(window); draftStarted != nil && draftStarted.Before(*window.ClosesAt) && now.Before(end) {
+		resp.Enabled = true
+		resp.InGrace = true
+		resp.SecondsUntilClose = secondsUntil(now, end)
+	}
+	return resp
+}
+
+// applicationAvailabilityFor resolves applicationAvailability for user. The
+// user's draft is only looked up during a grace period, the one time it
+// matters.
+func (app *application) applicationAvailabilityFor(r *http.Request, user *store.User) (ApplicationsEnabledResponse, error) {
+	ctx := r.Context()
+
+	enabled, err := app.store.Settings.GetApplicationsEnabled(ctx)
+	if err != nil {
+		return ApplicationsEnabledResponse{}, err
+	}
+
+	window, err := app.store.Settings.GetApplicationWindow(ctx)
+	if err != nil {
+		return ApplicationsEnabledResponse{}, err
+	}
+
+	now := time.Now()
+
+	var draftStarted *time.Time
+	if enabled && window.ClosesAt != nil && !now.Before(*window.ClosesAt) && now.Before(graceEnd(window)) {
+		application, err := app.store.Application.GetByUserID(ctx, user.ID)
+		switch {
+		case err == nil:
+			if application.Status == store.StatusDraft {
+				draftStarted = &application.CreatedAt
+			}
+		case !errors.Is(err, store.ErrNotFound):
+			return ApplicationsEnabledResponse{}, err
+		}
+	}
+
+	return applicationAvailability(enabled, window, now, draftStarted), nil
+}
+
+// getApplicationWindow returns when applications open and close
+//
+//	@Summary		Get application window (Super Admin)
+//	@Description	Returns the scheduled open and close times for applications, the timezone they are shown in, and the grace period for drafts in progress
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	ApplicationWindowResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/application-window [get]
+func (app *application) getApplicationWindow(w http.ResponseWriter, r *http.Request) {
+	window, err := app.store.Settings.GetApplicationWindow(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ApplicationWindowResponse{ApplicationWindow: window}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// setApplicationWindow updates when applications open and close
+//
+//	@Summary		Set application window (Super Admin)
+//	@Description	Replaces the application window. While the applications toggle is on, applications open at opens_at and close at closes_at; a null bound leaves that side open. Hackers whose draft was started before closes_at may keep editing and submit for grace_minutes after it.
+//	@Tags			superadmin/settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			window	body		store.ApplicationWindow	true	"Application window"
+//	@Success		200		{object}	ApplicationWindowResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/application-window [post]
+func (app *application) setApplicationWindow(w http.ResponseWriter, r *http.Request) {
+	var req store.ApplicationWindow
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if req.OpensAt != nil && req.ClosesAt != nil && !req.OpensAt.Before(*req.ClosesAt) {
+		app.badRequestResponse(w, r, errors.New("opens_at must be before closes_at"))
+		return
+	}
+
+	// Timezone carries the zone for display; the instants are stored in UTC.
+	for _, t := range []*time.Time{req.OpensAt, req.ClosesAt} {
+		if t != nil {
+			*t = t.UTC()
+		}
+	}
+
+	if err := app.auditedSettingWrite(r, store.SettingsKeyApplicationWindow, func() error {
+		return app.store.Settings.SetApplicationWindow(r.Context(), req)
+	}); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ApplicationWindowResponse{ApplicationWindow: req}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+func TestApplicationAvailability(t *testing.T) {
+	opens := time.Date(2026, 9, 1, 14, 0, 0, 0, time.UTC)
+	closes := time.Date(2026, 10, 1, 5, 0, 0, 0, time.UTC)
+	window := store.ApplicationWindow{OpensAt: &opens, ClosesAt: &closes, Timezone: "America/Chicago", GraceMinutes: 30}
+
+	seconds := func(s int64) *int64 { return &s }
+
+	early := opens.Add(-time.Hour)
+	draftBeforeClose := closes.Add(-24 * time.Hour)
+	draftAfterClose := closes.Add(time.Minute)
+
+	for _, tc := range []struct {
+		name         string
+		enabled      bool
+		window       store.ApplicationWindow
+		now          time.Time
+		draftStarted *time.Time
+		open         bool
+		inGrace      bool
+		untilOpen    *int64
+		untilClose   *int64
+	}{
+		{"toggle off", false, window, opens.Add(time.Hour), nil, false, false, nil, nil},
+		{"before open", true, window, early, nil, false, false, seconds(3600), nil},
+		{"inside window", true, window, closes.Add(-time.Minute), nil, true, false, nil, seconds(60)},
+		{"no bounds", true, store.ApplicationWindow{Timezone: "UTC"}, early, nil, true, false, nil, nil},
+		{"closed without a draft", true, window, closes.Add(time.Minute), nil, false, false, nil, nil},
+		{"grace for an earlier draft", true, window, closes.Add(10 * time.Minute), &draftBeforeClose, true, true, nil, seconds(20 * 60)},
+		{"no grace for a later draft", true, window, closes.Add(10 * time.Minute), &draftAfterClose, false, false, nil, nil},
+		{"grace over", true, window, closes.Add(31 * time.Minute), &draftBeforeClose, false, false, nil, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := applicationAvailability(tc.enabled, tc.window, tc.now, tc.draftStarted)
+			assert.Equal(t, tc.open, resp.Enabled)
+			assert.Equal(t, tc.inGrace, resp.InGrace)
+			assert.Equal(t, tc.untilOpen, resp.SecondsUntilOpen)
+			assert.Equal(t, tc.untilClose, resp.SecondsUntilClose)
+		})
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/store"
 )
 
@@ -23,6 +25,14 @@ type ApplicationWithSchema struct {
 	ApplicationSchema []store.ApplicationSchemaField `json:"application_schema"`
 	// Points is the user's total scan points; populated on read endpoints only.
 	Points int `json:"points"`
+	// Team is the applicant's team; populated on the admin endpoint only.
+	Team *store.Team `json:"team,omitempty"`
+	// Flags are the duplicate detection pass's findings; populated on the
+	// admin endpoint only.
+	Flags []store.ApplicationFlag `json:"flags,omitempty"`
+	// Timeline is every status change with its actor and reason; populated
+	// on the admin endpoint only.
+	Timeline []store.ApplicationStatusEvent `json:"timeline,omitempty"`
 }
 
 // userPoints returns the user's total scan points. Points are cosmetic, so a
@@ -40,11 +50,12 @@ func (app *application) userPoints(r *http.Request, userID string) int {
 // getOrCreateApplicationHandler returns or creates the user's hackathon application
 //
 //	@Summary		Get or create application
-//	@Description	Returns the authenticated user's hackathon application. If no application exists, creates a new draft application.
+//	@Description	Returns the authenticated user's hackathon application. If no application exists, creates a new draft application. The ETag header identifies this copy; send it back in If-Match when updating.
 //	@Tags			hackers
 //	@Accept			json
 //	@Produce		json
 //	@Success		200	{object}	ApplicationWithSchema
+//	@Header			200	{string}	ETag	"Version of the application"
 //	@Failure		401	{object}	object{error=string}
 //	@Failure		500	{object}	object{error=string}
 //	@Security		CookieAuth
@@ -93,6 +104,7 @@ func (app *application) getOrCreateApplicationHandler(w http.ResponseWriter, r *
 		Points:            app.userPoints(r, user.ID),
 	}
 
+	w.Header().Set("ETag", etagFor(application.UpdatedAt))
 	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
 		app.internalServerError(w, r, err)
 	}
@@ -101,16 +113,19 @@ func (app *application) getOrCreateApplicationHandler(w http.ResponseWriter, r *
 // updateApplicationHandler partially updates the authenticated user's application
 //
 //	@Summary		Update application
-//	@Description	Partially updates the authenticated user's application. Only fields included in the request body are updated. Application must be in draft status.
+//	@Description	Partially updates the authenticated user's application. Only fields included in the request body are updated. Application must be in draft status. If-Match must carry the ETag of the copy being edited; if the application has changed since, nothing is written and the 409 response carries the current copy.
 //	@Tags			hackers
 //	@Accept			json
 //	@Produce		json
 //	@Param			application	body		UpdateApplicationPayload	true	"Fields to update"
+//	@Param			If-Match	header		string						true	"ETag from the last read or update"
 //	@Success		200			{object}	ApplicationWithSchema
+//	@Header			200			{string}	ETag	"Version of the updated application"
 //	@Failure		400			{object}	object{error=string}
 //	@Failure		401			{object}	object{error=string}
 //	@Failure		404			{object}	object{error=string}
-//	@Failure		409			{object}	object{error=string}	"Application not in draft status"
+//	@Failure		409			{object}	object{error=string,data=ApplicationWithSchema}	"Application not in draft status, or changed since it was loaded (data is the current copy)"
+//	@Failure		428			{object}	object{error=string}	"Missing If-Match"
 //	@Security		CookieAuth
 //	@Router			/applications/me [patch]
 func (app *application) updateApplicationHandler(w http.ResponseWriter, r *http.Request) {
@@ -135,6 +150,11 @@ func (app *application) updateApplicationHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	loadedAt, ok := app.requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var req UpdateApplicationPayload
 	if err := readJSON(w, r, &req); err != nil {
 		app.badRequestResponse(w, r, err)
@@ -147,6 +167,11 @@ func (app *application) updateApplicationHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if !application.UpdatedAt.Equal(loadedAt) {
+		app.staleApplicationResponse(w, r, application, schema)
+		return
+	}
+
 	// Only update if field is present in the request
 	if req.Responses != nil {
 		// Validate types against the schema before persisting. Required fields are
@@ -163,13 +188,53 @@ func (app *application) updateApplicationHandler(w http.ResponseWriter, r *http.
 			return
 		}
 
+		// File answers are only re-checked against storage when they change.
+		var previous map[string]interface{}
+		if application.Responses != nil {
+			_ = json.Unmarshal(application.Responses, &previous)
+		}
+
 		application.Responses = req.Responses
+		if pruneHiddenResponses(schema, responses) {
+			pruned, err := json.Marshal(responses)
+			if err != nil {
+				app.internalServerError(w, r, err)
+				return
+			}
+			application.Responses = pruned
+		}
+
+		if err := app.checkUploadedFiles(r.Context(), user.ID, schema, previous, responses); err != nil {
+			if errors.Is(err, gcs.ErrUploadRejected) {
+				app.badRequestResponse(w, r, err)
+				return
+			}
+			app.internalServerError(w, r, err)
+			return
+		}
 	}
 	if req.ResumePath != nil {
+		if application.ResumePath == nil || *application.ResumePath != *req.ResumePath {
+			if err := app.checkUploadedResume(r.Context(), user.ID, *req.ResumePath); err != nil {
+				if errors.Is(err, gcs.ErrUploadRejected) {
+					app.badRequestResponse(w, r, err)
+					return
+				}
+				app.internalServerError(w, r, err)
+				return
+			}
+		}
 		application.ResumePath = req.ResumePath
 	}
 
 	if err := app.store.Application.Update(r.Context(), application); err != nil {
+		if errors.Is(err, store.ErrStale) {
+			// Another write landed between the read above and this one.
+			if current, err := app.store.Application.GetByUserID(r.Context(), user.ID); err == nil {
+				app.staleApplicationResponse(w, r, current, schema)
+				return
+			}
+		}
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -180,11 +245,22 @@ func (app *application) updateApplicationHandler(w http.ResponseWriter, r *http.
 		Points:            app.userPoints(r, user.ID),
 	}
 
+	w.Header().Set("ETag", etagFor(application.UpdatedAt))
 	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
 		app.internalServerError(w, r, err)
 	}
 }
 
+// staleApplicationResponse answers an update made against an outdated copy
+// of the hacker's application with the current one.
+func (app *application) staleApplicationResponse(w http.ResponseWriter, r *http.Request, current *store.Application, schema []store.ApplicationSchemaField) {
+	app.staleWriteResponse(w, r, ApplicationWithSchema{
+		Application:       current,
+		ApplicationSchema: schema,
+		Points:            app.userPoints(r, current.UserID),
+	}, current.UpdatedAt)
+}
+
 // submitApplicationHandler submits the authenticated user's application for review
 //
 //	@Summary		Submit application
@@ -245,6 +321,25 @@ func (app *application) submitApplicationHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// The schema may have changed since the draft was saved, leaving answers
+	// to fields that are now hidden. They must not be submitted.
+	if pruneHiddenResponses(schema, responses) {
+		pruned, err := json.Marshal(responses)
+		if err != nil {
+			app.internalServerError(w, r, err)
+			return
+		}
+		application.Responses = pruned
+		if err := app.store.Application.Update(r.Context(), application); err != nil {
+			if errors.Is(err, store.ErrStale) {
+				app.conflictResponse(w, r, errors.New("application changed while submitting, reload and try again"))
+				return
+			}
+			app.internalServerError(w, r, err)
+			return
+		}
+	}
+
 	// Submit!
 	if err := app.store.Application.Submit(r.Context(), application); err != nil {
 		app.internalServerError(w, r, err)
@@ -259,15 +354,22 @@ func (app *application) submitApplicationHandler(w http.ResponseWriter, r *http.
 // validateResponses checks each response value against its schema field definition.
 // Returns a list of human-readable validation error strings. When enforceRequired
 // is false, missing/empty required fields are allowed (used for draft saves) while
-// type checks on present values still apply.
+// type checks on present values still apply. Fields hidden by their show_if rule
+// are skipped entirely; callers prune their answers with pruneHiddenResponses.
 func validateResponses(schema []store.ApplicationSchemaField, responses map[string]interface{}, enforceRequired bool) []string {
 	var errs []string
+	rules := newFieldRules(schema, responses)
 
 	for _, field := range schema {
+		if !rules.isVisible(field.ID) {
+			continue
+		}
+
 		val, exists := responses[field.ID]
+		required := rules.isRequired(field)
 
 		// Required check
-		if enforceRequired && field.Required && (!exists || isEmpty(val)) {
+		if enforceRequired && required && (!exists || isEmpty(val)) {
 			errs = append(errs, field.ID+" is required")
 			continue
 		}
@@ -277,72 +379,12 @@ func validateResponses(schema []store.ApplicationSchemaField, responses map[stri
 			continue
 		}
 
-		// Type-specific validation
-		switch field.Type {
-		case "text", "textarea", "phone":
-			s, ok := val.(string)
-			if !ok {
-				errs = append(errs, field.ID+" must be a string")
-				continue
-			}
-			if maxLen, ok := field.Validation["maxLength"]; ok {
-				if ml, ok := maxLen.(float64); ok && float64(len(s)) > ml {
-					errs = append(errs, fmt.Sprintf("%s exceeds max length of %d", field.ID, int(ml)))
-				}
-			}
-
-		case "number":
-			n, ok := val.(float64)
-			if !ok {
-				errs = append(errs, field.ID+" must be a number")
-				continue
-			}
-			if minVal, ok := field.Validation["min"]; ok {
-				if mv, ok := minVal.(float64); ok && n < mv {
-					errs = append(errs, fmt.Sprintf("%s must be at least %v", field.ID, mv))
-				}
-			}
-			if maxVal, ok := field.Validation["max"]; ok {
-				if mv, ok := maxVal.(float64); ok && n > mv {
-					errs = append(errs, fmt.Sprintf("%s must be at most %v", field.ID, mv))
-				}
-			}
-
-		case "select":
-			s, ok := val.(string)
-			if !ok {
-				errs = append(errs, field.ID+" must be a string")
-				continue
-			}
-			if len(field.Options) > 0 && !containsString(field.Options, s) {
-				errs = append(errs, field.ID+" has invalid option: "+s)
-			}
-
-		case "multi_select":
-			arr, ok := val.([]interface{})
-			if !ok {
-				errs = append(errs, field.ID+" must be an array")
-				continue
-			}
-			for _, item := range arr {
-				s, ok := item.(string)
-				if !ok {
-					errs = append(errs, field.ID+" array items must be strings")
-					break
-				}
-				if len(field.Options) > 0 && !containsString(field.Options, s) {
-					errs = append(errs, field.ID+" has invalid option: "+s)
-				}
-			}
-
-		case "checkbox":
-			b, ok := val.(bool)
-			if !ok {
-				errs = append(errs, field.ID+" must be a boolean")
-			} else if enforceRequired && field.Required && !b {
-				errs = append(errs, field.ID+" must be checked")
-			}
+		if field.Type == "checkbox" && enforceRequired && required && val == false {
+			errs = append(errs, field.ID+" must be checked")
+			continue
 		}
+
+		errs = append(errs, validateFieldValue(field, val)...)
 	}
 
 	return errs
@@ -373,6 +415,75 @@ func containsString(slice []string, val string) bool {
 	return false
 }
 
+// withdrawApplicationHandler withdraws the authenticated hacker's application
+//
+//	@Summary		Withdraw application
+//	@Description	Withdraws the authenticated hacker's draft, submitted, or accepted application with a reason. Pending reviews are cancelled, any walk-in queue entry is dropped, and an accepted seat is released to the waitlist. A withdrawal cannot be undone.
+//	@Tags			hackers
+//	@Accept			json
+//	@Produce		json
+//	@Param			withdrawal	body		WithdrawApplicationPayload	true	"Withdrawal reason"
+//	@Success		200			{object}	store.Application
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		409			{object}	object{error=string}	"Application already decided or withdrawn"
+//	@Security		CookieAuth
+//	@Router			/applications/me/withdraw [post]
+func (app *application) withdrawApplicationHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	var payload WithdrawApplicationPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	payload.Reason = strings.TrimSpace(payload.Reason)
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	existing, err := app.store.Application.GetByUserID(r.Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("application not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	switch existing.Status {
+	case store.StatusDraft, store.StatusSubmitted, store.StatusAccepted:
+	case store.StatusWithdrawn:
+		app.conflictResponse(w, r, errors.New("application already withdrawn"))
+		return
+	default:
+		app.conflictResponse(w, r, fmt.Errorf("a %s application cannot be withdrawn", existing.Status))
+		return
+	}
+
+	application, err := app.store.Application.Withdraw(r.Context(), user.ID, payload.Reason)
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, errors.New("application status changed, reload and try again"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, application); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
 // getApplicationStatsHandler returns aggregated statistics for all applications
 //
 //	@Summary		Get application stats (Admin)
@@ -400,14 +511,28 @@ func (app *application) getApplicationStatsHandler(w http.ResponseWriter, r *htt
 // listApplicationsHandler lists all applications with cursor-based pagination
 //
 //	@Summary		List applications (Admin)
-//	@Description	Lists all applications with cursor-based pagination and optional status filter
+//	@Description	Lists all applications with cursor-based pagination. Every filter combines with the others and with either pagination direction.
 //	@Tags			admin/applications
 //	@Produce		json
 //	@Param			cursor		query		string	false	"Pagination cursor"
-//	@Param			status		query		string	false	"Filter by status (draft, submitted, accepted, rejected, waitlisted)"
+//	@Param			status		query		string	false	"Filter by status (draft, submitted, accepted, rejected, waitlisted, withdrawn)"
 //	@Param			limit		query		int		false	"Page size (default 50, max 100)"
 //	@Param			direction	query		string	false	"Pagination direction: forward (default) or backward"
 //	@Param			sort_by		query		string	false	"Sort column: created_at (default), accept_votes, reject_votes, waitlist_votes"
+//	@Param			team_id		query		string	false	"Filter by team ID"
+//	@Param			flagged		query		bool	false	"Only applications the duplicate scan did (true) or did not (false) flag"
+//	@Param			hackathon_id	query		string	false	"Read an archived hackathon (default: the active one)"
+//	@Param			has_resume		query		bool	false	"Only applications with (true) or without (false) a resume"
+//	@Param			ai_percent_min	query		int		false	"Minimum AI percent (0-100)"
+//	@Param			ai_percent_max	query		int		false	"Maximum AI percent (0-100)"
+//	@Param			points_min		query		int		false	"Minimum scan points"
+//	@Param			points_max		query		int		false	"Maximum scan points"
+//	@Param			submitted_from	query		string	false	"Submitted at or after (RFC 3339)"
+//	@Param			submitted_to	query		string	false	"Submitted at or before (RFC 3339)"
+//	@Param			meal_group		query		string	false	"Filter by meal group"
+//	@Param			text_search		query		string	false	"Full-text search over textarea answers; supports quoted phrases, or, and -exclusions"
+//	@Param			f.{field}		query		string	false	"Answer filter: f.<field>=<value> for select, country and multi_select fields; f.<field>.min and f.<field>.max for number fields"
+//	@Param			view_id			query		string	false	"Saved view to apply; request parameters override the view's"
 //	@Success		200			{object}	store.ApplicationListResult
 //	@Failure		400			{object}	object{error=string}
 //	@Failure		401			{object}	object{error=string}
@@ -416,7 +541,10 @@ func (app *application) getApplicationStatsHandler(w http.ResponseWriter, r *htt
 //	@Security		CookieAuth
 //	@Router			/admin/applications [get]
 func (app *application) listApplicationsHandler(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query()
+	query, _, ok := app.applySavedView(w, r, store.SavedViewApplications)
+	if !ok {
+		return
+	}
 
 	// Parse cursor
 	var cursor *store.ApplicationCursor
@@ -429,31 +557,22 @@ func (app *application) listApplicationsHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 
-	// Parse status filter
-	var filters store.ApplicationListFilters
-	if statusStr := query.Get("status"); statusStr != "" {
-		status := store.ApplicationStatus(statusStr)
-		switch status {
-		case store.StatusDraft, store.StatusSubmitted, store.StatusAccepted,
-			store.StatusRejected, store.StatusWaitlisted:
-			filters.Status = &status
-		default:
-			app.badRequestResponse(w, r, errors.New("invalid status value"))
-			return
-		}
+	filters, err := parseApplicationListFilters(query)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
 	}
 
-	// Parse search
-	if searchStr := query.Get("search"); searchStr != "" {
-		if len(searchStr) < 2 {
-			app.badRequestResponse(w, r, errors.New("search must be at least 2 characters"))
+	if needsSchemaFilters(query) {
+		schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+		if err != nil {
+			app.internalServerError(w, r, err)
 			return
 		}
-		if len(searchStr) > 100 {
-			app.badRequestResponse(w, r, errors.New("search must be at most 100 characters"))
+		if err := parseSchemaFilters(query, schema, &filters); err != nil {
+			app.badRequestResponse(w, r, err)
 			return
 		}
-		filters.Search = &searchStr
 	}
 
 	// Parse limit
@@ -479,35 +598,99 @@ func (app *application) listApplicationsHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 
-	// Parse sort_by
+	result, err := app.store.Application.List(r.Context(), filters, cursor, direction, limit)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, result); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// parseApplicationListFilters reads the status, search, team_id, hackathon_id,
+// flagged and sort_by query parameters, plus the structured filters, shared by
+// the application list and export endpoints. Filters on schema answers are
+// parsed separately once the schema is loaded.
+func parseApplicationListFilters(query url.Values) (store.ApplicationListFilters, error) {
+	var filters store.ApplicationListFilters
+
+	if statusStr := query.Get("status"); statusStr != "" {
+		status := store.ApplicationStatus(statusStr)
+		switch status {
+		case store.StatusDraft, store.StatusSubmitted, store.StatusAccepted,
+			store.StatusRejected, store.StatusWaitlisted, store.StatusWithdrawn:
+			filters.Status = &status
+		default:
+			return filters, errors.New("invalid status value")
+		}
+	}
+
+	if searchStr := query.Get("search"); searchStr != "" {
+		if len(searchStr) < 2 {
+			return filters, errors.New("search must be at least 2 characters")
+		}
+		if len(searchStr) > 100 {
+			return filters, errors.New("search must be at most 100 characters")
+		}
+		filters.Search = &searchStr
+	}
+
+	if teamID := query.Get("team_id"); teamID != "" {
+		if err := Validate.Var(teamID, "uuid"); err != nil {
+			return filters, errors.New("team_id must be a valid UUID")
+		}
+		filters.TeamID = &teamID
+	}
+
+	hackathonID, err := hackathonIDParam(query)
+	if err != nil {
+		return filters, err
+	}
+	filters.HackathonID = hackathonID
+
+	if flaggedStr := query.Get("flagged"); flaggedStr != "" {
+		flagged, err := strconv.ParseBool(flaggedStr)
+		if err != nil {
+			return filters, errors.New("flagged must be true or false")
+		}
+		filters.Flagged = &flagged
+	}
+
 	if sortStr := query.Get("sort_by"); sortStr != "" {
 		switch store.ApplicationSortBy(sortStr) {
 		case store.SortByCreatedAt, store.SortByAcceptVotes,
 			store.SortByRejectVotes, store.SortByWaitlistVotes:
 			filters.SortBy = store.ApplicationSortBy(sortStr)
 		default:
-			app.badRequestResponse(w, r, errors.New("invalid sort_by value"))
-			return
+			return filters, errors.New("invalid sort_by value")
 		}
 	}
 
-	result, err := app.store.Application.List(r.Context(), filters, cursor, direction, limit)
-	if err != nil {
-		app.internalServerError(w, r, err)
-		return
+	if err := parseStructuredFilters(query, &filters); err != nil {
+		return filters, err
 	}
 
-	if err := app.jsonResponse(w, http.StatusOK, result); err != nil {
-		app.internalServerError(w, r, err)
-	}
+	return filters, nil
+}
+
+type WithdrawApplicationPayload struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
 }
 
 type SetStatusPayload struct {
 	Status store.ApplicationStatus `json:"status" validate:"required,oneof=accepted rejected waitlisted"`
+	// Reason is an optional note recorded on the application's status
+	// timeline.
+	Reason string `json:"reason" validate:"max=1000"`
 }
 
 type ApplicationResponse struct {
 	Application *store.Application `json:"application"`
+	// QuotaWarnings lists the soft admission quotas a status change took
+	// past their cap.
+	QuotaWarnings []store.QuotaBreach `json:"quota_warnings,omitempty"`
 }
 
 type ApplicantInfo struct {
@@ -535,6 +718,7 @@ type EmailListResponse struct {
 //	@Failure		401				{object}	object{error=string}
 //	@Failure		403				{object}	object{error=string}
 //	@Failure		404				{object}	object{error=string}
+//	@Failure		409				{object}	object{error=string}	"Applicant withdrew or a hard admission quota is full"
 //	@Failure		500				{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/superadmin/applications/{applicationID}/status [patch]
@@ -551,12 +735,13 @@ func (app *application) setApplicationStatus(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	payload.Reason = strings.TrimSpace(payload.Reason)
 	if err := Validate.Struct(payload); err != nil {
 		app.badRequestResponse(w, r, err)
 		return
 	}
 
-	application, err := app.store.Application.SetStatus(r.Context(), applicationID, payload.Status)
+	existing, err := app.store.Application.GetByID(r.Context(), applicationID)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			app.notFoundResponse(w, r, errors.New("application not found"))
@@ -566,7 +751,30 @@ func (app *application) setApplicationStatus(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if err := app.jsonResponse(w, http.StatusOK, ApplicationResponse{Application: application}); err != nil {
+	user := getUserFromContext(r.Context())
+
+	application, breaches, err := app.store.Application.SetStatus(r.Context(), applicationID, payload.Status, user.ID, payload.Reason)
+	if err != nil {
+		var quotaErr *store.QuotaExceededError
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			app.notFoundResponse(w, r, errors.New("application not found"))
+		case errors.Is(err, store.ErrConflict):
+			app.conflictResponse(w, r, errors.New("applicant has withdrawn this application"))
+		case errors.As(err, &quotaErr):
+			app.conflictResponse(w, r, err)
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionApplicationStatusUpdate, store.AuditTargetApplication, applicationID,
+		map[string]store.ApplicationStatus{"status": existing.Status},
+		map[string]store.ApplicationStatus{"status": application.Status},
+	)
+
+	if err := app.jsonResponse(w, http.StatusOK, ApplicationResponse{Application: application, QuotaWarnings: breaches}); err != nil {
 		app.internalServerError(w, r, err)
 	}
 }
@@ -574,7 +782,7 @@ func (app *application) setApplicationStatus(w http.ResponseWriter, r *http.Requ
 // getApplication returns a single application by ID with embedded schema
 //
 //	@Summary		Get application by ID (Admin)
-//	@Description	Returns a single application by its ID with embedded application schema
+//	@Description	Returns a single application by its ID with embedded application schema, the applicant's team, if any, and the timeline of its status changes
 //	@Tags			admin/applications
 //	@Produce		json
 //	@Param			applicationID	path		string	true	"Application ID"
@@ -602,8 +810,27 @@ func (app *application) getApplication(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Fetch schema to embed in response
-	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	// Embed the schema the responses were written against, which may be an
+	// older version than the live one.
+	schema, err := app.store.Settings.GetApplicationSchemaForApplication(r.Context(), application.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	team, err := app.store.Teams.GetByUserID(r.Context(), application.UserID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	flags, err := app.store.ApplicationFlags.ListByApplicationID(r.Context(), application.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	timeline, err := app.store.Application.ListStatusEvents(r.Context(), application.ID)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -613,6 +840,9 @@ func (app *application) getApplication(w http.ResponseWriter, r *http.Request) {
 		Application:       application,
 		ApplicationSchema: schema,
 		Points:            app.userPoints(r, application.UserID),
+		Team:              team,
+		Flags:             flags,
+		Timeline:          timeline,
 	}
 
 	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -137,6 +138,7 @@ func TestUpdateApplication(t *testing.T) {
 		body := `{"responses": {"first_name": "Jane", "last_name": "Doe"}}`
 		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
 		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(existing.UpdatedAt))
 		req.Header.Set("Content-Type", "application/json")
 		req = setUserContext(req, user)
 
@@ -170,6 +172,7 @@ func TestUpdateApplication(t *testing.T) {
 		body := `{"responses": {"age": "abc"}}`
 		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
 		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(existing.UpdatedAt))
 		req.Header.Set("Content-Type", "application/json")
 		req = setUserContext(req, user)
 
@@ -180,6 +183,38 @@ func TestUpdateApplication(t *testing.T) {
 		mockSettings.AssertExpectations(t)
 	})
 
+	t.Run("should drop answers to hidden fields", func(t *testing.T) {
+		user := newTestUser()
+		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+		schema := []store.ApplicationSchemaField{
+			{ID: "student", Type: "checkbox", Label: "Student"},
+			{ID: "school", Type: "text", Label: "School", ShowIf: &store.FieldCondition{Field: "student", Op: "eq", Value: true}},
+		}
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockApps.On("Update", mock.MatchedBy(func(a *store.Application) bool {
+			var responses map[string]interface{}
+			_ = json.Unmarshal(a.Responses, &responses)
+			_, hasSchool := responses["school"]
+			return responses["student"] == false && !hasSchool
+		})).Return(nil).Once()
+		app.store.Scans.(*store.MockScansStore).On("GetTotalPointsByUserID", user.ID).Return(0, nil).Once()
+
+		body := `{"responses": {"student": false, "school": "UTD"}}`
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(existing.UpdatedAt))
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		mockSettings.AssertExpectations(t)
+	})
+
 	t.Run("should return 409 when application is already submitted", func(t *testing.T) {
 		user := newTestUser()
 		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusSubmitted}
@@ -189,6 +224,7 @@ func TestUpdateApplication(t *testing.T) {
 		body := `{"responses": {"first_name": "Jane"}}`
 		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
 		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(existing.UpdatedAt))
 		req.Header.Set("Content-Type", "application/json")
 		req = setUserContext(req, user)
 
@@ -214,6 +250,114 @@ func TestUpdateApplication(t *testing.T) {
 
 		mockApps.AssertExpectations(t)
 	})
+
+	t.Run("should accept an uploaded resume within limits", func(t *testing.T) {
+		user := newTestUser()
+		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+		path := "resumes/" + user.ID + "/abc.pdf"
+		mockGCS := app.gcsClient.(*gcs.MockClient)
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{}, nil).Once()
+		mockGCS.On("StatObject", mock.Anything, path).
+			Return(&gcs.ObjectAttrs{Size: 1024, ContentType: "application/pdf"}, nil).Once()
+		mockApps.On("Update", mock.AnythingOfType("*store.Application")).Return(nil).Once()
+		app.store.Scans.(*store.MockScansStore).On("GetTotalPointsByUserID", user.ID).Return(0, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"resume_path": "`+path+`"}`))
+		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(existing.UpdatedAt))
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		mockGCS.AssertExpectations(t)
+	})
+
+	t.Run("should reject and delete an oversized resume", func(t *testing.T) {
+		user := newTestUser()
+		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+		path := "resumes/" + user.ID + "/big.pdf"
+		mockGCS := app.gcsClient.(*gcs.MockClient)
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{}, nil).Once()
+		mockGCS.On("StatObject", mock.Anything, path).
+			Return(&gcs.ObjectAttrs{Size: 50 << 20, ContentType: "application/pdf"}, nil).Once()
+		mockGCS.On("DeleteObject", mock.Anything, path).Return(nil).Once()
+
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"resume_path": "`+path+`"}`))
+		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(existing.UpdatedAt))
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		mockGCS.AssertExpectations(t)
+	})
+
+	t.Run("should reject another user's resume path", func(t *testing.T) {
+		user := newTestUser()
+		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"resume_path": "resumes/someone-else/abc.pdf"}`))
+		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(existing.UpdatedAt))
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 409 with the current copy when the draft changed", func(t *testing.T) {
+		user := newTestUser()
+		loadedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		existing := &store.Application{
+			ID:        "app-1",
+			UserID:    user.ID,
+			Status:    store.StatusDraft,
+			Responses: json.RawMessage(`{"first_name":"Janet"}`),
+			UpdatedAt: loadedAt.Add(time.Second),
+		}
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{}, nil).Once()
+		app.store.Scans.(*store.MockScansStore).On("GetTotalPointsByUserID", user.ID).Return(0, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"responses": {"first_name": "Jane"}}`))
+		require.NoError(t, err)
+		req.Header.Set("If-Match", etagFor(loadedAt))
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, etagFor(existing.UpdatedAt), rr.Header().Get("ETag"))
+
+		var envelope struct {
+			Data ApplicationWithSchema `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+		assert.JSONEq(t, `{"first_name":"Janet"}`, string(envelope.Data.Application.Responses))
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 428 without If-Match", func(t *testing.T) {
+		user := newTestUser()
+		existing := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft}
+
+		mockApps.On("GetByUserID", user.ID).Return(existing, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"responses": {"first_name": "Jane"}}`))
+		require.NoError(t, err)
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationHandler))
+		checkResponseCode(t, http.StatusPreconditionRequired, rr.Code)
+	})
 }
 
 func TestSubmitApplication(t *testing.T) {
@@ -367,6 +511,82 @@ func TestSubmitApplication(t *testing.T) {
 		mockSettings.AssertExpectations(t)
 	})
 
+	t.Run("should not require a hidden field", func(t *testing.T) {
+		user := newTestUser()
+		application := newCompleteApplication(user.ID)
+		application.Responses = json.RawMessage(`{"first_name":"Jane","last_name":"Doe"}`)
+		schema := []store.ApplicationSchemaField{
+			{ID: "first_name", Type: "text", Label: "First Name", Required: true},
+			{ID: "last_name", Type: "text", Label: "Last Name", Required: true},
+			{ID: "school", Type: "text", Label: "School", Required: true, ShowIf: &store.FieldCondition{Field: "student", Op: "eq", Value: true}},
+			{ID: "student", Type: "checkbox", Label: "Student"},
+		}
+
+		mockApps.On("GetByUserID", user.ID).Return(application, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockApps.On("Submit", application).Return(nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.submitApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		mockSettings.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 when a require_if rule holds", func(t *testing.T) {
+		user := newTestUser()
+		application := newCompleteApplication(user.ID)
+		application.Responses = json.RawMessage(`{"age":16}`)
+		schema := []store.ApplicationSchemaField{
+			{ID: "age", Type: "number", Label: "Age", Required: true},
+			{ID: "guardian_email", Type: "text", Label: "Guardian Email", RequireIf: &store.FieldCondition{Field: "age", Op: "lt", Value: float64(18)}},
+		}
+
+		mockApps.On("GetByUserID", user.ID).Return(application, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.submitApplicationHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "guardian_email")
+
+		mockApps.AssertExpectations(t)
+		mockSettings.AssertExpectations(t)
+	})
+
+	t.Run("should prune answers hidden since the draft was saved", func(t *testing.T) {
+		user := newTestUser()
+		application := newCompleteApplication(user.ID)
+		application.Responses = json.RawMessage(`{"age":21,"guardian_email":"parent@example.com"}`)
+		schema := []store.ApplicationSchemaField{
+			{ID: "age", Type: "number", Label: "Age", Required: true},
+			{ID: "guardian_email", Type: "text", Label: "Guardian Email", ShowIf: &store.FieldCondition{Field: "age", Op: "lt", Value: float64(18)}},
+		}
+
+		mockApps.On("GetByUserID", user.ID).Return(application, nil).Once()
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockApps.On("Update", application).Return(nil).Once()
+		mockApps.On("Submit", application).Return(nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, http.HandlerFunc(app.submitApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"age":21}`, string(application.Responses))
+
+		mockApps.AssertExpectations(t)
+		mockSettings.AssertExpectations(t)
+	})
+
 	t.Run("should return 409 when application already submitted", func(t *testing.T) {
 		user := newTestUser()
 		application := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusSubmitted}
@@ -399,6 +619,77 @@ func TestSubmitApplication(t *testing.T) {
 	})
 }
 
+func TestWithdrawApplication(t *testing.T) {
+	app := newTestApplication(t)
+	mockApps := app.store.Application.(*store.MockApplicationStore)
+
+	newRequest := func(t *testing.T, user *store.User, body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		return setUserContext(req, user)
+	}
+
+	for _, status := range []store.ApplicationStatus{store.StatusDraft, store.StatusSubmitted, store.StatusAccepted} {
+		t.Run("should withdraw a "+string(status)+" application", func(t *testing.T) {
+			user := newTestUser()
+			current := &store.Application{ID: "app-1", UserID: user.ID, Status: status}
+			withdrawn := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusWithdrawn, WithdrawnFrom: &status}
+
+			mockApps.On("GetByUserID", user.ID).Return(current, nil).Once()
+			mockApps.On("Withdraw", user.ID, "Found an internship").Return(withdrawn, nil).Once()
+
+			rr := executeRequest(newRequest(t, user, `{"reason":"  Found an internship "}`), http.HandlerFunc(app.withdrawApplicationHandler))
+			checkResponseCode(t, http.StatusOK, rr.Code)
+
+			var body struct {
+				Data store.Application `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+			assert.Equal(t, store.StatusWithdrawn, body.Data.Status)
+
+			mockApps.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should return 400 without a reason", func(t *testing.T) {
+		rr := executeRequest(newRequest(t, newTestUser(), `{"reason":"   "}`), http.HandlerFunc(app.withdrawApplicationHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 409 once decided", func(t *testing.T) {
+		user := newTestUser()
+		current := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusRejected}
+		mockApps.On("GetByUserID", user.ID).Return(current, nil).Once()
+
+		rr := executeRequest(newRequest(t, user, `{"reason":"changed my mind"}`), http.HandlerFunc(app.withdrawApplicationHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 when already withdrawn", func(t *testing.T) {
+		user := newTestUser()
+		current := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusWithdrawn}
+		mockApps.On("GetByUserID", user.ID).Return(current, nil).Once()
+
+		rr := executeRequest(newRequest(t, user, `{"reason":"changed my mind"}`), http.HandlerFunc(app.withdrawApplicationHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 when no application exists", func(t *testing.T) {
+		user := newTestUser()
+		mockApps.On("GetByUserID", user.ID).Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newRequest(t, user, `{"reason":"changed my mind"}`), http.HandlerFunc(app.withdrawApplicationHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+}
+
 func TestGetApplicationStats(t *testing.T) {
 	app := newTestApplication(t)
 	mockApps := app.store.Application.(*store.MockApplicationStore)
@@ -488,6 +779,109 @@ func TestListApplications(t *testing.T) {
 		checkResponseCode(t, http.StatusBadRequest, rr.Code)
 	})
 
+	t.Run("should filter by team", func(t *testing.T) {
+		teamID := "6b1f2f0e-3c1d-4f5a-9d7e-2a8b4c6d8e0f"
+		mockApps.On("List",
+			store.ApplicationListFilters{TeamID: &teamID},
+			(*store.ApplicationCursor)(nil),
+			store.DirectionForward,
+			50,
+		).Return(&store.ApplicationListResult{Applications: []store.ApplicationListItem{}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?team_id="+teamID, nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 for invalid team_id", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "/?team_id=team-1", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should filter by duplicate flags", func(t *testing.T) {
+		flagged := true
+		mockApps.On("List",
+			store.ApplicationListFilters{Flagged: &flagged},
+			(*store.ApplicationCursor)(nil),
+			store.DirectionForward,
+			50,
+		).Return(&store.ApplicationListResult{Applications: []store.ApplicationListItem{}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?flagged=true", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should filter on schema answers", func(t *testing.T) {
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{
+			{ID: "level_of_study", Type: "select"},
+			{ID: "age", Type: "number"},
+			{ID: "why", Type: "textarea"},
+		}, nil).Once()
+
+		minAge := 18.0
+		text := "robotics"
+		mockApps.On("List",
+			store.ApplicationListFilters{
+				Responses: []store.ResponseFilter{
+					{FieldID: "level_of_study", Op: store.ResponseFilterEquals, Value: "Undergraduate"},
+					{FieldID: "age", Op: store.ResponseFilterRange, Min: &minAge},
+				},
+				TextQuery:  &text,
+				TextFields: []string{"why"},
+			},
+			(*store.ApplicationCursor)(nil),
+			store.DirectionForward,
+			50,
+		).Return(&store.ApplicationListResult{Applications: []store.ApplicationListItem{}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?f.level_of_study=Undergraduate&f.age.min=18&text_search=robotics", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		mockSettings.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 for a filter on an unknown field", func(t *testing.T) {
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockSettings.On("GetApplicationSchema").Return([]store.ApplicationSchemaField{}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?f.shoe_size=10", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 400 for invalid flagged", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "/?flagged=maybe", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
 	t.Run("should return 400 for search too short", func(t *testing.T) {
 		req, err := http.NewRequest(http.MethodGet, "/?search=a", nil)
 		require.NoError(t, err)
@@ -623,8 +1017,11 @@ func TestGetApplication(t *testing.T) {
 
 		existing := newCompleteApplication("user-1")
 		mockApps.On("GetByID", "app-1").Return(existing, nil).Once()
-		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockSettings.On("GetApplicationSchemaForApplication", "app-1").Return(schema, nil).Once()
 		mockScans.On("GetTotalPointsByUserID", "user-1").Return(42, nil).Once()
+		app.store.Teams.(*store.MockTeamsStore).On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+		app.store.ApplicationFlags.(*store.MockApplicationFlagsStore).On("ListByApplicationID", "app-1").Return([]store.ApplicationFlag{}, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return([]store.ApplicationStatusEvent{}, nil).Once()
 
 		rr := executeRequest(newRequest(t, "app-1"), http.HandlerFunc(app.getApplication))
 		checkResponseCode(t, http.StatusOK, rr.Code)
@@ -651,9 +1048,12 @@ func TestGetApplication(t *testing.T) {
 
 		existing := newCompleteApplication("user-1")
 		mockApps.On("GetByID", "app-1").Return(existing, nil).Once()
-		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockSettings.On("GetApplicationSchemaForApplication", "app-1").Return(schema, nil).Once()
 		mockScans.On("GetTotalPointsByUserID", "user-1").
 			Return(0, errors.New("scans unavailable")).Once()
+		app.store.Teams.(*store.MockTeamsStore).On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+		app.store.ApplicationFlags.(*store.MockApplicationFlagsStore).On("ListByApplicationID", "app-1").Return([]store.ApplicationFlag{}, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return([]store.ApplicationStatusEvent{}, nil).Once()
 
 		rr := executeRequest(newRequest(t, "app-1"), http.HandlerFunc(app.getApplication))
 		checkResponseCode(t, http.StatusOK, rr.Code)
@@ -669,6 +1069,108 @@ func TestGetApplication(t *testing.T) {
 		mockScans.AssertExpectations(t)
 	})
 
+	t.Run("should include the applicant's team", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockScans := app.store.Scans.(*store.MockScansStore)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+		existing := newCompleteApplication("user-1")
+		team := &store.Team{ID: "team-1", Name: "Byte Me", Members: []store.TeamMember{{UserID: "user-1"}, {UserID: "user-2"}}}
+		mockApps.On("GetByID", "app-1").Return(existing, nil).Once()
+		mockSettings.On("GetApplicationSchemaForApplication", "app-1").Return(schema, nil).Once()
+		mockScans.On("GetTotalPointsByUserID", "user-1").Return(0, nil).Once()
+		mockTeams.On("GetByUserID", "user-1").Return(team, nil).Once()
+		app.store.ApplicationFlags.(*store.MockApplicationFlagsStore).On("ListByApplicationID", "app-1").Return([]store.ApplicationFlag{}, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return([]store.ApplicationStatusEvent{}, nil).Once()
+
+		rr := executeRequest(newRequest(t, "app-1"), http.HandlerFunc(app.getApplication))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var envelope struct {
+			Data struct {
+				Team *store.Team `json:"team"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+		require.NotNil(t, envelope.Data.Team)
+		assert.Equal(t, "Byte Me", envelope.Data.Team.Name)
+		assert.Len(t, envelope.Data.Team.Members, 2)
+
+		mockTeams.AssertExpectations(t)
+	})
+
+	t.Run("should include duplicate flags", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockScans := app.store.Scans.(*store.MockScansStore)
+		mockFlags := app.store.ApplicationFlags.(*store.MockApplicationFlagsStore)
+
+		related, email := "app-2", "alt@example.com"
+		flags := []store.ApplicationFlag{{
+			ApplicationID:        "app-1",
+			Reason:               store.FlagReasonDuplicatePhone,
+			RelatedApplicationID: &related,
+			RelatedEmail:         &email,
+			Detail:               "phone 5551234567",
+		}}
+		mockApps.On("GetByID", "app-1").Return(newCompleteApplication("user-1"), nil).Once()
+		mockSettings.On("GetApplicationSchemaForApplication", "app-1").Return(schema, nil).Once()
+		mockScans.On("GetTotalPointsByUserID", "user-1").Return(0, nil).Once()
+		app.store.Teams.(*store.MockTeamsStore).On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+		mockFlags.On("ListByApplicationID", "app-1").Return(flags, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return([]store.ApplicationStatusEvent{}, nil).Once()
+
+		rr := executeRequest(newRequest(t, "app-1"), http.HandlerFunc(app.getApplication))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var envelope struct {
+			Data struct {
+				Flags []store.ApplicationFlag `json:"flags"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+		require.Len(t, envelope.Data.Flags, 1)
+		assert.Equal(t, store.FlagReasonDuplicatePhone, envelope.Data.Flags[0].Reason)
+		assert.Equal(t, &email, envelope.Data.Flags[0].RelatedEmail)
+
+		mockFlags.AssertExpectations(t)
+	})
+
+	t.Run("should include the status timeline", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		submitted, actor, reason := store.StatusSubmitted, "superadmin-1", "Strong project history"
+		events := []store.ApplicationStatusEvent{
+			{ID: "event-1", ApplicationID: "app-1", ToStatus: store.StatusSubmitted, Source: store.StatusEventSubmit},
+			{ID: "event-2", ApplicationID: "app-1", FromStatus: &submitted, ToStatus: store.StatusAccepted, Source: store.StatusEventAdmin, ActorID: &actor, Reason: &reason},
+		}
+		mockApps.On("GetByID", "app-1").Return(newCompleteApplication("user-1"), nil).Once()
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationSchemaForApplication", "app-1").Return(schema, nil).Once()
+		app.store.Scans.(*store.MockScansStore).On("GetTotalPointsByUserID", "user-1").Return(0, nil).Once()
+		app.store.Teams.(*store.MockTeamsStore).On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+		app.store.ApplicationFlags.(*store.MockApplicationFlagsStore).On("ListByApplicationID", "app-1").Return([]store.ApplicationFlag{}, nil).Once()
+		mockApps.On("ListStatusEvents", "app-1").Return(events, nil).Once()
+
+		rr := executeRequest(newRequest(t, "app-1"), http.HandlerFunc(app.getApplication))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var envelope struct {
+			Data struct {
+				Timeline []store.ApplicationStatusEvent `json:"timeline"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+		require.Len(t, envelope.Data.Timeline, 2)
+		assert.Equal(t, &reason, envelope.Data.Timeline[1].Reason)
+		assert.Equal(t, &actor, envelope.Data.Timeline[1].ActorID)
+
+		mockApps.AssertExpectations(t)
+	})
+
 	t.Run("should return 404 when application not found", func(t *testing.T) {
 		app := newTestApplication(t)
 		mockApps := app.store.Application.(*store.MockApplicationStore)
@@ -687,10 +1189,12 @@ func TestSetApplicationStatus(t *testing.T) {
 	mockApps := app.store.Application.(*store.MockApplicationStore)
 
 	t.Run("should set status to accepted", func(t *testing.T) {
+		current := &store.Application{ID: "app-1", Status: store.StatusSubmitted}
 		returned := &store.Application{ID: "app-1", Status: store.StatusAccepted}
-		mockApps.On("SetStatus", "app-1", store.StatusAccepted).Return(returned, nil).Once()
+		mockApps.On("GetByID", "app-1").Return(current, nil).Once()
+		mockApps.On("SetStatus", "app-1", store.StatusAccepted, "superadmin-1", "Strong project history").Return(returned, nil, nil).Once()
 
-		body := `{"status":"accepted"}`
+		body := `{"status":"accepted","reason":"Strong project history"}`
 		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -720,8 +1224,53 @@ func TestSetApplicationStatus(t *testing.T) {
 		checkResponseCode(t, http.StatusBadRequest, rr.Code)
 	})
 
+	t.Run("should return 409 when the applicant withdrew", func(t *testing.T) {
+		// The withdrawal lands after the read, so only the locked check in
+		// the store sees it.
+		current := &store.Application{ID: "app-1", Status: store.StatusSubmitted}
+		mockApps.On("GetByID", "app-1").Return(current, nil).Once()
+		mockApps.On("SetStatus", "app-1", store.StatusAccepted, "superadmin-1", "").
+			Return(nil, nil, store.ErrConflict).Once()
+
+		body := `{"status":"accepted"}`
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("applicationID", "app-1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		rr := executeRequest(req, http.HandlerFunc(app.setApplicationStatus))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 when a hard quota is full", func(t *testing.T) {
+		current := &store.Application{ID: "app-1", Status: store.StatusWaitlisted}
+		mockApps.On("GetByID", "app-1").Return(current, nil).Once()
+		mockApps.On("SetStatus", "app-1", store.StatusAccepted, "superadmin-1", "").
+			Return(nil, nil, &store.QuotaExceededError{Breaches: []store.QuotaBreach{{QuotaID: "total", Label: "Total", Max: 500, Count: 501, Hard: true}}}).Once()
+
+		body := `{"status":"accepted"}`
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("applicationID", "app-1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		rr := executeRequest(req, http.HandlerFunc(app.setApplicationStatus))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Total")
+
+		mockApps.AssertExpectations(t)
+	})
+
 	t.Run("should return 404 when application not found", func(t *testing.T) {
-		mockApps.On("SetStatus", "nonexistent", store.StatusRejected).Return(nil, store.ErrNotFound).Once()
+		mockApps.On("GetByID", "nonexistent").Return(nil, store.ErrNotFound).Once()
 
 		body := `{"status":"rejected"}`
 		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
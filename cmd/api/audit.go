@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/hackutd/portal/internal/store"
+)
+
+// recordAudit appends an audit event attributed to the requesting user.
+// before and after are marshalled to JSON; pass nil when a side has no state.
+// It runs after the mutation has committed, so a failure is logged rather
+// than returned: the change happened and the response should say so.
+func (app *application) recordAudit(r *http.Request, action store.AuditAction, targetType, targetID string, before, after any) {
+	event := &store.AuditEvent{
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		RequestID:  middleware.GetReqID(r.Context()),
+	}
+	if actor := getUserFromContext(r.Context()); actor != nil {
+		event.ActorID = &actor.ID
+		event.ActorEmail = actor.Email
+	}
+
+	var err error
+	if event.Before, err = auditJSON(before); err == nil {
+		event.After, err = auditJSON(after)
+	}
+	if err == nil {
+		err = app.store.AuditLog.Record(r.Context(), event)
+	}
+	if err != nil {
+		app.logger.Errorw("failed to record audit event",
+			"action", action,
+			"target_type", targetType,
+			"target_id", targetID,
+			"error", err,
+		)
+	}
+}
+
+func auditJSON(v any) (json.RawMessage, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case json.RawMessage:
+		return v, nil
+	}
+	return json.Marshal(v)
+}
+
+// auditedSettingWrite runs write and records the setting's stored value
+// before and after it. Snapshots are read straight from the settings table so
+// every key is audited in the same shape regardless of its typed getter.
+func (app *application) auditedSettingWrite(r *http.Request, key string, write func() error) error {
+	before := app.settingSnapshot(r, key)
+	if err := write(); err != nil {
+		return err
+	}
+
+	app.recordAudit(r, store.AuditActionSettingUpdate, store.AuditTargetSetting, key, before, app.settingSnapshot(r, key))
+	return nil
+}
+
+// settingSnapshot reads key's raw value for the audit trail. A failed read
+// only costs the snapshot, never the write it accompanies.
+func (app *application) settingSnapshot(r *http.Request, key string) json.RawMessage {
+	value, err := app.store.Settings.GetRaw(r.Context(), key)
+	if err != nil {
+		app.logger.Warnw("failed to snapshot setting for audit", "key", key, "error", err)
+		return nil
+	}
+	return value
+}
+
+// listAuditEventsHandler lists audit events with filters and cursor pagination
+//
+//	@Summary		List audit events (Super Admin)
+//	@Description	Lists admin and super admin mutations newest first, with optional filters and bidirectional cursor pagination
+//	@Tags			superadmin/audit
+//	@Produce		json
+//	@Param			actor_id	query		string	false	"Filter by acting user ID"
+//	@Param			action		query		string	false	"Filter by action (e.g. user.role_update, setting.update)"
+//	@Param			target_type	query		string	false	"Filter by target type (user, application, setting, walk_in_queue, hackathon)"
+//	@Param			target_id	query		string	false	"Filter by target ID"
+//	@Param			since		query		string	false	"Only events at or after this RFC 3339 timestamp"
+//	@Param			until		query		string	false	"Only events before this RFC 3339 timestamp"
+//	@Param			cursor		query		string	false	"Pagination cursor"
+//	@Param			direction	query		string	false	"Pagination direction (forward or backward)"
+//	@Param			limit		query		int		false	"Page size (default 50, max 100)"
+//	@Success		200			{object}	store.AuditEventListResult
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/audit-events [get]
+func (app *application) listAuditEventsHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filters := store.AuditEventFilters{
+		ActorID:    query.Get("actor_id"),
+		Action:     store.AuditAction(query.Get("action")),
+		TargetType: query.Get("target_type"),
+		TargetID:   query.Get("target_id"),
+	}
+
+	if filters.ActorID != "" {
+		if err := Validate.Var(filters.ActorID, "uuid"); err != nil {
+			app.badRequestResponse(w, r, errors.New("actor_id must be a valid UUID"))
+			return
+		}
+	}
+
+	if sinceStr := query.Get("since"); sinceStr != "" {
+		since, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			app.badRequestResponse(w, r, errors.New("since must be an RFC 3339 timestamp"))
+			return
+		}
+		filters.Since = &since
+	}
+
+	if untilStr := query.Get("until"); untilStr != "" {
+		until, err := time.Parse(time.RFC3339, untilStr)
+		if err != nil {
+			app.badRequestResponse(w, r, errors.New("until must be an RFC 3339 timestamp"))
+			return
+		}
+		filters.Until = &until
+	}
+
+	var cursor *store.AuditCursor
+	if cursorStr := query.Get("cursor"); cursorStr != "" {
+		decoded, err := store.DecodeAuditCursor(cursorStr)
+		if err != nil {
+			app.badRequestResponse(w, r, errors.New("invalid cursor"))
+			return
+		}
+		cursor = decoded
+	}
+
+	direction := store.DirectionForward
+	if dirStr := query.Get("direction"); dirStr == "backward" {
+		direction = store.DirectionBackward
+	}
+
+	limit := 50
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit < 1 || parsedLimit > 100 {
+			app.badRequestResponse(w, r, errors.New("limit must be between 1 and 100"))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	result, err := app.store.AuditLog.List(r.Context(), filters, cursor, direction, limit)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, result); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedAuditEvents returns the events passed to AuditLog.Record so far.
+func recordedAuditEvents(app *application) []*store.AuditEvent {
+	var events []*store.AuditEvent
+	for _, call := range app.store.AuditLog.(*store.MockAuditLogStore).Calls {
+		if call.Method == "Record" {
+			events = append(events, call.Arguments.Get(0).(*store.AuditEvent))
+		}
+	}
+	return events
+}
+
+func TestListAuditEvents(t *testing.T) {
+	app := newTestApplication(t)
+	mockAudit := app.store.AuditLog.(*store.MockAuditLogStore)
+
+	t.Run("should return events with default limit", func(t *testing.T) {
+		actorID := "superadmin-1"
+		result := &store.AuditEventListResult{
+			Events: []store.AuditEvent{
+				{
+					ID:         "evt-1",
+					ActorID:    &actorID,
+					ActorEmail: "superadmin@test.com",
+					Action:     store.AuditActionUserRoleUpdate,
+					TargetType: store.AuditTargetUser,
+					TargetID:   "user-1",
+					Before:     json.RawMessage(`{"role":"hacker"}`),
+					After:      json.RawMessage(`{"role":"admin"}`),
+					CreatedAt:  time.Now(),
+				},
+			},
+		}
+		mockAudit.On("List", store.AuditEventFilters{}, (*store.AuditCursor)(nil), store.DirectionForward, 50).Return(result, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listAuditEventsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data store.AuditEventListResult `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.Len(t, body.Data.Events, 1)
+		assert.Equal(t, store.AuditActionUserRoleUpdate, body.Data.Events[0].Action)
+		assert.JSONEq(t, `{"role":"admin"}`, string(body.Data.Events[0].After))
+
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("should pass filters through", func(t *testing.T) {
+		since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		filters := store.AuditEventFilters{
+			ActorID:    "5f0c1c52-0f5e-4d8e-9a64-3f7d0c8a2b11",
+			Action:     store.AuditActionSettingUpdate,
+			TargetType: store.AuditTargetSetting,
+			TargetID:   "review_assignment_toggle",
+			Since:      &since,
+		}
+		mockAudit.On("List", filters, (*store.AuditCursor)(nil), store.DirectionBackward, 10).
+			Return(&store.AuditEventListResult{Events: []store.AuditEvent{}}, nil).Once()
+
+		url := "/?actor_id=5f0c1c52-0f5e-4d8e-9a64-3f7d0c8a2b11&action=setting.update&target_type=setting" +
+			"&target_id=review_assignment_toggle&since=2026-10-01T00:00:00Z&direction=backward&limit=10"
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listAuditEventsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockAudit.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name  string
+		query string
+	}{
+		{"invalid actor_id", "?actor_id=not-a-uuid"},
+		{"invalid since", "?since=yesterday"},
+		{"invalid until", "?until=2026-10-01"},
+		{"invalid cursor", "?cursor=!!!"},
+		{"limit too large", "?limit=101"},
+		{"limit not a number", "?limit=abc"},
+	} {
+		t.Run("should return 400 for "+tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/"+tc.query, nil)
+			require.NoError(t, err)
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.listAuditEventsHandler))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestAuditRecordedForMutations(t *testing.T) {
+	t.Run("role update records before and after role", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockUsers := app.store.Users.(*store.MockUsersStore)
+
+		mockUsers.On("GetByID", "user-1").Return(&store.User{ID: "user-1", Role: store.RoleHacker}, nil).Once()
+		mockUsers.On("UpdateRole", "user-1", store.RoleAdmin).Return(&store.User{ID: "user-1", Role: store.RoleAdmin}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"role":"admin"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("userID", "user-1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateUserRoleHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Equal(t, store.AuditActionUserRoleUpdate, events[0].Action)
+		assert.Equal(t, store.AuditTargetUser, events[0].TargetType)
+		assert.Equal(t, "user-1", events[0].TargetID)
+		require.NotNil(t, events[0].ActorID)
+		assert.Equal(t, "superadmin-1", *events[0].ActorID)
+		assert.JSONEq(t, `{"role":"hacker"}`, string(events[0].Before))
+		assert.JSONEq(t, `{"role":"admin"}`, string(events[0].After))
+	})
+
+	t.Run("setting write records raw values", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		// The catch-all GetRaw default matches first, so swap in a fresh mock.
+		mockSettings.ExpectedCalls = nil
+		mockSettings.On("GetHackathonID").Return(testHackathonID, nil).Maybe()
+		mockSettings.On("GetRaw", store.SettingsKeyReviewAssignmentToggle).Return(json.RawMessage(`false`), nil).Once()
+		mockSettings.On("GetRaw", store.SettingsKeyReviewAssignmentToggle).Return(json.RawMessage(`true`), nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		err = app.auditedSettingWrite(req, store.SettingsKeyReviewAssignmentToggle, func() error { return nil })
+		require.NoError(t, err)
+
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Equal(t, store.AuditActionSettingUpdate, events[0].Action)
+		assert.Equal(t, store.SettingsKeyReviewAssignmentToggle, events[0].TargetID)
+		assert.JSONEq(t, `false`, string(events[0].Before))
+		assert.JSONEq(t, `true`, string(events[0].After))
+		mockSettings.AssertExpectations(t)
+	})
+
+	t.Run("failed write records nothing", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		err = app.auditedSettingWrite(req, store.SettingsKeyReviewAssignmentToggle, func() error { return store.ErrNotFound })
+		assert.ErrorIs(t, err, store.ErrNotFound)
+		assert.Empty(t, recordedAuditEvents(app))
+		app.store.AuditLog.(*store.MockAuditLogStore).AssertNotCalled(t, "Record", mock.Anything)
+	})
+}
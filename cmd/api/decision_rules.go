@@ -0,0 +1,257 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+// decisionMetrics are the non-answer fields a decision rule can test. Vote
+// counts are always present; ai_percent is absent until a reviewer sets it.
+var decisionMetrics = map[string]bool{
+	"accept_votes":   true,
+	"reject_votes":   true,
+	"waitlist_votes": true,
+	"ai_percent":     true,
+}
+
+type DecisionRulesPayload struct {
+	Rules []store.DecisionRule `json:"rules" validate:"max=50,dive"`
+}
+
+type DecisionRulesResponse struct {
+	Rules []store.DecisionRule `json:"rules"`
+}
+
+// validateDecisionRules checks that rule names are unique and that every
+// condition tests a metric or an answer in the schema with a value its
+// operator can use.
+func validateDecisionRules(rules []store.DecisionRule, schema []store.ApplicationSchemaField) error {
+	fields := make(map[string]bool, len(schema))
+	for _, f := range schema {
+		fields[f.ID] = true
+	}
+
+	seen := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if seen[rule.Name] {
+			return fmt.Errorf("duplicate rule name %q", rule.Name)
+		}
+		seen[rule.Name] = true
+
+		for i := range rule.Conditions {
+			c := &rule.Conditions[i]
+			if err := checkDecisionCondition(c, fields); err != nil {
+				return fmt.Errorf("rule %q: %w", rule.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func checkDecisionCondition(c *store.FieldCondition, fields map[string]bool) error {
+	if decisionMetrics[c.Field] {
+		switch c.Op {
+		case "contains":
+			return fmt.Errorf("%s can't use contains", c.Field)
+		case "empty", "not_empty":
+			if c.Field != "ai_percent" {
+				return fmt.Errorf("%s is never empty", c.Field)
+			}
+		case "eq", "neq":
+			if _, ok := c.Value.(float64); !ok {
+				return fmt.Errorf("%s needs a numeric value", c.Field)
+			}
+		}
+	} else if id, ok := strings.CutPrefix(c.Field, responseFilterPrefix); !ok || !fields[id] {
+		return fmt.Errorf("unknown field %q", c.Field)
+	}
+	return checkConditionValue(c)
+}
+
+// decideByRules returns a decide func for the store that gives each
+// application the first rule whose conditions all hold.
+func decideByRules(rules []store.DecisionRule) func(*store.DecisionCandidate) *store.DecisionRule {
+	return func(c *store.DecisionCandidate) *store.DecisionRule {
+		values := make(map[string]interface{}, len(c.Responses)+len(decisionMetrics))
+		for id, v := range c.Responses {
+			values[responseFilterPrefix+id] = v
+		}
+		values["accept_votes"] = float64(c.AcceptVotes)
+		values["reject_votes"] = float64(c.RejectVotes)
+		values["waitlist_votes"] = float64(c.WaitlistVotes)
+		if c.AIPercent != nil {
+			values["ai_percent"] = float64(*c.AIPercent)
+		}
+
+		// No schema, so every value counts as visible.
+		fr := newFieldRules(nil, values)
+		for i := range rules {
+			holds := true
+			for j := range rules[i].Conditions {
+				if !fr.holds(&rules[i].Conditions[j]) {
+					holds = false
+					break
+				}
+			}
+			if holds {
+				return &rules[i]
+			}
+		}
+		return nil
+	}
+}
+
+// getDecisionRules returns the auto-decision rules
+//
+//	@Summary		Get decision rules (Super Admin)
+//	@Description	Returns the ordered rules that decide submitted applications on their review votes, AI percent and answers
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	DecisionRulesResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/decision-rules [get]
+func (app *application) getDecisionRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := app.store.Settings.GetDecisionRules(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, DecisionRulesResponse{Rules: rules}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// setDecisionRules replaces the auto-decision rules
+//
+//	@Summary		Set decision rules (Super Admin)
+//	@Description	Replaces the decision rules. Rules are tried in order and the first whose conditions all hold decides. A condition's field is accept_votes, reject_votes, waitlist_votes, ai_percent, or a schema answer as f.<field id>.
+//	@Tags			superadmin/settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			rules	body		DecisionRulesPayload	true	"Rules to set"
+//	@Success		200		{object}	DecisionRulesResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/decision-rules [put]
+func (app *application) setDecisionRules(w http.ResponseWriter, r *http.Request) {
+	var req DecisionRulesPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if req.Rules == nil {
+		req.Rules = []store.DecisionRule{}
+	}
+	for i := range req.Rules {
+		req.Rules[i].Name = strings.TrimSpace(req.Rules[i].Name)
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+	if err := validateDecisionRules(req.Rules, schema); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := app.auditedSettingWrite(r, store.SettingsKeyDecisionRules, func() error {
+		return app.store.Settings.SetDecisionRules(r.Context(), req.Rules)
+	}); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, DecisionRulesResponse(req)); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// previewDecisionsHandler shows what the decision rules would do
+//
+//	@Summary		Preview rule-based decisions (Super Admin)
+//	@Description	Runs the decision rules over every submitted application in the active hackathon without changing anything, returning the count per status and each decided application with the rule that matched
+//	@Tags			superadmin/applications
+//	@Produce		json
+//	@Success		200	{object}	store.DecisionRunResult
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/applications/decisions/preview [post]
+func (app *application) previewDecisionsHandler(w http.ResponseWriter, r *http.Request) {
+	app.runDecisions(w, r, true)
+}
+
+// applyDecisionsHandler sets the statuses the decision rules give
+//
+//	@Summary		Apply rule-based decisions (Super Admin)
+//	@Description	Runs the decision rules over every submitted application in the active hackathon and sets the resulting statuses in one transaction. Applications no rule matches stay submitted.
+//	@Tags			superadmin/applications
+//	@Produce		json
+//	@Success		200	{object}	store.DecisionRunResult
+//	@Failure		400	{object}	object{error=string}	"No rules configured"
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		409	{object}	object{error=string}	"A hard admission quota is full"
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/applications/decisions/apply [post]
+func (app *application) applyDecisionsHandler(w http.ResponseWriter, r *http.Request) {
+	app.runDecisions(w, r, false)
+}
+
+func (app *application) runDecisions(w http.ResponseWriter, r *http.Request, dryRun bool) {
+	rules, err := app.store.Settings.GetDecisionRules(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+	if len(rules) == 0 && !dryRun {
+		app.badRequestResponse(w, r, errors.New("no decision rules are configured"))
+		return
+	}
+
+	user := getUserFromContext(r.Context())
+
+	result, err := app.store.Application.Decide(r.Context(), decideByRules(rules), user.ID, dryRun)
+	if err != nil {
+		var quotaErr *store.QuotaExceededError
+		if errors.As(err, &quotaErr) {
+			app.conflictResponse(w, r, err)
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if !dryRun && len(result.Decisions) > 0 {
+		app.recordAudit(r, store.AuditActionApplicationAutoDecide, store.AuditTargetApplication, "", nil, map[string]any{
+			"bulk_id": result.BulkID,
+			"counts":  result.Counts,
+			"rules":   rules,
+		})
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, result); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecideByRules(t *testing.T) {
+	rules := []store.DecisionRule{
+		{Name: "strong yes", Status: store.StatusAccepted, Conditions: []store.FieldCondition{
+			{Field: "accept_votes", Op: "gte", Value: 2.0},
+			{Field: "reject_votes", Op: "eq", Value: 0.0},
+		}},
+		{Name: "split", Status: store.StatusWaitlisted, Conditions: []store.FieldCondition{
+			{Field: "accept_votes", Op: "gt", Value: 0.0},
+			{Field: "reject_votes", Op: "gt", Value: 0.0},
+		}},
+		{Name: "likely generated", Status: store.StatusRejected, Conditions: []store.FieldCondition{
+			{Field: "ai_percent", Op: "gte", Value: 90.0},
+			{Field: "f.level_of_study", Op: "neq", Value: "Graduate"},
+		}},
+	}
+	decide := decideByRules(rules)
+
+	aiPercent := func(p int16) *int16 { return &p }
+	for _, tc := range []struct {
+		name string
+		c    store.DecisionCandidate
+		want string
+	}{
+		{"unanimous accepts", store.DecisionCandidate{AcceptVotes: 3}, "strong yes"},
+		{"split votes", store.DecisionCandidate{AcceptVotes: 2, RejectVotes: 1}, "split"},
+		{"high AI percent", store.DecisionCandidate{AIPercent: aiPercent(95), Responses: map[string]interface{}{"level_of_study": "Undergraduate"}}, "likely generated"},
+		{"high AI percent but exempt answer", store.DecisionCandidate{AIPercent: aiPercent(95), Responses: map[string]interface{}{"level_of_study": "Graduate"}}, ""},
+		{"no AI percent yet", store.DecisionCandidate{}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := decide(&tc.c)
+			if tc.want == "" {
+				assert.Nil(t, rule)
+				return
+			}
+			require.NotNil(t, rule)
+			assert.Equal(t, tc.want, rule.Name)
+		})
+	}
+}
+
+func TestSetDecisionRules(t *testing.T) {
+	schema := []store.ApplicationSchemaField{{ID: "major", Type: "text"}}
+
+	t.Run("should save valid rules", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockSettings.On("SetDecisionRules", mock.MatchedBy(func(rules []store.DecisionRule) bool {
+			return len(rules) == 1 && rules[0].Name == "cs"
+		})).Return(nil).Once()
+
+		body := `{"rules":[{"name":" cs ","status":"accepted","conditions":[
+			{"field":"accept_votes","op":"gte","value":1},{"field":"f.major","op":"eq","value":"CS"}]}]}`
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setDecisionRules))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockSettings.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name, rules string
+	}{
+		{"a rule without conditions", `[{"name":"a","status":"accepted","conditions":[]}]`},
+		{"a status rules can't give", `[{"name":"a","status":"withdrawn","conditions":[{"field":"accept_votes","op":"gt","value":0}]}]`},
+		{"an unknown field", `[{"name":"a","status":"accepted","conditions":[{"field":"f.gpa","op":"gt","value":3}]}]`},
+		{"a non-numeric vote count", `[{"name":"a","status":"accepted","conditions":[{"field":"accept_votes","op":"eq","value":"two"}]}]`},
+		{"an empty test on votes", `[{"name":"a","status":"accepted","conditions":[{"field":"reject_votes","op":"empty"}]}]`},
+		{"duplicate names", `[{"name":"a","status":"accepted","conditions":[{"field":"accept_votes","op":"gt","value":0}]},
+			{"name":"a","status":"rejected","conditions":[{"field":"reject_votes","op":"gt","value":0}]}]`},
+	} {
+		t.Run("should return 400 for "+tc.name, func(t *testing.T) {
+			app := newTestApplication(t)
+			app.store.Settings.(*store.MockSettingsStore).On("GetApplicationSchema").Return(schema, nil).Maybe()
+
+			req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(`{"rules":`+tc.rules+`}`))
+			require.NoError(t, err)
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.setDecisionRules))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestApplyDecisions(t *testing.T) {
+	rules := []store.DecisionRule{{Name: "yes", Status: store.StatusAccepted, Conditions: []store.FieldCondition{
+		{Field: "accept_votes", Op: "gte", Value: 2.0},
+	}}}
+
+	t.Run("should preview without auditing", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.store.Settings.(*store.MockSettingsStore).On("GetDecisionRules").Return(rules, nil).Once()
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockApps.On("Decide", mock.Anything, "superadmin-1", true).
+			Return(&store.DecisionRunResult{DryRun: true, Decisions: []store.ApplicationDecision{{Status: store.StatusAccepted}}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.previewDecisionsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		assert.Empty(t, recordedAuditEvents(app))
+	})
+
+	t.Run("should apply and audit the decisions", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.store.Settings.(*store.MockSettingsStore).On("GetDecisionRules").Return(rules, nil).Once()
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockApps.On("Decide", mock.Anything, "superadmin-1", false).
+			Return(&store.DecisionRunResult{BulkID: "bulk-1", Decisions: []store.ApplicationDecision{{Status: store.StatusAccepted}}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.applyDecisionsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Equal(t, store.AuditActionApplicationAutoDecide, events[0].Action)
+	})
+
+	t.Run("should return 400 when no rules are configured", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.store.Settings.(*store.MockSettingsStore).On("GetDecisionRules").Return([]store.DecisionRule{}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.applyDecisionsHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/hackutd/portal/internal/store"
 )
@@ -77,3 +78,26 @@ func (app *application) authMethodMismatchResponse(w http.ResponseWriter, r *htt
 	}
 	writeJSONError(w, http.StatusConflict, msg)
 }
+
+func (app *application) preconditionRequiredResponse(w http.ResponseWriter, r *http.Request) {
+	app.logger.Warnw("missing If-Match", "method", r.Method, "path", r.URL.Path)
+
+	writeJSONError(w, http.StatusPreconditionRequired, "If-Match header is required")
+}
+
+// staleWriteResponse rejects a write made against an outdated copy. The
+// current copy goes back with its ETag so the client can merge or retry.
+func (app *application) staleWriteResponse(w http.ResponseWriter, r *http.Request, current any, updatedAt time.Time) {
+	app.logger.Warnw("stale write rejected", "method", r.Method, "path", r.URL.Path)
+
+	type envelope struct {
+		Error string `json:"error"`
+		Data  any    `json:"data"`
+	}
+
+	w.Header().Set("ETag", etagFor(updatedAt))
+	writeJSON(w, http.StatusConflict, &envelope{
+		Error: "this was changed elsewhere since you loaded it",
+		Data:  current,
+	})
+}
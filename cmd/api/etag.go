@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Edits that used to be last-write-wins are guarded by ETags. A row's ETag is
+// its updated_at, which every write bumps, so a client that sends back the
+// ETag it loaded in If-Match only overwrites the copy it saw.
+
+var (
+	errMissingIfMatch   = errors.New("missing If-Match header")
+	errMalformedIfMatch = errors.New("If-Match must be an ETag returned by the server")
+)
+
+// etagFor returns the ETag of a row last written at updatedAt. It is the
+// quoted updated_at, so clients can also build it from a listed item.
+func etagFor(updatedAt time.Time) string {
+	return `"` + updatedAt.UTC().Format(time.RFC3339Nano) + `"`
+}
+
+// ifMatchTime returns the updated_at named by the request's If-Match header.
+func ifMatchTime(r *http.Request) (time.Time, error) {
+	v := strings.TrimSpace(r.Header.Get("If-Match"))
+	if v == "" {
+		return time.Time{}, errMissingIfMatch
+	}
+	t, err := time.Parse(time.RFC3339Nano, strings.Trim(strings.TrimPrefix(v, "W/"), `"`))
+	if err != nil {
+		return time.Time{}, errMalformedIfMatch
+	}
+	return t, nil
+}
+
+// requireIfMatch reads If-Match, answering 428 when it is missing and 400
+// when it is malformed. ok is false once a response has been written.
+func (app *application) requireIfMatch(w http.ResponseWriter, r *http.Request) (time.Time, bool) {
+	t, err := ifMatchTime(r)
+	switch {
+	case errors.Is(err, errMissingIfMatch):
+		app.preconditionRequiredResponse(w, r)
+		return time.Time{}, false
+	case err != nil:
+		app.badRequestResponse(w, r, err)
+		return time.Time{}, false
+	}
+	return t, true
+}
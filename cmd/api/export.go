@@ -0,0 +1,300 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hackutd/portal/internal/spreadsheet"
+	"github.com/hackutd/portal/internal/store"
+)
+
+// exportTimeFormat is used for every timestamp column. Spreadsheets parse it
+// as a date while it still sorts correctly as text.
+const exportTimeFormat = "2006-01-02 15:04:05"
+
+// exportApplicationsHandler streams applications as a spreadsheet
+//
+//	@Summary		Export applications (Admin)
+//	@Description	Streams every application matching the filters as CSV or XLSX. Responses are expanded into one column per application schema field, in form order, with multi-select answers joined by "; ". Votes, confirmation, team, meal group, points and check-in status follow the schema columns.
+//	@Tags			admin/applications
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			format	query		string	false	"File format"	Enums(csv, xlsx)	default(csv)
+//	@Param			status	query		string	false	"Filter by status"	Enums(draft, submitted, accepted, rejected, waitlisted, withdrawn)
+//	@Param			search	query		string	false	"Search by email, first name, or last name (min 2 chars)"
+//	@Param			team_id	query		string	false	"Filter by team ID"
+//	@Param			flagged	query		bool	false	"Only applications the duplicate scan did (true) or did not (false) flag"
+//	@Param			hackathon_id	query		string	false	"Read an archived hackathon (default: the active one)"
+//	@Param			has_resume	query		bool	false	"Only applications with (true) or without (false) a resume"
+//	@Param			ai_percent_min	query		int		false	"Minimum AI percent (0-100)"
+//	@Param			ai_percent_max	query		int		false	"Maximum AI percent (0-100)"
+//	@Param			points_min	query		int		false	"Minimum scan points"
+//	@Param			points_max	query		int		false	"Maximum scan points"
+//	@Param			submitted_from	query		string	false	"Submitted at or after (RFC 3339)"
+//	@Param			submitted_to	query		string	false	"Submitted at or before (RFC 3339)"
+//	@Param			meal_group	query		string	false	"Filter by meal group"
+//	@Param			text_search	query		string	false	"Full-text search over textarea answers"
+//	@Param			f.{field}	query		string	false	"Answer filter, as on the list endpoint"
+//	@Param			sort_by	query		string	false	"Sort column"	Enums(created_at, accept_votes, reject_votes, waitlist_votes)
+//	@Param			view_id	query		string	false	"Saved view to apply; its visible columns limit the exported columns"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/applications/export [get]
+func (app *application) exportApplicationsHandler(w http.ResponseWriter, r *http.Request) {
+	query, view, ok := app.applySavedView(w, r, store.SavedViewApplications)
+	if !ok {
+		return
+	}
+
+	format := spreadsheet.FormatCSV
+	if f := query.Get("format"); f != "" {
+		format = spreadsheet.Format(f)
+		if format != spreadsheet.FormatCSV && format != spreadsheet.FormatXLSX {
+			app.badRequestResponse(w, r, errors.New("format must be 'csv' or 'xlsx'"))
+			return
+		}
+	}
+
+	filters, err := parseApplicationListFilters(query)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := parseSchemaFilters(query, schema, &filters); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	scanTypes, err := app.store.Settings.GetScanTypes(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	var checkInTypes []string
+	for _, st := range scanTypes {
+		if st.Category == store.ScanCategoryCheckIn {
+			checkInTypes = append(checkInTypes, st.Name)
+		}
+	}
+
+	fields := exportSchemaColumns(schema)
+	var keep []int
+	if view != nil {
+		keep = exportColumnSelection(exportColumnKeys(fields), view.Columns)
+	}
+
+	// The server's WriteTimeout is sized for JSON responses; give a large
+	// export as long as the query itself may run.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(store.ExportTimeoutDuration))
+
+	filename := fmt.Sprintf("applications-%s.%s", time.Now().UTC().Format("2006-01-02"), format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+
+	sheet, err := spreadsheet.NewWriter(w, format, "Applications")
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := sheet.WriteRow(pickColumns(exportHeaderRow(fields), keep)); err != nil {
+		app.logger.Errorw("application export aborted", "error", err)
+		return
+	}
+
+	// From here the status line has been sent, so failures can only be logged;
+	// the client sees a truncated file.
+	err = app.store.Application.Export(r.Context(), filters, checkInTypes, func(row *store.ApplicationExportRow) error {
+		return sheet.WriteRow(pickColumns(exportApplicationRow(fields, row), keep))
+	})
+	if err != nil {
+		app.logger.Errorw("application export aborted", "error", err)
+		return
+	}
+
+	if err := sheet.Close(); err != nil {
+		app.logger.Errorw("application export aborted", "error", err)
+	}
+}
+
+// exportSchemaColumns returns the schema fields in the order the form shows
+// them.
+func exportSchemaColumns(schema []store.ApplicationSchemaField) []store.ApplicationSchemaField {
+	fields := slices.Clone(schema)
+	slices.SortStableFunc(fields, func(a, b store.ApplicationSchemaField) int {
+		if a.SectionOrder != b.SectionOrder {
+			return a.SectionOrder - b.SectionOrder
+		}
+		return a.DisplayOrder - b.DisplayOrder
+	})
+	return fields
+}
+
+// exportColumnKeys names each export column the way saved views list visible
+// columns: application list fields, and schema field IDs for responses.
+func exportColumnKeys(fields []store.ApplicationSchemaField) []string {
+	keys := []string{"id", "email", "status", "submitted_at", "created_at"}
+	for _, f := range fields {
+		keys = append(keys, f.ID)
+	}
+	return append(keys,
+		"accept_votes", "reject_votes", "waitlist_votes", "confirmation",
+		"team_name", "meal_group", "points", "checked_in", "checked_in_at",
+	)
+}
+
+// exportColumnSelection returns the indexes of the columns a view shows, or
+// nil to keep them all. The ID column is always kept, the table's name column
+// stands for the first and last name answers, and visible columns the export
+// doesn't have are ignored; a view that matches nothing exports everything.
+func exportColumnSelection(keys, visible []string) []int {
+	if len(visible) == 0 {
+		return nil
+	}
+
+	show := map[string]bool{}
+	for _, c := range visible {
+		show[c] = true
+		if c == "name" {
+			show["first_name"], show["last_name"] = true, true
+		}
+	}
+
+	keep := []int{0}
+	for i, key := range keys[1:] {
+		if show[key] {
+			keep = append(keep, i+1)
+		}
+	}
+	if len(keep) == 1 {
+		return nil
+	}
+	return keep
+}
+
+// pickColumns returns the cells at keep, or all of them when keep is nil.
+func pickColumns(cells []string, keep []int) []string {
+	if keep == nil {
+		return cells
+	}
+	picked := make([]string, len(keep))
+	for i, idx := range keep {
+		picked[i] = cells[idx]
+	}
+	return picked
+}
+
+func exportHeaderRow(fields []store.ApplicationSchemaField) []string {
+	header := []string{"Application ID", "Email", "Status", "Submitted At", "Created At"}
+	for _, f := range fields {
+		header = append(header, f.Label)
+	}
+	return append(header,
+		"Accept Votes", "Reject Votes", "Waitlist Votes", "Confirmation",
+		"Team", "Meal Group", "Points", "Checked In", "Checked In At",
+	)
+}
+
+func exportApplicationRow(fields []store.ApplicationSchemaField, row *store.ApplicationExportRow) []string {
+	var responses map[string]any
+	if len(row.Responses) > 0 {
+		// A malformed document leaves the response columns blank rather than
+		// failing the whole export.
+		_ = json.Unmarshal(row.Responses, &responses)
+	}
+
+	cells := []string{
+		row.ID,
+		row.Email,
+		string(row.Status),
+		formatExportTime(row.SubmittedAt),
+		row.CreatedAt.UTC().Format(exportTimeFormat),
+	}
+	for _, f := range fields {
+		cells = append(cells, formatExportValue(responses[f.ID]))
+	}
+
+	confirmation := ""
+	if row.ConfirmationStatus != nil {
+		confirmation = string(*row.ConfirmationStatus)
+	}
+	checkedIn := "No"
+	if row.CheckedInAt != nil {
+		checkedIn = "Yes"
+	}
+
+	return append(cells,
+		strconv.Itoa(row.AcceptVotes),
+		strconv.Itoa(row.RejectVotes),
+		strconv.Itoa(row.WaitlistVotes),
+		confirmation,
+		derefString(row.TeamName),
+		derefString(row.MealGroup),
+		strconv.Itoa(row.Points),
+		checkedIn,
+		formatExportTime(row.CheckedInAt),
+	)
+}
+
+// formatExportValue renders one response value as cell text. Multi-select
+// answers are joined with "; " so commas inside options stay readable.
+func formatExportValue(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		if v {
+			return "Yes"
+		}
+		return "No"
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, formatExportValue(item))
+		}
+		return strings.Join(parts, "; ")
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(exportTimeFormat)
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
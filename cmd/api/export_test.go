@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/csv"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+func newExportRequest(t *testing.T, target string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	require.NoError(t, err)
+	return setUserContext(req, newAdminUser())
+}
+
+func TestExportApplications(t *testing.T) {
+	schema := []store.ApplicationSchemaField{
+		{ID: "tracks", Type: "multi_select", Label: "Interests", SectionOrder: 1, DisplayOrder: 0},
+		{ID: "first_name", Type: "text", Label: "First Name", SectionOrder: 0, DisplayOrder: 0},
+		{ID: "over_18", Type: "checkbox", Label: "Over 18", SectionOrder: 0, DisplayOrder: 1},
+	}
+	scanTypes := []store.ScanType{
+		{Name: "check_in", Category: store.ScanCategoryCheckIn},
+		{Name: "lunch", Category: store.ScanCategoryMeal},
+	}
+
+	t.Run("should stream one column per schema field", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		status := store.StatusAccepted
+		checkedIn := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+		team := "Byte Me"
+
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
+		mockApps.On("Export", mock.MatchedBy(func(f store.ApplicationListFilters) bool {
+			return f.Status != nil && *f.Status == status
+		}), []string{"check_in"}).Return([]store.ApplicationExportRow{
+			{
+				ID:          "app-1",
+				Email:       "hacker@test.com",
+				Status:      store.StatusAccepted,
+				Responses:   []byte(`{"first_name":"=Ada","over_18":true,"tracks":["AI","Hardware, IoT"]}`),
+				CreatedAt:   checkedIn.Add(-48 * time.Hour),
+				AcceptVotes: 2,
+				TeamName:    &team,
+				Points:      15,
+				CheckedInAt: &checkedIn,
+			},
+		}, nil).Once()
+
+		rr := executeRequest(newExportRequest(t, "/?status=accepted"), http.HandlerFunc(app.exportApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Header().Get("Content-Disposition"), ".csv")
+
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+
+		header, row := records[0], records[1]
+		assert.Equal(t, []string{"First Name", "Over 18", "Interests"}, header[5:8])
+		assert.Equal(t, []string{"'=Ada", "Yes", "AI; Hardware, IoT"}, row[5:8])
+
+		values := map[string]string{}
+		for i, h := range header {
+			values[h] = row[i]
+		}
+		assert.Equal(t, "2", values["Accept Votes"])
+		assert.Equal(t, "Byte Me", values["Team"])
+		assert.Equal(t, "15", values["Points"])
+		assert.Equal(t, "Yes", values["Checked In"])
+		assert.Equal(t, "2026-10-17 09:30:00", values["Checked In At"])
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return an xlsx workbook", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
+		mockApps.On("Export", mock.Anything, []string{"check_in"}).Return([]store.ApplicationExportRow{}, nil).Once()
+
+		rr := executeRequest(newExportRequest(t, "/?format=xlsx"), http.HandlerFunc(app.exportApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "PK", rr.Body.String()[:2])
+	})
+
+	for name, target := range map[string]string{
+		"unknown format": "/?format=pdf",
+		"bad status":     "/?status=pending",
+		"bad team":       "/?team_id=nope",
+		"bad hackathon":  "/?hackathon_id=2026",
+	} {
+		t.Run("should reject "+name, func(t *testing.T) {
+			app := newTestApplication(t)
+
+			rr := executeRequest(newExportRequest(t, target), http.HandlerFunc(app.exportApplicationsHandler))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
@@ -68,7 +68,7 @@ func (app *application) getFAQEditPermissionHandler(w http.ResponseWriter, r *ht
 //	@Security		CookieAuth
 //	@Router			/faq [get]
 func (app *application) getHackerFAQHandler(w http.ResponseWriter, r *http.Request) {
-	app.listFAQsHandler(w, r)
+	app.writeFAQList(w, r, nil)
 }
 
 // listFAQsHandler returns all FAQs (Admin)
@@ -77,14 +77,28 @@ func (app *application) getHackerFAQHandler(w http.ResponseWriter, r *http.Reque
 //	@Description	Returns all frequently asked questions ordered by display order
 //	@Tags			admin/faq
 //	@Produce		json
+//	@Param			hackathon_id	query		string	false	"Read an archived hackathon (default: the active one)"
 //	@Success		200	{object}	FAQListResponse
+//	@Failure		400	{object}	object{error=string}
 //	@Failure		401	{object}	object{error=string}
 //	@Failure		403	{object}	object{error=string}
 //	@Failure		500	{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/admin/faq [get]
 func (app *application) listFAQsHandler(w http.ResponseWriter, r *http.Request) {
-	faqs, err := app.store.FAQs.List(r.Context())
+	hackathonID, err := hackathonIDParam(r.URL.Query())
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	app.writeFAQList(w, r, hackathonID)
+}
+
+// writeFAQList responds with a hackathon's FAQs; nil is the active one.
+// Hacker and public reads always pass nil.
+func (app *application) writeFAQList(w http.ResponseWriter, r *http.Request, hackathonID *string) {
+	faqs, err := app.store.FAQs.List(r.Context(), hackathonID)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -147,11 +161,14 @@ func (app *application) createFAQHandler(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			faqID	path		string		true	"FAQ ID"
 //	@Param			faq		body		FAQPayload	true	"FAQ updates"
+//	@Param			If-Match	header		string		true	"ETag of the FAQ being edited (its quoted updated_at)"
 //	@Success		200		{object}	store.FAQ
 //	@Failure		400		{object}	object{error=string}
 //	@Failure		401		{object}	object{error=string}
 //	@Failure		403		{object}	object{error=string}
 //	@Failure		404		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string,data=store.FAQ}	"FAQ changed since it was loaded; data is the current copy"
+//	@Failure		428		{object}	object{error=string}
 //	@Failure		500		{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/admin/faq/{faqID} [put]
@@ -162,6 +179,11 @@ func (app *application) updateFAQHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	loadedAt, ok := app.requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var payload FAQPayload
 	if err := readJSON(w, r, &payload); err != nil {
 		app.badRequestResponse(w, r, err)
@@ -178,9 +200,17 @@ func (app *application) updateFAQHandler(w http.ResponseWriter, r *http.Request)
 		Question:     payload.Question,
 		Answer:       payload.Answer,
 		DisplayOrder: payload.DisplayOrder,
+		UpdatedAt:    loadedAt,
 	}
 
 	if err := app.store.FAQs.Update(r.Context(), faq); err != nil {
+		if errors.Is(err, store.ErrStale) {
+			var current *store.FAQ
+			if current, err = app.store.FAQs.GetByID(r.Context(), id); err == nil {
+				app.staleWriteResponse(w, r, current, current.UpdatedAt)
+				return
+			}
+		}
 		if errors.Is(err, store.ErrNotFound) {
 			app.notFoundResponse(w, r, errors.New("FAQ not found"))
 			return
@@ -189,6 +219,7 @@ func (app *application) updateFAQHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	w.Header().Set("ETag", etagFor(faq.UpdatedAt))
 	if err := app.jsonResponse(w, http.StatusOK, faq); err != nil {
 		app.internalServerError(w, r, err)
 	}
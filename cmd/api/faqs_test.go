@@ -39,7 +39,7 @@ func TestListFAQs(t *testing.T) {
 
 	t.Run("should list all FAQs", func(t *testing.T) {
 		faqs := []store.FAQ{newTestFAQ("faq-1"), newTestFAQ("faq-2")}
-		mockFAQs.On("List").Return(faqs, nil).Once()
+		mockFAQs.On("List", (*string)(nil)).Return(faqs, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/", nil)
 		require.NoError(t, err)
@@ -66,7 +66,7 @@ func TestGetHackerFAQ(t *testing.T) {
 
 	t.Run("should return FAQs for an authenticated hacker", func(t *testing.T) {
 		faqs := []store.FAQ{newTestFAQ("faq-1")}
-		mockFAQs.On("List").Return(faqs, nil).Once()
+		mockFAQs.On("List", (*string)(nil)).Return(faqs, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/", nil)
 		require.NoError(t, err)
@@ -153,7 +153,7 @@ func TestGetPublicFAQ(t *testing.T) {
 
 	t.Run("should return FAQs with valid api key", func(t *testing.T) {
 		faqs := []store.FAQ{newTestFAQ("faq-1")}
-		mockFAQs.On("List").Return(faqs, nil).Once()
+		mockFAQs.On("List", (*string)(nil)).Return(faqs, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/v1/public/faq", nil)
 		require.NoError(t, err)
@@ -227,9 +227,12 @@ func TestCreateFAQ(t *testing.T) {
 func TestUpdateFAQ(t *testing.T) {
 	app := newTestApplication(t)
 	mockFAQs := app.store.FAQs.(*store.MockFAQsStore)
+	loadedAt := time.Date(2026, 3, 1, 12, 0, 0, 123456000, time.UTC)
 
 	t.Run("should update a FAQ", func(t *testing.T) {
-		mockFAQs.On("Update", mock.AnythingOfType("*store.FAQ")).Run(func(args mock.Arguments) {
+		mockFAQs.On("Update", mock.MatchedBy(func(f *store.FAQ) bool {
+			return f.UpdatedAt.Equal(loadedAt)
+		})).Run(func(args mock.Arguments) {
 			faq := args.Get(0).(*store.FAQ)
 			faq.CreatedAt = time.Now()
 			faq.UpdatedAt = time.Now()
@@ -239,6 +242,7 @@ func TestUpdateFAQ(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", etagFor(loadedAt))
 		req = setUserContext(req, newSuperAdminUser())
 		req = withFAQRouteParam(req, "faq-to-update")
 
@@ -251,6 +255,7 @@ func TestUpdateFAQ(t *testing.T) {
 		err = json.NewDecoder(rr.Body).Decode(&respBody)
 		require.NoError(t, err)
 		assert.Equal(t, "Updated question?", respBody.Data.Question)
+		assert.Equal(t, etagFor(respBody.Data.UpdatedAt), rr.Header().Get("ETag"))
 
 		mockFAQs.AssertExpectations(t)
 	})
@@ -262,6 +267,7 @@ func TestUpdateFAQ(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", etagFor(loadedAt))
 		req = setUserContext(req, newSuperAdminUser())
 		req = withFAQRouteParam(req, "nonexistent")
 
@@ -270,6 +276,44 @@ func TestUpdateFAQ(t *testing.T) {
 
 		mockFAQs.AssertExpectations(t)
 	})
+
+	t.Run("should return 409 with the current copy if the FAQ changed", func(t *testing.T) {
+		current := &store.FAQ{ID: "faq-1", Question: "Theirs?", UpdatedAt: loadedAt.Add(time.Second)}
+		mockFAQs.On("Update", mock.AnythingOfType("*store.FAQ")).Return(store.ErrStale).Once()
+		mockFAQs.On("GetByID", "faq-1").Return(current, nil).Once()
+
+		body := `{"question":"Mine?","answer":"Updated answer.","display_order":1}`
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", etagFor(loadedAt))
+		req = setUserContext(req, newSuperAdminUser())
+		req = withFAQRouteParam(req, "faq-1")
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateFAQHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, etagFor(current.UpdatedAt), rr.Header().Get("ETag"))
+
+		var respBody struct {
+			Data store.FAQ `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&respBody))
+		assert.Equal(t, "Theirs?", respBody.Data.Question)
+
+		mockFAQs.AssertExpectations(t)
+	})
+
+	t.Run("should return 428 without If-Match", func(t *testing.T) {
+		body := `{"question":"Updated question?","answer":"Updated answer.","display_order":1}`
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		req = withFAQRouteParam(req, "faq-1")
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateFAQHandler))
+		checkResponseCode(t, http.StatusPreconditionRequired, rr.Code)
+	})
 }
 
 func TestDeleteFAQ(t *testing.T) {
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
+	"github.com/hackutd/portal/internal/store"
+)
+
+const (
+	hackathonArchivePrefix     = "hackathon-archives/"
+	hackathonArchiveExt        = ".json.gz"
+	hackathonArchiveTimeLayout = "20060102T150405Z"
+	// Resumes are only accepted as PDFs, so their archived copies are too.
+	archivedResumeContentType = "application/pdf"
+)
+
+// An archive ID is the snapshot time followed by the archived hackathon's ID,
+// so IDs sort by age and the listing needs nothing but object names.
+var hackathonArchiveIDPattern = regexp.MustCompile(`^(\d{8}T\d{6}Z)-([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+
+// errInvalidHackathonArchive marks a stored bundle that cannot be restored.
+var errInvalidHackathonArchive = errors.New("invalid hackathon archive")
+
+type HackathonArchiveInfo struct {
+	ID          string    `json:"id"`
+	HackathonID string    `json:"hackathon_id"`
+	SizeBytes   int64     `json:"size_bytes"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type HackathonArchiveResponse struct {
+	Archive HackathonArchiveInfo `json:"archive"`
+}
+
+type HackathonArchiveListResponse struct {
+	Archives []HackathonArchiveInfo `json:"archives"`
+}
+
+type RestoreHackathonArchiveResponse struct {
+	ArchiveID string `json:"archive_id"`
+	// Restored counts the rows written per table.
+	Restored map[string]int `json:"restored"`
+	// ResumesRestored counts the resume and file-field uploads queued to be
+	// copied back from the archive. Copying happens in the background; files
+	// the archive doesn't hold are logged server-side.
+	ResumesRestored int `json:"resumes_restored"`
+}
+
+// parseHackathonArchiveID splits an archive ID into its snapshot time and
+// hackathon ID.
+func parseHackathonArchiveID(id string) (time.Time, string, bool) {
+	m := hackathonArchiveIDPattern.FindStringSubmatch(id)
+	if m == nil {
+		return time.Time{}, "", false
+	}
+	createdAt, err := time.Parse(hackathonArchiveTimeLayout, m[1])
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return createdAt, m[2], true
+}
+
+func hackathonArchivePath(id string) string {
+	return hackathonArchivePrefix + id + hackathonArchiveExt
+}
+
+// hackathonArchiveResumePath is where an archive keeps its copy of a resume,
+// under the archive's ID and the resume's original path. The listing skips
+// these, since they don't end in the bundle extension.
+func hackathonArchiveResumePath(archiveID, resumePath string) string {
+	return hackathonArchivePrefix + archiveID + "/resumes/" + resumePath
+}
+
+// archivedResumePaths returns the resume paths of an archive's applications,
+// along with the uploads answering their file fields. Both are PDFs and are
+// archived the same way.
+func archivedResumePaths(archive *store.HackathonArchive) []string {
+	var paths []string
+	for _, row := range archive.Tables["applications"] {
+		var application struct {
+			ResumePath *string                `json:"resume_path"`
+			Responses  map[string]interface{} `json:"responses"`
+		}
+		if json.Unmarshal(row, &application) != nil {
+			continue
+		}
+		if application.ResumePath != nil && *application.ResumePath != "" {
+			paths = append(paths, *application.ResumePath)
+		}
+		for _, answer := range application.Responses {
+			if path, ok := answer.(string); ok && strings.HasPrefix(path, applicationFilesPrefix) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// byteCounter counts what is written through it.
+type byteCounter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// archiveHackathon snapshots the active hackathon and streams it to object
+// storage as gzipped JSON, so the compressed bundle is never buffered. It
+// returns the archive with the resume paths its applications point at, which
+// the caller copies in with archiveResumeObjects or copyResumesIntoArchive.
+func (app *application) archiveHackathon(ctx context.Context) (*HackathonArchiveInfo, []string, error) {
+	archive, err := app.store.Hackathon.Snapshot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := &HackathonArchiveInfo{
+		ID:          archive.CreatedAt.UTC().Format(hackathonArchiveTimeLayout) + "-" + archive.Hackathon.ID,
+		HackathonID: archive.Hackathon.ID,
+		CreatedAt:   archive.CreatedAt.UTC().Truncate(time.Second),
+	}
+
+	pr, pw := io.Pipe()
+	counter := &byteCounter{w: pw}
+	done := make(chan error, 1)
+	go func() {
+		gz := gzip.NewWriter(counter)
+		werr := json.NewEncoder(gz).Encode(archive)
+		if werr == nil {
+			werr = gz.Close()
+		}
+		pw.CloseWithError(werr)
+		done <- werr
+	}()
+
+	err = app.gcsClient.WriteObject(ctx, hackathonArchivePath(info.ID), "application/gzip", pr)
+	// Unblock the writer if storage gave up before reading everything.
+	pr.Close()
+	if werr := <-done; werr != nil && err == nil {
+		err = werr
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info.SizeBytes = counter.n
+	return info, archivedResumePaths(archive), nil
+}
+
+// copyResumesIntoArchive copies resume files into an archive taken while they
+// are still in use, so the archive can be restored after they are gone.
+func (app *application) copyResumesIntoArchive(archiveID string, paths []string) {
+	app.forEachResumeObject("archive copy", paths, func(ctx context.Context, path string) error {
+		return app.copyObject(ctx, path, hackathonArchiveResumePath(archiveID, path), archivedResumeContentType)
+	})
+}
+
+// restoreResumeObjects copies an archive's resume files back to the paths the
+// restored applications point at.
+func (app *application) restoreResumeObjects(archiveID string, paths []string) {
+	app.forEachResumeObject("restore", paths, func(ctx context.Context, path string) error {
+		return app.copyObject(ctx, hackathonArchiveResumePath(archiveID, path), path, archivedResumeContentType)
+	})
+}
+
+// readHackathonArchive loads an archive, refusing bundles written in a format
+// this build does not know.
+func (app *application) readHackathonArchive(ctx context.Context, id string) (*store.HackathonArchive, error) {
+	rc, err := app.gcsClient.ReadObject(ctx, hackathonArchivePath(id))
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	gz, err := gzip.NewReader(rc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidHackathonArchive, err)
+	}
+	defer gz.Close()
+
+	var archive store.HackathonArchive
+	if err := json.NewDecoder(gz).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidHackathonArchive, err)
+	}
+	// Older versions hold a subset of today's tables, which restore as empty.
+	if archive.Version < 1 || archive.Version > store.HackathonArchiveVersion {
+		return nil, fmt.Errorf("%w: version %d is not supported", errInvalidHackathonArchive, archive.Version)
+	}
+
+	return &archive, nil
+}
+
+// listHackathonArchivesHandler lists stored hackathon archives
+//
+//	@Summary		List hackathon archives (Super Admin)
+//	@Description	Lists the archives in object storage, newest first. Archives are read from storage rather than the database, so they are listed even on a fresh install.
+//	@Tags			superadmin/hackathons
+//	@Produce		json
+//	@Success		200	{object}	HackathonArchiveListResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Failure		503	{object}	object{error=string}	"Object storage is not configured"
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons/archives [get]
+func (app *application) listHackathonArchivesHandler(w http.ResponseWriter, r *http.Request) {
+	if app.gcsClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "archive storage is not configured")
+		return
+	}
+
+	objects, err := app.gcsClient.ListObjects(r.Context(), hackathonArchivePrefix)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	archives := []HackathonArchiveInfo{}
+	for _, o := range objects {
+		id := strings.TrimSuffix(strings.TrimPrefix(o.Path, hackathonArchivePrefix), hackathonArchiveExt)
+		createdAt, hackathonID, ok := parseHackathonArchiveID(id)
+		if !ok || !strings.HasSuffix(o.Path, hackathonArchiveExt) {
+			continue
+		}
+		archives = append(archives, HackathonArchiveInfo{
+			ID:          id,
+			HackathonID: hackathonID,
+			SizeBytes:   o.Size,
+			CreatedAt:   createdAt,
+		})
+	}
+	sort.Slice(archives, func(i, j int) bool { return archives[i].CreatedAt.After(archives[j].CreatedAt) })
+
+	if err := app.jsonResponse(w, http.StatusOK, HackathonArchiveListResponse{Archives: archives}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// createHackathonArchiveHandler archives the active hackathon
+//
+//	@Summary		Archive hackathon (Super Admin)
+//	@Description	Snapshots the active hackathon's applications with their responses, reviews, status events and flags, scans, schedule, sponsors, settings, application schema versions, policies with their signatures and saved views, plus the users they reference, into a versioned, gzipped JSON bundle in object storage. Resume files are copied alongside it in the background. Resets take one automatically first.
+//	@Tags			superadmin/hackathons
+//	@Produce		json
+//	@Success		201	{object}	HackathonArchiveResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Failure		503	{object}	object{error=string}	"Object storage is not configured"
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons/archives [post]
+func (app *application) createHackathonArchiveHandler(w http.ResponseWriter, r *http.Request) {
+	if app.gcsClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "archive storage is not configured")
+		return
+	}
+
+	info, resumePaths, err := app.archiveHackathon(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+	if len(resumePaths) > 0 {
+		go app.copyResumesIntoArchive(info.ID, resumePaths)
+	}
+
+	app.recordAudit(r, store.AuditActionHackathonArchive, store.AuditTargetHackathonArchive, info.ID, nil, info)
+
+	if err := app.jsonResponse(w, http.StatusCreated, HackathonArchiveResponse{Archive: *info}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// restoreHackathonArchiveHandler loads an archive into the active hackathon
+//
+//	@Summary		Restore hackathon archive (Super Admin)
+//	@Description	Loads an archive into the active hackathon in one transaction. The active hackathon must be empty: no applications, walk-ins, teams, scans, schedule, notifications, sponsors or FAQs. Archived settings overwrite the current ones, and referenced users are recreated unless they already exist. A referenced user whose email now belongs to a different account is refused with 409 listing the emails. Resume files held by the archive are copied back in the background.
+//	@Tags			superadmin/hackathons
+//	@Produce		json
+//	@Param			archiveID	path		string	true	"Archive ID"
+//	@Success		200			{object}	RestoreHackathonArchiveResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		409			{object}	object{error=string}	"The active hackathon already has data, or archived users registered again under a new ID"
+//	@Failure		500			{object}	object{error=string}
+//	@Failure		503			{object}	object{error=string}	"Object storage is not configured"
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons/archives/{archiveID}/restore [post]
+func (app *application) restoreHackathonArchiveHandler(w http.ResponseWriter, r *http.Request) {
+	if app.gcsClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "archive storage is not configured")
+		return
+	}
+
+	archiveID := chi.URLParam(r, "archiveID")
+	if _, _, ok := parseHackathonArchiveID(archiveID); !ok {
+		app.badRequestResponse(w, r, errors.New("invalid archive ID"))
+		return
+	}
+
+	archive, err := app.readHackathonArchive(r.Context(), archiveID)
+	if err != nil {
+		switch {
+		case errors.Is(err, gcs.ErrObjectNotFound):
+			app.notFoundResponse(w, r, err)
+		case errors.Is(err, errInvalidHackathonArchive):
+			app.badRequestResponse(w, r, err)
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	restored, err := app.store.Hackathon.Restore(r.Context(), archive)
+	if err != nil {
+		var emailErr *store.UserEmailConflictError
+		if errors.As(err, &emailErr) {
+			app.conflictResponse(w, r, err)
+			return
+		}
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, errors.New("the active hackathon already has data or the archived rows still exist; reset it first"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	resumePaths := archivedResumePaths(archive)
+	if len(resumePaths) > 0 {
+		go app.restoreResumeObjects(archiveID, resumePaths)
+	}
+
+	response := RestoreHackathonArchiveResponse{ArchiveID: archiveID, Restored: restored, ResumesRestored: len(resumePaths)}
+	app.recordAudit(r, store.AuditActionHackathonRestore, store.AuditTargetHackathonArchive, archiveID, nil, response)
+
+	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
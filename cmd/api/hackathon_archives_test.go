@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/gcs"
+	"github.com/hackutd/portal/internal/store"
+)
+
+func newTestHackathonArchive() *store.HackathonArchive {
+	return &store.HackathonArchive{
+		Version:   store.HackathonArchiveVersion,
+		Hackathon: store.Hackathon{ID: testEventID, Name: "HackUTD 2026", IsActive: true},
+		CreatedAt: time.Date(2026, 11, 20, 18, 30, 0, 0, time.UTC),
+		Tables: map[string][]json.RawMessage{
+			"applications": {json.RawMessage(`{"id":"a1","responses":{"major":"CS"}}`)},
+			"settings":     {json.RawMessage(`{"key":"hackathon_name","value":"HackUTD 2026"}`)},
+		},
+	}
+}
+
+func TestHackathonArchiveRoundTrip(t *testing.T) {
+	local, err := gcs.NewLocal(t.TempDir(), "http://localhost:8080/v1/storage", []byte("test-secret"))
+	require.NoError(t, err)
+
+	app := newTestApplication(t)
+	app.gcsClient = local
+	mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+	archive := newTestHackathonArchive()
+	mockHackathons.On("Snapshot").Return(archive, nil).Once()
+
+	req, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.createHackathonArchiveHandler))
+	checkResponseCode(t, http.StatusCreated, rr.Code)
+
+	var created struct {
+		Data HackathonArchiveResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+	archiveID := "20261120T183000Z-" + testEventID
+	assert.Equal(t, archiveID, created.Data.Archive.ID)
+
+	req, err = http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+
+	rr = executeRequest(req, http.HandlerFunc(app.listHackathonArchivesHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+
+	var listed struct {
+		Data HackathonArchiveListResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &listed))
+	require.Len(t, listed.Data.Archives, 1)
+	assert.Equal(t, created.Data.Archive, listed.Data.Archives[0])
+
+	mockHackathons.On("Restore", mock.MatchedBy(func(a *store.HackathonArchive) bool {
+		return a.Hackathon.ID == testEventID &&
+			string(a.Tables["applications"][0]) == `{"id":"a1","responses":{"major":"CS"}}`
+	})).Return(map[string]int{"applications": 1, "settings": 1}, nil).Once()
+
+	rr = executeRequest(newRestoreRequest(t, archiveID), http.HandlerFunc(app.restoreHackathonArchiveHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+	mockHackathons.AssertExpectations(t)
+
+	events := recordedAuditEvents(app)
+	require.Len(t, events, 2)
+	assert.Equal(t, store.AuditActionHackathonArchive, events[0].Action)
+	assert.Equal(t, store.AuditActionHackathonRestore, events[1].Action)
+}
+
+func newRestoreRequest(t *testing.T, archiveID string) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("archiveID", archiveID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestRestoreHackathonArchiveHandler(t *testing.T) {
+	archiveID := "20261120T183000Z-" + testEventID
+
+	storeArchive := func(t *testing.T, app *application, archive any) {
+		local, err := gcs.NewLocal(t.TempDir(), "http://localhost:8080/v1/storage", []byte("test-secret"))
+		require.NoError(t, err)
+		app.gcsClient = local
+
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		require.NoError(t, json.NewEncoder(gz).Encode(archive))
+		require.NoError(t, gz.Close())
+		require.NoError(t, local.WriteObject(context.Background(), hackathonArchivePath(archiveID), "application/gzip", &buf))
+	}
+
+	t.Run("should return 409 when the active hackathon has data", func(t *testing.T) {
+		app := newTestApplication(t)
+		storeArchive(t, app, newTestHackathonArchive())
+		app.store.Hackathon.(*store.MockHackathonStore).
+			On("Restore", mock.Anything).Return(nil, store.ErrConflict).Once()
+
+		rr := executeRequest(newRestoreRequest(t, archiveID), http.HandlerFunc(app.restoreHackathonArchiveHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("should return 409 listing users who registered again", func(t *testing.T) {
+		app := newTestApplication(t)
+		storeArchive(t, app, newTestHackathonArchive())
+		app.store.Hackathon.(*store.MockHackathonStore).
+			On("Restore", mock.Anything).
+			Return(nil, &store.UserEmailConflictError{Emails: []string{"hacker@test.com"}}).Once()
+
+		rr := executeRequest(newRestoreRequest(t, archiveID), http.HandlerFunc(app.restoreHackathonArchiveHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Contains(t, rr.Body.String(), "hacker@test.com")
+	})
+
+	t.Run("should reject an unknown archive version", func(t *testing.T) {
+		app := newTestApplication(t)
+		archive := newTestHackathonArchive()
+		archive.Version = store.HackathonArchiveVersion + 1
+		storeArchive(t, app, archive)
+
+		rr := executeRequest(newRestoreRequest(t, archiveID), http.HandlerFunc(app.restoreHackathonArchiveHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		app.store.Hackathon.(*store.MockHackathonStore).AssertNotCalled(t, "Restore", mock.Anything)
+	})
+
+	t.Run("should copy archived resumes back", func(t *testing.T) {
+		app := newTestApplication(t)
+		archive := newTestHackathonArchive()
+		archive.Tables["applications"] = []json.RawMessage{
+			json.RawMessage(`{"id":"a1","resume_path":"resumes/user-1.pdf"}`),
+			json.RawMessage(`{"id":"a2","resume_path":null,"responses":{"portfolio":"application-files/user-2/portfolio/abc.pdf","school":"UTD"}}`),
+		}
+		storeArchive(t, app, archive)
+		local := app.gcsClient.(*gcs.LocalClient)
+		for _, path := range []string{"resumes/user-1.pdf", "application-files/user-2/portfolio/abc.pdf"} {
+			require.NoError(t, local.WriteObject(context.Background(),
+				hackathonArchiveResumePath(archiveID, path), "application/pdf", strings.NewReader("%PDF")))
+		}
+		app.store.Hackathon.(*store.MockHackathonStore).
+			On("Restore", mock.Anything).Return(map[string]int{"applications": 2}, nil).Once()
+
+		rr := executeRequest(newRestoreRequest(t, archiveID), http.HandlerFunc(app.restoreHackathonArchiveHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data RestoreHackathonArchiveResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.Equal(t, 2, body.Data.ResumesRestored)
+
+		// The copy runs in the background.
+		assert.Eventually(t, func() bool {
+			_, err := local.StatObject(context.Background(), "resumes/user-1.pdf")
+			_, fileErr := local.StatObject(context.Background(), "application-files/user-2/portfolio/abc.pdf")
+			return err == nil && fileErr == nil
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should return 404 for a missing archive", func(t *testing.T) {
+		app := newTestApplication(t)
+		storeArchive(t, app, newTestHackathonArchive())
+
+		rr := executeRequest(newRestoreRequest(t, "20250101T000000Z-"+testEventID), http.HandlerFunc(app.restoreHackathonArchiveHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should reject a malformed archive ID", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		rr := executeRequest(newRestoreRequest(t, "../secrets"), http.HandlerFunc(app.restoreHackathonArchiveHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
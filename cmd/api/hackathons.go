@@ -0,0 +1,232 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+)
+
+type CreateHackathonPayload struct {
+	Name     string  `json:"name" validate:"required,max=100"`
+	StartsOn *string `json:"starts_on" validate:"omitempty,datetime=2006-01-02"`
+	EndsOn   *string `json:"ends_on" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type HackathonResponse struct {
+	Hackathon store.Hackathon `json:"hackathon"`
+}
+
+type HackathonListResponse struct {
+	Hackathons []store.Hackathon `json:"hackathons"`
+}
+
+type HackathonStatsResponse struct {
+	Hackathons []store.HackathonStats `json:"hackathons"`
+}
+
+type HackathonSettingsResponse struct {
+	Settings map[string]json.RawMessage `json:"settings"`
+}
+
+// hackathonIDParam reads the optional hackathon_id query parameter admin
+// reads take to look at an archived event. nil means the active one.
+func hackathonIDParam(query url.Values) (*string, error) {
+	hackathonID := query.Get("hackathon_id")
+	if hackathonID == "" {
+		return nil, nil
+	}
+	if err := Validate.Var(hackathonID, "uuid"); err != nil {
+		return nil, errors.New("hackathon_id must be a valid UUID")
+	}
+	return &hackathonID, nil
+}
+
+// listHackathonsHandler lists every hackathon
+//
+//	@Summary		List hackathons (Super Admin)
+//	@Description	Lists every hackathon, newest first. Exactly one is active; the rest are archived and stay queryable.
+//	@Tags			superadmin/hackathons
+//	@Produce		json
+//	@Success		200	{object}	HackathonListResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons [get]
+func (app *application) listHackathonsHandler(w http.ResponseWriter, r *http.Request) {
+	hackathons, err := app.store.Hackathon.List(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, HackathonListResponse{Hackathons: hackathons}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// createHackathonHandler creates a new, inactive hackathon
+//
+//	@Summary		Create hackathon (Super Admin)
+//	@Description	Creates an inactive hackathon. Its settings are copied from the active event, except the per-cycle ones: dates, points name, hacker pack, judging and scan types start fresh, and applications start closed. Activate it to switch the portal over.
+//	@Tags			superadmin/hackathons
+//	@Accept			json
+//	@Produce		json
+//	@Param			hackathon	body		CreateHackathonPayload	true	"Hackathon name and dates"
+//	@Success		201			{object}	HackathonResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons [post]
+func (app *application) createHackathonHandler(w http.ResponseWriter, r *http.Request) {
+	var req CreateHackathonPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Both are YYYY-MM-DD, so string order is date order.
+	if req.StartsOn != nil && req.EndsOn != nil && *req.EndsOn < *req.StartsOn {
+		app.badRequestResponse(w, r, errors.New("ends_on must not be before starts_on"))
+		return
+	}
+
+	hackathon := &store.Hackathon{
+		Name:     req.Name,
+		StartsOn: req.StartsOn,
+		EndsOn:   req.EndsOn,
+	}
+	if err := app.store.Hackathon.Create(r.Context(), hackathon); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionHackathonCreate, store.AuditTargetHackathon, hackathon.ID, nil, hackathon)
+
+	if err := app.jsonResponse(w, http.StatusCreated, HackathonResponse{Hackathon: *hackathon}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// activateHackathonHandler switches the portal to another hackathon
+//
+//	@Summary		Activate hackathon (Super Admin)
+//	@Description	Makes the hackathon the active event and archives the previous one. Every portal page, QR pass and setting then reads the new event; the archived event's data is kept.
+//	@Tags			superadmin/hackathons
+//	@Produce		json
+//	@Param			hackathonID	path		string	true	"Hackathon ID"
+//	@Success		200			{object}	HackathonResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		409			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons/{hackathonID}/activate [post]
+func (app *application) activateHackathonHandler(w http.ResponseWriter, r *http.Request) {
+	hackathonID := chi.URLParam(r, "hackathonID")
+	if err := Validate.Var(hackathonID, "required,uuid"); err != nil {
+		app.badRequestResponse(w, r, errors.New("invalid hackathon ID"))
+		return
+	}
+
+	previous, err := app.store.Hackathon.GetActive(r.Context())
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	hackathon, err := app.store.Hackathon.Activate(r.Context(), hackathonID)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			app.notFoundResponse(w, r, err)
+		case errors.Is(err, store.ErrConflict):
+			app.conflictResponse(w, r, errors.New("another hackathon was activated at the same time"))
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionHackathonActivate, store.AuditTargetHackathon, hackathon.ID, previous, hackathon)
+
+	if err := app.jsonResponse(w, http.StatusOK, HackathonResponse{Hackathon: *hackathon}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getHackathonStatsHandler compares hackathons side by side
+//
+//	@Summary		Compare hackathons (Super Admin)
+//	@Description	Returns headline numbers for every hackathon, newest first: applications (excluding drafts), decisions, confirmations, check-ins, teams, projects and sponsors.
+//	@Tags			superadmin/hackathons
+//	@Produce		json
+//	@Success		200	{object}	HackathonStatsResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons/stats [get]
+func (app *application) getHackathonStatsHandler(w http.ResponseWriter, r *http.Request) {
+	stats, err := app.store.Hackathon.Stats(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, HackathonStatsResponse{Hackathons: stats}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getHackathonSettingsHandler returns a hackathon's stored settings
+//
+//	@Summary		Get hackathon settings (Super Admin)
+//	@Description	Returns every setting stored for the hackathon, active or archived, keyed by setting name with its raw value. Settings never changed from their defaults are absent.
+//	@Tags			superadmin/hackathons
+//	@Produce		json
+//	@Param			hackathonID	path		string	true	"Hackathon ID"
+//	@Success		200			{object}	HackathonSettingsResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/hackathons/{hackathonID}/settings [get]
+func (app *application) getHackathonSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	hackathonID := chi.URLParam(r, "hackathonID")
+	if err := Validate.Var(hackathonID, "required,uuid"); err != nil {
+		app.badRequestResponse(w, r, errors.New("hackathon ID must be a valid UUID"))
+		return
+	}
+
+	settings, err := app.store.Hackathon.Settings(r.Context(), hackathonID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("hackathon not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, HackathonSettingsResponse{Settings: settings}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
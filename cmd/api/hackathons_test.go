@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+const (
+	testEventID         = "5d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	testArchivedEventID = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+)
+
+func TestCreateHackathonHandler(t *testing.T) {
+	t.Run("should create an inactive hackathon", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+		mockHackathons.On("Create", mock.MatchedBy(func(h *store.Hackathon) bool {
+			return h.Name == "HackUTD 2027" && *h.StartsOn == "2027-11-13" && *h.EndsOn == "2027-11-14"
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).(*store.Hackathon).ID = testEventID
+		}).Return(nil).Once()
+
+		body := `{"name":"  HackUTD 2027 ","starts_on":"2027-11-13","ends_on":"2027-11-14"}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createHackathonHandler))
+		checkResponseCode(t, http.StatusCreated, rr.Code)
+		mockHackathons.AssertExpectations(t)
+
+		var resp struct {
+			Data HackathonResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, testEventID, resp.Data.Hackathon.ID)
+
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Equal(t, store.AuditActionHackathonCreate, events[0].Action)
+	})
+
+	for name, body := range map[string]string{
+		"missing name":     `{"name":"  "}`,
+		"malformed date":   `{"name":"HackUTD","starts_on":"11/13/2027"}`,
+		"reversed dates":   `{"name":"HackUTD","starts_on":"2027-11-14","ends_on":"2027-11-13"}`,
+		"unknown property": `{"name":"HackUTD","is_active":true}`,
+	} {
+		t.Run("should reject "+name, func(t *testing.T) {
+			app := newTestApplication(t)
+
+			req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			require.NoError(t, err)
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.createHackathonHandler))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+			app.store.Hackathon.(*store.MockHackathonStore).AssertNotCalled(t, "Create", mock.Anything)
+		})
+	}
+}
+
+func TestActivateHackathonHandler(t *testing.T) {
+	newRequest := func(t *testing.T, id string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("hackathonID", id)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("should switch the active hackathon", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+		previous := &store.Hackathon{ID: testArchivedEventID, Name: "HackUTD 2026", IsActive: true}
+		mockHackathons.On("GetActive").Return(previous, nil).Once()
+		mockHackathons.On("Activate", testEventID).
+			Return(&store.Hackathon{ID: testEventID, Name: "HackUTD 2027", IsActive: true}, nil).Once()
+
+		rr := executeRequest(newRequest(t, testEventID), http.HandlerFunc(app.activateHackathonHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		mockHackathons.AssertExpectations(t)
+
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Equal(t, store.AuditActionHackathonActivate, events[0].Action)
+		assert.Equal(t, testEventID, events[0].TargetID)
+		assert.Contains(t, string(events[0].Before), testArchivedEventID)
+	})
+
+	t.Run("should return 404 for an unknown hackathon", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+		mockHackathons.On("GetActive").Return(&store.Hackathon{ID: testArchivedEventID}, nil).Once()
+		mockHackathons.On("Activate", testEventID).Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newRequest(t, testEventID), http.HandlerFunc(app.activateHackathonHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should reject an invalid ID", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		rr := executeRequest(newRequest(t, "nope"), http.HandlerFunc(app.activateHackathonHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetHackathonStatsHandler(t *testing.T) {
+	app := newTestApplication(t)
+	mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+	stats := []store.HackathonStats{
+		{HackathonID: testEventID, Name: "HackUTD 2027", IsActive: true, Applications: 1200, CheckedIn: 800},
+		{HackathonID: testArchivedEventID, Name: "HackUTD 2026", Applications: 1000, CheckedIn: 700},
+	}
+	mockHackathons.On("Stats").Return(stats, nil).Once()
+
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.getHackathonStatsHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+
+	var resp struct {
+		Data HackathonStatsResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, stats, resp.Data.Hackathons)
+}
+
+func TestGetHackathonSettingsHandler(t *testing.T) {
+	newRequest := func(t *testing.T, id string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("hackathonID", id)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("should return an archived hackathon's settings", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+		mockHackathons.On("Settings", testArchivedEventID).Return(map[string]json.RawMessage{
+			store.SettingsKeyPointsName: json.RawMessage(`"Coins"`),
+		}, nil).Once()
+
+		rr := executeRequest(newRequest(t, testArchivedEventID), http.HandlerFunc(app.getHackathonSettingsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var resp struct {
+			Data HackathonSettingsResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.JSONEq(t, `"Coins"`, string(resp.Data.Settings[store.SettingsKeyPointsName]))
+		mockHackathons.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 for an unknown hackathon", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+		mockHackathons.On("Settings", testEventID).Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newRequest(t, testEventID), http.HandlerFunc(app.getHackathonSettingsHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
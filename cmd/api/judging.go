@@ -0,0 +1,366 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+)
+
+// overallTrack names the ranking that includes every project regardless of track
+const overallTrack = "overall"
+
+type SubmitScoresPayload struct {
+	Scores map[string]int `json:"scores" validate:"required"`
+	Notes  *string        `json:"notes" validate:"omitempty,max=2000"`
+}
+
+type JudgingAssignmentsResponse struct {
+	Assignments []store.ProjectJudgingWithDetails `json:"assignments"`
+	Rubric      []store.RubricCriterion           `json:"rubric"`
+}
+
+type JudgingScoreResponse struct {
+	Judging store.ProjectJudging `json:"judging"`
+}
+
+// ProjectRanking is one project's place in a track ranking. Score is the mean
+// of the project's per-judge z-scores; RawScore is its mean weighted rubric
+// score on a 0-1 scale before normalization.
+type ProjectRanking struct {
+	Rank       int     `json:"rank"`
+	ProjectID  string  `json:"project_id"`
+	Title      string  `json:"title"`
+	TeamName   string  `json:"team_name"`
+	Score      float64 `json:"score"`
+	RawScore   float64 `json:"raw_score"`
+	JudgeCount int     `json:"judge_count"`
+}
+
+type TrackRanking struct {
+	Track    string           `json:"track"`
+	Projects []ProjectRanking `json:"projects"`
+}
+
+type JudgingRankingsResponse struct {
+	Rankings []TrackRanking `json:"rankings"`
+}
+
+// validateScores checks scores against the rubric: every criterion must be
+// scored within its range and no unknown criteria are allowed.
+func validateScores(rubric []store.RubricCriterion, scores map[string]int) error {
+	if len(rubric) == 0 {
+		return errors.New("judging rubric has not been configured")
+	}
+
+	known := make(map[string]bool, len(rubric))
+	for _, c := range rubric {
+		known[c.ID] = true
+		score, ok := scores[c.ID]
+		if !ok {
+			return fmt.Errorf("missing score for %q", c.ID)
+		}
+		if score < 0 || score > c.MaxScore {
+			return fmt.Errorf("score for %q must be between 0 and %d", c.ID, c.MaxScore)
+		}
+	}
+
+	for id := range scores {
+		if !known[id] {
+			return fmt.Errorf("unknown rubric criterion %q", id)
+		}
+	}
+
+	return nil
+}
+
+// weightedScore collapses a judge's rubric scores into a single 0-1 value.
+// Criteria removed from the rubric after scoring are ignored; ok is false when
+// none of the scored criteria are still in the rubric.
+func weightedScore(rubric []store.RubricCriterion, scores map[string]int) (score float64, ok bool) {
+	var total, weights float64
+	for _, c := range rubric {
+		s, found := scores[c.ID]
+		if !found || c.MaxScore <= 0 {
+			continue
+		}
+		total += c.Weight * float64(s) / float64(c.MaxScore)
+		weights += c.Weight
+	}
+	if weights == 0 {
+		return 0, false
+	}
+	return total / weights, true
+}
+
+// computeRankings ranks projects overall and within each track. Each judge's
+// weighted scores are converted to z-scores against that judge's own scores so
+// harsh and lenient judges count equally; a project's score is the mean of its
+// z-scores. Judges with a single score, or identical scores, contribute zero.
+func computeRankings(projects []store.Project, scores []store.JudgingScore, rubric []store.RubricCriterion, tracks []string) []TrackRanking {
+	type judged struct {
+		projectID string
+		raw       float64
+	}
+
+	byJudge := make(map[string][]judged)
+	for _, s := range scores {
+		raw, ok := weightedScore(rubric, s.Scores)
+		if !ok {
+			continue
+		}
+		byJudge[s.JudgeID] = append(byJudge[s.JudgeID], judged{projectID: s.ProjectID, raw: raw})
+	}
+
+	type tally struct {
+		z, raw float64
+		judges int
+	}
+
+	tallies := make(map[string]*tally)
+	for _, entries := range byJudge {
+		var mean float64
+		for _, e := range entries {
+			mean += e.raw
+		}
+		mean /= float64(len(entries))
+
+		var variance float64
+		for _, e := range entries {
+			variance += (e.raw - mean) * (e.raw - mean)
+		}
+		stddev := math.Sqrt(variance / float64(len(entries)))
+
+		for _, e := range entries {
+			t, ok := tallies[e.projectID]
+			if !ok {
+				t = &tally{}
+				tallies[e.projectID] = t
+			}
+			if stddev > 0 {
+				t.z += (e.raw - mean) / stddev
+			}
+			t.raw += e.raw
+			t.judges++
+		}
+	}
+
+	rankings := []TrackRanking{{Track: overallTrack, Projects: []ProjectRanking{}}}
+	trackIndex := make(map[string]int, len(tracks))
+	for _, track := range tracks {
+		trackIndex[track] = len(rankings)
+		rankings = append(rankings, TrackRanking{Track: track, Projects: []ProjectRanking{}})
+	}
+
+	for _, p := range projects {
+		t, ok := tallies[p.ID]
+		if !ok {
+			continue
+		}
+		entry := ProjectRanking{
+			ProjectID:  p.ID,
+			Title:      p.Title,
+			TeamName:   p.TeamName,
+			Score:      t.z / float64(t.judges),
+			RawScore:   t.raw / float64(t.judges),
+			JudgeCount: t.judges,
+		}
+
+		rankings[0].Projects = append(rankings[0].Projects, entry)
+		for _, track := range p.Tracks {
+			if i, ok := trackIndex[track]; ok {
+				rankings[i].Projects = append(rankings[i].Projects, entry)
+			}
+		}
+	}
+
+	for i := range rankings {
+		slices.SortFunc(rankings[i].Projects, func(a, b ProjectRanking) int {
+			return cmp.Or(
+				cmp.Compare(b.Score, a.Score),
+				cmp.Compare(b.RawScore, a.RawScore),
+				cmp.Compare(a.Title, b.Title),
+			)
+		})
+		for j := range rankings[i].Projects {
+			rankings[i].Projects[j].Rank = j + 1
+		}
+	}
+
+	return rankings
+}
+
+// getMyJudgingAssignments returns the projects assigned to the current judge
+//
+//	@Summary		Get my judging assignments (Admin)
+//	@Description	Returns the projects assigned to the current admin for judging, unscored first, along with the rubric to score them against
+//	@Tags			admin/judging
+//	@Produce		json
+//	@Success		200	{object}	JudgingAssignmentsResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/judging/assignments [get]
+func (app *application) getMyJudgingAssignments(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+
+	assignments, err := app.store.Judging.GetByJudgeID(r.Context(), user.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	config, err := app.store.Settings.GetJudgingConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	response := JudgingAssignmentsResponse{
+		Assignments: assignments,
+		Rubric:      config.Rubric,
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// submitJudgingScores records the current judge's rubric scores for an assignment
+//
+//	@Summary		Submit judging scores (Admin)
+//	@Description	Records rubric scores for a project assigned to the current admin. Every rubric criterion must be scored between 0 and its max score. Scores can be revised by submitting again.
+//	@Tags			admin/judging
+//	@Accept			json
+//	@Produce		json
+//	@Param			judgingID	path		string				true	"Judging assignment ID"
+//	@Param			scores		body		SubmitScoresPayload	true	"Scores keyed by rubric criterion ID and optional notes"
+//	@Success		200			{object}	JudgingScoreResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/judging/assignments/{judgingID} [put]
+func (app *application) submitJudgingScores(w http.ResponseWriter, r *http.Request) {
+	judgingID := chi.URLParam(r, "judgingID")
+	if err := Validate.Var(judgingID, "required,uuid"); err != nil {
+		app.badRequestResponse(w, r, errors.New("judging ID must be a valid UUID"))
+		return
+	}
+
+	user := getUserFromContext(r.Context())
+
+	var req SubmitScoresPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	config, err := app.store.Settings.GetJudgingConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := validateScores(config.Rubric, req.Scores); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	judging, err := app.store.Judging.SubmitScores(r.Context(), judgingID, user.ID, req.Scores, req.Notes)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("judging assignment not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, JudgingScoreResponse{Judging: *judging}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// batchAssignJudges assigns judges to submitted projects using workload balancing
+//
+//	@Summary		Batch assign judges (SuperAdmin)
+//	@Description	Assigns admins to every project with fewer judges than the configured judges per project, least-loaded first. Admins are never assigned their own team's project.
+//	@Tags			superadmin/judging
+//	@Produce		json
+//	@Success		200	{object}	store.JudgingAssignmentResult
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/judging/assign [post]
+func (app *application) batchAssignJudges(w http.ResponseWriter, r *http.Request) {
+	config, err := app.store.Settings.GetJudgingConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	result, err := app.store.Judging.BatchAssign(r.Context(), config.JudgesPerProject)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, result); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getJudgingRankings returns normalized project rankings overall and per track
+//
+//	@Summary		Get judging rankings (SuperAdmin)
+//	@Description	Ranks scored projects overall and within each configured track. Each judge's scores are normalized to z-scores before averaging so lenient and harsh judges carry equal weight.
+//	@Tags			superadmin/judging
+//	@Produce		json
+//	@Success		200	{object}	JudgingRankingsResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/judging/rankings [get]
+func (app *application) getJudgingRankings(w http.ResponseWriter, r *http.Request) {
+	config, err := app.store.Settings.GetJudgingConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	projects, err := app.store.Projects.List(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	scores, err := app.store.Judging.ListScores(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	response := JudgingRankingsResponse{
+		Rankings: computeRankings(projects, scores, config.Rubric, config.Tracks),
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+const testJudgingID = "6f1c2a9e-3b4d-4e5f-8a7b-9c0d1e2f3a4b"
+
+func newJudgingScoreRequest(t *testing.T, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req = setUserContext(req, newAdminUser())
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("judgingID", testJudgingID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestSubmitJudgingScores(t *testing.T) {
+	t.Run("should record scores that match the rubric", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockJudging := app.store.Judging.(*store.MockJudgingStore)
+		admin := newAdminUser()
+
+		scores := map[string]int{"impact": 8, "polish": 5}
+		mockSettings.On("GetJudgingConfig").Return(newTestJudgingConfig(), nil).Once()
+		mockJudging.On("SubmitScores", testJudgingID, admin.ID, scores, (*string)(nil)).
+			Return(&store.ProjectJudging{ID: testJudgingID, Scores: scores}, nil).Once()
+
+		rr := executeRequest(newJudgingScoreRequest(t, `{"scores":{"impact":8,"polish":5}}`), http.HandlerFunc(app.submitJudgingScores))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockJudging.AssertExpectations(t)
+	})
+
+	for name, body := range map[string]string{
+		"missing criterion": `{"scores":{"impact":8}}`,
+		"out of range":      `{"scores":{"impact":11,"polish":5}}`,
+		"unknown criterion": `{"scores":{"impact":8,"polish":5,"vibes":3}}`,
+	} {
+		t.Run("should reject "+name, func(t *testing.T) {
+			app := newTestApplication(t)
+			mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+			mockSettings.On("GetJudgingConfig").Return(newTestJudgingConfig(), nil).Once()
+
+			rr := executeRequest(newJudgingScoreRequest(t, body), http.HandlerFunc(app.submitJudgingScores))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+
+	t.Run("should return 404 for another judge's assignment", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockJudging := app.store.Judging.(*store.MockJudgingStore)
+		admin := newAdminUser()
+
+		scores := map[string]int{"impact": 8, "polish": 5}
+		mockSettings.On("GetJudgingConfig").Return(newTestJudgingConfig(), nil).Once()
+		mockJudging.On("SubmitScores", testJudgingID, admin.ID, scores, (*string)(nil)).Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newJudgingScoreRequest(t, `{"scores":{"impact":8,"polish":5}}`), http.HandlerFunc(app.submitJudgingScores))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestBatchAssignJudges(t *testing.T) {
+	app := newTestApplication(t)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+	mockJudging := app.store.Judging.(*store.MockJudgingStore)
+
+	config := newTestJudgingConfig()
+	config.JudgesPerProject = 2
+	mockSettings.On("GetJudgingConfig").Return(config, nil).Once()
+	mockJudging.On("BatchAssign", 2).Return(&store.JudgingAssignmentResult{AssignmentsCreated: 6}, nil).Once()
+
+	req, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newSuperAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.batchAssignJudges))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"data":{"assignments_created":6}}`, rr.Body.String())
+
+	mockJudging.AssertExpectations(t)
+}
+
+func TestComputeRankings(t *testing.T) {
+	rubric := []store.RubricCriterion{{ID: "impact", Label: "Impact", MaxScore: 10, Weight: 1}}
+	projects := []store.Project{
+		{ID: "p1", Title: "Alpha", Tracks: store.StringArray{"Hardware"}},
+		{ID: "p2", Title: "Bravo", Tracks: store.StringArray{"Hardware", "Design"}},
+		{ID: "p3", Title: "Charlie"},
+		{ID: "p4", Title: "Unscored", Tracks: store.StringArray{"Design"}},
+	}
+
+	// The harsh judge scores low across the board, the lenient one high. Both
+	// prefer Bravo to Alpha, and only the harsh judge saw Charlie.
+	scores := []store.JudgingScore{
+		{ProjectID: "p1", JudgeID: "harsh", Scores: map[string]int{"impact": 2}},
+		{ProjectID: "p2", JudgeID: "harsh", Scores: map[string]int{"impact": 4}},
+		{ProjectID: "p3", JudgeID: "harsh", Scores: map[string]int{"impact": 3}},
+		{ProjectID: "p1", JudgeID: "lenient", Scores: map[string]int{"impact": 8}},
+		{ProjectID: "p2", JudgeID: "lenient", Scores: map[string]int{"impact": 10}},
+		{ProjectID: "p1", JudgeID: "stale", Scores: map[string]int{"removed": 10}},
+	}
+
+	rankings := computeRankings(projects, scores, rubric, []string{"Hardware", "Design"})
+	require.Len(t, rankings, 3)
+
+	overall := rankings[0]
+	assert.Equal(t, overallTrack, overall.Track)
+	require.Len(t, overall.Projects, 3)
+	assert.Equal(t, []string{"p2", "p3", "p1"}, []string{
+		overall.Projects[0].ProjectID, overall.Projects[1].ProjectID, overall.Projects[2].ProjectID,
+	})
+	assert.Equal(t, 1, overall.Projects[0].Rank)
+	assert.Equal(t, 2, overall.Projects[0].JudgeCount)
+	assert.InDelta(t, 0.7, overall.Projects[0].RawScore, 1e-9)
+
+	hardware := rankings[1]
+	assert.Equal(t, "Hardware", hardware.Track)
+	require.Len(t, hardware.Projects, 2)
+	assert.Equal(t, "p2", hardware.Projects[0].ProjectID)
+
+	design := rankings[2]
+	require.Len(t, design.Projects, 1)
+	assert.Equal(t, "p2", design.Projects[0].ProjectID)
+}
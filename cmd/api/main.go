@@ -14,6 +14,7 @@ import (
 	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/logger"
 	"github.com/hackutd/portal/internal/mailer"
+	"github.com/hackutd/portal/internal/qrtoken"
 	"github.com/hackutd/portal/internal/ratelimiter"
 	"github.com/hackutd/portal/internal/store"
 	"github.com/joho/godotenv"
@@ -73,7 +74,18 @@ func main() {
 			PortalURL:     frontendURL,
 		},
 		gcs: gcsConfig{
-			bucketName: env.GetString("GCS_BUCKET_NAME", ""),
+			backend:       env.GetString("STORAGE_BACKEND", ""),
+			bucketName:    env.GetString("GCS_BUCKET_NAME", ""),
+			localDir:      env.GetString("STORAGE_LOCAL_DIR", "./data/storage"),
+			signingSecret: env.GetString("STORAGE_SIGNING_SECRET", ""),
+			s3: gcs.S3Config{
+				Endpoint:        env.GetString("S3_ENDPOINT", "https://s3.amazonaws.com"),
+				Region:          env.GetString("S3_REGION", "us-east-1"),
+				Bucket:          env.GetString("S3_BUCKET", ""),
+				AccessKeyID:     env.GetString("S3_ACCESS_KEY_ID", ""),
+				SecretAccessKey: env.GetString("S3_SECRET_ACCESS_KEY", ""),
+				UsePathStyle:    env.GetBool("S3_USE_PATH_STYLE", false),
+			},
 		},
 		auth: authConfig{
 			basic: basicConfig{
@@ -113,6 +125,10 @@ func main() {
 			wwdrCertificateBase64: env.GetString("APPLE_WALLET_WWDR_CERTIFICATE_BASE64", ""),
 			iconPath:              env.GetString("APPLE_WALLET_ICON_PATH", "client/web/public/pwa-192x192.png"),
 		},
+		qrToken: qrTokenConfig{
+			secret: env.GetRequiredString("QR_SIGNING_SECRET"),
+			ttl:    time.Duration(env.GetInt("QR_TOKEN_TTL_HOURS", 0)) * time.Hour,
+		},
 	}
 
 	// Init Logger
@@ -180,17 +196,43 @@ func main() {
 		return mailer.Identity{HackathonName: name, FromEmail: fromEmail, FromName: fromName}
 	})
 
-	// Init GCS (optional in local/dev)
+	// Init object storage (optional in local/dev). A bare GCS_BUCKET_NAME
+	// still selects GCS so existing deployments need no new config.
+	storageBackend := cfg.gcs.backend
+	if storageBackend == "" && cfg.gcs.bucketName != "" {
+		storageBackend = "gcs"
+	}
+
 	var gcsClient gcs.Client
-	if cfg.gcs.bucketName != "" {
+	switch storageBackend {
+	case "":
+		logger.Warn("object storage is not configured; resume uploads are disabled")
+	case "gcs":
 		gc, err := gcs.New(context.Background(), cfg.gcs.bucketName)
 		if err != nil {
 			logger.Fatal("failed to initialize gcs client", zap.Error(err))
 		}
-		defer gc.Close()
-
 		gcsClient = gc
 		logger.Infow("gcs client initialized", "bucket", cfg.gcs.bucketName)
+	case "local":
+		lc, err := gcs.NewLocal(cfg.gcs.localDir, cfg.appURL+storagePathPrefix, []byte(cfg.gcs.signingSecret))
+		if err != nil {
+			logger.Fatal("failed to initialize local storage", zap.Error(err))
+		}
+		gcsClient = lc
+		logger.Infow("local storage initialized", "dir", cfg.gcs.localDir)
+	case "s3":
+		sc, err := gcs.NewS3(cfg.gcs.s3)
+		if err != nil {
+			logger.Fatal("failed to initialize s3 client", zap.Error(err))
+		}
+		gcsClient = sc
+		logger.Infow("s3 client initialized", "endpoint", cfg.gcs.s3.Endpoint, "bucket", cfg.gcs.s3.Bucket)
+	default:
+		logger.Fatalw("unknown STORAGE_BACKEND", "backend", storageBackend)
+	}
+	if gcsClient != nil {
+		defer gcsClient.Close()
 	}
 
 	// Init rate limiter
@@ -209,6 +251,13 @@ func main() {
 		logger.Info("Apple Wallet pass generation enabled")
 	}
 
+	// QR codes carry HMAC-signed tokens rather than bare user IDs, so the
+	// scanner can reject guessed IDs and passes from a previous hackathon.
+	qrTokens, err := qrtoken.New([]byte(cfg.qrToken.secret))
+	if err != nil {
+		logger.Fatal(err)
+	}
+
 	// Init app
 	app := &application{
 		config:            cfg,
@@ -217,6 +266,7 @@ func main() {
 		mailer:            mailClient,
 		gcsClient:         gcsClient,
 		appleWalletPasses: appleWalletPasses,
+		qrTokens:          qrTokens,
 		rateLimiter:       rateLimiter,
 	}
 
@@ -229,11 +279,20 @@ func main() {
 		return runtime.NumGoroutine()
 	}))
 
+	// A restart cuts off any duplicate scan that was running in this
+	// process; fail it so a new one can start.
+	if n, err := store.ApplicationFlags.FailInterruptedScans(context.Background()); err != nil {
+		logger.Errorw("failed to clear interrupted duplicate scans", "error", err)
+	} else if n > 0 {
+		logger.Warnw("marked interrupted duplicate scans failed", "count", n)
+	}
+
 	mux := app.mount()
 
 	dispatcherCtx, cancelDispatcher := context.WithCancel(context.Background())
 	app.dispatcherCancel = cancelDispatcher
 	go app.runNotificationDispatcher(dispatcherCtx)
+	go app.runRSVPSweeper(dispatcherCtx)
 
 	log.Fatal(app.run(mux))
 }
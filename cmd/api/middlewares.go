@@ -204,7 +204,7 @@ func (app *application) AdminScheduleEditPermissionMiddleware(next http.Handler)
 	})
 }
 
-// Checks whether the applications feature is enabled. If not, blocks access to all application-related endpoints for non-super-admins.
+// Checks whether applications are open to the user, following the applications toggle and the application window. If not, blocks access to all application-related endpoints for non-super-admins.
 func (app *application) ApplicationsEnabledMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		user := getUserFromContext(r.Context())
@@ -218,13 +218,13 @@ func (app *application) ApplicationsEnabledMiddleware(next http.Handler) http.Ha
 			return
 		}
 
-		enabled, err := app.store.Settings.GetApplicationsEnabled(r.Context())
+		availability, err := app.applicationAvailabilityFor(r, user)
 		if err != nil {
 			app.internalServerError(w, r, err)
 			return
 		}
 
-		if !enabled {
+		if !availability.Enabled {
 			app.forbiddenResponse(w, r, fmt.Errorf("applications are currently closed"))
 			return
 		}
@@ -264,6 +264,7 @@ func TestApplicationsEnabledMiddleware(t *testing.T) {
 
 	t.Run("should return 403 when applications are disabled", func(t *testing.T) {
 		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationsEnabled", mock.Anything).Return(false, nil).Once()
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationWindow").Return(store.ApplicationWindow{Timezone: "UTC"}, nil).Once()
 
 		handler := app.ApplicationsEnabledMiddleware(ok)
 
@@ -278,6 +279,7 @@ func TestApplicationsEnabledMiddleware(t *testing.T) {
 
 	t.Run("should allow request when applications are enabled", func(t *testing.T) {
 		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationsEnabled", mock.Anything).Return(true, nil).Once()
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationWindow").Return(store.ApplicationWindow{Timezone: "UTC"}, nil).Once()
 
 		handler := app.ApplicationsEnabledMiddleware(ok)
 
@@ -290,6 +292,41 @@ func TestApplicationsEnabledMiddleware(t *testing.T) {
 		checkResponseCode(t, http.StatusOK, rr.Code)
 	})
 
+	t.Run("should return 403 once the window has closed", func(t *testing.T) {
+		closed := time.Now().Add(-time.Hour)
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationsEnabled", mock.Anything).Return(true, nil).Once()
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationWindow").Return(store.ApplicationWindow{ClosesAt: &closed, Timezone: "UTC"}, nil).Once()
+
+		handler := app.ApplicationsEnabledMiddleware(ok)
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, handler)
+		checkResponseCode(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should allow a draft started before the close through the grace period", func(t *testing.T) {
+		user := newTestUser()
+		closed := time.Now().Add(-10 * time.Minute)
+		draft := &store.Application{ID: "app-1", UserID: user.ID, Status: store.StatusDraft, CreatedAt: closed.Add(-24 * time.Hour)}
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationsEnabled", mock.Anything).Return(true, nil).Once()
+		app.store.Settings.(*store.MockSettingsStore).On("GetApplicationWindow").Return(store.ApplicationWindow{ClosesAt: &closed, Timezone: "UTC", GraceMinutes: 30}, nil).Once()
+		app.store.Application.(*store.MockApplicationStore).On("GetByUserID", user.ID).Return(draft, nil).Once()
+
+		handler := app.ApplicationsEnabledMiddleware(ok)
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		req = setUserContext(req, user)
+
+		rr := executeRequest(req, handler)
+		checkResponseCode(t, http.StatusOK, rr.Code)
+	})
+
 	t.Run("should always allow super admin through", func(t *testing.T) {
 		handler := app.ApplicationsEnabledMiddleware(ok)
 
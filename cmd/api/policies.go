@@ -0,0 +1,641 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+)
+
+// ageOfMajority is the age below which a parent or guardian has to sign
+// alongside the hacker.
+const ageOfMajority = 18
+
+// maxUserAgentLength bounds the User-Agent kept with a signature.
+const maxUserAgentLength = 512
+
+type PolicyPayload struct {
+	Title        string `json:"title" validate:"required,min=1,max=200"`
+	Body         string `json:"body" validate:"required,min=1"`
+	Required     bool   `json:"required"`
+	DisplayOrder int    `json:"display_order" validate:"min=0"`
+}
+
+type PolicyListResponse struct {
+	Policies []store.Policy `json:"policies"`
+}
+
+type PolicyVersionsResponse struct {
+	Versions []store.PolicyVersion `json:"versions"`
+}
+
+type PolicySignersResponse struct {
+	Policy  store.Policy         `json:"policy"`
+	Signers []store.PolicySigner `json:"signers"`
+}
+
+// MyPoliciesResponse lists the policies for the hacker to sign.
+// GuardianRequired is set for minors, whose signatures need a guardian's
+// name and email and only count once the guardian confirms them.
+type MyPoliciesResponse struct {
+	Policies         []store.UserPolicy `json:"policies"`
+	GuardianRequired bool               `json:"guardian_required"`
+}
+
+type SignPolicyPayload struct {
+	Version       int    `json:"version" validate:"required,min=1"`
+	SignedName    string `json:"signed_name" validate:"required,min=1,max=200"`
+	GuardianName  string `json:"guardian_name" validate:"max=200"`
+	GuardianEmail string `json:"guardian_email" validate:"omitempty,email,max=320"`
+}
+
+// listPoliciesHandler returns the active hackathon's policies (Admin)
+//
+//	@Summary		List policies (Admin)
+//	@Description	Returns the policies hackers agree to, each at its latest version, in display order
+//	@Tags			admin/policies
+//	@Produce		json
+//	@Success		200	{object}	PolicyListResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/policies [get]
+func (app *application) listPoliciesHandler(w http.ResponseWriter, r *http.Request) {
+	policies, err := app.store.Policies.List(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, PolicyListResponse{Policies: policies}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getPolicySignersHandler reports who has signed a policy (Admin)
+//
+//	@Summary		Get policy signatures (Admin)
+//	@Description	Lists every accepted applicant, signed or not, plus anyone else who signed the policy, with the newest version each signed. current is false for signatures of an older version. Send format=csv for a spreadsheet download.
+//	@Tags			admin/policies
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			policyID	path		string	true	"Policy ID"
+//	@Param			format		query		string	false	"Response format"	Enums(json, csv)
+//	@Success		200			{object}	PolicySignersResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/policies/{policyID}/signatures [get]
+func (app *application) getPolicySignersHandler(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "policyID")
+	if id == "" {
+		app.badRequestResponse(w, r, errors.New("missing policy ID"))
+		return
+	}
+
+	format, err := analyticsFormat(r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	policy, err := app.store.Policies.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("policy not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	signers, err := app.store.Policies.Signers(r.Context(), id)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if format == "csv" {
+		rows := [][]string{{"email", "first_name", "last_name", "status", "version", "current", "signed_name", "guardian_name", "guardian_email", "guardian_confirmed_at", "ip_address", "signed_at"}}
+		for _, s := range signers {
+			var version, guardianConfirmedAt, signedAt string
+			if s.Version != nil {
+				version = strconv.Itoa(*s.Version)
+			}
+			if s.GuardianConfirmedAt != nil {
+				guardianConfirmedAt = s.GuardianConfirmedAt.UTC().Format(time.RFC3339)
+			}
+			if s.SignedAt != nil {
+				signedAt = s.SignedAt.UTC().Format(time.RFC3339)
+			}
+			rows = append(rows, []string{
+				s.Email, derefString(s.FirstName), derefString(s.LastName), string(s.Status),
+				version, strconv.FormatBool(s.Current), derefString(s.SignedName),
+				derefString(s.GuardianName), derefString(s.GuardianEmail), guardianConfirmedAt, derefString(s.IPAddress), signedAt,
+			})
+		}
+		app.writeAnalyticsCSV(w, r, "policy-signatures", rows)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, PolicySignersResponse{Policy: *policy, Signers: signers}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// createPolicyHandler creates a policy (Super Admin)
+//
+//	@Summary		Create policy (Super Admin)
+//	@Description	Creates a policy for hackers to sign. Its body becomes version 1. Required policies must be signed before check-in.
+//	@Tags			superadmin/policies
+//	@Accept			json
+//	@Produce		json
+//	@Param			policy	body		PolicyPayload	true	"Policy to create"
+//	@Success		201		{object}	store.Policy
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/policies [post]
+func (app *application) createPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("user not in context"))
+		return
+	}
+
+	var payload PolicyPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	policy := &store.Policy{
+		Title:        strings.TrimSpace(payload.Title),
+		Body:         payload.Body,
+		Required:     payload.Required,
+		DisplayOrder: payload.DisplayOrder,
+	}
+
+	if err := app.store.Policies.Create(r.Context(), policy, user.ID); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionPolicyCreate, store.AuditTargetPolicy, policy.ID, nil, policy)
+
+	w.Header().Set("ETag", etagFor(policy.UpdatedAt))
+	if err := app.jsonResponse(w, http.StatusCreated, policy); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// updatePolicyHandler updates a policy (Super Admin)
+//
+//	@Summary		Update policy (Super Admin)
+//	@Description	Updates a policy. A changed body is saved as a new version, and hackers who signed an earlier one must sign again before check-in. An unchanged body keeps the current version and its signatures.
+//	@Tags			superadmin/policies
+//	@Accept			json
+//	@Produce		json
+//	@Param			policyID	path		string			true	"Policy ID"
+//	@Param			policy		body		PolicyPayload	true	"Policy updates"
+//	@Param			If-Match	header		string			true	"ETag of the policy being edited (its quoted updated_at)"
+//	@Success		200			{object}	store.Policy
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		409			{object}	object{error=string,data=store.Policy}	"Policy changed since it was loaded; data is the current copy"
+//	@Failure		428			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/policies/{policyID} [put]
+func (app *application) updatePolicyHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("user not in context"))
+		return
+	}
+
+	id := chi.URLParam(r, "policyID")
+	if id == "" {
+		app.badRequestResponse(w, r, errors.New("missing policy ID"))
+		return
+	}
+
+	loadedAt, ok := app.requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var payload PolicyPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	policy := &store.Policy{
+		ID:           id,
+		Title:        strings.TrimSpace(payload.Title),
+		Body:         payload.Body,
+		Required:     payload.Required,
+		DisplayOrder: payload.DisplayOrder,
+		UpdatedAt:    loadedAt,
+	}
+
+	if err := app.store.Policies.Update(r.Context(), policy, user.ID); err != nil {
+		if errors.Is(err, store.ErrStale) {
+			var current *store.Policy
+			if current, err = app.store.Policies.GetByID(r.Context(), id); err == nil {
+				app.staleWriteResponse(w, r, current, current.UpdatedAt)
+				return
+			}
+		}
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("policy not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionPolicyUpdate, store.AuditTargetPolicy, policy.ID, nil, policy)
+
+	w.Header().Set("ETag", etagFor(policy.UpdatedAt))
+	if err := app.jsonResponse(w, http.StatusOK, policy); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// deletePolicyHandler deletes a policy (Super Admin)
+//
+//	@Summary		Delete policy (Super Admin)
+//	@Description	Deletes a policy nobody has signed. Once signed, a policy is kept so the signatures keep the text they agreed to; mark it not required instead.
+//	@Tags			superadmin/policies
+//	@Param			policyID	path	string	true	"Policy ID"
+//	@Success		204
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		404	{object}	object{error=string}
+//	@Failure		409	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/policies/{policyID} [delete]
+func (app *application) deletePolicyHandler(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "policyID")
+	if id == "" {
+		app.badRequestResponse(w, r, errors.New("missing policy ID"))
+		return
+	}
+
+	if err := app.store.Policies.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("policy not found"))
+			return
+		}
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, errors.New("policy has signatures and cannot be deleted"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionPolicyDelete, store.AuditTargetPolicy, id, nil, nil)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listPolicyVersionsHandler returns a policy's version history (Super Admin)
+//
+//	@Summary		List policy versions (Super Admin)
+//	@Description	Returns every version of a policy's text, newest first
+//	@Tags			superadmin/policies
+//	@Produce		json
+//	@Param			policyID	path		string	true	"Policy ID"
+//	@Success		200			{object}	PolicyVersionsResponse
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/policies/{policyID}/versions [get]
+func (app *application) listPolicyVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "policyID")
+	if id == "" {
+		app.badRequestResponse(w, r, errors.New("missing policy ID"))
+		return
+	}
+
+	if _, err := app.store.Policies.GetByID(r.Context(), id); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("policy not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	versions, err := app.store.Policies.ListVersions(r.Context(), id)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, PolicyVersionsResponse{Versions: versions}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getMyPoliciesHandler returns the policies for the hacker to sign
+//
+//	@Summary		Get my policies
+//	@Description	Returns the active hackathon's policies at their latest version, each with the newest version the authenticated user signed. guardian_required is set when the application's age is under 18.
+//	@Tags			hackers
+//	@Produce		json
+//	@Success		200	{object}	MyPoliciesResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/policies [get]
+func (app *application) getMyPoliciesHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	minor, err := app.isMinor(r, user.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	policies, err := app.store.Policies.ListForUser(r.Context(), user.ID, minor)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, MyPoliciesResponse{Policies: policies, GuardianRequired: minor}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// signPolicyHandler records the hacker's signature of a policy
+//
+//	@Summary		Sign a policy
+//	@Description	Signs the given version of a policy with a typed name; the time, IP address and user agent are recorded with it. version must be the policy's latest, so nobody signs text they weren't shown. Minors must also give a parent or guardian's name and email; the guardian is emailed a confirmation link and the signature stays pending until they follow it. Signing again before then replaces the pending signature and sends a new link. Adults' guardian fields are ignored.
+//	@Tags			hackers
+//	@Accept			json
+//	@Produce		json
+//	@Param			policyID	path		string				true	"Policy ID"
+//	@Param			signature	body		SignPolicyPayload	true	"Signature"
+//	@Success		201			{object}	store.PolicySignature
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		409			{object}	object{error=string}	"Already signed, or a newer version was published"
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/policies/{policyID}/sign [post]
+func (app *application) signPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	id := chi.URLParam(r, "policyID")
+	if id == "" {
+		app.badRequestResponse(w, r, errors.New("missing policy ID"))
+		return
+	}
+
+	var payload SignPolicyPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+	payload.SignedName = strings.TrimSpace(payload.SignedName)
+	payload.GuardianName = strings.TrimSpace(payload.GuardianName)
+	payload.GuardianEmail = strings.TrimSpace(payload.GuardianEmail)
+
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	minor, err := app.isMinor(r, user.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	sig := &store.PolicySignature{
+		PolicyID:   id,
+		Version:    payload.Version,
+		UserID:     user.ID,
+		SignedName: payload.SignedName,
+		IPAddress:  clientIP(r),
+		UserAgent:  userAgent(r),
+	}
+	var policy *store.Policy
+	var guardianToken string
+	if minor {
+		if payload.GuardianName == "" || payload.GuardianEmail == "" {
+			app.badRequestResponse(w, r, errors.New("hackers under 18 need a parent or guardian's name and email"))
+			return
+		}
+		if strings.EqualFold(payload.GuardianEmail, user.Email) {
+			app.badRequestResponse(w, r, errors.New("the parent or guardian's email must be their own, not yours"))
+			return
+		}
+
+		// The guardian's email needs the title, and a missing policy is
+		// reported the same way Sign would.
+		policy, err = app.store.Policies.GetByID(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				app.notFoundResponse(w, r, errors.New("policy not found"))
+				return
+			}
+			app.internalServerError(w, r, err)
+			return
+		}
+
+		guardianToken, err = randomHex(32)
+		if err != nil {
+			app.internalServerError(w, r, err)
+			return
+		}
+		tokenHash := hashGuardianToken(guardianToken)
+		sig.GuardianName = &payload.GuardianName
+		sig.GuardianEmail = &payload.GuardianEmail
+		sig.GuardianTokenHash = &tokenHash
+	}
+
+	if err := app.store.Policies.Sign(r.Context(), sig); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			app.notFoundResponse(w, r, errors.New("policy not found"))
+		case errors.Is(err, store.ErrStale):
+			app.conflictResponse(w, r, fmt.Errorf("version %d is no longer the latest; review the policy and sign again", payload.Version))
+		case errors.Is(err, store.ErrConflict):
+			app.conflictResponse(w, r, errors.New("policy already signed"))
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	if minor {
+		go func() {
+			if err := app.mailer.SendGuardianConsentEmail(payload.GuardianEmail, payload.GuardianName, payload.SignedName, policy.Title, guardianToken); err != nil {
+				app.logger.Errorw("failed to send guardian consent email", "signature_id", sig.ID, "error", err)
+			}
+		}()
+	}
+
+	if err := app.jsonResponse(w, http.StatusCreated, sig); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// hashGuardianToken returns the hex SHA-256 of a guardian confirmation token,
+// the form it is stored and looked up in.
+func hashGuardianToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// getGuardianConsentHandler shows a guardian what they are asked to confirm
+//
+//	@Summary		Get a guardian confirmation
+//	@Description	Returns the policy text a minor signed and the names on the signature, for the parent or guardian who received the confirmation link. The token from the link is the only credential. confirmed_at is set once the guardian has confirmed.
+//	@Tags			guardians
+//	@Produce		json
+//	@Param			token	path		string	true	"Token from the confirmation link"
+//	@Success		200		{object}	store.GuardianConsent
+//	@Failure		404		{object}	object{error=string}	"Unknown link, or replaced by a newer signature"
+//	@Failure		500		{object}	object{error=string}
+//	@Router			/guardian-consent/{token} [get]
+func (app *application) getGuardianConsentHandler(w http.ResponseWriter, r *http.Request) {
+	consent, err := app.store.Policies.GuardianConsent(r.Context(), hashGuardianToken(chi.URLParam(r, "token")))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("this confirmation link is no longer valid"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, consent); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// confirmGuardianConsentHandler records a guardian agreeing to a minor's
+// signature
+//
+//	@Summary		Confirm a minor's signature
+//	@Description	Records the parent or guardian agreeing to the policy the minor signed, with the time and their IP address. From then on the signature counts toward check-in.
+//	@Tags			guardians
+//	@Produce		json
+//	@Param			token	path		string	true	"Token from the confirmation link"
+//	@Success		200		{object}	store.GuardianConsent
+//	@Failure		404		{object}	object{error=string}	"Unknown link, or replaced by a newer signature"
+//	@Failure		409		{object}	object{error=string}	"Already confirmed"
+//	@Failure		500		{object}	object{error=string}
+//	@Router			/guardian-consent/{token} [post]
+func (app *application) confirmGuardianConsentHandler(w http.ResponseWriter, r *http.Request) {
+	consent, err := app.store.Policies.ConfirmGuardian(r.Context(), hashGuardianToken(chi.URLParam(r, "token")), clientIP(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			app.notFoundResponse(w, r, errors.New("this confirmation link is no longer valid"))
+		case errors.Is(err, store.ErrConflict):
+			app.conflictResponse(w, r, errors.New("already confirmed"))
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, consent); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// isMinor reports whether the user's application gives an age under
+// ageOfMajority. Without an application or a usable age they are treated as
+// an adult; check-in looks again once the application is final.
+func (app *application) isMinor(r *http.Request, userID string) (bool, error) {
+	application, err := app.store.Application.GetByUserID(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return applicantIsMinor(application), nil
+}
+
+// applicantIsMinor reports whether application gives an age under
+// ageOfMajority. A missing or unusable age counts as an adult.
+func applicantIsMinor(application *store.Application) bool {
+	var responses struct {
+		Age json.Number `json:"age"`
+	}
+	if len(application.Responses) == 0 || json.Unmarshal(application.Responses, &responses) != nil {
+		return false
+	}
+	age, err := responses.Age.Float64()
+	if err != nil {
+		return false
+	}
+	return age < ageOfMajority
+}
+
+// userAgent returns the request's User-Agent, cut to maxUserAgentLength.
+func userAgent(r *http.Request) string {
+	ua := r.UserAgent()
+	if len(ua) > maxUserAgentLength {
+		ua = strings.ToValidUTF8(ua[:maxUserAgentLength], "")
+	}
+	return ua
+}
+
+// clientIP returns the address the request came from, without the port.
+// middleware.RealIP has already applied any proxy headers.
+func clientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
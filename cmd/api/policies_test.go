@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/mailer"
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func withPolicyRouteParam(req *http.Request, policyID string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("policyID", policyID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func newTestPolicy(id string) store.Policy {
+	return store.Policy{
+		ID:        id,
+		Title:     "Code of Conduct",
+		Body:      "Be excellent to each other.",
+		Version:   2,
+		Required:  true,
+		CreatedAt: time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2026, 9, 2, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestSignPolicy(t *testing.T) {
+	newRequest := func(t *testing.T, user *store.User, body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "test-browser")
+		req.RemoteAddr = "203.0.113.7:51234"
+		return withPolicyRouteParam(setUserContext(req, user), "policy-1")
+	}
+
+	t.Run("should record the signer's name, IP and user agent", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+		user := newTestUser()
+
+		mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID, Responses: json.RawMessage(`{"age": 21}`)}, nil).Once()
+		mockPolicies.On("Sign", mock.MatchedBy(func(s *store.PolicySignature) bool {
+			return s.PolicyID == "policy-1" && s.Version == 2 && s.UserID == user.ID &&
+				s.SignedName == "Ada Lovelace" && s.IPAddress == "203.0.113.7" &&
+				s.UserAgent == "test-browser" && s.GuardianName == nil && s.GuardianEmail == nil
+		})).Return(nil).Once()
+
+		body := `{"version": 2, "signed_name": "  Ada Lovelace ", "guardian_name": "Ignored", "guardian_email": "ignored@example.com"}`
+		rr := executeRequest(newRequest(t, user, body), http.HandlerFunc(app.signPolicyHandler))
+		checkResponseCode(t, http.StatusCreated, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("should require a guardian for minors", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+		user := newTestUser()
+
+		mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID, Responses: json.RawMessage(`{"age": 16}`)}, nil).Once()
+
+		rr := executeRequest(newRequest(t, user, `{"version": 2, "signed_name": "Ada Lovelace"}`), http.HandlerFunc(app.signPolicyHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+
+		mockPolicies.AssertNotCalled(t, "Sign", mock.Anything)
+	})
+
+	t.Run("should store a minor's signature as pending and email the guardian", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+		mockMailer := app.mailer.(*mailer.MockClient)
+		user := newTestUser()
+		policy := newTestPolicy("policy-1")
+
+		var storedHash string
+		mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID, Responses: json.RawMessage(`{"age": 16}`)}, nil).Once()
+		mockPolicies.On("GetByID", "policy-1").Return(&policy, nil).Once()
+		mockPolicies.On("Sign", mock.MatchedBy(func(s *store.PolicySignature) bool {
+			return s.GuardianName != nil && *s.GuardianName == "Anne Byron" &&
+				s.GuardianEmail != nil && *s.GuardianEmail == "anne@example.com" &&
+				s.GuardianTokenHash != nil && s.GuardianConfirmedAt == nil
+		})).Run(func(args mock.Arguments) {
+			storedHash = *args.Get(0).(*store.PolicySignature).GuardianTokenHash
+		}).Return(nil).Once()
+
+		emailed := make(chan string, 1)
+		mockMailer.On("SendGuardianConsentEmail", "anne@example.com", "Anne Byron", "Ada Lovelace", "Code of Conduct", mock.Anything).
+			Run(func(args mock.Arguments) { emailed <- args.String(4) }).Return(nil).Once()
+
+		body := `{"version": 2, "signed_name": "Ada Lovelace", "guardian_name": "Anne Byron", "guardian_email": "anne@example.com"}`
+		rr := executeRequest(newRequest(t, user, body), http.HandlerFunc(app.signPolicyHandler))
+		checkResponseCode(t, http.StatusCreated, rr.Code)
+
+		select {
+		case token := <-emailed:
+			// Only the hash is stored; the emailed token must match it.
+			assert.NotEqual(t, storedHash, token)
+			assert.Equal(t, storedHash, hashGuardianToken(token))
+		case <-time.After(time.Second):
+			t.Fatal("guardian email was not sent")
+		}
+
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("should refuse the minor's own email as the guardian's", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+		user := newTestUser()
+
+		mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID, Responses: json.RawMessage(`{"age": 16}`)}, nil).Once()
+
+		body := `{"version": 2, "signed_name": "Ada Lovelace", "guardian_name": "Anne Byron", "guardian_email": "` + strings.ToUpper(user.Email) + `"}`
+		rr := executeRequest(newRequest(t, user, body), http.HandlerFunc(app.signPolicyHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+
+		mockPolicies.AssertNotCalled(t, "Sign", mock.Anything)
+	})
+
+	t.Run("should return 409 for an outdated version", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+		user := newTestUser()
+
+		mockApps.On("GetByUserID", user.ID).Return(nil, store.ErrNotFound).Once()
+		mockPolicies.On("Sign", mock.Anything).Return(store.ErrStale).Once()
+
+		rr := executeRequest(newRequest(t, user, `{"version": 1, "signed_name": "Ada Lovelace"}`), http.HandlerFunc(app.signPolicyHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 without a typed name", func(t *testing.T) {
+		app := newTestApplication(t)
+		user := newTestUser()
+
+		rr := executeRequest(newRequest(t, user, `{"version": 2, "signed_name": "   "}`), http.HandlerFunc(app.signPolicyHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGuardianConsent(t *testing.T) {
+	newRequest := func(t *testing.T, method, token string) *http.Request {
+		req, err := http.NewRequest(method, "/", nil)
+		require.NoError(t, err)
+		req.RemoteAddr = "198.51.100.4:40000"
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("token", token)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+	consent := &store.GuardianConsent{
+		SignatureID:  "sig-1",
+		PolicyTitle:  "Code of Conduct",
+		PolicyBody:   "Be excellent to each other.",
+		Version:      2,
+		SignedName:   "Ada Lovelace",
+		GuardianName: "Anne Byron",
+	}
+
+	t.Run("should look the link up by the token's hash", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+		mockPolicies.On("GuardianConsent", hashGuardianToken("tok")).Return(consent, nil).Once()
+
+		rr := executeRequest(newRequest(t, http.MethodGet, "tok"), http.HandlerFunc(app.getGuardianConsentHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Be excellent to each other.")
+
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 for an unknown link", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+		mockPolicies.On("GuardianConsent", hashGuardianToken("bogus")).Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newRequest(t, http.MethodGet, "bogus"), http.HandlerFunc(app.getGuardianConsentHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should confirm with the guardian's IP", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+		mockPolicies.On("ConfirmGuardian", hashGuardianToken("tok"), "198.51.100.4").Return(consent, nil).Once()
+
+		rr := executeRequest(newRequest(t, http.MethodPost, "tok"), http.HandlerFunc(app.confirmGuardianConsentHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 when already confirmed", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+		mockPolicies.On("ConfirmGuardian", hashGuardianToken("tok"), "198.51.100.4").Return(nil, store.ErrConflict).Once()
+
+		rr := executeRequest(newRequest(t, http.MethodPost, "tok"), http.HandlerFunc(app.confirmGuardianConsentHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestGetMyPolicies(t *testing.T) {
+	app := newTestApplication(t)
+	mockApps := app.store.Application.(*store.MockApplicationStore)
+	mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+	user := newTestUser()
+
+	policy := newTestPolicy("policy-1")
+	mockApps.On("GetByUserID", user.ID).Return(&store.Application{ID: "app-1", UserID: user.ID, Responses: json.RawMessage(`{"age": "17"}`)}, nil).Once()
+	mockPolicies.On("ListForUser", user.ID, true).Return([]store.UserPolicy{{Policy: policy}}, nil).Once()
+
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, user)
+
+	rr := executeRequest(req, http.HandlerFunc(app.getMyPoliciesHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+
+	var body struct {
+		Data MyPoliciesResponse `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.True(t, body.Data.GuardianRequired)
+	require.Len(t, body.Data.Policies, 1)
+	assert.Nil(t, body.Data.Policies[0].Signature)
+
+	mockApps.AssertExpectations(t)
+	mockPolicies.AssertExpectations(t)
+}
+
+func TestUpdatePolicy(t *testing.T) {
+	newRequest := func(t *testing.T, loadedAt time.Time) *http.Request {
+		body := `{"title": "Code of Conduct", "body": "Be kind.", "required": true, "display_order": 0}`
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", etagFor(loadedAt))
+		return withPolicyRouteParam(setUserContext(req, newSuperAdminUser()), "policy-1")
+	}
+
+	t.Run("should save and audit the update", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+		existing := newTestPolicy("policy-1")
+
+		mockPolicies.On("Update", mock.MatchedBy(func(p *store.Policy) bool {
+			return p.ID == "policy-1" && p.Body == "Be kind." && p.UpdatedAt.Equal(existing.UpdatedAt)
+		}), "superadmin-1").Return(nil).Once()
+
+		rr := executeRequest(newRequest(t, existing.UpdatedAt), http.HandlerFunc(app.updatePolicyHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Equal(t, store.AuditActionPolicyUpdate, events[0].Action)
+		assert.Equal(t, "policy-1", events[0].TargetID)
+
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("should return the current copy when stale", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+		current := newTestPolicy("policy-1")
+
+		mockPolicies.On("Update", mock.Anything, "superadmin-1").Return(store.ErrStale).Once()
+		mockPolicies.On("GetByID", "policy-1").Return(&current, nil).Once()
+
+		rr := executeRequest(newRequest(t, current.UpdatedAt.Add(-time.Hour)), http.HandlerFunc(app.updatePolicyHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, etagFor(current.UpdatedAt), rr.Header().Get("ETag"))
+		assert.Empty(t, recordedAuditEvents(app))
+
+		mockPolicies.AssertExpectations(t)
+	})
+}
+
+func TestDeletePolicy(t *testing.T) {
+	app := newTestApplication(t)
+	mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+	t.Run("should refuse a signed policy", func(t *testing.T) {
+		mockPolicies.On("Delete", "policy-1").Return(store.ErrConflict).Once()
+
+		req, err := http.NewRequest(http.MethodDelete, "/", nil)
+		require.NoError(t, err)
+		req = withPolicyRouteParam(setUserContext(req, newSuperAdminUser()), "policy-1")
+
+		rr := executeRequest(req, http.HandlerFunc(app.deletePolicyHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+
+		mockPolicies.AssertExpectations(t)
+	})
+}
+
+func TestGetPolicySigners(t *testing.T) {
+	app := newTestApplication(t)
+	mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+	policy := newTestPolicy("policy-1")
+	version, name, ip := 1, "Ada Lovelace", "203.0.113.7"
+	signedAt := time.Date(2026, 9, 20, 17, 0, 0, 0, time.UTC)
+	signers := []store.PolicySigner{
+		{UserID: "user-1", Email: "ada@example.com", Status: store.StatusAccepted, Version: &version, SignedName: &name, IPAddress: &ip, SignedAt: &signedAt},
+		{UserID: "user-2", Email: "bob@example.com", Status: store.StatusAccepted},
+	}
+
+	t.Run("should export a CSV", func(t *testing.T) {
+		mockPolicies.On("GetByID", "policy-1").Return(&policy, nil).Once()
+		mockPolicies.On("Signers", "policy-1").Return(signers, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?format=csv", nil)
+		require.NoError(t, err)
+		req = withPolicyRouteParam(setUserContext(req, newAdminUser()), "policy-1")
+
+		rr := executeRequest(req, http.HandlerFunc(app.getPolicySignersHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		rows, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		assert.Equal(t, []string{"ada@example.com", "", "", "accepted", "1", "false", "Ada Lovelace", "", "", "", "203.0.113.7", "2026-09-20T17:00:00Z"}, rows[1])
+		assert.Equal(t, "", rows[2][4], "unsigned applicants have no version")
+
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 for an unknown policy", func(t *testing.T) {
+		mockPolicies.On("GetByID", "policy-1").Return(nil, store.ErrNotFound).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		req = withPolicyRouteParam(setUserContext(req, newAdminUser()), "policy-1")
+
+		rr := executeRequest(req, http.HandlerFunc(app.getPolicySignersHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+
+		mockPolicies.AssertExpectations(t)
+	})
+}
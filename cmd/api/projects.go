@@ -0,0 +1,221 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+type SaveProjectPayload struct {
+	Title       string   `json:"title" validate:"required,min=1,max=120"`
+	Description string   `json:"description" validate:"required,min=1,max=5000"`
+	RepoURL     *string  `json:"repo_url" validate:"omitempty,http_url,max=500"`
+	DemoURL     *string  `json:"demo_url" validate:"omitempty,http_url,max=500"`
+	Tracks      []string `json:"tracks" validate:"max=10,dive,required,max=100"`
+}
+
+type MyProjectResponse struct {
+	Project            *store.Project `json:"project"`
+	SubmissionDeadline *time.Time     `json:"submission_deadline"`
+	Tracks             []string       `json:"tracks"`
+	Editable           bool           `json:"editable"`
+}
+
+type ProjectListResponse struct {
+	Projects []store.Project `json:"projects"`
+}
+
+// projectsEditable reports whether submissions are still open under config
+func projectsEditable(config store.JudgingConfig, now time.Time) bool {
+	return config.SubmissionDeadline == nil || now.Before(*config.SubmissionDeadline)
+}
+
+// getMyProjectHandler returns the project submitted by the authenticated hacker's team
+//
+//	@Summary		Get my team's project
+//	@Description	Returns the project submitted by the hacker's team along with the submission deadline and the tracks projects can enter. project is null until the team submits.
+//	@Tags			projects
+//	@Produce		json
+//	@Success		200	{object}	MyProjectResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		404	{object}	object{error=string}	"Not on a team"
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/projects/me [get]
+func (app *application) getMyProjectHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	team, err := app.store.Teams.GetByUserID(r.Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("you are not on a team"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	project, err := app.store.Projects.GetByTeamID(r.Context(), team.ID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	config, err := app.store.Settings.GetJudgingConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	response := MyProjectResponse{
+		Project:            project,
+		SubmissionDeadline: config.SubmissionDeadline,
+		Tracks:             config.Tracks,
+		Editable:           projectsEditable(config, time.Now()),
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// saveMyProjectHandler creates or updates the authenticated hacker's team project
+//
+//	@Summary		Save my team's project
+//	@Description	Creates or replaces the team's project submission. Any team member may edit it until the submission deadline. Tracks must be among the configured judging tracks.
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			project	body		SaveProjectPayload	true	"Project submission"
+//	@Success		200		{object}	MyProjectResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}	"Submission deadline has passed"
+//	@Failure		404		{object}	object{error=string}	"Not on a team"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/projects/me [put]
+func (app *application) saveMyProjectHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	var req SaveProjectPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	req.Title = strings.TrimSpace(req.Title)
+	req.Description = strings.TrimSpace(req.Description)
+	req.RepoURL = trimOptional(req.RepoURL)
+	req.DemoURL = trimOptional(req.DemoURL)
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	config, err := app.store.Settings.GetJudgingConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if !projectsEditable(config, time.Now()) {
+		app.forbiddenResponse(w, r, errors.New("project submissions are closed"))
+		return
+	}
+
+	tracks := []string{}
+	for _, track := range req.Tracks {
+		if !slices.Contains(config.Tracks, track) {
+			app.badRequestResponse(w, r, fmt.Errorf("unknown track %q", track))
+			return
+		}
+		if !slices.Contains(tracks, track) {
+			tracks = append(tracks, track)
+		}
+	}
+
+	team, err := app.store.Teams.GetByUserID(r.Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("you are not on a team"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	project := &store.Project{
+		TeamID:      team.ID,
+		Title:       req.Title,
+		Description: req.Description,
+		RepoURL:     req.RepoURL,
+		DemoURL:     req.DemoURL,
+		Tracks:      tracks,
+		SubmittedBy: &user.ID,
+	}
+	if err := app.store.Projects.Upsert(r.Context(), project); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	response := MyProjectResponse{
+		Project:            project,
+		SubmissionDeadline: config.SubmissionDeadline,
+		Tracks:             config.Tracks,
+		Editable:           true,
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// listProjectsHandler lists every project submission
+//
+//	@Summary		List projects (Admin)
+//	@Description	Lists every project submission, most recently updated first
+//	@Tags			admin/judging
+//	@Produce		json
+//	@Success		200	{object}	ProjectListResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/projects [get]
+func (app *application) listProjectsHandler(w http.ResponseWriter, r *http.Request) {
+	projects, err := app.store.Projects.List(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ProjectListResponse{Projects: projects}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// trimOptional trims s and maps an empty result to nil
+func trimOptional(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+func newTestJudgingConfig() store.JudgingConfig {
+	return store.JudgingConfig{
+		Tracks: []string{"Best Hardware", "Best Design"},
+		Rubric: []store.RubricCriterion{
+			{ID: "impact", Label: "Impact", MaxScore: 10, Weight: 2},
+			{ID: "polish", Label: "Polish", MaxScore: 5, Weight: 1},
+		},
+		JudgesPerProject: 3,
+	}
+}
+
+func TestGetMyProject(t *testing.T) {
+	t.Run("should return the project, deadline and tracks", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockProjects := app.store.Projects.(*store.MockProjectsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		config := newTestJudgingConfig()
+		deadline := time.Now().Add(time.Hour)
+		config.SubmissionDeadline = &deadline
+
+		mockTeams.On("GetByUserID", "user-1").Return(newTestTeam(), nil).Once()
+		mockProjects.On("GetByTeamID", "team-1").Return(&store.Project{ID: "proj-1", TeamID: "team-1", Title: "Widget"}, nil).Once()
+		mockSettings.On("GetJudgingConfig").Return(config, nil).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodGet, ""), http.HandlerFunc(app.getMyProjectHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data MyProjectResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.NotNil(t, body.Data.Project)
+		assert.Equal(t, "Widget", body.Data.Project.Title)
+		assert.True(t, body.Data.Editable)
+		assert.Equal(t, config.Tracks, body.Data.Tracks)
+	})
+
+	t.Run("should return 404 when not on a team", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+		mockTeams.On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodGet, ""), http.HandlerFunc(app.getMyProjectHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestSaveMyProject(t *testing.T) {
+	t.Run("should upsert the project with deduplicated tracks", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockProjects := app.store.Projects.(*store.MockProjectsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		mockSettings.On("GetJudgingConfig").Return(newTestJudgingConfig(), nil).Once()
+		mockTeams.On("GetByUserID", "user-1").Return(newTestTeam(), nil).Once()
+		mockProjects.On("Upsert", mock.MatchedBy(func(p *store.Project) bool {
+			return p.TeamID == "team-1" && p.Title == "Widget" &&
+				p.RepoURL != nil && *p.RepoURL == "https://github.com/x/widget" &&
+				p.DemoURL == nil && len(p.Tracks) == 1 &&
+				p.SubmittedBy != nil && *p.SubmittedBy == "user-1"
+		})).Return(nil).Once()
+
+		body := `{"title":" Widget ","description":"A widget","repo_url":"https://github.com/x/widget","demo_url":"  ","tracks":["Best Design","Best Design"]}`
+		rr := executeRequest(newTeamRequest(t, http.MethodPut, body), http.HandlerFunc(app.saveMyProjectHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockProjects.AssertExpectations(t)
+	})
+
+	t.Run("should return 403 after the deadline", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockProjects := app.store.Projects.(*store.MockProjectsStore)
+
+		config := newTestJudgingConfig()
+		deadline := time.Now().Add(-time.Minute)
+		config.SubmissionDeadline = &deadline
+		mockSettings.On("GetJudgingConfig").Return(config, nil).Once()
+
+		body := `{"title":"Widget","description":"A widget","tracks":[]}`
+		rr := executeRequest(newTeamRequest(t, http.MethodPut, body), http.HandlerFunc(app.saveMyProjectHandler))
+		checkResponseCode(t, http.StatusForbidden, rr.Code)
+		mockProjects.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("should return 400 for an unknown track", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		mockSettings.On("GetJudgingConfig").Return(newTestJudgingConfig(), nil).Once()
+
+		body := `{"title":"Widget","description":"A widget","tracks":["Best Vibes"]}`
+		rr := executeRequest(newTeamRequest(t, http.MethodPut, body), http.HandlerFunc(app.saveMyProjectHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 400 for an invalid URL", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		body := `{"title":"Widget","description":"A widget","repo_url":"not a url","tracks":[]}`
+		rr := executeRequest(newTeamRequest(t, http.MethodPut, body), http.HandlerFunc(app.saveMyProjectHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
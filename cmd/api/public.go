@@ -16,7 +16,7 @@ import (
 //	@Failure		500			{object}	object{error=string}
 //	@Router			/public/schedule [get]
 func (app *application) getPublicScheduleHandler(w http.ResponseWriter, r *http.Request) {
-	app.listScheduleHandler(w, r)
+	app.writeScheduleList(w, r, nil)
 }
 
 // getPublicSponsorsHandler returns all sponsors (public, API key auth)
@@ -31,7 +31,7 @@ func (app *application) getPublicScheduleHandler(w http.ResponseWriter, r *http.
 //	@Failure		500			{object}	object{error=string}
 //	@Router			/public/sponsors [get]
 func (app *application) getPublicSponsorsHandler(w http.ResponseWriter, r *http.Request) {
-	app.listSponsorsHandler(w, r)
+	app.writeSponsorList(w, r, nil)
 }
 
 // getPublicFAQHandler returns all FAQs (public, API key auth)
@@ -46,5 +46,5 @@ func (app *application) getPublicSponsorsHandler(w http.ResponseWriter, r *http.
 //	@Failure		500			{object}	object{error=string}
 //	@Router			/public/faq [get]
 func (app *application) getPublicFAQHandler(w http.ResponseWriter, r *http.Request) {
-	app.listFAQsHandler(w, r)
+	app.writeFAQList(w, r, nil)
 }
@@ -59,7 +59,7 @@ func TestGetPublicSchedule(t *testing.T) {
 			},
 		}
 
-		mockSchedule.On("List").Return(items, nil).Once()
+		mockSchedule.On("List", (*string)(nil)).Return(items, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/v1/public/schedule", nil)
 		require.NoError(t, err)
@@ -84,7 +84,7 @@ func TestGetPublicSchedule(t *testing.T) {
 		mockSchedule := app.store.Schedule.(*store.MockScheduleStore)
 		mux := app.mount()
 
-		mockSchedule.On("List").Return([]store.ScheduleItem{}, nil).Once()
+		mockSchedule.On("List", (*string)(nil)).Return([]store.ScheduleItem{}, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/v1/public/schedule", nil)
 		require.NoError(t, err)
@@ -108,7 +108,7 @@ func TestGetPublicSchedule(t *testing.T) {
 		mockSchedule := app.store.Schedule.(*store.MockScheduleStore)
 		mux := app.mount()
 
-		mockSchedule.On("List").Return(nil, errors.New("db error")).Once()
+		mockSchedule.On("List", (*string)(nil)).Return(nil, errors.New("db error")).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/v1/public/schedule", nil)
 		require.NoError(t, err)
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hackutd/portal/internal/qrtoken"
+)
+
+type qrTokenConfig struct {
+	secret string
+	// ttl bounds how long an issued token verifies. Zero issues tokens that
+	// stay valid until another hackathon is activated.
+	ttl time.Duration
+}
+
+type QRTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// issueQRToken signs a QR payload for userID bound to the current hackathon.
+func (app *application) issueQRToken(ctx context.Context, userID string) (string, error) {
+	hackathonID, err := app.store.Settings.GetHackathonID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("read hackathon ID: %w", err)
+	}
+
+	return app.qrTokens.Issue(userID, hackathonID, app.config.qrToken.ttl)
+}
+
+// verifyQRToken checks a scanned QR payload against the current hackathon and
+// returns the claims it carries. Verification errors from the qrtoken package
+// are returned unwrapped so callers can report them as a bad scan.
+func (app *application) verifyQRToken(ctx context.Context, token string) (qrtoken.Claims, error) {
+	hackathonID, err := app.store.Settings.GetHackathonID(ctx)
+	if err != nil {
+		return qrtoken.Claims{}, fmt.Errorf("read hackathon ID: %w", err)
+	}
+
+	return app.qrTokens.Verify(token, hackathonID)
+}
+
+// isQRTokenError reports whether err means the scanned code itself is bad, as
+// opposed to a failure reading the hackathon ID.
+func isQRTokenError(err error) bool {
+	return errors.Is(err, qrtoken.ErrMalformed) ||
+		errors.Is(err, qrtoken.ErrInvalidSignature) ||
+		errors.Is(err, qrtoken.ErrExpired) ||
+		errors.Is(err, qrtoken.ErrWrongHackathon)
+}
+
+// getMyQRTokenHandler returns a signed QR payload for the current user
+//
+//	@Summary		Get my QR token
+//	@Description	Returns a signed token to render as the authenticated user's check-in QR code. The token is bound to the current hackathon and expires after QR_TOKEN_TTL_HOURS when that is set.
+//	@Tags			hackers
+//	@Produce		json
+//	@Success		200	{object}	QRTokenResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/users/me/qr-token [get]
+func (app *application) getMyQRTokenHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("user not in context"))
+		return
+	}
+
+	token, err := app.issueQRToken(r.Context(), user.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "private, no-store")
+	if err := app.jsonResponse(w, http.StatusOK, QRTokenResponse{Token: token}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
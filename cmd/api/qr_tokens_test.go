@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMyQRToken(t *testing.T) {
+	t.Run("returns a token bound to the current hackathon", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.getMyQRTokenHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "private, no-store", rr.Header().Get("Cache-Control"))
+
+		var body struct {
+			Data QRTokenResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+
+		claims, err := app.qrTokens.Verify(body.Data.Token, testHackathonID)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", claims.UserID)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		rr := executeRequest(req, http.HandlerFunc(app.getMyQRTokenHandler))
+		checkResponseCode(t, http.StatusUnauthorized, rr.Code)
+	})
+}
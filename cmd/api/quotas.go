@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+type AdmissionQuotasPayload struct {
+	Quotas []store.AdmissionQuota `json:"quotas" validate:"max=50,dive"`
+}
+
+type AdmissionQuotasResponse struct {
+	Quotas []store.AdmissionQuota `json:"quotas"`
+}
+
+type QuotaUsageResponse struct {
+	Quotas []store.QuotaUsage `json:"quotas"`
+}
+
+// validateAdmissionQuotas checks that quota IDs are unique and that every
+// quota is keyed on a schema field it can count.
+func validateAdmissionQuotas(quotas []store.AdmissionQuota, schema []store.ApplicationSchemaField) error {
+	fields := make(map[string]store.ApplicationSchemaField, len(schema))
+	for _, f := range schema {
+		fields[f.ID] = f
+	}
+
+	seen := make(map[string]bool, len(quotas))
+	for _, q := range quotas {
+		if seen[q.ID] {
+			return fmt.Errorf("duplicate quota id %q", q.ID)
+		}
+		seen[q.ID] = true
+
+		if q.Field == "" {
+			if q.Value != nil {
+				return fmt.Errorf("quota %q: value needs a field", q.ID)
+			}
+			continue
+		}
+
+		field, ok := fields[q.Field]
+		if !ok {
+			return fmt.Errorf("quota %q: unknown field %q", q.ID, q.Field)
+		}
+		switch field.Type {
+		case "file":
+			return fmt.Errorf("quota %q: file fields can't be counted", q.ID)
+		case "multi_select", "ranked_choice":
+			// A hacker holds one seat but can give several answers.
+			if q.Value == nil {
+				return fmt.Errorf("quota %q: %s fields need a value to cap", q.ID, field.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// getAdmissionQuotas returns the admission quotas
+//
+//	@Summary		Get admission quotas (Super Admin)
+//	@Description	Returns the caps on accepted hackers, overall or keyed on an application answer
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	AdmissionQuotasResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/quotas [get]
+func (app *application) getAdmissionQuotas(w http.ResponseWriter, r *http.Request) {
+	quotas, err := app.store.Settings.GetAdmissionQuotas(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, AdmissionQuotasResponse{Quotas: quotas}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// setAdmissionQuotas replaces the admission quotas
+//
+//	@Summary		Set admission quotas (Super Admin)
+//	@Description	Replaces the admission quotas. A quota with no field caps everyone; with a field and value it caps hackers giving that answer; with a field and no value it caps each answer separately. Hard quotas block acceptances past the cap, soft ones only warn.
+//	@Tags			superadmin/settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			quotas	body		AdmissionQuotasPayload	true	"Quotas to set"
+//	@Success		200		{object}	AdmissionQuotasResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/quotas [put]
+func (app *application) setAdmissionQuotas(w http.ResponseWriter, r *http.Request) {
+	var req AdmissionQuotasPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if req.Quotas == nil {
+		req.Quotas = []store.AdmissionQuota{}
+	}
+	for i := range req.Quotas {
+		q := &req.Quotas[i]
+		q.ID = strings.TrimSpace(q.ID)
+		q.Label = strings.TrimSpace(q.Label)
+		if q.Value != nil {
+			value := strings.TrimSpace(*q.Value)
+			q.Value = &value
+		}
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+	if err := validateAdmissionQuotas(req.Quotas, schema); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := app.auditedSettingWrite(r, store.SettingsKeyAdmissionQuotas, func() error {
+		return app.store.Settings.SetAdmissionQuotas(r.Context(), req.Quotas)
+	}); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, AdmissionQuotasResponse(req)); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getQuotaUsageHandler returns the seats held against each admission quota
+//
+//	@Summary		Get admission quota usage (Admin)
+//	@Description	Returns each admission quota with the accepted hackers holding a seat against it, pending or confirmed. Per-answer quotas list the count for every answer.
+//	@Tags			admin/applications
+//	@Produce		json
+//	@Success		200	{object}	QuotaUsageResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/applications/quotas [get]
+func (app *application) getQuotaUsageHandler(w http.ResponseWriter, r *http.Request) {
+	usage, err := app.store.Application.QuotaUsage(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, QuotaUsageResponse{Quotas: usage}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
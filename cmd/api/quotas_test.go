@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAdmissionQuotas(t *testing.T) {
+	schema := []store.ApplicationSchemaField{
+		{ID: "university", Type: "select"},
+		{ID: "dietary", Type: "multi_select"},
+		{ID: "resume", Type: "file"},
+	}
+
+	t.Run("should save valid quotas", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+		mockSettings.On("SetAdmissionQuotas", mock.MatchedBy(func(quotas []store.AdmissionQuota) bool {
+			return len(quotas) == 3 && quotas[0].ID == "total" && *quotas[2].Value == "Vegan"
+		})).Return(nil).Once()
+
+		body := `{"quotas":[
+			{"id":" total ","label":"Everyone","max":500,"hard":true},
+			{"id":"per-school","label":"Per university","field":"university","max":50},
+			{"id":"vegan","label":"Vegan meals","field":"dietary","value":" Vegan ","max":40,"hard":true}]}`
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setAdmissionQuotas))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockSettings.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name, quotas string
+	}{
+		{"a quota without a label", `[{"id":"a","label":"","max":10}]`},
+		{"a negative cap", `[{"id":"a","label":"A","max":-1}]`},
+		{"an unknown field", `[{"id":"a","label":"A","field":"gpa","max":10}]`},
+		{"a value without a field", `[{"id":"a","label":"A","value":"x","max":10}]`},
+		{"a per-answer cap on a multi-select", `[{"id":"a","label":"A","field":"dietary","max":10}]`},
+		{"a file field", `[{"id":"a","label":"A","field":"resume","max":10}]`},
+		{"duplicate ids", `[{"id":"a","label":"A","max":10},{"id":"a","label":"B","max":20}]`},
+	} {
+		t.Run("should return 400 for "+tc.name, func(t *testing.T) {
+			app := newTestApplication(t)
+			app.store.Settings.(*store.MockSettingsStore).On("GetApplicationSchema").Return(schema, nil).Maybe()
+
+			req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(`{"quotas":`+tc.quotas+`}`))
+			require.NoError(t, err)
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.setAdmissionQuotas))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestGetQuotaUsage(t *testing.T) {
+	app := newTestApplication(t)
+	mockApps := app.store.Application.(*store.MockApplicationStore)
+	mockApps.On("QuotaUsage").Return([]store.QuotaUsage{
+		{AdmissionQuota: store.AdmissionQuota{ID: "total", Label: "Everyone", Max: 500, Hard: true}, Count: 480},
+	}, nil).Once()
+
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.getQuotaUsageHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+
+	var envelope struct {
+		Data QuotaUsageResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+	require.Len(t, envelope.Data.Quotas, 1)
+	assert.Equal(t, 480, envelope.Data.Quotas[0].Count)
+
+	mockApps.AssertExpectations(t)
+}
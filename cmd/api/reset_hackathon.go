@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"sync/atomic"
@@ -11,11 +12,11 @@ import (
 	"github.com/hackutd/portal/internal/store"
 )
 
-// A full reset can carry thousands of resume objects, so the cleanup runs
+// A full reset can carry thousands of resume objects, so moving them runs
 // detached from the request with a bounded fan-out.
 const (
-	resumeDeleteConcurrency = 16
-	resumeDeleteTimeout     = 10 * time.Minute
+	resumeObjectConcurrency = 16
+	resumeObjectTimeout     = 10 * time.Minute
 )
 
 type ResetHackathonPayload struct {
@@ -28,6 +29,9 @@ type ResetHackathonPayload struct {
 	ResetSponsors      bool `json:"reset_sponsors"`
 	ResetFAQs          bool `json:"reset_faqs"`
 	ResetConfig        bool `json:"reset_config"`
+	// SkipArchive lets a deployment without object storage reset anyway.
+	// Without it such a reset is refused, since nothing could be restored.
+	SkipArchive bool `json:"skip_archive"`
 }
 
 func (p ResetHackathonPayload) toStoreOptions() store.ResetOptions {
@@ -54,16 +58,23 @@ type ResetHackathonResponse struct {
 	ResetSponsors      bool `json:"reset_sponsors"`
 	ResetFAQs          bool `json:"reset_faqs"`
 	ResetConfig        bool `json:"reset_config"`
-	// ResumesDeleted counts the resume files queued for removal from object
-	// storage. Deletion happens in the background, so a file may still fail;
-	// failures are logged server-side.
+	// ResumesDeleted counts the resume and file-field uploads queued for
+	// removal from object storage. Each is copied into the archive first and only removed once
+	// the copy is written. This happens in the background, so a file may
+	// still fail; failures are logged server-side and leave the file in place.
 	ResumesDeleted int `json:"resumes_deleted"`
+	// ArchiveID names the archive taken just before the reset. It is empty
+	// when the archive was skipped.
+	ArchiveID string `json:"archive_id,omitempty"`
+	// ArchiveSkipped is set when the reset ran without an archive because no
+	// object storage is configured and the caller passed skip_archive.
+	ArchiveSkipped bool `json:"archive_skipped"`
 }
 
 // resetHackathonHandler resets hackathon data based on options
 //
 //	@Summary		Reset hackathon data (Super Admin)
-//	@Description	Resets selected hackathon data (applications and walk-in queue, scans, scan types, schedule, notifications, sponsors, FAQs, settings, per-cycle config). Resetting config also closes applications. Database work is performed in a single transaction; resume files are removed from object storage in the background.
+//	@Description	Resets selected data of the active hackathon (applications, teams, projects and walk-in queue, scans, scan types, schedule, notifications, sponsors, FAQs, settings, per-cycle config). Resetting config also closes applications. The active hackathon is archived to object storage first, and the reset is refused if archiving fails. Without object storage the reset is refused with 503 unless skip_archive is set. Database work is performed in a single transaction; resume files are moved into the archive in the background.
 //	@Tags			superadmin
 //	@Accept			json
 //	@Produce		json
@@ -73,6 +84,7 @@ type ResetHackathonResponse struct {
 //	@Failure		401		{object}	object{error=string}
 //	@Failure		403		{object}	object{error=string}
 //	@Failure		500		{object}	object{error=string}
+//	@Failure		503		{object}	object{error=string}	"No object storage to archive into and skip_archive not set"
 //	@Security		CookieAuth
 //	@Router			/superadmin/reset-hackathon [post]
 func (app *application) resetHackathonHandler(w http.ResponseWriter, r *http.Request) {
@@ -93,6 +105,26 @@ func (app *application) resetHackathonHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Archive first: a reset cannot be undone, an archive can be restored.
+	var (
+		archiveID       string
+		archivedResumes []string
+	)
+	if app.gcsClient == nil {
+		if !req.SkipArchive {
+			writeJSONError(w, http.StatusServiceUnavailable, "no object storage is configured to archive into; set skip_archive to reset without an archive")
+			return
+		}
+		app.logger.Warnw("resetting without an archive: no object storage configured")
+	} else {
+		archive, paths, err := app.archiveHackathon(r.Context())
+		if err != nil {
+			app.internalServerError(w, r, fmt.Errorf("archive before reset: %w", err))
+			return
+		}
+		archiveID, archivedResumes = archive.ID, paths
+	}
+
 	resumePaths, err := app.store.Hackathon.Reset(r.Context(), opts)
 	if err != nil {
 		app.internalServerError(w, r, err)
@@ -105,8 +137,11 @@ func (app *application) resetHackathonHandler(w http.ResponseWriter, r *http.Req
 			app.logger.Warnw("resume files left in object storage: no GCS client configured", "count", len(resumePaths))
 		} else {
 			resumesQueued = len(resumePaths)
-			go app.deleteResumeObjects(resumePaths)
+			go app.archiveResumeObjects(archiveID, resumePaths)
 		}
+	} else if len(archivedResumes) > 0 {
+		// The resumes stay in use, but the archive still gets its own copy.
+		go app.copyResumesIntoArchive(archiveID, archivedResumes)
 	}
 
 	response := ResetHackathonResponse{
@@ -120,24 +155,40 @@ func (app *application) resetHackathonHandler(w http.ResponseWriter, r *http.Req
 		ResetFAQs:          req.ResetFAQs,
 		ResetConfig:        req.ResetConfig,
 		ResumesDeleted:     resumesQueued,
+		ArchiveID:          archiveID,
+		ArchiveSkipped:     archiveID == "",
 	}
 
+	app.recordAudit(r, store.AuditActionHackathonReset, store.AuditTargetHackathon, "", nil, response)
+
 	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
 		app.internalServerError(w, r, err)
 	}
 }
 
-// deleteResumeObjects removes resume files from object storage on a best-effort
-// basis, using its own context so the work outlives the request. Individual
-// failures are logged rather than surfaced — the rows are already gone.
-func (app *application) deleteResumeObjects(paths []string) {
-	ctx, cancel := context.WithTimeout(context.Background(), resumeDeleteTimeout)
+// archiveResumeObjects moves resume files into the archive taken before the
+// reset, so restoring it brings them back. A file whose copy fails is left
+// where it is rather than lost.
+func (app *application) archiveResumeObjects(archiveID string, paths []string) {
+	app.forEachResumeObject("archive", paths, func(ctx context.Context, path string) error {
+		if err := app.copyObject(ctx, path, hackathonArchiveResumePath(archiveID, path), archivedResumeContentType); err != nil {
+			return fmt.Errorf("copy into archive: %w", err)
+		}
+		return app.gcsClient.DeleteObject(ctx, path)
+	})
+}
+
+// forEachResumeObject runs fn on every path with a bounded fan-out, using its
+// own context so the work outlives the request. Individual failures are
+// logged rather than surfaced — the rows have already changed.
+func (app *application) forEachResumeObject(task string, paths []string, fn func(ctx context.Context, path string) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), resumeObjectTimeout)
 	defer cancel()
 
 	var (
 		wg     sync.WaitGroup
 		failed atomic.Int64
-		sem    = make(chan struct{}, resumeDeleteConcurrency)
+		sem    = make(chan struct{}, resumeObjectConcurrency)
 	)
 
 	for _, path := range paths {
@@ -148,18 +199,29 @@ func (app *application) deleteResumeObjects(paths []string) {
 			defer wg.Done()
 			defer func() { <-sem }()
 
-			if err := app.gcsClient.DeleteObject(ctx, path); err != nil {
+			if err := fn(ctx, path); err != nil {
 				failed.Add(1)
-				app.logger.Errorw("failed to delete resume from object storage", "path", path, "error", err)
+				app.logger.Errorw("resume "+task+" failed", "path", path, "error", err)
 			}
 		}(path)
 	}
 
 	wg.Wait()
 
-	app.logger.Infow("resume cleanup finished",
+	app.logger.Infow("resume "+task+" finished",
 		"total", len(paths),
-		"deleted", int64(len(paths))-failed.Load(),
+		"succeeded", int64(len(paths))-failed.Load(),
 		"failed", failed.Load(),
 	)
 }
+
+// copyObject streams the object at from to to.
+func (app *application) copyObject(ctx context.Context, from, to, contentType string) error {
+	rc, err := app.gcsClient.ReadObject(ctx, from)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return app.gcsClient.WriteObject(ctx, to, contentType, rc)
+}
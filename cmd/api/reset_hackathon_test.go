@@ -2,22 +2,26 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
-	"sync"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResetHackathon(t *testing.T) {
 	t.Run("should allow super admin to reset data", func(t *testing.T) {
 		app := newTestApplication(t)
-		mockGCS := app.gcsClient.(*gcs.MockClient)
 
 		payload := ResetHackathonPayload{
 			ResetApplications:  true,
@@ -31,6 +35,11 @@ func TestResetHackathon(t *testing.T) {
 			ResetConfig:        true,
 		}
 
+		local := expectResetArchive(t, app)
+		for _, path := range []string{"resume1.pdf", "resume2.pdf"} {
+			require.NoError(t, local.WriteObject(context.Background(), path, "application/pdf", strings.NewReader("%PDF")))
+		}
+
 		// Mock successful reset
 		app.store.Hackathon.(*store.MockHackathonStore).
 			On("Reset", store.ResetOptions{
@@ -40,16 +49,6 @@ func TestResetHackathon(t *testing.T) {
 			}).
 			Return([]string{"resume1.pdf", "resume2.pdf"}, nil)
 
-		// Resume cleanup runs in the background; wait for both deletes.
-		var deletions sync.WaitGroup
-		deletions.Add(2)
-		for _, path := range []string{"resume1.pdf", "resume2.pdf"} {
-			mockGCS.On("DeleteObject", mock.Anything, path).
-				Return(nil).
-				Once().
-				Run(func(mock.Arguments) { deletions.Done() })
-		}
-
 		reqBody, _ := json.Marshal(payload)
 		req, _ := http.NewRequest(http.MethodPost, "/v1/superadmin/reset-hackathon", bytes.NewBuffer(reqBody))
 		req = setUserContext(req, newSuperAdminUser())
@@ -64,9 +63,18 @@ func TestResetHackathon(t *testing.T) {
 		err := json.Unmarshal(rr.Body.Bytes(), &respBody)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, respBody.Data.ResumesDeleted)
+		archiveID := "20261120T183000Z-" + testEventID
+		assert.Equal(t, archiveID, respBody.Data.ArchiveID)
 
-		deletions.Wait()
-		mockGCS.AssertExpectations(t)
+		// Resumes move into the archive in the background.
+		for _, path := range []string{"resume1.pdf", "resume2.pdf"} {
+			assert.Eventually(t, func() bool {
+				_, err := local.StatObject(context.Background(), path)
+				return errors.Is(err, gcs.ErrObjectNotFound)
+			}, time.Second, 10*time.Millisecond)
+			_, err := local.StatObject(context.Background(), hackathonArchiveResumePath(archiveID, path))
+			assert.NoError(t, err)
+		}
 		app.store.Hackathon.(*store.MockHackathonStore).AssertExpectations(t)
 	})
 
@@ -78,7 +86,7 @@ func TestResetHackathon(t *testing.T) {
 			On("Reset", store.ResetOptions{Applications: true}).
 			Return([]string{"resume1.pdf", "resume2.pdf"}, nil)
 
-		reqBody, _ := json.Marshal(ResetHackathonPayload{ResetApplications: true})
+		reqBody, _ := json.Marshal(ResetHackathonPayload{ResetApplications: true, SkipArchive: true})
 		req, _ := http.NewRequest(http.MethodPost, "/v1/superadmin/reset-hackathon", bytes.NewBuffer(reqBody))
 		req = setUserContext(req, newSuperAdminUser())
 
@@ -92,8 +100,27 @@ func TestResetHackathon(t *testing.T) {
 		err := json.Unmarshal(rr.Body.Bytes(), &respBody)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, respBody.Data.ResumesDeleted)
+		assert.True(t, respBody.Data.ArchiveSkipped)
 
 		app.store.Hackathon.(*store.MockHackathonStore).AssertExpectations(t)
+		events := recordedAuditEvents(app)
+		require.Len(t, events, 1)
+		assert.Contains(t, string(events[0].After), `"archive_skipped":true`)
+	})
+
+	t.Run("should refuse to reset without storage unless the archive is skipped", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.gcsClient = nil
+		mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+		reqBody, _ := json.Marshal(ResetHackathonPayload{ResetApplications: true})
+		req, _ := http.NewRequest(http.MethodPost, "/v1/superadmin/reset-hackathon", bytes.NewBuffer(reqBody))
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.resetHackathonHandler))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		mockHackathons.AssertNotCalled(t, "Reset", mock.Anything)
 	})
 
 	t.Run("should reset content-only domains without touching applications", func(t *testing.T) {
@@ -105,6 +132,7 @@ func TestResetHackathon(t *testing.T) {
 			ResetFAQs:      true,
 		}
 
+		expectResetArchive(t, app)
 		app.store.Hackathon.(*store.MockHackathonStore).
 			On("Reset", store.ResetOptions{ScanTypes: true, Sponsors: true, FAQs: true}).
 			Return([]string(nil), nil)
@@ -133,6 +161,7 @@ func TestResetHackathon(t *testing.T) {
 
 	t.Run("should reset per-cycle config on its own", func(t *testing.T) {
 		app := newTestApplication(t)
+		expectResetArchive(t, app)
 
 		app.store.Hackathon.(*store.MockHackathonStore).
 			On("Reset", store.ResetOptions{Config: true}).
@@ -157,12 +186,35 @@ func TestResetHackathon(t *testing.T) {
 		app.store.Hackathon.(*store.MockHackathonStore).AssertExpectations(t)
 	})
 
+	t.Run("should not reset when the archive cannot be written", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockHackathons := app.store.Hackathon.(*store.MockHackathonStore)
+
+		// A file where the archive directory belongs makes the write fail.
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, strings.TrimSuffix(hackathonArchivePrefix, "/")), nil, 0o600))
+		local, err := gcs.NewLocal(dir, "http://localhost:8080/v1/storage", []byte("test-secret"))
+		require.NoError(t, err)
+		app.gcsClient = local
+		mockHackathons.On("Snapshot").Return(newTestHackathonArchive(), nil).Once()
+
+		reqBody, _ := json.Marshal(ResetHackathonPayload{ResetApplications: true})
+		req, _ := http.NewRequest(http.MethodPost, "/v1/superadmin/reset-hackathon", bytes.NewBuffer(reqBody))
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.resetHackathonHandler))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		mockHackathons.AssertNotCalled(t, "Reset", mock.Anything)
+	})
+
 	t.Run("should return 500 when transaction fails", func(t *testing.T) {
 		app := newTestApplication(t)
 		app.gcsClient = nil
 
 		payload := ResetHackathonPayload{
 			ResetApplications: true,
+			SkipArchive:       true,
 		}
 
 		// Simulate partial failure/rollback by returning error from store
@@ -216,3 +268,14 @@ func TestResetHackathon(t *testing.T) {
 		assert.Equal(t, http.StatusForbidden, rr.Code)
 	})
 }
+
+// expectResetArchive lets the archive taken before a reset succeed, storing
+// it in a local bucket the test can inspect.
+func expectResetArchive(t *testing.T, app *application) *gcs.LocalClient {
+	local, err := gcs.NewLocal(t.TempDir(), "http://localhost:8080/v1/storage", []byte("test-secret"))
+	require.NoError(t, err)
+	app.gcsClient = local
+	app.store.Hackathon.(*store.MockHackathonStore).
+		On("Snapshot").Return(newTestHackathonArchive(), nil).Once()
+	return local
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/store"
 )
 
@@ -136,6 +139,10 @@ func (app *application) deleteResumeHandler(w http.ResponseWriter, r *http.Reque
 
 	application.ResumePath = nil
 	if err := app.store.Application.Update(r.Context(), application); err != nil {
+		if errors.Is(err, store.ErrStale) {
+			app.conflictResponse(w, r, errors.New("application changed while removing the resume, reload and try again"))
+			return
+		}
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -152,6 +159,7 @@ func (app *application) deleteResumeHandler(w http.ResponseWriter, r *http.Reque
 		Points:            app.userPoints(r, user.ID),
 	}
 
+	w.Header().Set("ETag", etagFor(application.UpdatedAt))
 	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
 		app.internalServerError(w, r, err)
 	}
@@ -269,6 +277,64 @@ func (app *application) getResumeDownloadURLHandler(w http.ResponseWriter, r *ht
 	}
 }
 
+// checkUploadedResume verifies that objectPath is one of the user's own resume
+// paths and that the uploaded object is within the resume limits. Failures the
+// hacker can fix wrap gcs.ErrUploadRejected.
+func (app *application) checkUploadedResume(ctx context.Context, userID, objectPath string) error {
+	if !strings.HasPrefix(objectPath, "resumes/"+userID+"/") || !strings.HasSuffix(objectPath, ".pdf") {
+		return fmt.Errorf("%w: invalid resume path", gcs.ErrUploadRejected)
+	}
+	return app.checkUploadedPDF(ctx, objectPath, "resume")
+}
+
+// checkUploadedPDF checks a hacker's uploaded PDF against the resume limits.
+// S3 cannot cap an upload's size in a presigned URL, so the limit is
+// re-checked here for every backend; rejected objects are deleted. what names
+// the upload in error messages.
+func (app *application) checkUploadedPDF(ctx context.Context, objectPath, what string) error {
+	if app.gcsClient == nil {
+		return nil
+	}
+
+	attrs, err := app.gcsClient.StatObject(ctx, objectPath)
+	if err != nil {
+		if errors.Is(err, gcs.ErrObjectNotFound) {
+			return fmt.Errorf("%w: %s has not been uploaded", gcs.ErrUploadRejected, what)
+		}
+		return err
+	}
+
+	if err := gcs.CheckResumeObject(attrs); err != nil {
+		if delErr := app.gcsClient.DeleteObject(ctx, objectPath); delErr != nil {
+			app.logger.Warnw("failed to delete rejected upload", "path", objectPath, "error", delErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// checkUploadedImage is checkUploadedPDF for image uploads. It returns the
+// object's attributes so callers can apply tighter limits of their own.
+func (app *application) checkUploadedImage(ctx context.Context, objectPath, what string) (*gcs.ObjectAttrs, error) {
+	attrs, err := app.gcsClient.StatObject(ctx, objectPath)
+	if err != nil {
+		if errors.Is(err, gcs.ErrObjectNotFound) {
+			return nil, fmt.Errorf("%w: %s has not been uploaded", gcs.ErrUploadRejected, what)
+		}
+		return nil, err
+	}
+
+	if err := gcs.CheckImageObject(attrs); err != nil {
+		if delErr := app.gcsClient.DeleteObject(ctx, objectPath); delErr != nil {
+			app.logger.Warnw("failed to delete rejected upload", "path", objectPath, "error", delErr)
+		}
+		return nil, err
+	}
+
+	return attrs, nil
+}
+
 func randomHex(size int) (string, error) {
 	buf := make([]byte, size)
 	if _, err := rand.Read(buf); err != nil {
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
+	"github.com/hackutd/portal/internal/spreadsheet"
+	"github.com/hackutd/portal/internal/store"
+)
+
+const (
+	resumeBookBuildTimeout = 30 * time.Minute
+	resumeBookListLimit    = 50
+	resumeBookIndexName    = "index.csv"
+)
+
+type CreateResumeBookPayload struct {
+	OptInField string `json:"opt_in_field" validate:"required,max=100"`
+}
+
+type ResumeBookResponse struct {
+	ResumeBook store.ResumeBook `json:"resume_book"`
+}
+
+type ResumeBookListResponse struct {
+	ResumeBooks []store.ResumeBook `json:"resume_books"`
+}
+
+type ResumeBookDownloadURLResponse struct {
+	DownloadURL string `json:"download_url"`
+}
+
+// createResumeBookHandler starts building a resume book
+//
+//	@Summary		Create resume book (SuperAdmin)
+//	@Description	Starts a background job that bundles the resumes of accepted, checked-in hackers who ticked the given checkbox field into a ZIP of PDFs named by applicant, with an index.csv. Poll the list endpoint until the book is ready.
+//	@Tags			superadmin/resume-books
+//	@Accept			json
+//	@Produce		json
+//	@Param			resume_book	body		CreateResumeBookPayload	true	"Checkbox field hackers opt in with"
+//	@Success		202			{object}	ResumeBookResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Failure		503			{object}	object{error=string}	"Object storage is not configured"
+//	@Security		CookieAuth
+//	@Router			/superadmin/resume-books [post]
+func (app *application) createResumeBookHandler(w http.ResponseWriter, r *http.Request) {
+	if app.gcsClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "resume storage is not configured")
+		return
+	}
+
+	var req CreateResumeBookPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	req.OptInField = strings.TrimSpace(req.OptInField)
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	schema, err := app.store.Settings.GetApplicationSchema(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := validateOptInField(schema, req.OptInField); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	scanTypes, err := app.store.Settings.GetScanTypes(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	var checkInTypes []string
+	for _, st := range scanTypes {
+		if st.Category == store.ScanCategoryCheckIn {
+			checkInTypes = append(checkInTypes, st.Name)
+		}
+	}
+	if len(checkInTypes) == 0 {
+		app.badRequestResponse(w, r, errors.New("no check-in scan types are configured"))
+		return
+	}
+
+	user := getUserFromContext(r.Context())
+	book := &store.ResumeBook{
+		OptInField: req.OptInField,
+		CreatedBy:  &user.ID,
+	}
+	if err := app.store.ResumeBooks.Create(r.Context(), book); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.recordAudit(r, store.AuditActionResumeBookCreate, store.AuditTargetResumeBook, book.ID, nil, book)
+
+	go app.buildResumeBook(book.ID, book.OptInField, checkInTypes)
+
+	if err := app.jsonResponse(w, http.StatusAccepted, ResumeBookResponse{ResumeBook: *book}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// listResumeBooksHandler lists recent resume books
+//
+//	@Summary		List resume books (SuperAdmin)
+//	@Description	Lists the 50 most recent resume books, newest first, with their build status
+//	@Tags			superadmin/resume-books
+//	@Produce		json
+//	@Success		200	{object}	ResumeBookListResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/resume-books [get]
+func (app *application) listResumeBooksHandler(w http.ResponseWriter, r *http.Request) {
+	books, err := app.store.ResumeBooks.List(r.Context(), resumeBookListLimit)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ResumeBookListResponse{ResumeBooks: books}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getResumeBookDownloadURLHandler returns a signed download URL for a resume book
+//
+//	@Summary		Get resume book download URL (SuperAdmin)
+//	@Description	Returns a time-limited signed URL for downloading a finished resume book
+//	@Tags			superadmin/resume-books
+//	@Produce		json
+//	@Param			bookID	path		string	true	"Resume book ID"
+//	@Success		200		{object}	ResumeBookDownloadURLResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string}	"Resume book is not ready"
+//	@Failure		500		{object}	object{error=string}
+//	@Failure		503		{object}	object{error=string}	"Object storage is not configured"
+//	@Security		CookieAuth
+//	@Router			/superadmin/resume-books/{bookID}/download-url [get]
+func (app *application) getResumeBookDownloadURLHandler(w http.ResponseWriter, r *http.Request) {
+	bookID := chi.URLParam(r, "bookID")
+	if err := Validate.Var(bookID, "required,uuid"); err != nil {
+		app.badRequestResponse(w, r, errors.New("resume book ID must be a valid UUID"))
+		return
+	}
+
+	book, err := app.store.ResumeBooks.GetByID(r.Context(), bookID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("resume book not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if book.Status != store.ResumeBookReady || book.ObjectPath == nil {
+		app.conflictResponse(w, r, fmt.Errorf("resume book is %s", book.Status))
+		return
+	}
+
+	if app.gcsClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "resume storage is not configured")
+		return
+	}
+
+	downloadURL, err := app.gcsClient.GenerateDownloadURL(r.Context(), *book.ObjectPath)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ResumeBookDownloadURLResponse{DownloadURL: downloadURL}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// validateOptInField checks that id names a checkbox in the application schema
+func validateOptInField(schema []store.ApplicationSchemaField, id string) error {
+	for _, f := range schema {
+		if f.ID != id {
+			continue
+		}
+		if f.Type != "checkbox" {
+			return fmt.Errorf("opt-in field %q must be a checkbox", id)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown application field %q", id)
+}
+
+// buildResumeBook assembles a resume book and records the outcome on its row.
+// It runs detached from the request that started it, so it uses its own
+// context.
+func (app *application) buildResumeBook(bookID, optInField string, checkInTypes []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), resumeBookBuildTimeout)
+	defer cancel()
+
+	objectPath := "resume-books/" + bookID + ".zip"
+
+	included, skipped, err := app.writeResumeBookObject(ctx, objectPath, optInField, checkInTypes)
+	if err != nil {
+		app.logger.Errorw("resume book build failed", "resume_book_id", bookID, "error", err)
+		// The build context may be what expired; record the failure regardless.
+		if err := app.store.ResumeBooks.Fail(context.Background(), bookID, err.Error()); err != nil {
+			app.logger.Errorw("failed to mark resume book failed", "resume_book_id", bookID, "error", err)
+		}
+		return
+	}
+
+	if err := app.store.ResumeBooks.Complete(ctx, bookID, objectPath, included, skipped); err != nil {
+		app.logger.Errorw("failed to mark resume book ready", "resume_book_id", bookID, "error", err)
+		return
+	}
+
+	app.logger.Infow("resume book ready", "resume_book_id", bookID, "resumes", included, "skipped", skipped)
+}
+
+// writeResumeBookObject streams the ZIP straight into object storage so the
+// bundle is never held in memory.
+func (app *application) writeResumeBookObject(ctx context.Context, objectPath, optInField string, checkInTypes []string) (included, skipped int, err error) {
+	entries, err := app.store.ResumeBooks.ListEntries(ctx, optInField, checkInTypes)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		var werr error
+		included, skipped, werr = writeResumeBook(ctx, app.gcsClient, pw, entries, func(e store.ResumeBookEntry) {
+			app.logger.Warnw("resume missing from storage, leaving it out of resume book",
+				"application_id", e.ApplicationID, "path", e.ResumePath)
+		})
+		pw.CloseWithError(werr)
+		done <- werr
+	}()
+
+	err = app.gcsClient.WriteObject(ctx, objectPath, "application/zip", pr)
+	// Unblock the writer if storage gave up before reading everything.
+	pr.Close()
+	if werr := <-done; werr != nil && err == nil {
+		err = werr
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return included, skipped, nil
+}
+
+// writeResumeBook writes a ZIP holding each entry's resume, named
+// Last_First.pdf, followed by an index.csv describing the included files.
+// Entries whose resume object no longer exists are reported to onMissing and
+// counted as skipped.
+func writeResumeBook(ctx context.Context, client gcs.Client, w io.Writer, entries []store.ResumeBookEntry, onMissing func(store.ResumeBookEntry)) (included, skipped int, err error) {
+	zw := zip.NewWriter(w)
+
+	index := [][]string{{"File", "First Name", "Last Name", "Email", "University", "Major", "Level of Study"}}
+	used := make(map[string]bool, len(entries))
+
+	for _, e := range entries {
+		rc, err := client.ReadObject(ctx, e.ResumePath)
+		if err != nil {
+			if errors.Is(err, gcs.ErrObjectNotFound) {
+				skipped++
+				if onMissing != nil {
+					onMissing(e)
+				}
+				continue
+			}
+			return 0, 0, err
+		}
+
+		name := uniqueResumeFileName(resumeFileBase(e), used)
+		fw, err := zw.Create(name)
+		if err == nil {
+			_, err = io.Copy(fw, rc)
+		}
+		rc.Close()
+		if err != nil {
+			return 0, 0, err
+		}
+
+		included++
+		index = append(index, []string{
+			name,
+			derefString(e.FirstName),
+			derefString(e.LastName),
+			e.Email,
+			derefString(e.University),
+			derefString(e.Major),
+			derefString(e.LevelOfStudy),
+		})
+	}
+
+	fw, err := zw.Create(resumeBookIndexName)
+	if err != nil {
+		return 0, 0, err
+	}
+	sheet, err := spreadsheet.NewWriter(fw, spreadsheet.FormatCSV, "")
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, row := range index {
+		if err := sheet.WriteRow(row); err != nil {
+			return 0, 0, err
+		}
+	}
+	if err := sheet.Close(); err != nil {
+		return 0, 0, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return 0, 0, err
+	}
+
+	return included, skipped, nil
+}
+
+// resumeFileBase names a resume after its applicant as "Last_First", falling
+// back to the application ID when neither name is usable.
+func resumeFileBase(e store.ResumeBookEntry) string {
+	var parts []string
+	for _, name := range []*string{e.LastName, e.FirstName} {
+		if s := sanitizeFileComponent(derefString(name)); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	if len(parts) == 0 {
+		return e.ApplicationID
+	}
+	return strings.Join(parts, "_")
+}
+
+// sanitizeFileComponent keeps letters and digits, turns runs of anything else
+// into a single hyphen and trims hyphens from the ends.
+func sanitizeFileComponent(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+	return b.String()
+}
+
+// uniqueResumeFileName returns base.pdf, or base-2.pdf, base-3.pdf, ... when
+// an earlier applicant already has that name.
+func uniqueResumeFileName(base string, used map[string]bool) string {
+	name := base + ".pdf"
+	for n := 2; used[strings.ToLower(name)]; n++ {
+		name = base + "-" + strconv.Itoa(n) + ".pdf"
+	}
+	used[strings.ToLower(name)] = true
+	return name
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/gcs"
+	"github.com/hackutd/portal/internal/store"
+)
+
+const testResumeBookID = "0b7e4c1d-2f3a-4b5c-9d6e-7f8a9b0c1d2e"
+
+func strPtr(s string) *string { return &s }
+
+func TestBuildResumeBook(t *testing.T) {
+	dir := t.TempDir()
+	local, err := gcs.NewLocal(dir, "http://localhost:8080/v1/storage", []byte("test-secret"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, local.WriteObject(ctx, "resumes/a.pdf", "application/pdf", strings.NewReader("%PDF-a")))
+	require.NoError(t, local.WriteObject(ctx, "resumes/b.pdf", "application/pdf", strings.NewReader("%PDF-b")))
+
+	app := newTestApplication(t)
+	app.gcsClient = local
+	mockBooks := app.store.ResumeBooks.(*store.MockResumeBooksStore)
+
+	entries := []store.ResumeBookEntry{
+		{ApplicationID: "app-1", Email: "jane@example.com", FirstName: strPtr("Jane"), LastName: strPtr("Doe"), ResumePath: "resumes/a.pdf"},
+		{ApplicationID: "app-2", Email: "jane2@example.com", FirstName: strPtr("Jane"), LastName: strPtr("Doe"), ResumePath: "resumes/b.pdf"},
+		{ApplicationID: "app-3", Email: "gone@example.com", FirstName: strPtr("Gone"), ResumePath: "resumes/missing.pdf"},
+	}
+	objectPath := "resume-books/" + testResumeBookID + ".zip"
+	mockBooks.On("ListEntries", "resume_opt_in", []string{"check_in"}).Return(entries, nil).Once()
+	mockBooks.On("Complete", testResumeBookID, objectPath, 2, 1).Return(nil).Once()
+
+	app.buildResumeBook(testResumeBookID, "resume_opt_in", []string{"check_in"})
+	mockBooks.AssertExpectations(t)
+
+	zr, err := zip.OpenReader(filepath.Join(dir, "resume-books", testResumeBookID+".zip"))
+	require.NoError(t, err)
+	defer zr.Close()
+
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		rc.Close()
+		require.NoError(t, err)
+		files[f.Name] = string(body)
+	}
+
+	assert.Equal(t, "%PDF-a", files["Doe_Jane.pdf"])
+	assert.Equal(t, "%PDF-b", files["Doe_Jane-2.pdf"])
+	require.Contains(t, files, resumeBookIndexName)
+	assert.Len(t, files, 3)
+
+	rows, err := csv.NewReader(strings.NewReader(files[resumeBookIndexName])).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"Doe_Jane-2.pdf", "Jane", "Doe", "jane2@example.com", "", "", ""}, rows[2])
+}
+
+func TestBuildResumeBookFailure(t *testing.T) {
+	dir := t.TempDir()
+	local, err := gcs.NewLocal(dir, "http://localhost:8080/v1/storage", []byte("test-secret"))
+	require.NoError(t, err)
+
+	app := newTestApplication(t)
+	app.gcsClient = local
+	mockBooks := app.store.ResumeBooks.(*store.MockResumeBooksStore)
+
+	// An object path that escapes the storage root makes the read fail outright.
+	entries := []store.ResumeBookEntry{{ApplicationID: "app-1", ResumePath: "../outside.pdf"}}
+	mockBooks.On("ListEntries", "resume_opt_in", []string{"check_in"}).Return(entries, nil).Once()
+	mockBooks.On("Fail", testResumeBookID, mock.Anything).Return(nil).Once()
+
+	app.buildResumeBook(testResumeBookID, "resume_opt_in", []string{"check_in"})
+	mockBooks.AssertExpectations(t)
+
+	_, err = os.Stat(filepath.Join(dir, "resume-books", testResumeBookID+".zip"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestResumeFileBase(t *testing.T) {
+	assert.Equal(t, "O-Brien_Mary-Kate", resumeFileBase(store.ResumeBookEntry{FirstName: strPtr(" Mary Kate "), LastName: strPtr("O'Brien")}))
+	assert.Equal(t, "Zoë", resumeFileBase(store.ResumeBookEntry{FirstName: strPtr("Zoë"), LastName: strPtr("../")}))
+	assert.Equal(t, "app-9", resumeFileBase(store.ResumeBookEntry{ApplicationID: "app-9"}))
+}
+
+func TestCreateResumeBookHandler(t *testing.T) {
+	schema := []store.ApplicationSchemaField{
+		{ID: "resume_opt_in", Type: "checkbox", Label: "Share my resume with sponsors"},
+		{ID: "major", Type: "text", Label: "Major"},
+	}
+
+	for name, body := range map[string]string{
+		"unknown field":      `{"opt_in_field":"nope"}`,
+		"non-checkbox field": `{"opt_in_field":"major"}`,
+	} {
+		t.Run("should reject "+name, func(t *testing.T) {
+			app := newTestApplication(t)
+			mockSettings := app.store.Settings.(*store.MockSettingsStore)
+			mockSettings.On("GetApplicationSchema").Return(schema, nil).Once()
+
+			req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			require.NoError(t, err)
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.createResumeBookHandler))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+
+	t.Run("should return 503 without object storage", func(t *testing.T) {
+		app := newTestApplication(t)
+		app.gcsClient = nil
+
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(`{"opt_in_field":"resume_opt_in"}`))
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createResumeBookHandler))
+		checkResponseCode(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
+
+func TestGetResumeBookDownloadURLHandler(t *testing.T) {
+	newRequest := func(t *testing.T) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("bookID", testResumeBookID)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("should sign a ready book", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockBooks := app.store.ResumeBooks.(*store.MockResumeBooksStore)
+		mockGCS := app.gcsClient.(*gcs.MockClient)
+
+		path := "resume-books/" + testResumeBookID + ".zip"
+		mockBooks.On("GetByID", testResumeBookID).
+			Return(&store.ResumeBook{ID: testResumeBookID, Status: store.ResumeBookReady, ObjectPath: &path}, nil).Once()
+		mockGCS.On("GenerateDownloadURL", mock.Anything, path).Return("https://signed.example/book.zip", nil).Once()
+
+		rr := executeRequest(newRequest(t), http.HandlerFunc(app.getResumeBookDownloadURLHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "https://signed.example/book.zip")
+	})
+
+	t.Run("should return 409 while building", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockBooks := app.store.ResumeBooks.(*store.MockResumeBooksStore)
+
+		mockBooks.On("GetByID", testResumeBookID).
+			Return(&store.ResumeBook{ID: testResumeBookID, Status: store.ResumeBookBuilding}, nil).Once()
+
+		rr := executeRequest(newRequest(t), http.HandlerFunc(app.getResumeBookDownloadURLHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+	})
+}
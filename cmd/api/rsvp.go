@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+const rsvpSweepInterval = time.Minute
+
+// confirmApplicationHandler confirms the authenticated hacker's spot
+//
+//	@Summary		Confirm attendance
+//	@Description	Confirms the authenticated hacker's spot. The application must be accepted with a pending confirmation whose deadline has not passed.
+//	@Tags			hackers
+//	@Produce		json
+//	@Success		200	{object}	store.Application
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		404	{object}	object{error=string}
+//	@Failure		409	{object}	object{error=string}	"Not accepted, already responded, or deadline passed"
+//	@Security		CookieAuth
+//	@Router			/applications/me/confirm [post]
+func (app *application) confirmApplicationHandler(w http.ResponseWriter, r *http.Request) {
+	app.respondToConfirmation(w, r, store.ConfirmationConfirmed)
+}
+
+// declineApplicationHandler gives up the authenticated hacker's spot
+//
+//	@Summary		Decline attendance
+//	@Description	Declines the authenticated hacker's spot so it can be offered to the waitlist. Allowed while the confirmation is pending and before the deadline, or after confirming. A decline cannot be undone.
+//	@Tags			hackers
+//	@Produce		json
+//	@Success		200	{object}	store.Application
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		404	{object}	object{error=string}
+//	@Failure		409	{object}	object{error=string}	"Not accepted, already declined, or deadline passed"
+//	@Security		CookieAuth
+//	@Router			/applications/me/decline [post]
+func (app *application) declineApplicationHandler(w http.ResponseWriter, r *http.Request) {
+	app.respondToConfirmation(w, r, store.ConfirmationDeclined)
+}
+
+func (app *application) respondToConfirmation(w http.ResponseWriter, r *http.Request, status store.ConfirmationStatus) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	existing, err := app.store.Application.GetByUserID(r.Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("application not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if existing.Status != store.StatusAccepted {
+		app.conflictResponse(w, r, errors.New("application is not accepted"))
+		return
+	}
+
+	application, err := app.store.Application.SetConfirmation(r.Context(), user.ID, status)
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, confirmationConflict(existing))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, application); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// confirmationConflict explains why a confirmation response was refused,
+// based on the application as it stood before the attempt.
+func confirmationConflict(existing *store.Application) error {
+	if existing.ConfirmationStatus == nil {
+		return errors.New("confirmation is not open for this application")
+	}
+
+	switch *existing.ConfirmationStatus {
+	case store.ConfirmationConfirmed:
+		return errors.New("attendance already confirmed")
+	case store.ConfirmationDeclined:
+		return errors.New("attendance already declined")
+	case store.ConfirmationExpired:
+		return errors.New("confirmation deadline has passed")
+	}
+
+	if existing.ConfirmationDeadline != nil && !existing.ConfirmationDeadline.After(time.Now()) {
+		return errors.New("confirmation deadline has passed")
+	}
+	return errors.New("confirmation could not be recorded")
+}
+
+// runRSVPSweeper periodically expires overdue confirmations and backfills the
+// freed seats from the waitlist until ctx is cancelled.
+func (app *application) runRSVPSweeper(ctx context.Context) {
+	app.logger.Infow("rsvp sweeper started", "interval", rsvpSweepInterval)
+
+	ticker := time.NewTicker(rsvpSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			app.logger.Infow("rsvp sweeper stopped")
+			return
+		case <-ticker.C:
+			app.sweepConfirmations(ctx)
+		}
+	}
+}
+
+func (app *application) sweepConfirmations(ctx context.Context) {
+	expired, err := app.store.Application.ExpireConfirmations(ctx)
+	if err != nil {
+		app.logger.Errorw("failed to expire confirmations", "error", err)
+		return
+	}
+	if expired > 0 {
+		app.logger.Infow("expired unconfirmed acceptances", "count", expired)
+	}
+
+	config, err := app.store.Settings.GetRSVPConfig(ctx)
+	if err != nil {
+		app.logger.Errorw("failed to read rsvp config", "error", err)
+		return
+	}
+	if config.Capacity <= 0 {
+		return
+	}
+
+	promoted, err := app.store.Application.PromoteWaitlisted(ctx, config.Capacity)
+	if err != nil {
+		app.logger.Errorw("failed to promote waitlisted applications", "error", err)
+		return
+	}
+	if len(promoted) == 0 {
+		return
+	}
+
+	app.logger.Infow("promoted waitlisted applications", "count", len(promoted), "capacity", config.Capacity)
+	app.dispatchDecisionEmails(promoted, store.DecisionEmailKindDecision)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/mailer"
+	"github.com/hackutd/portal/internal/store"
+)
+
+func newAcceptedApplication(confirmation store.ConfirmationStatus, deadline *time.Time) *store.Application {
+	return &store.Application{
+		ID:                   "app-1",
+		UserID:               "user-1",
+		Status:               store.StatusAccepted,
+		ConfirmationStatus:   &confirmation,
+		ConfirmationDeadline: deadline,
+	}
+}
+
+func TestConfirmApplication(t *testing.T) {
+	t.Run("should confirm a pending acceptance", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		deadline := time.Now().Add(24 * time.Hour)
+		mockApps.On("GetByUserID", "user-1").Return(newAcceptedApplication(store.ConfirmationPending, &deadline), nil).Once()
+		mockApps.On("SetConfirmation", "user-1", store.ConfirmationConfirmed).
+			Return(newAcceptedApplication(store.ConfirmationConfirmed, &deadline), nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.confirmApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data store.Application `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.NotNil(t, body.Data.ConfirmationStatus)
+		assert.Equal(t, store.ConfirmationConfirmed, *body.Data.ConfirmationStatus)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 when not accepted", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		mockApps.On("GetByUserID", "user-1").
+			Return(&store.Application{ID: "app-1", UserID: "user-1", Status: store.StatusWaitlisted}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.confirmApplicationHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+
+		mockApps.AssertExpectations(t)
+		mockApps.AssertNotCalled(t, "SetConfirmation", "user-1", store.ConfirmationConfirmed)
+	})
+
+	t.Run("should return 409 after the deadline", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		deadline := time.Now().Add(-time.Hour)
+		mockApps.On("GetByUserID", "user-1").Return(newAcceptedApplication(store.ConfirmationPending, &deadline), nil).Once()
+		mockApps.On("SetConfirmation", "user-1", store.ConfirmationConfirmed).Return(nil, store.ErrConflict).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.confirmApplicationHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Contains(t, rr.Body.String(), "deadline has passed")
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 without an application", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		mockApps.On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.confirmApplicationHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestDeclineApplication(t *testing.T) {
+	t.Run("should decline after confirming", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		mockApps.On("GetByUserID", "user-1").Return(newAcceptedApplication(store.ConfirmationConfirmed, nil), nil).Once()
+		mockApps.On("SetConfirmation", "user-1", store.ConfirmationDeclined).
+			Return(newAcceptedApplication(store.ConfirmationDeclined, nil), nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.declineApplicationHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 when already declined", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		mockApps.On("GetByUserID", "user-1").Return(newAcceptedApplication(store.ConfirmationDeclined, nil), nil).Once()
+		mockApps.On("SetConfirmation", "user-1", store.ConfirmationDeclined).Return(nil, store.ErrConflict).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newTestUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.declineApplicationHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Contains(t, rr.Body.String(), "already declined")
+	})
+}
+
+func TestSweepConfirmations(t *testing.T) {
+	t.Run("expires overdue confirmations and backfills from the waitlist", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockMailer := app.mailer.(*mailer.MockClient)
+
+		promoted := []store.DecisionEmailRecipient{
+			newDecisionRecipient("app-2", "next@test.com", store.StatusAccepted),
+		}
+
+		mockApps.On("ExpireConfirmations").Return(int64(1), nil).Once()
+		mockSettings.On("GetRSVPConfig").Return(store.RSVPConfig{DeadlineHours: 48, Capacity: 300}, nil).Once()
+		mockApps.On("PromoteWaitlisted", 300).Return(promoted, nil).Once()
+		mockMailer.On("SendDecisionEmail", "next@test.com", "Ada", mailer.DecisionAccepted).Return(nil).Once()
+
+		app.sweepConfirmations(t.Context())
+
+		mockApps.AssertExpectations(t)
+		mockSettings.AssertExpectations(t)
+		mockMailer.AssertExpectations(t)
+	})
+
+	t.Run("does not promote when capacity is unset", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		mockApps.On("ExpireConfirmations").Return(int64(0), nil).Once()
+		mockSettings.On("GetRSVPConfig").Return(store.RSVPConfig{DeadlineHours: 48}, nil).Once()
+
+		app.sweepConfirmations(t.Context())
+
+		mockApps.AssertExpectations(t)
+		mockApps.AssertNotCalled(t, "PromoteWaitlisted", 0)
+	})
+}
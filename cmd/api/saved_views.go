@@ -0,0 +1,377 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+)
+
+// savedViewTransientParams change from one request to the next, so they are
+// never stored in a view.
+var savedViewTransientParams = []string{"view_id", "cursor", "direction", "limit", "offset", "format"}
+
+// savedViewParams are the list parameters each resource's views may hold.
+// Application views may also hold f.* answer filters.
+var savedViewParams = map[store.SavedViewResource]map[string]bool{
+	store.SavedViewApplications: {
+		"status": true, "search": true, "team_id": true, "hackathon_id": true,
+		"flagged": true, "sort_by": true, "has_resume": true,
+		"ai_percent_min": true, "ai_percent_max": true,
+		"points_min": true, "points_max": true,
+		"submitted_from": true, "submitted_to": true,
+		"meal_group": true, "text_search": true,
+	},
+	store.SavedViewUsers: {"role": true, "search": true},
+}
+
+type SavedViewPayload struct {
+	Name    string              `json:"name" validate:"required,max=100"`
+	Query   map[string][]string `json:"query"`
+	Columns []string            `json:"columns" validate:"max=100,dive,required,max=100"`
+	Shared  bool                `json:"shared"`
+}
+
+type CreateSavedViewPayload struct {
+	SavedViewPayload
+	Resource store.SavedViewResource `json:"resource" validate:"required,oneof=applications users"`
+}
+
+type SavedViewListResponse struct {
+	Views []store.SavedView `json:"views"`
+}
+
+// listSavedViewsHandler lists the saved views an admin can use
+//
+//	@Summary		List saved views (Admin)
+//	@Description	Lists the caller's own views of a list page followed by views other admins have shared
+//	@Tags			admin/views
+//	@Produce		json
+//	@Param			resource	query		string	true	"List page"	Enums(applications, users)
+//	@Success		200			{object}	SavedViewListResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/views [get]
+func (app *application) listSavedViewsHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("user not in context"))
+		return
+	}
+
+	resource := store.SavedViewResource(r.URL.Query().Get("resource"))
+	if _, ok := savedViewParams[resource]; !ok {
+		app.badRequestResponse(w, r, errors.New("resource must be 'applications' or 'users'"))
+		return
+	}
+
+	views, err := app.store.SavedViews.List(r.Context(), user.ID, resource)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, SavedViewListResponse{Views: views}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// createSavedViewHandler saves a named view
+//
+//	@Summary		Create saved view (Admin)
+//	@Description	Saves a list page's filters, sort and visible columns under a name. Pagination parameters in the query are dropped. Pass the view's ID as view_id to the list, user search or export endpoints to apply it.
+//	@Tags			admin/views
+//	@Accept			json
+//	@Produce		json
+//	@Param			view	body		CreateSavedViewPayload	true	"View to save"
+//	@Success		201		{object}	store.SavedView
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string}	"A view with this name exists"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/views [post]
+func (app *application) createSavedViewHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("user not in context"))
+		return
+	}
+
+	var payload CreateSavedViewPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	payload.Name = strings.TrimSpace(payload.Name)
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	view := &store.SavedView{
+		OwnerID:    user.ID,
+		OwnerEmail: user.Email,
+		Resource:   payload.Resource,
+		Name:       payload.Name,
+		Columns:    payload.Columns,
+		Shared:     payload.Shared,
+	}
+	if !app.setSavedViewQuery(w, r, view, payload.Query) {
+		return
+	}
+
+	if err := app.store.SavedViews.Create(r.Context(), view); err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, errors.New("you already have a view with this name"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusCreated, view); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// updateSavedViewHandler replaces a saved view
+//
+//	@Summary		Update saved view (Admin)
+//	@Description	Replaces the name, query, columns and sharing of one of the caller's views. Shared views can only be changed by their owner.
+//	@Tags			admin/views
+//	@Accept			json
+//	@Produce		json
+//	@Param			viewID	path		string				true	"Saved view ID"
+//	@Param			view	body		SavedViewPayload	true	"New view contents"
+//	@Success		200		{object}	store.SavedView
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string}	"A view with this name exists"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/views/{viewID} [put]
+func (app *application) updateSavedViewHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("user not in context"))
+		return
+	}
+
+	var payload SavedViewPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	payload.Name = strings.TrimSpace(payload.Name)
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	view, ok := app.visibleSavedView(w, r, chi.URLParam(r, "viewID"))
+	if !ok {
+		return
+	}
+	if view.OwnerID != user.ID {
+		app.forbiddenResponse(w, r, errors.New("only the owner can change a shared view"))
+		return
+	}
+
+	view.Name = payload.Name
+	view.Columns = payload.Columns
+	view.Shared = payload.Shared
+	if !app.setSavedViewQuery(w, r, view, payload.Query) {
+		return
+	}
+
+	if err := app.store.SavedViews.Update(r.Context(), view); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			app.notFoundResponse(w, r, errors.New("saved view not found"))
+		case errors.Is(err, store.ErrConflict):
+			app.conflictResponse(w, r, errors.New("you already have a view with this name"))
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, view); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// deleteSavedViewHandler deletes a saved view
+//
+//	@Summary		Delete saved view (Admin)
+//	@Description	Deletes one of the caller's views
+//	@Tags			admin/views
+//	@Param			viewID	path	string	true	"Saved view ID"
+//	@Success		204
+//	@Failure		400	{object}	object{error=string}
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		404	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/views/{viewID} [delete]
+func (app *application) deleteSavedViewHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("user not in context"))
+		return
+	}
+
+	viewID := chi.URLParam(r, "viewID")
+	if err := Validate.Var(viewID, "uuid"); err != nil {
+		app.badRequestResponse(w, r, errors.New("view ID must be a valid UUID"))
+		return
+	}
+
+	if err := app.store.SavedViews.Delete(r.Context(), viewID, user.ID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("saved view not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// visibleSavedView loads a view the caller owns or that is shared, answering
+// 404 for any other. ok is false once a response has been written.
+func (app *application) visibleSavedView(w http.ResponseWriter, r *http.Request, viewID string) (*store.SavedView, bool) {
+	if err := Validate.Var(viewID, "uuid"); err != nil {
+		app.badRequestResponse(w, r, errors.New("view ID must be a valid UUID"))
+		return nil, false
+	}
+
+	view, err := app.store.SavedViews.GetByID(r.Context(), viewID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("saved view not found"))
+			return nil, false
+		}
+		app.internalServerError(w, r, err)
+		return nil, false
+	}
+
+	user := getUserFromContext(r.Context())
+	if user == nil || (view.OwnerID != user.ID && !view.Shared) {
+		app.notFoundResponse(w, r, errors.New("saved view not found"))
+		return nil, false
+	}
+
+	return view, true
+}
+
+// applySavedView resolves the view_id query parameter. The view's parameters
+// are the base and any given on the request override them one by one, so a
+// view can be narrowed or paged without editing it. Without view_id the
+// request's query is returned as is. ok is false once a response has been
+// written.
+func (app *application) applySavedView(w http.ResponseWriter, r *http.Request, resource store.SavedViewResource) (url.Values, *store.SavedView, bool) {
+	query := r.URL.Query()
+	viewID := query.Get("view_id")
+	if viewID == "" {
+		return query, nil, true
+	}
+
+	view, ok := app.visibleSavedView(w, r, viewID)
+	if !ok {
+		return nil, nil, false
+	}
+	if view.Resource != resource {
+		app.badRequestResponse(w, r, fmt.Errorf("view_id names a view of %s, not %s", view.Resource, resource))
+		return nil, nil, false
+	}
+
+	merged := url.Values{}
+	for key, values := range view.Query {
+		merged[key] = values
+	}
+	for key, values := range query {
+		if key != "view_id" {
+			merged[key] = values
+		}
+	}
+
+	return merged, view, true
+}
+
+// setSavedViewQuery checks query the way the view's list endpoint would and
+// stores it on view without pagination parameters. ok is false once a
+// response has been written.
+func (app *application) setSavedViewQuery(w http.ResponseWriter, r *http.Request, view *store.SavedView, query url.Values) bool {
+	query = cleanSavedViewQuery(query)
+
+	allowed := savedViewParams[view.Resource]
+	for key := range query {
+		if !allowed[key] && !(view.Resource == store.SavedViewApplications && strings.HasPrefix(key, responseFilterPrefix)) {
+			app.badRequestResponse(w, r, fmt.Errorf("%s can't be saved in a view of %s", key, view.Resource))
+			return false
+		}
+	}
+
+	var err error
+	switch view.Resource {
+	case store.SavedViewApplications:
+		var filters store.ApplicationListFilters
+		filters, err = parseApplicationListFilters(query)
+		if err == nil && needsSchemaFilters(query) {
+			schema, schemaErr := app.store.Settings.GetApplicationSchema(r.Context())
+			if schemaErr != nil {
+				app.internalServerError(w, r, schemaErr)
+				return false
+			}
+			err = parseSchemaFilters(query, schema, &filters)
+		}
+	case store.SavedViewUsers:
+		_, err = parseUserRoles(query["role"])
+		if search := query.Get("search"); err == nil && search != "" && (len(search) < 2 || len(search) > 100) {
+			err = errors.New("search must be between 2 and 100 characters")
+		}
+	}
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return false
+	}
+
+	view.Query = query
+	return true
+}
+
+// cleanSavedViewQuery drops pagination parameters and empty values.
+func cleanSavedViewQuery(query url.Values) url.Values {
+	cleaned := url.Values{}
+	for key, values := range query {
+		var kept []string
+		for _, v := range values {
+			if v != "" {
+				kept = append(kept, v)
+			}
+		}
+		if len(kept) > 0 {
+			cleaned[key] = kept
+		}
+	}
+	for _, key := range savedViewTransientParams {
+		delete(cleaned, key)
+	}
+	return cleaned
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testViewID = "0d6f3c1e-8a2b-4c5d-9e7f-1a2b3c4d5e6f"
+
+func withViewRouteParam(req *http.Request, viewID string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("viewID", viewID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestCreateSavedView(t *testing.T) {
+	t.Run("should save the filters without pagination", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+
+		mockViews.On("Create", mock.MatchedBy(func(v *store.SavedView) bool {
+			return v.OwnerID == "admin-1" && v.Name == "Accepted CS" && v.Shared &&
+				assert.ObjectsAreEqual(map[string][]string{"status": {"accepted"}, "sort_by": {"accept_votes"}}, v.Query)
+		})).Return(nil).Once()
+
+		body := `{"name":" Accepted CS ","resource":"applications","shared":true,
+			"query":{"status":["accepted"],"sort_by":["accept_votes"],"cursor":["abc"],"search":[""]},
+			"columns":["name","email"]}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createSavedViewHandler))
+		checkResponseCode(t, http.StatusCreated, rr.Code)
+
+		mockViews.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name, body string
+	}{
+		{"invalid filter value", `{"name":"v","resource":"applications","query":{"status":["maybe"]}}`},
+		{"parameter the list doesn't take", `{"name":"v","resource":"applications","query":{"role":["admin"]}}`},
+		{"invalid user role", `{"name":"v","resource":"users","query":{"role":["owner"]}}`},
+		{"unknown resource", `{"name":"v","resource":"teams"}`},
+		{"blank name", `{"name":"  ","resource":"users"}`},
+	} {
+		t.Run("should return 400 for "+tc.name, func(t *testing.T) {
+			app := newTestApplication(t)
+
+			req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			req = setUserContext(req, newAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.createSavedViewHandler))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+
+	t.Run("should return 409 for a duplicate name", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+		mockViews.On("Create", mock.Anything).Return(store.ErrConflict).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"v","resource":"users"}`))
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createSavedViewHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestUpdateSavedView(t *testing.T) {
+	t.Run("should return 403 for another admin's shared view", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+		mockViews.On("GetByID", testViewID).Return(&store.SavedView{
+			ID: testViewID, OwnerID: "someone-else", Resource: store.SavedViewApplications, Shared: true,
+		}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(`{"name":"mine now"}`))
+		require.NoError(t, err)
+		req = withViewRouteParam(setUserContext(req, newAdminUser()), testViewID)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateSavedViewHandler))
+		checkResponseCode(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should return 404 for another admin's private view", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+		mockViews.On("GetByID", testViewID).Return(&store.SavedView{
+			ID: testViewID, OwnerID: "someone-else", Resource: store.SavedViewApplications,
+		}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(`{"name":"mine now"}`))
+		require.NoError(t, err)
+		req = withViewRouteParam(setUserContext(req, newAdminUser()), testViewID)
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateSavedViewHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestApplySavedView(t *testing.T) {
+	t.Run("should list applications with a shared view and request overrides", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+		mockApps := app.store.Application.(*store.MockApplicationStore)
+
+		mockViews.On("GetByID", testViewID).Return(&store.SavedView{
+			ID: testViewID, OwnerID: "someone-else", Resource: store.SavedViewApplications, Shared: true,
+			Query: map[string][]string{"status": {"accepted"}, "sort_by": {"reject_votes"}},
+		}, nil).Once()
+
+		status := store.StatusSubmitted
+		mockApps.On("List",
+			store.ApplicationListFilters{Status: &status, SortBy: store.SortByRejectVotes},
+			(*store.ApplicationCursor)(nil),
+			store.DirectionForward,
+			20,
+		).Return(&store.ApplicationListResult{Applications: []store.ApplicationListItem{}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?view_id="+testViewID+"&status=submitted&limit=20", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 for a view of another list", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+		mockViews.On("GetByID", testViewID).Return(&store.SavedView{
+			ID: testViewID, OwnerID: "superadmin-1", Resource: store.SavedViewApplications,
+		}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?view_id="+testViewID, nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.searchUsersHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should search users with a saved view", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+		mockUsers := app.store.Users.(*store.MockUsersStore)
+
+		mockViews.On("GetByID", testViewID).Return(&store.SavedView{
+			ID: testViewID, OwnerID: "superadmin-1", Resource: store.SavedViewUsers,
+			Query: map[string][]string{"search": {"jane"}},
+		}, nil).Once()
+		mockUsers.On("Search", "jane", 20, 0).Return(&store.UserSearchResult{Users: []store.UserListItem{}}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?view_id="+testViewID, nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.searchUsersHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockUsers.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 for a missing view", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockViews := app.store.SavedViews.(*store.MockSavedViewsStore)
+		mockViews.On("GetByID", testViewID).Return(nil, store.ErrNotFound).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?view_id="+testViewID, nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listApplicationsHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestExportColumnSelection(t *testing.T) {
+	keys := exportColumnKeys([]store.ApplicationSchemaField{{ID: "first_name"}, {ID: "last_name"}, {ID: "major"}})
+
+	assert.Nil(t, exportColumnSelection(keys, nil))
+	assert.Nil(t, exportColumnSelection(keys, []string{"updated_at"}))
+
+	keep := exportColumnSelection(keys, []string{"name", "status", "points", "updated_at"})
+	var picked []string
+	for _, i := range keep {
+		picked = append(picked, keys[i])
+	}
+	assert.Equal(t, []string{"id", "status", "first_name", "last_name", "points"}, picked)
+	assert.Equal(t, []string{"b", "d"}, pickColumns([]string{"a", "b", "c", "d"}, []int{1, 3}))
+}
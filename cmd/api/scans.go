@@ -6,13 +6,15 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi"
 	"github.com/hackutd/portal/internal/store"
 )
 
 type CreateScanPayload struct {
-	UserID   string `json:"user_id" validate:"required"`
+	// QRToken is the signed payload read from the hacker's QR code.
+	QRToken  string `json:"qr_token" validate:"required"`
 	ScanType string `json:"scan_type" validate:"required"`
 }
 
@@ -66,7 +68,7 @@ func (app *application) getScanTypesHandler(w http.ResponseWriter, r *http.Reque
 // createScanHandler records a scan for a user
 //
 //	@Summary		Create a scan (Admin)
-//	@Description	Records a scan for a user. Validates scan type exists and is active. Non-check_in scans require the user to have checked in first. Shop scans deduct the type's points from the user's balance and are repeatable.
+//	@Description	Records a scan for the user identified by a signed QR token. Validates scan type exists and is active, and rejects tokens that are forged, expired, or issued for a previous hackathon. Check-in scans require every required policy to be signed at its latest version. Non-check_in scans require the user to have checked in first. Shop scans deduct the type's points from the user's balance and are repeatable.
 //	@Tags			admin/scans
 //	@Accept			json
 //	@Produce		json
@@ -118,9 +120,21 @@ func (app *application) createScanHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	claims, err := app.verifyQRToken(r.Context(), req.QRToken)
+	if err != nil {
+		if isQRTokenError(err) {
+			app.badRequestResponse(w, r, err)
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+	userID := claims.UserID
+	admin := getUserFromContext(r.Context())
+
 	// Walk-in scan: skip check-in prerequisite, enqueue user, send queued email.
 	if found.Category == store.ScanCategoryWalkIn {
-		scannedUser, err := app.store.Users.GetByID(r.Context(), req.UserID)
+		scannedUser, err := app.store.Users.GetByID(r.Context(), userID)
 		if err != nil {
 			if errors.Is(err, store.ErrNotFound) {
 				app.notFoundResponse(w, r, errors.New("user not found"))
@@ -130,7 +144,7 @@ func (app *application) createScanHandler(w http.ResponseWriter, r *http.Request
 			return
 		}
 
-		inserted, position, err := app.store.WalkIns.Enqueue(r.Context(), req.UserID)
+		inserted, position, err := app.store.WalkIns.Enqueue(r.Context(), userID, admin.ID)
 		if err != nil {
 			app.internalServerError(w, r, err)
 			return
@@ -151,7 +165,7 @@ func (app *application) createScanHandler(w http.ResponseWriter, r *http.Request
 			}
 		}
 
-		hasCheckIn, err := app.store.Scans.HasCheckIn(r.Context(), req.UserID, checkInTypes)
+		hasCheckIn, err := app.store.Scans.HasCheckIn(r.Context(), userID, checkInTypes)
 		if err != nil {
 			app.internalServerError(w, r, err)
 			return
@@ -162,8 +176,8 @@ func (app *application) createScanHandler(w http.ResponseWriter, r *http.Request
 			return
 		}
 	} else {
-		// Check-in scan: require accepted status.
-		status, err := app.store.Application.GetStatusByUserID(r.Context(), req.UserID)
+		// Check-in scan: require accepted status with the spot still held.
+		application, err := app.store.Application.GetByUserID(r.Context(), userID)
 		if err != nil {
 			if errors.Is(err, store.ErrNotFound) {
 				app.forbiddenResponse(w, r, errors.New("user has no application"))
@@ -172,16 +186,40 @@ func (app *application) createScanHandler(w http.ResponseWriter, r *http.Request
 			app.internalServerError(w, r, err)
 			return
 		}
-		if status != store.StatusAccepted {
-			app.forbiddenResponse(w, r, fmt.Errorf("user is not accepted (status: %s)", status))
+		if application.Status != store.StatusAccepted {
+			app.forbiddenResponse(w, r, fmt.Errorf("user is not accepted (status: %s)", application.Status))
+			return
+		}
+		if c := application.ConfirmationStatus; c != nil && (*c == store.ConfirmationDeclined || *c == store.ConfirmationExpired) {
+			app.forbiddenResponse(w, r, fmt.Errorf("user gave up their spot (confirmation: %s)", *c))
 			return
 		}
-	}
 
-	admin := getUserFromContext(r.Context())
+		// The age is read now rather than trusted from signing time: a hacker
+		// who signed before answering it still needs a guardian's confirmed
+		// signature.
+		minor := applicantIsMinor(application)
+		missing, err := app.store.Policies.MissingRequired(r.Context(), userID, minor)
+		if err != nil {
+			app.internalServerError(w, r, err)
+			return
+		}
+		if len(missing) > 0 {
+			titles := make([]string, len(missing))
+			for i, p := range missing {
+				titles[i] = p.Title
+			}
+			if minor {
+				app.forbiddenResponse(w, r, fmt.Errorf("user must sign %s, and their guardian confirm it, before checking in", strings.Join(titles, ", ")))
+				return
+			}
+			app.forbiddenResponse(w, r, fmt.Errorf("user must sign %s before checking in", strings.Join(titles, ", ")))
+			return
+		}
+	}
 
 	scan := &store.Scan{
-		UserID:    req.UserID,
+		UserID:    userID,
 		ScanType:  req.ScanType,
 		ScannedBy: admin.ID,
 		Points:    found.Points,
@@ -221,13 +259,15 @@ func (app *application) createScanHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	app.recordAudit(r, store.AuditActionScanCreate, store.AuditTargetUser, userID, nil, scan)
+
 	var mealGroup *string
 	if found.Category == store.ScanCategoryCheckIn {
-		mealGroup = app.assignMealGroup(r.Context(), req.UserID)
+		mealGroup = app.assignMealGroup(r.Context(), userID)
 	} else {
-		mealGroup, err = app.store.Application.GetMealGroupByUserID(r.Context(), req.UserID)
+		mealGroup, err = app.store.Application.GetMealGroupByUserID(r.Context(), userID)
 		if err != nil && !errors.Is(err, store.ErrNotFound) {
-			app.logger.Warnw("failed to fetch meal group for scan response", "user_id", req.UserID, "error", err)
+			app.logger.Warnw("failed to fetch meal group for scan response", "user_id", userID, "error", err)
 		}
 	}
 
@@ -283,7 +323,8 @@ func (app *application) assignMealGroup(ctx context.Context, userID string) *str
 //	@Description	Returns all scan records for the specified user, ordered by most recent first
 //	@Tags			admin/scans
 //	@Produce		json
-//	@Param			userID	path		string	true	"User ID"
+//	@Param			userID			path		string	true	"User ID"
+//	@Param			hackathon_id	query		string	false	"Read an archived hackathon (default: the active one)"
 //	@Success		200		{object}	ScansResponse
 //	@Failure		400		{object}	object{error=string}
 //	@Failure		401		{object}	object{error=string}
@@ -298,7 +339,13 @@ func (app *application) getUserScansHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	scans, err := app.store.Scans.GetByUserID(r.Context(), userID)
+	hackathonID, err := hackathonIDParam(r.URL.Query())
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	scans, err := app.store.Scans.GetByUserID(r.Context(), userID, hackathonID)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -315,14 +362,22 @@ func (app *application) getUserScansHandler(w http.ResponseWriter, r *http.Reque
 //	@Description	Returns aggregate scan counts grouped by scan type
 //	@Tags			admin/scans
 //	@Produce		json
+//	@Param			hackathon_id	query		string	false	"Read an archived hackathon (default: the active one)"
 //	@Success		200	{object}	ScanStatsResponse
+//	@Failure		400	{object}	object{error=string}
 //	@Failure		401	{object}	object{error=string}
 //	@Failure		403	{object}	object{error=string}
 //	@Failure		500	{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/admin/scans/stats [get]
 func (app *application) getScanStatsHandler(w http.ResponseWriter, r *http.Request) {
-	stats, err := app.store.Scans.GetStats(r.Context())
+	hackathonID, err := hackathonIDParam(r.URL.Query())
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	stats, err := app.store.Scans.GetStats(r.Context(), hackathonID)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -417,7 +472,9 @@ func (app *application) updateScanTypesHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	if err := app.store.Settings.UpdateScanTypes(r.Context(), req.ScanTypes); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyScanTypes, func() error {
+		return app.store.Settings.UpdateScanTypes(r.Context(), req.ScanTypes)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
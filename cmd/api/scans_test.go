@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"testing"
@@ -47,6 +48,11 @@ func TestGetScanTypes(t *testing.T) {
 	})
 }
 
+func scanRequestBody(t *testing.T, app *application, userID, scanType string) string {
+	t.Helper()
+	return fmt.Sprintf(`{"qr_token":%q,"scan_type":%q}`, newTestQRToken(t, app, userID), scanType)
+}
+
 func TestCreateScan(t *testing.T) {
 	scanTypes := []store.ScanType{
 		{Name: "check_in", DisplayName: "Check In", Category: store.ScanCategoryCheckIn, IsActive: true, Points: 10},
@@ -64,7 +70,8 @@ func TestCreateScan(t *testing.T) {
 		hackerApp := &store.Application{ID: "app-1", UserID: "user-1", MealGroup: nil}
 
 		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
-		mockApps.On("GetStatusByUserID", "user-1").Return(store.StatusAccepted, nil).Once()
+		mockApps.On("GetByUserID", "user-1").Return(&store.Application{UserID: "user-1", Status: store.StatusAccepted}, nil).Once()
+		app.store.Policies.(*store.MockPoliciesStore).On("MissingRequired", "user-1", false).Return([]store.Policy{}, nil).Once()
 		mockSettings.On("GetMealGroups").Return(groups, nil).Once()
 		mockApps.On("GetByUserID", "user-1").Return(hackerApp, nil).Once()
 		mockApps.On("SetMealGroup", "app-1", mock.AnythingOfType("string")).
@@ -73,7 +80,7 @@ func TestCreateScan(t *testing.T) {
 			return s.Points == 10
 		})).Return(nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"check_in"}`
+		body := scanRequestBody(t, app, "user-1", "check_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -111,7 +118,7 @@ func TestCreateScan(t *testing.T) {
 		})).Return(nil).Once()
 		mockApps.On("GetMealGroupByUserID", "user-1").Return(&mealGroup, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"lunch"}`
+		body := scanRequestBody(t, app, "user-1", "lunch")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -143,12 +150,13 @@ func TestCreateScan(t *testing.T) {
 		hackerApp := &store.Application{ID: "app-1", UserID: "user-1", MealGroup: &existing}
 
 		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
-		mockApps.On("GetStatusByUserID", "user-1").Return(store.StatusAccepted, nil).Once()
+		mockApps.On("GetByUserID", "user-1").Return(&store.Application{UserID: "user-1", Status: store.StatusAccepted}, nil).Once()
+		app.store.Policies.(*store.MockPoliciesStore).On("MissingRequired", "user-1", false).Return([]store.Policy{}, nil).Once()
 		mockSettings.On("GetMealGroups").Return(groups, nil).Once()
 		mockApps.On("GetByUserID", "user-1").Return(hackerApp, nil).Once()
 		mockScans.On("Create", mock.AnythingOfType("*store.Scan")).Return(nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"check_in"}`
+		body := scanRequestBody(t, app, "user-1", "check_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -179,11 +187,12 @@ func TestCreateScan(t *testing.T) {
 		mockApps := app.store.Application.(*store.MockApplicationStore)
 
 		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
-		mockApps.On("GetStatusByUserID", "user-1").Return(store.StatusAccepted, nil).Once()
+		mockApps.On("GetByUserID", "user-1").Return(&store.Application{UserID: "user-1", Status: store.StatusAccepted}, nil).Once()
+		app.store.Policies.(*store.MockPoliciesStore).On("MissingRequired", "user-1", false).Return([]store.Policy{}, nil).Once()
 		mockSettings.On("GetMealGroups").Return(nil, errors.New("db error")).Once()
 		mockScans.On("Create", mock.AnythingOfType("*store.Scan")).Return(nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"check_in"}`
+		body := scanRequestBody(t, app, "user-1", "check_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -217,7 +226,7 @@ func TestCreateScan(t *testing.T) {
 		mockScans.On("Create", mock.AnythingOfType("*store.Scan")).Return(nil).Once()
 		mockApps.On("GetMealGroupByUserID", "user-1").Return(&mealGroup, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"lunch"}`
+		body := scanRequestBody(t, app, "user-1", "lunch")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -239,7 +248,7 @@ func TestCreateScan(t *testing.T) {
 		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
 		mockScans.On("HasCheckIn", "user-1", []string{"check_in"}).Return(false, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"lunch"}`
+		body := scanRequestBody(t, app, "user-1", "lunch")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -259,10 +268,11 @@ func TestCreateScan(t *testing.T) {
 		mockApp := app.store.Application.(*store.MockApplicationStore)
 
 		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
-		mockApp.On("GetStatusByUserID", "user-1").Return(store.StatusAccepted, nil).Once()
+		mockApp.On("GetByUserID", "user-1").Return(&store.Application{UserID: "user-1", Status: store.StatusAccepted}, nil).Once()
+		app.store.Policies.(*store.MockPoliciesStore).On("MissingRequired", "user-1", false).Return([]store.Policy{}, nil).Once()
 		mockScans.On("Create", mock.AnythingOfType("*store.Scan")).Return(store.ErrConflict).Once()
 
-		body := `{"user_id":"user-1","scan_type":"check_in"}`
+		body := scanRequestBody(t, app, "user-1", "check_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -282,7 +292,7 @@ func TestCreateScan(t *testing.T) {
 
 		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"nonexistent"}`
+		body := scanRequestBody(t, app, "user-1", "nonexistent")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -300,7 +310,7 @@ func TestCreateScan(t *testing.T) {
 
 		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"inactive_item"}`
+		body := scanRequestBody(t, app, "user-1", "inactive_item")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -345,7 +355,7 @@ func TestCreateScan(t *testing.T) {
 		})).Return(70, nil).Once()
 		mockApps.On("GetMealGroupByUserID", "user-1").Return(&mealGroup, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"hoodie"}`
+		body := scanRequestBody(t, app, "user-1", "hoodie")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -380,7 +390,7 @@ func TestCreateScan(t *testing.T) {
 		mockScans.On("CreatePurchase", mock.AnythingOfType("*store.Scan")).
 			Return(30, store.ErrInsufficientPoints).Once()
 
-		body := `{"user_id":"user-1","scan_type":"hoodie"}`
+		body := scanRequestBody(t, app, "user-1", "hoodie")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -408,7 +418,7 @@ func TestCreateScan(t *testing.T) {
 		mockSettings.On("GetScanTypes").Return(shopScanTypes, nil).Once()
 		mockScans.On("HasCheckIn", "user-1", []string{"check_in"}).Return(false, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"hoodie"}`
+		body := scanRequestBody(t, app, "user-1", "hoodie")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -440,12 +450,12 @@ func TestCreateScan(t *testing.T) {
 
 		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
 		mockUsers.On("GetByID", "user-1").Return(&store.User{ID: "user-1", Email: "hacker@test.com"}, nil).Once()
-		mockWalkIns.On("Enqueue", "user-1").Return(true, 7, nil).Once()
+		mockWalkIns.On("Enqueue", "user-1", "admin-1").Return(true, 7, nil).Once()
 		mockMailer.On("SendWalkInQueuedEmail", "hacker@test.com", 7).Return(nil).Maybe()
 		mockScans.On("Create", mock.AnythingOfType("*store.Scan")).Return(nil).Once()
 		mockApps.On("GetMealGroupByUserID", "user-1").Return((*string)(nil), store.ErrNotFound).Once()
 
-		body := `{"user_id":"user-1","scan_type":"walk_in"}`
+		body := scanRequestBody(t, app, "user-1", "walk_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -472,11 +482,11 @@ func TestCreateScan(t *testing.T) {
 
 		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
 		mockUsers.On("GetByID", "user-1").Return(&store.User{ID: "user-1", Email: "hacker@test.com"}, nil).Once()
-		mockWalkIns.On("Enqueue", "user-1").Return(false, 0, nil).Once()
+		mockWalkIns.On("Enqueue", "user-1", "admin-1").Return(false, 0, nil).Once()
 		mockScans.On("Create", mock.AnythingOfType("*store.Scan")).Return(nil).Once()
 		mockApps.On("GetMealGroupByUserID", "user-1").Return((*string)(nil), store.ErrNotFound).Once()
 
-		body := `{"user_id":"user-1","scan_type":"walk_in"}`
+		body := scanRequestBody(t, app, "user-1", "walk_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -502,11 +512,11 @@ func TestCreateScan(t *testing.T) {
 
 		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
 		mockUsers.On("GetByID", "user-1").Return(&store.User{ID: "user-1", Email: "hacker@test.com"}, nil).Once()
-		mockWalkIns.On("Enqueue", "user-1").Return(false, 0, nil).Once()
+		mockWalkIns.On("Enqueue", "user-1", "admin-1").Return(false, 0, nil).Once()
 		mockScans.On("Create", mock.AnythingOfType("*store.Scan")).Return(nil).Once()
 		mockApps.On("GetMealGroupByUserID", "user-1").Return((*string)(nil), store.ErrNotFound).Once()
 
-		body := `{"user_id":"user-1","scan_type":"walk_in"}`
+		body := scanRequestBody(t, app, "user-1", "walk_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -523,9 +533,86 @@ func TestCreateScan(t *testing.T) {
 		mockApp := app.store.Application.(*store.MockApplicationStore)
 
 		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
-		mockApp.On("GetStatusByUserID", "user-1").Return(store.StatusWaitlisted, nil).Once()
+		mockApp.On("GetByUserID", "user-1").Return(&store.Application{UserID: "user-1", Status: store.StatusWaitlisted}, nil).Once()
+
+		body := scanRequestBody(t, app, "user-1", "check_in")
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createScanHandler))
+		checkResponseCode(t, http.StatusForbidden, rr.Code)
+
+		mockSettings.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("check-in scan of user who declined returns 403", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockApp := app.store.Application.(*store.MockApplicationStore)
+
+		declined := store.ConfirmationDeclined
+		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
+		mockApp.On("GetByUserID", "user-1").
+			Return(&store.Application{UserID: "user-1", Status: store.StatusAccepted, ConfirmationStatus: &declined}, nil).Once()
+
+		body := scanRequestBody(t, app, "user-1", "check_in")
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createScanHandler))
+		checkResponseCode(t, http.StatusForbidden, rr.Code)
+
+		mockSettings.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("check-in scan with unsigned policies returns 403", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockApp := app.store.Application.(*store.MockApplicationStore)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
+		mockApp.On("GetByUserID", "user-1").Return(&store.Application{UserID: "user-1", Status: store.StatusAccepted}, nil).Once()
+		mockPolicies.On("MissingRequired", "user-1", false).Return([]store.Policy{
+			{ID: "policy-1", Title: "Code of Conduct", Version: 2, Required: true},
+			{ID: "policy-2", Title: "Liability Waiver", Version: 1, Required: true},
+		}, nil).Once()
+
+		body := scanRequestBody(t, app, "user-1", "check_in")
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createScanHandler))
+		checkResponseCode(t, http.StatusForbidden, rr.Code)
+
+		mockSettings.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+		mockPolicies.AssertExpectations(t)
+	})
+
+	t.Run("check-in scan of minor with guardian-less signatures returns 403", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockApp := app.store.Application.(*store.MockApplicationStore)
+		mockPolicies := app.store.Policies.(*store.MockPoliciesStore)
+
+		// Signed while the draft had no age, so no guardian was asked for.
+		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
+		mockApp.On("GetByUserID", "user-1").
+			Return(&store.Application{UserID: "user-1", Status: store.StatusAccepted, Responses: json.RawMessage(`{"age": 16}`)}, nil).Once()
+		mockPolicies.On("MissingRequired", "user-1", true).Return([]store.Policy{
+			{ID: "policy-1", Title: "Liability Waiver", Version: 1, Required: true},
+		}, nil).Once()
 
-		body := `{"user_id":"user-1","scan_type":"check_in"}`
+		body := scanRequestBody(t, app, "user-1", "check_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -536,6 +623,7 @@ func TestCreateScan(t *testing.T) {
 
 		mockSettings.AssertExpectations(t)
 		mockApp.AssertExpectations(t)
+		mockPolicies.AssertExpectations(t)
 	})
 
 	t.Run("check-in scan of user with no application returns 403", func(t *testing.T) {
@@ -544,9 +632,9 @@ func TestCreateScan(t *testing.T) {
 		mockApp := app.store.Application.(*store.MockApplicationStore)
 
 		mockSettings.On("GetScanTypes").Return(walkInScanTypes, nil).Once()
-		mockApp.On("GetStatusByUserID", "user-1").Return(store.ApplicationStatus(""), store.ErrNotFound).Once()
+		mockApp.On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
 
-		body := `{"user_id":"user-1","scan_type":"check_in"}`
+		body := scanRequestBody(t, app, "user-1", "check_in")
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
@@ -558,6 +646,49 @@ func TestCreateScan(t *testing.T) {
 		mockSettings.AssertExpectations(t)
 		mockApp.AssertExpectations(t)
 	})
+
+	t.Run("raw user ID is rejected", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockScans := app.store.Scans.(*store.MockScansStore)
+
+		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
+
+		body := `{"qr_token":"user-1","scan_type":"lunch"}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createScanHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+
+		mockScans.AssertNotCalled(t, "HasCheckIn", mock.Anything, mock.Anything)
+		mockScans.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("token from a previous hackathon is rejected", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockScans := app.store.Scans.(*store.MockScansStore)
+
+		mockSettings.On("GetScanTypes").Return(scanTypes, nil).Once()
+
+		token, err := app.qrTokens.Issue("user-1", "last-year", 0)
+		require.NoError(t, err)
+
+		body := fmt.Sprintf(`{"qr_token":%q,"scan_type":"lunch"}`, token)
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.createScanHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "different hackathon")
+
+		mockScans.AssertNotCalled(t, "Create", mock.Anything)
+	})
 }
 
 func TestGetUserScans(t *testing.T) {
@@ -570,7 +701,7 @@ func TestGetUserScans(t *testing.T) {
 			{ID: "scan-2", UserID: "user-1", ScanType: "lunch", ScannedBy: "admin-1", ScannedAt: time.Now(), CreatedAt: time.Now()},
 		}
 
-		mockScans.On("GetByUserID", "user-1").Return(scans, nil).Once()
+		mockScans.On("GetByUserID", "user-1", (*string)(nil)).Return(scans, nil).Once()
 
 		r := chi.NewRouter()
 		r.Get("/scans/user/{userID}", app.getUserScansHandler)
@@ -595,7 +726,7 @@ func TestGetUserScans(t *testing.T) {
 		app := newTestApplication(t)
 		mockScans := app.store.Scans.(*store.MockScansStore)
 
-		mockScans.On("GetByUserID", "user-2").Return([]store.Scan{}, nil).Once()
+		mockScans.On("GetByUserID", "user-2", (*string)(nil)).Return([]store.Scan{}, nil).Once()
 
 		r := chi.NewRouter()
 		r.Get("/scans/user/{userID}", app.getUserScansHandler)
@@ -638,7 +769,7 @@ func TestGetScanStats(t *testing.T) {
 			{ScanType: "lunch", Count: 30},
 		}
 
-		mockScans.On("GetStats").Return(stats, nil).Once()
+		mockScans.On("GetStats", (*string)(nil)).Return(stats, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/", nil)
 		require.NoError(t, err)
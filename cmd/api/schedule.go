@@ -73,7 +73,7 @@ func (app *application) getAdminScheduleDateRange(w http.ResponseWriter, r *http
 //	@Security		CookieAuth
 //	@Router			/schedule [get]
 func (app *application) getHackerScheduleHandler(w http.ResponseWriter, r *http.Request) {
-	app.listScheduleHandler(w, r)
+	app.writeScheduleList(w, r, nil)
 }
 
 // getHackerScheduleDateRange returns configured hackathon start/end dates.
@@ -111,14 +111,28 @@ func (app *application) getHackerScheduleDateRange(w http.ResponseWriter, r *htt
 //	@Description	Returns the full event schedule, ordered by start time ascending
 //	@Tags			admin/schedule
 //	@Produce		json
+//	@Param			hackathon_id	query		string	false	"Read an archived hackathon (default: the active one)"
 //	@Success		200	{object}	ScheduleListResponse
+//	@Failure		400	{object}	object{error=string}
 //	@Failure		401	{object}	object{error=string}
 //	@Failure		403	{object}	object{error=string}
 //	@Failure		500	{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/admin/schedule [get]
 func (app *application) listScheduleHandler(w http.ResponseWriter, r *http.Request) {
-	items, err := app.store.Schedule.List(r.Context())
+	hackathonID, err := hackathonIDParam(r.URL.Query())
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	app.writeScheduleList(w, r, hackathonID)
+}
+
+// writeScheduleList responds with a hackathon's schedule; nil is the active
+// one. Hacker and public reads always pass nil.
+func (app *application) writeScheduleList(w http.ResponseWriter, r *http.Request, hackathonID *string) {
+	items, err := app.store.Schedule.List(r.Context(), hackathonID)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -194,17 +208,25 @@ func (app *application) createScheduleHandler(w http.ResponseWriter, r *http.Req
 //	@Produce		json
 //	@Param			scheduleID	path		string					true	"Schedule item ID"
 //	@Param			schedule	body		UpdateSchedulePayload	true	"Schedule item to update"
+//	@Param			If-Match	header		string					true	"ETag of the item being edited (its quoted updated_at)"
 //	@Success		200			{object}	ScheduleItemResponse
 //	@Failure		400			{object}	object{error=string}
 //	@Failure		401			{object}	object{error=string}
 //	@Failure		403			{object}	object{error=string}
 //	@Failure		404			{object}	object{error=string}
+//	@Failure		409			{object}	object{error=string,data=ScheduleItemResponse}	"Item changed since it was loaded; data is the current copy"
+//	@Failure		428			{object}	object{error=string}
 //	@Failure		500			{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/admin/schedule/{scheduleID} [put]
 func (app *application) updateScheduleHandler(w http.ResponseWriter, r *http.Request) {
 	scheduleID := chi.URLParam(r, "scheduleID")
 
+	loadedAt, ok := app.requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var payload UpdateSchedulePayload
 	if err := readJSON(w, r, &payload); err != nil {
 		app.badRequestResponse(w, r, err)
@@ -234,9 +256,17 @@ func (app *application) updateScheduleHandler(w http.ResponseWriter, r *http.Req
 		EndTime:     payload.EndTime,
 		Location:    payload.Location,
 		Tags:        store.StringArray(tags),
+		UpdatedAt:   loadedAt,
 	}
 
 	if err := app.store.Schedule.Update(r.Context(), item); err != nil {
+		if errors.Is(err, store.ErrStale) {
+			var current *store.ScheduleItem
+			if current, err = app.store.Schedule.GetByID(r.Context(), scheduleID); err == nil {
+				app.staleWriteResponse(w, r, ScheduleItemResponse{Schedule: *current}, current.UpdatedAt)
+				return
+			}
+		}
 		if errors.Is(err, store.ErrNotFound) {
 			app.notFoundResponse(w, r, err)
 			return
@@ -245,6 +275,7 @@ func (app *application) updateScheduleHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	w.Header().Set("ETag", etagFor(item.UpdatedAt))
 	if err := app.jsonResponse(w, http.StatusOK, ScheduleItemResponse{Schedule: *item}); err != nil {
 		app.internalServerError(w, r, err)
 	}
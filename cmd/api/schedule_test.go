@@ -44,7 +44,7 @@ func TestListSchedule(t *testing.T) {
 				Tags:      store.StringArray{},
 			},
 		}
-		mockSchedule.On("List").Return(items, nil).Once()
+		mockSchedule.On("List", (*string)(nil)).Return(items, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/", nil)
 		require.NoError(t, err)
@@ -219,6 +219,7 @@ func TestUpdateSchedule(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPut, "/item-1", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"2026-03-01T12:00:00Z"`)
 		req = setUserContext(req, newSuperAdminUser())
 
 		rr := executeRequest(req, r)
@@ -253,6 +254,7 @@ func TestUpdateSchedule(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPut, "/nonexistent", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"2026-03-01T12:00:00Z"`)
 		req = setUserContext(req, newSuperAdminUser())
 
 		rr := executeRequest(req, r)
@@ -261,6 +263,63 @@ func TestUpdateSchedule(t *testing.T) {
 		mockSettings.AssertExpectations(t)
 		mockSchedule.AssertExpectations(t)
 	})
+
+	t.Run("returns 409 with the current item when it changed", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockSchedule := app.store.Schedule.(*store.MockScheduleStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		r := scheduleRouter(app)
+		start := "2026-03-13"
+		end := "2026-03-15"
+		current := &store.ScheduleItem{
+			ID:        "item-1",
+			EventName: "Renamed Elsewhere",
+			Tags:      store.StringArray{},
+			UpdatedAt: time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC),
+		}
+
+		mockSettings.On("GetHackathonDateRange").Return(store.HackathonDateRange{
+			StartDate: &start,
+			EndDate:   &end,
+		}, nil).Once()
+		mockSchedule.On("Update", mock.MatchedBy(func(item *store.ScheduleItem) bool {
+			return item.UpdatedAt.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+		})).Return(store.ErrStale).Once()
+		mockSchedule.On("GetByID", "item-1").Return(current, nil).Once()
+
+		body := `{"event_name":"Updated Ceremony","start_time":"2026-03-14T11:00:00Z","end_time":"2026-03-14T12:00:00Z"}`
+		req, err := http.NewRequest(http.MethodPut, "/item-1", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"2026-03-01T12:00:00Z"`)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, r)
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, etagFor(current.UpdatedAt), rr.Header().Get("ETag"))
+
+		var resp struct {
+			Data ScheduleItemResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, "Renamed Elsewhere", resp.Data.Schedule.EventName)
+
+		mockSchedule.AssertExpectations(t)
+	})
+
+	t.Run("returns 428 without If-Match", func(t *testing.T) {
+		app := newTestApplication(t)
+		r := scheduleRouter(app)
+
+		body := `{"event_name":"Updated Ceremony","start_time":"2026-03-14T11:00:00Z","end_time":"2026-03-14T12:00:00Z"}`
+		req, err := http.NewRequest(http.MethodPut, "/item-1", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, r)
+		checkResponseCode(t, http.StatusPreconditionRequired, rr.Code)
+	})
 }
 
 func TestScheduleDateRangeValidation(t *testing.T) {
@@ -0,0 +1,385 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+)
+
+type ApplicationSchemaVersionsResponse struct {
+	Versions []store.ApplicationSchemaVersion `json:"versions"`
+}
+
+// SchemaFieldChange describes a field present in both versions of a diff.
+// Changes names the attributes that differ.
+type SchemaFieldChange struct {
+	ID      string                       `json:"id"`
+	Changes []string                     `json:"changes"`
+	Before  store.ApplicationSchemaField `json:"before"`
+	After   store.ApplicationSchemaField `json:"after"`
+}
+
+type ApplicationSchemaDiff struct {
+	FromVersion int                            `json:"from_version"`
+	ToVersion   int                            `json:"to_version"`
+	Added       []store.ApplicationSchemaField `json:"added"`
+	Removed     []store.ApplicationSchemaField `json:"removed"`
+	Changed     []SchemaFieldChange            `json:"changed"`
+	// SuggestedMapping pairs removed fields with added ones of the same type
+	// and label, which are most likely renames.
+	SuggestedMapping map[string]string `json:"suggested_mapping"`
+}
+
+type RemapResponsesPayload struct {
+	// Mapping renames answers from an old field ID to a field ID in the
+	// latest version.
+	Mapping map[string]string `json:"mapping" validate:"dive,keys,required,max=50,endkeys,required,max=50"`
+}
+
+type RemapResponsesResponse struct {
+	FromVersion  int `json:"from_version"`
+	ToVersion    int `json:"to_version"`
+	Applications int `json:"applications"`
+}
+
+// schemaFieldAttributes are the attributes compared by diffSchemas, in the
+// order changes are reported.
+var schemaFieldAttributes = []struct {
+	name string
+	get  func(store.ApplicationSchemaField) any
+}{
+	{"type", func(f store.ApplicationSchemaField) any { return f.Type }},
+	{"label", func(f store.ApplicationSchemaField) any { return f.Label }},
+	{"required", func(f store.ApplicationSchemaField) any { return f.Required }},
+	{"section", func(f store.ApplicationSchemaField) any {
+		return [3]any{f.Section, f.SectionLabel, f.SectionOrder}
+	}},
+	{"display_order", func(f store.ApplicationSchemaField) any { return f.DisplayOrder }},
+	{"options", func(f store.ApplicationSchemaField) any { return f.Options }},
+	{"validation", func(f store.ApplicationSchemaField) any { return f.Validation }},
+	{"show_if", func(f store.ApplicationSchemaField) any { return f.ShowIf }},
+	{"require_if", func(f store.ApplicationSchemaField) any { return f.RequireIf }},
+}
+
+// diffSchemas compares two schema versions field by field, matching fields
+// by ID. Fields are reported in the order they appear in their version.
+func diffSchemas(from, to []store.ApplicationSchemaField) ApplicationSchemaDiff {
+	diff := ApplicationSchemaDiff{
+		Added:            []store.ApplicationSchemaField{},
+		Removed:          []store.ApplicationSchemaField{},
+		Changed:          []SchemaFieldChange{},
+		SuggestedMapping: map[string]string{},
+	}
+
+	toByID := make(map[string]store.ApplicationSchemaField, len(to))
+	for _, f := range to {
+		toByID[f.ID] = f
+	}
+	fromIDs := make(map[string]bool, len(from))
+
+	for _, before := range from {
+		fromIDs[before.ID] = true
+		after, ok := toByID[before.ID]
+		if !ok {
+			diff.Removed = append(diff.Removed, before)
+			continue
+		}
+		var changes []string
+		for _, attr := range schemaFieldAttributes {
+			if !reflect.DeepEqual(attr.get(before), attr.get(after)) {
+				changes = append(changes, attr.name)
+			}
+		}
+		if len(changes) > 0 {
+			diff.Changed = append(diff.Changed, SchemaFieldChange{
+				ID:      before.ID,
+				Changes: changes,
+				Before:  before,
+				After:   after,
+			})
+		}
+	}
+
+	for _, f := range to {
+		if !fromIDs[f.ID] {
+			diff.Added = append(diff.Added, f)
+		}
+	}
+
+	claimed := make(map[string]bool)
+	for _, removed := range diff.Removed {
+		for _, added := range diff.Added {
+			if !claimed[added.ID] && added.Type == removed.Type && added.Label == removed.Label {
+				diff.SuggestedMapping[removed.ID] = added.ID
+				claimed[added.ID] = true
+				break
+			}
+		}
+	}
+
+	return diff
+}
+
+// validateRemapping checks that mapping renames fields of the old version to
+// distinct fields of the new one, without changing an answer's type.
+func validateRemapping(from, to []store.ApplicationSchemaField, mapping map[string]string) error {
+	fromByID := make(map[string]store.ApplicationSchemaField, len(from))
+	for _, f := range from {
+		fromByID[f.ID] = f
+	}
+	toByID := make(map[string]store.ApplicationSchemaField, len(to))
+	for _, f := range to {
+		toByID[f.ID] = f
+	}
+
+	targets := make(map[string]string, len(mapping))
+	for oldID, newID := range mapping {
+		before, ok := fromByID[oldID]
+		if !ok {
+			return fmt.Errorf("%s is not a field of the old version", oldID)
+		}
+		after, ok := toByID[newID]
+		if !ok {
+			return fmt.Errorf("%s is not a field of the latest version", newID)
+		}
+		if before.Type != after.Type {
+			return fmt.Errorf("cannot map %s (%s) to %s (%s)", oldID, before.Type, newID, after.Type)
+		}
+		if other, ok := targets[newID]; ok {
+			return fmt.Errorf("%s and %s both map to %s", min(other, oldID), max(other, oldID), newID)
+		}
+		targets[newID] = oldID
+	}
+
+	// Renaming onto a field the old version still answers would overwrite
+	// those answers unless that field is itself renamed away.
+	for newID, oldID := range targets {
+		if _, answered := fromByID[newID]; !answered {
+			continue
+		}
+		if _, moved := mapping[newID]; !moved {
+			return fmt.Errorf("cannot map %s to %s: %s already holds answers in the old version", oldID, newID, newID)
+		}
+	}
+
+	return nil
+}
+
+// schemaVersionParam parses the {version} URL parameter.
+func schemaVersionParam(r *http.Request) (int, error) {
+	version, err := strconv.Atoi(chi.URLParam(r, "version"))
+	if err != nil || version < 1 {
+		return 0, errors.New("version must be a positive integer")
+	}
+	return version, nil
+}
+
+// listApplicationSchemaVersions lists the saved versions of the application schema
+//
+//	@Summary		List application schema versions (Super Admin)
+//	@Description	Lists every saved version of the active hackathon's application schema, newest first, without their fields
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	ApplicationSchemaVersionsResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/application-schema/versions [get]
+func (app *application) listApplicationSchemaVersions(w http.ResponseWriter, r *http.Request) {
+	versions, err := app.store.Settings.ListApplicationSchemaVersions(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ApplicationSchemaVersionsResponse{Versions: versions}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getApplicationSchemaVersion returns one saved version of the application schema
+//
+//	@Summary		Get application schema version (Super Admin)
+//	@Description	Returns one saved version of the active hackathon's application schema with its fields
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Param			version	path		int	true	"Schema version"
+//	@Success		200		{object}	store.ApplicationSchemaVersion
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/application-schema/versions/{version} [get]
+func (app *application) getApplicationSchemaVersion(w http.ResponseWriter, r *http.Request) {
+	number, err := schemaVersionParam(r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	version, err := app.store.Settings.GetApplicationSchemaVersion(r.Context(), number)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("schema version not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, version); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getApplicationSchemaDiff compares two saved versions of the application schema
+//
+//	@Summary		Diff application schema versions (Super Admin)
+//	@Description	Lists the fields added, removed and changed between two versions of the application schema. Fields are matched by ID; removed fields that reappear under a new ID with the same type and label are suggested as renames.
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Param			from	query		int	true	"Older schema version"
+//	@Param			to		query		int	true	"Newer schema version"
+//	@Success		200		{object}	ApplicationSchemaDiff
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/application-schema/diff [get]
+func (app *application) getApplicationSchemaDiff(w http.ResponseWriter, r *http.Request) {
+	var numbers [2]int
+	for i, name := range []string{"from", "to"} {
+		n, err := strconv.Atoi(r.URL.Query().Get(name))
+		if err != nil || n < 1 {
+			app.badRequestResponse(w, r, fmt.Errorf("%s must be a positive integer", name))
+			return
+		}
+		numbers[i] = n
+	}
+
+	var versions [2]*store.ApplicationSchemaVersion
+	for i, n := range numbers {
+		v, err := app.store.Settings.GetApplicationSchemaVersion(r.Context(), n)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				app.notFoundResponse(w, r, fmt.Errorf("schema version %d not found", n))
+				return
+			}
+			app.internalServerError(w, r, err)
+			return
+		}
+		versions[i] = v
+	}
+
+	diff := diffSchemas(versions[0].Fields, versions[1].Fields)
+	diff.FromVersion = numbers[0]
+	diff.ToVersion = numbers[1]
+
+	if err := app.jsonResponse(w, http.StatusOK, diff); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// remapApplicationResponses moves applications from an old schema version onto the latest one
+//
+//	@Summary		Remap responses to the latest schema (Super Admin)
+//	@Description	Rewrites the responses of every application keyed against the given schema version so they match the latest version, renaming answers per the mapping from old field IDs to new ones. Mapped fields must keep their type. Answers to fields missing from the mapping are kept under their old IDs. Applications then record the latest version.
+//	@Tags			superadmin/settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			version	path		int						true	"Schema version to move applications off"
+//	@Param			mapping	body		RemapResponsesPayload	true	"Old field ID to new field ID"
+//	@Success		200		{object}	RemapResponsesResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string}	"Version is already the latest, or the mapping would overwrite existing answers"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/application-schema/versions/{version}/remap [post]
+func (app *application) remapApplicationResponses(w http.ResponseWriter, r *http.Request) {
+	number, err := schemaVersionParam(r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	var req RemapResponsesPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	versions, err := app.store.Settings.ListApplicationSchemaVersions(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+	if len(versions) == 0 {
+		app.notFoundResponse(w, r, errors.New("schema version not found"))
+		return
+	}
+	latest := versions[0].Version
+	if number == latest {
+		app.conflictResponse(w, r, errors.New("applications on the latest version need no remapping"))
+		return
+	}
+
+	from, err := app.store.Settings.GetApplicationSchemaVersion(r.Context(), number)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("schema version not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+	to, err := app.store.Settings.GetApplicationSchemaVersion(r.Context(), latest)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := validateRemapping(from.Fields, to.Fields, req.Mapping); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	moved, err := app.store.Application.RemapResponses(r.Context(), number, latest, req.Mapping)
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, errors.New("the mapping would overwrite answers already stored under a new field ID"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	response := RemapResponsesResponse{
+		FromVersion:  number,
+		ToVersion:    latest,
+		Applications: moved,
+	}
+
+	app.recordAudit(r, store.AuditActionSchemaResponsesRemap, store.AuditTargetSchemaVersion, strconv.Itoa(number), req, response)
+
+	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
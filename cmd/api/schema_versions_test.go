@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/store"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	from := []store.ApplicationSchemaField{
+		{ID: "first_name", Type: "text", Label: "First Name", Required: true},
+		{ID: "school", Type: "text", Label: "University"},
+		{ID: "shirt", Type: "select", Label: "Shirt Size", Options: []string{"S", "M"}},
+		{ID: "dietary", Type: "textarea", Label: "Dietary Restrictions"},
+	}
+	to := []store.ApplicationSchemaField{
+		{ID: "first_name", Type: "text", Label: "First Name", Required: true},
+		{ID: "university", Type: "text", Label: "University"},
+		{ID: "shirt", Type: "select", Label: "T-Shirt Size", Options: []string{"S", "M", "L"}},
+		{ID: "github", Type: "url", Label: "GitHub"},
+	}
+
+	diff := diffSchemas(from, to)
+
+	var added, removed []string
+	for _, f := range diff.Added {
+		added = append(added, f.ID)
+	}
+	for _, f := range diff.Removed {
+		removed = append(removed, f.ID)
+	}
+	assert.Equal(t, []string{"university", "github"}, added)
+	assert.Equal(t, []string{"school", "dietary"}, removed)
+
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, "shirt", diff.Changed[0].ID)
+	assert.Equal(t, []string{"label", "options"}, diff.Changed[0].Changes)
+
+	assert.Equal(t, map[string]string{"school": "university"}, diff.SuggestedMapping)
+}
+
+func TestValidateRemapping(t *testing.T) {
+	from := []store.ApplicationSchemaField{
+		{ID: "school", Type: "text"},
+		{ID: "major", Type: "text"},
+		{ID: "age", Type: "number"},
+	}
+	to := []store.ApplicationSchemaField{
+		{ID: "university", Type: "text"},
+		{ID: "major", Type: "text"},
+		{ID: "age_years", Type: "number"},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		mapping map[string]string
+		valid   bool
+	}{
+		{"rename", map[string]string{"school": "university", "age": "age_years"}, true},
+		{"empty", map[string]string{}, true},
+		{"unknown old field", map[string]string{"college": "university"}, false},
+		{"unknown new field", map[string]string{"school": "college"}, false},
+		{"type change", map[string]string{"age": "university"}, false},
+		{"two fields onto one", map[string]string{"school": "university", "major": "university"}, false},
+		{"onto an answered field", map[string]string{"school": "major"}, false},
+		{"swap with an answered field", map[string]string{"school": "major", "major": "university"}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRemapping(from, to, tc.mapping)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRemapApplicationResponses(t *testing.T) {
+	app := newTestApplication(t)
+	mockApps := app.store.Application.(*store.MockApplicationStore)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+	versions := []store.ApplicationSchemaVersion{{Version: 2}, {Version: 1}}
+	v1 := &store.ApplicationSchemaVersion{Version: 1, Fields: []store.ApplicationSchemaField{{ID: "school", Type: "text"}}}
+	v2 := &store.ApplicationSchemaVersion{Version: 2, Fields: []store.ApplicationSchemaField{{ID: "university", Type: "text"}}}
+
+	newRequest := func(t *testing.T, version, body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("version", version)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("should remap responses onto the latest version", func(t *testing.T) {
+		mapping := map[string]string{"school": "university"}
+		mockSettings.On("ListApplicationSchemaVersions").Return(versions, nil).Once()
+		mockSettings.On("GetApplicationSchemaVersion", 1).Return(v1, nil).Once()
+		mockSettings.On("GetApplicationSchemaVersion", 2).Return(v2, nil).Once()
+		mockApps.On("RemapResponses", 1, 2, mapping).Return(7, nil).Once()
+
+		rr := executeRequest(newRequest(t, "1", `{"mapping":{"school":"university"}}`), http.HandlerFunc(app.remapApplicationResponses))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data RemapResponsesResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		assert.Equal(t, RemapResponsesResponse{FromVersion: 1, ToVersion: 2, Applications: 7}, body.Data)
+
+		mockSettings.AssertExpectations(t)
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 for the latest version", func(t *testing.T) {
+		mockSettings.On("ListApplicationSchemaVersions").Return(versions, nil).Once()
+
+		rr := executeRequest(newRequest(t, "2", `{"mapping":{}}`), http.HandlerFunc(app.remapApplicationResponses))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("should return 400 for a mapping to an unknown field", func(t *testing.T) {
+		mockSettings.On("ListApplicationSchemaVersions").Return(versions, nil).Once()
+		mockSettings.On("GetApplicationSchemaVersion", 1).Return(v1, nil).Once()
+		mockSettings.On("GetApplicationSchemaVersion", 2).Return(v2, nil).Once()
+
+		rr := executeRequest(newRequest(t, "1", `{"mapping":{"school":"college"}}`), http.HandlerFunc(app.remapApplicationResponses))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 409 when stored answers would be overwritten", func(t *testing.T) {
+		mapping := map[string]string{"school": "university"}
+		mockSettings.On("ListApplicationSchemaVersions").Return(versions, nil).Once()
+		mockSettings.On("GetApplicationSchemaVersion", 1).Return(v1, nil).Once()
+		mockSettings.On("GetApplicationSchemaVersion", 2).Return(v2, nil).Once()
+		mockApps.On("RemapResponses", 1, 2, mapping).Return(0, store.ErrConflict).Once()
+
+		rr := executeRequest(newRequest(t, "1", `{"mapping":{"school":"university"}}`), http.HandlerFunc(app.remapApplicationResponses))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+
+		mockApps.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 for a malformed version", func(t *testing.T) {
+		rr := executeRequest(newRequest(t, "latest", `{"mapping":{}}`), http.HandlerFunc(app.remapApplicationResponses))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
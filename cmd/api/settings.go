@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -17,6 +18,12 @@ type ApplicationSchemaResponse struct {
 	Fields []store.ApplicationSchemaField `json:"fields"`
 }
 
+type UpdateApplicationSchemaResponse struct {
+	Fields []store.ApplicationSchemaField `json:"fields"`
+	// Version is the schema version the saved fields are recorded as.
+	Version int `json:"version"`
+}
+
 // getApplicationSchema returns the configurable application schema
 //
 //	@Summary		Get application schema (Super Admin)
@@ -48,12 +55,12 @@ func (app *application) getApplicationSchema(w http.ResponseWriter, r *http.Requ
 // updateApplicationSchema replaces the application schema
 //
 //	@Summary		Update application schema (Super Admin)
-//	@Description	Replaces the application schema with the provided array of fields
+//	@Description	Replaces the application schema with the provided array of fields and records it as a new schema version, unless it matches the latest one. Each field's type, options and validation settings are checked before saving. Fields may carry show_if and require_if rules on another field's answer; rules must refer to existing fields and show_if rules must not loop.
 //	@Tags			superadmin/settings
 //	@Accept			json
 //	@Produce		json
 //	@Param			fields	body		UpdateApplicationSchemaPayload	true	"Schema fields to set"
-//	@Success		200		{object}	ApplicationSchemaResponse
+//	@Success		200		{object}	UpdateApplicationSchemaResponse
 //	@Failure		400		{object}	object{error=string}
 //	@Failure		401		{object}	object{error=string}
 //	@Failure		403		{object}	object{error=string}
@@ -61,6 +68,12 @@ func (app *application) getApplicationSchema(w http.ResponseWriter, r *http.Requ
 //	@Security		CookieAuth
 //	@Router			/superadmin/settings/application-schema [put]
 func (app *application) updateApplicationSchema(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, errors.New("missing user in context"))
+		return
+	}
+
 	var req UpdateApplicationSchemaPayload
 	if err := readJSON(w, r, &req); err != nil {
 		app.badRequestResponse(w, r, err)
@@ -80,14 +93,32 @@ func (app *application) updateApplicationSchema(w http.ResponseWriter, r *http.R
 			return
 		}
 		idMap[f.ID] = true
+
+		if err := validateFieldConfig(f); err != nil {
+			app.badRequestResponse(w, r, err)
+			return
+		}
 	}
 
-	if err := app.store.Settings.UpdateApplicationSchema(r.Context(), req.Fields); err != nil {
+	if err := validateSchemaConditions(req.Fields); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	var version int
+	if err := app.auditedSettingWrite(r, store.SettingsKeyApplicationSchema, func() error {
+		var err error
+		version, err = app.store.Settings.UpdateApplicationSchema(r.Context(), req.Fields, user.ID)
+		return err
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
 
-	response := ApplicationSchemaResponse(req)
+	response := UpdateApplicationSchemaResponse{
+		Fields:  req.Fields,
+		Version: version,
+	}
 
 	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
 		app.internalServerError(w, r, err)
@@ -157,7 +188,9 @@ func (app *application) setReviewsPerApp(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := app.store.Settings.SetReviewsPerApplication(r.Context(), req.ReviewsPerApplication); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyReviewsPerApplication, func() error {
+		return app.store.Settings.SetReviewsPerApplication(r.Context(), req.ReviewsPerApplication)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -214,7 +247,9 @@ type SetApplicationsEnabledPayload struct {
 	Enabled bool `json:"enabled"`
 }
 
-type ApplicationsEnabledResponse struct {
+// ApplicationsToggleResponse is the manual applications switch. Applications
+// are only open while it is on and the application window allows.
+type ApplicationsToggleResponse struct {
 	Enabled bool `json:"enabled"`
 }
 
@@ -224,6 +259,15 @@ type HackathonDateRangeResponse struct {
 	Configured bool    `json:"configured"`
 }
 
+type SetRSVPConfigPayload struct {
+	DeadlineHours *int `json:"deadline_hours" validate:"required,min=0,max=720"`
+	Capacity      *int `json:"capacity" validate:"required,min=0"`
+}
+
+type RSVPConfigResponse struct {
+	store.RSVPConfig
+}
+
 type SetHackerPackURLPayload struct {
 	URL string `json:"url"`
 }
@@ -297,7 +341,9 @@ func (app *application) setReviewAssignmentToggle(w http.ResponseWriter, r *http
 		return
 	}
 
-	if err := app.store.Settings.SetReviewAssignmentToggle(r.Context(), req.UserID, req.Enabled); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyReviewAssignmentToggle, func() error {
+		return app.store.Settings.SetReviewAssignmentToggle(r.Context(), req.UserID, req.Enabled)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -359,7 +405,9 @@ func (app *application) setAdminScheduleEditToggle(w http.ResponseWriter, r *htt
 		return
 	}
 
-	if err := app.store.Settings.SetAdminScheduleEditEnabled(r.Context(), req.Enabled); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyAdminScheduleEditEnabled, func() error {
+		return app.store.Settings.SetAdminScheduleEditEnabled(r.Context(), req.Enabled)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -421,7 +469,9 @@ func (app *application) setAdminSponsorEditToggle(w http.ResponseWriter, r *http
 		return
 	}
 
-	if err := app.store.Settings.SetAdminSponsorEditEnabled(r.Context(), req.Enabled); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyAdminSponsorEditEnabled, func() error {
+		return app.store.Settings.SetAdminSponsorEditEnabled(r.Context(), req.Enabled)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -483,7 +533,9 @@ func (app *application) setAdminFAQEditToggle(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := app.store.Settings.SetAdminFAQEditEnabled(r.Context(), req.Enabled); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyAdminFAQEditEnabled, func() error {
+		return app.store.Settings.SetAdminFAQEditEnabled(r.Context(), req.Enabled)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -579,7 +631,9 @@ func (app *application) setHackathonDateRange(w http.ResponseWriter, r *http.Req
 		StartDate: &req.StartDate,
 		EndDate:   &req.EndDate,
 	}
-	if err := app.store.Settings.SetHackathonDateRange(r.Context(), dateRange); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyHackathonDateRange, func() error {
+		return app.store.Settings.SetHackathonDateRange(r.Context(), dateRange)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -595,6 +649,240 @@ func (app *application) setHackathonDateRange(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// getRSVPConfig returns the attendance confirmation settings
+//
+//	@Summary		Get RSVP config (Super Admin)
+//	@Description	Returns how many hours accepted hackers have to confirm (0 = no deadline) and the seat capacity the waitlist is promoted up to (0 = auto-promotion off)
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	RSVPConfigResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/rsvp [get]
+func (app *application) getRSVPConfig(w http.ResponseWriter, r *http.Request) {
+	config, err := app.store.Settings.GetRSVPConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, RSVPConfigResponse{RSVPConfig: config}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// setRSVPConfig updates the attendance confirmation settings
+//
+//	@Summary		Set RSVP config (Super Admin)
+//	@Description	Updates the confirmation window and seat capacity. The window applies to hackers accepted after the change. While capacity is above zero, seats freed by declines and expired confirmations are backfilled from the top of the waitlist, who receive the accepted decision email.
+//	@Tags			superadmin/settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			config	body		SetRSVPConfigPayload	true	"RSVP config"
+//	@Success		200		{object}	RSVPConfigResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/rsvp [post]
+func (app *application) setRSVPConfig(w http.ResponseWriter, r *http.Request) {
+	var req SetRSVPConfigPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	config := store.RSVPConfig{
+		DeadlineHours: *req.DeadlineHours,
+		Capacity:      *req.Capacity,
+	}
+	if err := app.auditedSettingWrite(r, store.SettingsKeyRSVPConfig, func() error {
+		return app.store.Settings.SetRSVPConfig(r.Context(), config)
+	}); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, RSVPConfigResponse{RSVPConfig: config}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+type SetTeamSizeMaxPayload struct {
+	TeamSizeMax int `json:"team_size_max" validate:"required,min=1,max=20"`
+}
+
+type TeamSizeMaxResponse struct {
+	TeamSizeMax int `json:"team_size_max"`
+}
+
+// getTeamSizeMax returns the maximum number of members per team
+//
+//	@Summary		Get team size cap (Super Admin)
+//	@Description	Returns the maximum number of members a hacker team may have
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	TeamSizeMaxResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/team-size [get]
+func (app *application) getTeamSizeMax(w http.ResponseWriter, r *http.Request) {
+	size, err := app.store.Settings.GetTeamSizeMax(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, TeamSizeMaxResponse{TeamSizeMax: size}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// setTeamSizeMax sets the maximum number of members per team
+//
+//	@Summary		Set team size cap (Super Admin)
+//	@Description	Sets the maximum number of members a hacker team may have. Teams already above a lowered cap keep their members but cannot take new ones.
+//	@Tags			superadmin/settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			team_size_max	body		SetTeamSizeMaxPayload	true	"Team size cap"
+//	@Success		200				{object}	TeamSizeMaxResponse
+//	@Failure		400				{object}	object{error=string}
+//	@Failure		401				{object}	object{error=string}
+//	@Failure		403				{object}	object{error=string}
+//	@Failure		500				{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/team-size [post]
+func (app *application) setTeamSizeMax(w http.ResponseWriter, r *http.Request) {
+	var req SetTeamSizeMaxPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := app.auditedSettingWrite(r, store.SettingsKeyTeamSizeMax, func() error {
+		return app.store.Settings.SetTeamSizeMax(r.Context(), req.TeamSizeMax)
+	}); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, TeamSizeMaxResponse{TeamSizeMax: req.TeamSizeMax}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+type JudgingConfigResponse struct {
+	store.JudgingConfig
+}
+
+// getJudgingConfig returns the project submission and judging settings
+//
+//	@Summary		Get judging config (Super Admin)
+//	@Description	Returns the project submission deadline, judging tracks, scoring rubric and judges per project
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	JudgingConfigResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/judging [get]
+func (app *application) getJudgingConfig(w http.ResponseWriter, r *http.Request) {
+	config, err := app.store.Settings.GetJudgingConfig(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, JudgingConfigResponse{JudgingConfig: config}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// setJudgingConfig updates the project submission and judging settings
+//
+//	@Summary		Set judging config (Super Admin)
+//	@Description	Replaces the judging config. Projects are editable until submission_deadline (never closes when null). Track names and rubric criterion IDs must be unique; "overall" is reserved for the combined ranking. Rubric changes apply to rankings retroactively, and scores for removed criteria are ignored.
+//	@Tags			superadmin/settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			config	body		store.JudgingConfig	true	"Judging config"
+//	@Success		200		{object}	JudgingConfigResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/judging [post]
+func (app *application) setJudgingConfig(w http.ResponseWriter, r *http.Request) {
+	var req store.JudgingConfig
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if req.Tracks == nil {
+		req.Tracks = []string{}
+	}
+	if req.Rubric == nil {
+		req.Rubric = []store.RubricCriterion{}
+	}
+
+	seenTracks := make(map[string]bool, len(req.Tracks))
+	for _, track := range req.Tracks {
+		if track == overallTrack {
+			app.badRequestResponse(w, r, fmt.Errorf("track name %q is reserved", overallTrack))
+			return
+		}
+		if seenTracks[track] {
+			app.badRequestResponse(w, r, fmt.Errorf("duplicate track %q", track))
+			return
+		}
+		seenTracks[track] = true
+	}
+
+	seenCriteria := make(map[string]bool, len(req.Rubric))
+	for _, c := range req.Rubric {
+		if seenCriteria[c.ID] {
+			app.badRequestResponse(w, r, fmt.Errorf("duplicate rubric criterion %q", c.ID))
+			return
+		}
+		seenCriteria[c.ID] = true
+	}
+
+	if err := app.auditedSettingWrite(r, store.SettingsKeyJudgingConfig, func() error {
+		return app.store.Settings.SetJudgingConfig(r.Context(), req)
+	}); err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, JudgingConfigResponse{JudgingConfig: req}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
 // getHackerPackURL returns the configured Hacker Pack Notion URL
 //
 //	@Summary		Get Hacker Pack URL (Super Admin)
@@ -648,7 +936,9 @@ func (app *application) setHackerPackURL(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := app.store.Settings.SetHackerPackURL(r.Context(), url); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyHackerPackURL, func() error {
+		return app.store.Settings.SetHackerPackURL(r.Context(), url)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -710,7 +1000,9 @@ func (app *application) setPointsName(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := app.store.Settings.SetPointsName(r.Context(), req.Name); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyPointsName, func() error {
+		return app.store.Settings.SetPointsName(r.Context(), req.Name)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -766,7 +1058,9 @@ func (app *application) setPointsEnabled(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := app.store.Settings.SetPointsEnabled(r.Context(), req.Enabled); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyPointsEnabled, func() error {
+		return app.store.Settings.SetPointsEnabled(r.Context(), req.Enabled)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -886,7 +1180,9 @@ func (app *application) updateMealGroups(w http.ResponseWriter, r *http.Request)
 		nameMap[name] = true
 	}
 
-	if err := app.store.Settings.SetMealGroups(r.Context(), req.Groups); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyMealGroups, func() error {
+		return app.store.Settings.SetMealGroups(r.Context(), req.Groups)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -923,7 +1219,7 @@ func (app *application) getMealGroupStats(w http.ResponseWriter, r *http.Request
 // getApplicationsEnabled returns whether applications are currently open
 //
 //	@Summary		Get applications enabled status
-//	@Description	Returns whether the application portal is currently open for submissions
+//	@Description	Returns whether the authenticated hacker can edit and submit their application right now, the application window, and countdowns to it opening or closing. A draft started before the close stays enabled through the grace period.
 //	@Tags			hackers
 //	@Produce		json
 //	@Success		200	{object}	ApplicationsEnabledResponse
@@ -932,31 +1228,57 @@ func (app *application) getMealGroupStats(w http.ResponseWriter, r *http.Request
 //	@Security		CookieAuth
 //	@Router			/applications/enabled [get]
 func (app *application) getApplicationsEnabled(w http.ResponseWriter, r *http.Request) {
-	enabled, err := app.store.Settings.GetApplicationsEnabled(r.Context())
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	response, err := app.applicationAvailabilityFor(r, user)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
 
-	response := ApplicationsEnabledResponse{
-		Enabled: enabled,
+	if err = app.jsonResponse(w, http.StatusOK, response); err != nil {
+		app.internalServerError(w, r, err)
+		return
 	}
+}
 
-	if err = app.jsonResponse(w, http.StatusOK, response); err != nil {
+// getApplicationsToggle returns the manual applications switch
+//
+//	@Summary		Get applications toggle (Super Admin)
+//	@Description	Returns whether the applications switch is on. Applications are open while it is on and the application window allows.
+//	@Tags			superadmin/settings
+//	@Produce		json
+//	@Success		200	{object}	ApplicationsToggleResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/superadmin/settings/applications-enabled [get]
+func (app *application) getApplicationsToggle(w http.ResponseWriter, r *http.Request) {
+	enabled, err := app.store.Settings.GetApplicationsEnabled(r.Context())
+	if err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
+
+	if err := app.jsonResponse(w, http.StatusOK, ApplicationsToggleResponse{Enabled: enabled}); err != nil {
+		app.internalServerError(w, r, err)
+	}
 }
 
 // setApplicationsEnabled updates whether applications are currently open
 //
 //	@Summary		Set applications enabled status (Super Admin)
-//	@Description	Sets whether the application portal is currently open for submissions. Requires SuperAdmin privileges.
+//	@Description	Turns the applications switch on or off. While it is on, applications follow the application window. Requires SuperAdmin privileges.
 //	@Tags			superadmin/settings
 //	@Accept			json
 //	@Produce		json
 //	@Param			payload	body		SetApplicationsEnabledPayload	true	"Enable or disable applications"
-//	@Success		200		{object}	ApplicationsEnabledResponse
+//	@Success		200		{object}	ApplicationsToggleResponse
 //	@Failure		400		{object}	object{error=string}
 //	@Failure		401		{object}	object{error=string}
 //	@Failure		403		{object}	object{error=string}
@@ -970,12 +1292,14 @@ func (app *application) setApplicationsEnabled(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	if err := app.store.Settings.SetApplicationsEnabled(r.Context(), req.Enabled); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyApplicationsEnabled, func() error {
+		return app.store.Settings.SetApplicationsEnabled(r.Context(), req.Enabled)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
 
-	response := ApplicationsEnabledResponse(req)
+	response := ApplicationsToggleResponse(req)
 
 	if err := app.jsonResponse(w, http.StatusOK, response); err != nil {
 		app.internalServerError(w, r, err)
@@ -1006,21 +1330,12 @@ type SetFromNamePayload struct {
 	Name string `json:"name" validate:"required,min=1,max=100"`
 }
 
-type DateSettingResponse struct {
-	Date       string `json:"date"`
-	Configured bool   `json:"configured"`
-}
-
-type SetDateSettingPayload struct {
-	Date string `json:"date" validate:"required"`
-}
-
 // OnboardingStatusResponse reports which required hackathon settings are
 // configured. The SuperAdmin onboarding form is shown until complete is true.
 type OnboardingStatusResponse struct {
 	HackathonName      bool `json:"hackathon_name"`
 	HackathonDateRange bool `json:"hackathon_date_range"`
-	ApplicationDueDate bool `json:"application_due_date"`
+	ApplicationWindow  bool `json:"application_window"`
 	ContactEmail       bool `json:"contact_email"`
 	FromEmail          bool `json:"from_email"`
 	Complete           bool `json:"complete"`
@@ -1029,6 +1344,8 @@ type OnboardingStatusResponse struct {
 // HackathonConfigResponse exposes the hackathon identity and key dates to any
 // authenticated user so hacker-facing pages don't hardcode them. Kickoff is the
 // hackathon start date, so it isn't configured (or returned) separately.
+// ApplicationDueDate is the last day applications are open, in the
+// application window's timezone.
 type HackathonConfigResponse struct {
 	HackathonName      string  `json:"hackathon_name"`
 	ContactEmail       string  `json:"contact_email"`
@@ -1037,13 +1354,17 @@ type HackathonConfigResponse struct {
 	EndDate            *string `json:"end_date"`
 }
 
-// parseDateOnly validates a YYYY-MM-DD date string.
-func parseDateOnly(value string) (string, error) {
-	trimmed := strings.TrimSpace(value)
-	if _, err := time.Parse("2006-01-02", trimmed); err != nil {
-		return "", errors.New("date must be YYYY-MM-DD")
+// applicationDueDate formats the last day of window as YYYY-MM-DD, or "" if
+// it has no close. A close at midnight ends the day before.
+func applicationDueDate(window store.ApplicationWindow) string {
+	if window.ClosesAt == nil {
+		return ""
+	}
+	loc, err := time.LoadLocation(window.Timezone)
+	if err != nil {
+		loc = time.UTC
 	}
-	return trimmed, nil
+	return window.ClosesAt.Add(-time.Nanosecond).In(loc).Format("2006-01-02")
 }
 
 // getHackathonName returns the configured hackathon name
@@ -1098,7 +1419,9 @@ func (app *application) setHackathonName(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := app.store.Settings.SetHackathonName(r.Context(), req.Name); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyHackathonName, func() error {
+		return app.store.Settings.SetHackathonName(r.Context(), req.Name)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -1160,7 +1483,9 @@ func (app *application) setContactEmail(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := app.store.Settings.SetContactEmail(r.Context(), req.Email); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyContactEmail, func() error {
+		return app.store.Settings.SetContactEmail(r.Context(), req.Email)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -1222,7 +1547,9 @@ func (app *application) setFromEmail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := app.store.Settings.SetFromEmail(r.Context(), req.Email); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyFromEmail, func() error {
+		return app.store.Settings.SetFromEmail(r.Context(), req.Email)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -1284,7 +1611,9 @@ func (app *application) setFromName(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := app.store.Settings.SetFromName(r.Context(), req.Name); err != nil {
+	if err := app.auditedSettingWrite(r, store.SettingsKeyFromName, func() error {
+		return app.store.Settings.SetFromName(r.Context(), req.Name)
+	}); err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
@@ -1294,68 +1623,6 @@ func (app *application) setFromName(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// getApplicationDueDate returns the application deadline
-//
-//	@Summary		Get application due date (Super Admin)
-//	@Description	Returns the configured application deadline
-//	@Tags			superadmin/settings
-//	@Produce		json
-//	@Success		200	{object}	DateSettingResponse
-//	@Failure		401	{object}	object{error=string}
-//	@Failure		403	{object}	object{error=string}
-//	@Failure		500	{object}	object{error=string}
-//	@Security		CookieAuth
-//	@Router			/superadmin/settings/application-due-date [get]
-func (app *application) getApplicationDueDate(w http.ResponseWriter, r *http.Request) {
-	date, err := app.store.Settings.GetApplicationDueDate(r.Context())
-	if err != nil {
-		app.internalServerError(w, r, err)
-		return
-	}
-
-	if err := app.jsonResponse(w, http.StatusOK, DateSettingResponse{Date: date, Configured: date != ""}); err != nil {
-		app.internalServerError(w, r, err)
-	}
-}
-
-// setApplicationDueDate updates the application deadline
-//
-//	@Summary		Set application due date (Super Admin)
-//	@Description	Updates the application deadline (YYYY-MM-DD)
-//	@Tags			superadmin/settings
-//	@Accept			json
-//	@Produce		json
-//	@Param			date	body		SetDateSettingPayload	true	"Application due date"
-//	@Success		200		{object}	DateSettingResponse
-//	@Failure		400		{object}	object{error=string}
-//	@Failure		401		{object}	object{error=string}
-//	@Failure		403		{object}	object{error=string}
-//	@Failure		500		{object}	object{error=string}
-//	@Security		CookieAuth
-//	@Router			/superadmin/settings/application-due-date [post]
-func (app *application) setApplicationDueDate(w http.ResponseWriter, r *http.Request) {
-	var req SetDateSettingPayload
-	if err := readJSON(w, r, &req); err != nil {
-		app.badRequestResponse(w, r, err)
-		return
-	}
-
-	date, err := parseDateOnly(req.Date)
-	if err != nil {
-		app.badRequestResponse(w, r, err)
-		return
-	}
-
-	if err := app.store.Settings.SetApplicationDueDate(r.Context(), date); err != nil {
-		app.internalServerError(w, r, err)
-		return
-	}
-
-	if err := app.jsonResponse(w, http.StatusOK, DateSettingResponse{Date: date, Configured: true}); err != nil {
-		app.internalServerError(w, r, err)
-	}
-}
-
 // getOnboardingStatus reports which required hackathon settings are configured
 //
 //	@Summary		Get onboarding status (Super Admin)
@@ -1383,7 +1650,7 @@ func (app *application) getOnboardingStatus(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	appDue, err := app.store.Settings.GetApplicationDueDate(ctx)
+	window, err := app.store.Settings.GetApplicationWindow(ctx)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -1404,13 +1671,13 @@ func (app *application) getOnboardingStatus(w http.ResponseWriter, r *http.Reque
 	response := OnboardingStatusResponse{
 		HackathonName:      name != "",
 		HackathonDateRange: dateRange.StartDate != nil && dateRange.EndDate != nil,
-		ApplicationDueDate: appDue != "",
+		ApplicationWindow:  window.ClosesAt != nil,
 		ContactEmail:       contactEmail != "",
 		FromEmail:          fromEmail != "",
 	}
 	response.Complete = response.HackathonName &&
 		response.HackathonDateRange &&
-		response.ApplicationDueDate &&
+		response.ApplicationWindow &&
 		response.ContactEmail &&
 		response.FromEmail
 
@@ -1445,7 +1712,7 @@ func (app *application) getHackathonConfigHandler(w http.ResponseWriter, r *http
 		return
 	}
 
-	appDue, err := app.store.Settings.GetApplicationDueDate(ctx)
+	window, err := app.store.Settings.GetApplicationWindow(ctx)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -1460,7 +1727,7 @@ func (app *application) getHackathonConfigHandler(w http.ResponseWriter, r *http
 	response := HackathonConfigResponse{
 		HackathonName:      name,
 		ContactEmail:       contactEmail,
-		ApplicationDueDate: appDue,
+		ApplicationDueDate: applicationDueDate(window),
 		StartDate:          dateRange.StartDate,
 		EndDate:            dateRange.EndDate,
 	}
@@ -5,9 +5,11 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hackutd/portal/internal/store"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,7 +52,7 @@ func TestUpdateApplicationSchema(t *testing.T) {
 			{ID: "first_name", Type: "text", Label: "First Name", Required: true, DisplayOrder: 0},
 		}
 
-		mockSettings.On("UpdateApplicationSchema", fields).Return(nil).Once()
+		mockSettings.On("UpdateApplicationSchema", fields, newSuperAdminUser().ID).Return(3, nil).Once()
 
 		body := `{"fields":[{"id":"first_name","type":"text","label":"First Name","required":true,"display_order":0}]}`
 		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
@@ -61,6 +63,12 @@ func TestUpdateApplicationSchema(t *testing.T) {
 		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationSchema))
 		checkResponseCode(t, http.StatusOK, rr.Code)
 
+		var resp struct {
+			Data UpdateApplicationSchemaResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		assert.Equal(t, 3, resp.Data.Version)
+
 		mockSettings.AssertExpectations(t)
 	})
 
@@ -76,6 +84,41 @@ func TestUpdateApplicationSchema(t *testing.T) {
 		checkResponseCode(t, http.StatusBadRequest, rr.Code)
 	})
 
+	t.Run("should return 400 for invalid conditions", func(t *testing.T) {
+		cases := map[string]string{
+			"unknown field":  `{"fields":[{"id":"f1","type":"text","label":"A","required":false,"display_order":0,"show_if":{"field":"nope","op":"not_empty"}}]}`,
+			"self reference": `{"fields":[{"id":"f1","type":"text","label":"A","required":false,"display_order":0,"require_if":{"field":"f1","op":"empty"}}]}`,
+			"bad value":      `{"fields":[{"id":"f1","type":"number","label":"A","required":false,"display_order":0},{"id":"f2","type":"text","label":"B","required":false,"display_order":1,"show_if":{"field":"f1","op":"lt","value":"18"}}]}`,
+			"unknown op":     `{"fields":[{"id":"f1","type":"text","label":"A","required":false,"display_order":0},{"id":"f2","type":"text","label":"B","required":false,"display_order":1,"show_if":{"field":"f1","op":"like","value":"x"}}]}`,
+			"loop":           `{"fields":[{"id":"f1","type":"text","label":"A","required":false,"display_order":0,"show_if":{"field":"f2","op":"not_empty"}},{"id":"f2","type":"text","label":"B","required":false,"display_order":1,"show_if":{"field":"f1","op":"not_empty"}}]}`,
+		}
+
+		for name, body := range cases {
+			t.Run(name, func(t *testing.T) {
+				req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+				require.NoError(t, err)
+				req.Header.Set("Content-Type", "application/json")
+				req = setUserContext(req, newSuperAdminUser())
+
+				rr := executeRequest(req, http.HandlerFunc(app.updateApplicationSchema))
+				checkResponseCode(t, http.StatusBadRequest, rr.Code)
+			})
+		}
+	})
+
+	t.Run("should return 400 for a malformed validation config", func(t *testing.T) {
+		body := `{"fields":[{"id":"nickname","type":"text","label":"Nickname","required":false,"display_order":0,"validation":{"pattern":"[a-z"}}]}`
+
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateApplicationSchema))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "pattern")
+	})
+
 	t.Run("should return 400 for empty fields array", func(t *testing.T) {
 		body := `{}`
 		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
@@ -495,6 +538,71 @@ func TestSetHackathonDateRange(t *testing.T) {
 	})
 }
 
+func TestSetRSVPConfig(t *testing.T) {
+	app := newTestApplication(t)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+	t.Run("should set deadline and capacity", func(t *testing.T) {
+		mockSettings.On("SetRSVPConfig", store.RSVPConfig{DeadlineHours: 72, Capacity: 400}).Return(nil).Once()
+
+		body := `{"deadline_hours":72,"capacity":400}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setRSVPConfig))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var respBody struct {
+			Data RSVPConfigResponse `json:"data"`
+		}
+		err = json.NewDecoder(rr.Body).Decode(&respBody)
+		require.NoError(t, err)
+		assert.Equal(t, 72, respBody.Data.DeadlineHours)
+		assert.Equal(t, 400, respBody.Data.Capacity)
+
+		mockSettings.AssertExpectations(t)
+	})
+
+	t.Run("should accept zero to turn both off", func(t *testing.T) {
+		mockSettings.On("SetRSVPConfig", store.RSVPConfig{}).Return(nil).Once()
+
+		body := `{"deadline_hours":0,"capacity":0}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setRSVPConfig))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockSettings.AssertExpectations(t)
+	})
+
+	t.Run("should reject missing fields", func(t *testing.T) {
+		body := `{"deadline_hours":48}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setRSVPConfig))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should reject negative capacity", func(t *testing.T) {
+		body := `{"deadline_hours":48,"capacity":-1}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setRSVPConfig))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
 func TestGetHackerPackURL(t *testing.T) {
 	app := newTestApplication(t)
 	mockSettings := app.store.Settings.(*store.MockSettingsStore)
@@ -877,43 +985,61 @@ func TestSetContactEmail(t *testing.T) {
 	})
 }
 
-func TestSetApplicationDueDate(t *testing.T) {
+func TestSetApplicationWindow(t *testing.T) {
 	app := newTestApplication(t)
 	mockSettings := app.store.Settings.(*store.MockSettingsStore)
 
-	t.Run("should store a valid date", func(t *testing.T) {
-		mockSettings.On("SetApplicationDueDate", "2026-03-14").Return(nil).Once()
+	t.Run("should store a valid window", func(t *testing.T) {
+		opens := time.Date(2026, 2, 1, 6, 0, 0, 0, time.UTC)
+		closes := time.Date(2026, 3, 15, 5, 59, 0, 0, time.UTC)
+		mockSettings.On("SetApplicationWindow", store.ApplicationWindow{
+			OpensAt:      &opens,
+			ClosesAt:     &closes,
+			Timezone:     "America/Chicago",
+			GraceMinutes: 30,
+		}).Return(nil).Once()
 
-		body := `{"date":"2026-03-14"}`
+		body := `{"opens_at":"2026-02-01T00:00:00-06:00","closes_at":"2026-03-14T23:59:00-06:00","timezone":"America/Chicago","grace_minutes":30}`
 		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
 		req = setUserContext(req, newSuperAdminUser())
 
-		rr := executeRequest(req, http.HandlerFunc(app.setApplicationDueDate))
+		rr := executeRequest(req, http.HandlerFunc(app.setApplicationWindow))
 		checkResponseCode(t, http.StatusOK, rr.Code)
 
-		var respBody struct {
-			Data DateSettingResponse `json:"data"`
-		}
-		err = json.NewDecoder(rr.Body).Decode(&respBody)
-		require.NoError(t, err)
-		assert.True(t, respBody.Data.Configured)
-		assert.Equal(t, "2026-03-14", respBody.Data.Date)
-
 		mockSettings.AssertExpectations(t)
 	})
 
-	t.Run("should reject a non ISO date", func(t *testing.T) {
-		body := `{"date":"03/14/2026"}`
-		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
-		require.NoError(t, err)
-		req.Header.Set("Content-Type", "application/json")
-		req = setUserContext(req, newSuperAdminUser())
+	for _, tc := range []struct {
+		name string
+		body string
+	}{
+		{"close before open", `{"opens_at":"2026-03-15T00:00:00Z","closes_at":"2026-03-01T00:00:00Z","timezone":"UTC"}`},
+		{"unknown timezone", `{"closes_at":"2026-03-01T00:00:00Z","timezone":"Mars/Olympus"}`},
+		{"missing timezone", `{"closes_at":"2026-03-01T00:00:00Z"}`},
+		{"negative grace", `{"closes_at":"2026-03-01T00:00:00Z","timezone":"UTC","grace_minutes":-5}`},
+	} {
+		t.Run("should reject "+tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.setApplicationWindow))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
 
-		rr := executeRequest(req, http.HandlerFunc(app.setApplicationDueDate))
-		checkResponseCode(t, http.StatusBadRequest, rr.Code)
-	})
+func TestApplicationDueDate(t *testing.T) {
+	closes := time.Date(2026, 3, 15, 5, 0, 0, 0, time.UTC)
+	midnight := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "", applicationDueDate(store.ApplicationWindow{Timezone: "UTC"}))
+	assert.Equal(t, "2026-03-15", applicationDueDate(store.ApplicationWindow{ClosesAt: &closes, Timezone: "UTC"}))
+	assert.Equal(t, "2026-03-14", applicationDueDate(store.ApplicationWindow{ClosesAt: &closes, Timezone: "America/Chicago"}))
+	assert.Equal(t, "2026-03-14", applicationDueDate(store.ApplicationWindow{ClosesAt: &midnight, Timezone: "UTC"}))
 }
 
 func TestGetOnboardingStatus(t *testing.T) {
@@ -925,7 +1051,8 @@ func TestGetOnboardingStatus(t *testing.T) {
 		end := "2026-04-05"
 		mockSettings.On("GetHackathonName").Return("HackUTD 2026", nil).Once()
 		mockSettings.On("GetHackathonDateRange").Return(store.HackathonDateRange{StartDate: &start, EndDate: &end}, nil).Once()
-		mockSettings.On("GetApplicationDueDate").Return("2026-03-14", nil).Once()
+		closes := time.Date(2026, 3, 15, 5, 59, 0, 0, time.UTC)
+		mockSettings.On("GetApplicationWindow").Return(store.ApplicationWindow{ClosesAt: &closes, Timezone: "America/Chicago"}, nil).Once()
 		mockSettings.On("GetContactEmail").Return("hello@hackutd.co", nil).Once()
 		mockSettings.On("GetFromEmail").Return("noreply@hackutd.co", nil).Once()
 
@@ -952,7 +1079,7 @@ func TestGetOnboardingStatus(t *testing.T) {
 
 		mockSettings.On("GetHackathonName").Return("", nil).Once()
 		mockSettings.On("GetHackathonDateRange").Return(store.HackathonDateRange{}, nil).Once()
-		mockSettings.On("GetApplicationDueDate").Return("", nil).Once()
+		mockSettings.On("GetApplicationWindow").Return(store.ApplicationWindow{Timezone: "UTC"}, nil).Once()
 		mockSettings.On("GetContactEmail").Return("", nil).Once()
 		mockSettings.On("GetFromEmail").Return("", nil).Once()
 
@@ -974,3 +1101,80 @@ func TestGetOnboardingStatus(t *testing.T) {
 		mockSettings.AssertExpectations(t)
 	})
 }
+
+func TestSetTeamSizeMax(t *testing.T) {
+	app := newTestApplication(t)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+	t.Run("should set team size cap", func(t *testing.T) {
+		mockSettings.On("SetTeamSizeMax", 5).Return(nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(`{"team_size_max":5}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setTeamSizeMax))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var respBody struct {
+			Data TeamSizeMaxResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&respBody))
+		assert.Equal(t, 5, respBody.Data.TeamSizeMax)
+
+		mockSettings.AssertExpectations(t)
+	})
+
+	for _, body := range []string{`{"team_size_max":0}`, `{"team_size_max":21}`, `{}`} {
+		t.Run("should reject "+body, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.setTeamSizeMax))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestSetJudgingConfig(t *testing.T) {
+	app := newTestApplication(t)
+	mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+	t.Run("should save the judging config", func(t *testing.T) {
+		mockSettings.On("SetJudgingConfig", mock.MatchedBy(func(c store.JudgingConfig) bool {
+			return len(c.Tracks) == 1 && len(c.Rubric) == 1 && c.JudgesPerProject == 3
+		})).Return(nil).Once()
+
+		body := `{"submission_deadline":null,"tracks":["Best Hardware"],"rubric":[{"id":"impact","label":"Impact","max_score":10,"weight":1}],"judges_per_project":3}`
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.setJudgingConfig))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockSettings.AssertExpectations(t)
+	})
+
+	for name, body := range map[string]string{
+		"reserved track":     `{"tracks":["overall"],"rubric":[],"judges_per_project":3}`,
+		"duplicate track":    `{"tracks":["AI","AI"],"rubric":[],"judges_per_project":3}`,
+		"duplicate criteria": `{"tracks":[],"rubric":[{"id":"a","label":"A","max_score":5,"weight":1},{"id":"a","label":"B","max_score":5,"weight":1}],"judges_per_project":3}`,
+		"zero weight":        `{"tracks":[],"rubric":[{"id":"a","label":"A","max_score":5,"weight":0}],"judges_per_project":3}`,
+		"no judges":          `{"tracks":[],"rubric":[],"judges_per_project":0}`,
+	} {
+		t.Run("should reject "+name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req = setUserContext(req, newSuperAdminUser())
+
+			rr := executeRequest(req, http.HandlerFunc(app.setJudgingConfig))
+			checkResponseCode(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
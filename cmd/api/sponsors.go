@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/store"
 )
 
@@ -19,6 +23,17 @@ var allowedLogoContentTypes = map[string]bool{
 
 const maxLogoBytes = 1 * 1024 * 1024 // 1MB decoded limit
 
+const randomLogoObjectIDBytes = 16
+
+// logoExtensions names uploaded logo objects so backends that infer the
+// content type from the path report the type the upload URL was issued for.
+var logoExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
 type SponsorPayload struct {
 	Name         string `json:"name" validate:"required,min=1,max=100"`
 	Tier         string `json:"tier" validate:"required,min=1,max=50"`
@@ -31,25 +46,51 @@ type SponsorListResponse struct {
 	Sponsors []store.Sponsor `json:"sponsors"`
 }
 
+// LogoUploadPayload carries either the logo itself as base64 or the path of a
+// logo already uploaded through a signed URL.
 type LogoUploadPayload struct {
-	LogoData    string `json:"logo_data" validate:"required"`
+	LogoData    string `json:"logo_data" validate:"required_without=LogoPath"`
+	ContentType string `json:"content_type" validate:"required_without=LogoPath"`
+	LogoPath    string `json:"logo_path"`
+}
+
+type LogoUploadURLPayload struct {
 	ContentType string `json:"content_type" validate:"required"`
 }
 
+type LogoUploadURLResponse struct {
+	UploadURL string `json:"upload_url"`
+	LogoPath  string `json:"logo_path"`
+}
+
 // listSponsorsHandler returns all sponsors (Super Admin)
 //
 //	@Summary		List sponsors (Super Admin)
 //	@Description	Returns all sponsors ordered by display order
 //	@Tags			admin/sponsors
 //	@Produce		json
+//	@Param			hackathon_id	query		string	false	"Read an archived hackathon (default: the active one)"
 //	@Success		200	{object}	SponsorListResponse
+//	@Failure		400	{object}	object{error=string}
 //	@Failure		401	{object}	object{error=string}
 //	@Failure		403	{object}	object{error=string}
 //	@Failure		500	{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/admin/sponsors [get]
 func (app *application) listSponsorsHandler(w http.ResponseWriter, r *http.Request) {
-	sponsors, err := app.store.Sponsors.List(r.Context())
+	hackathonID, err := hackathonIDParam(r.URL.Query())
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	app.writeSponsorList(w, r, hackathonID)
+}
+
+// writeSponsorList responds with a hackathon's sponsors; nil is the active
+// one. Public reads always pass nil.
+func (app *application) writeSponsorList(w http.ResponseWriter, r *http.Request, hackathonID *string) {
+	sponsors, err := app.store.Sponsors.List(r.Context(), hackathonID)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
@@ -114,11 +155,14 @@ func (app *application) createSponsorHandler(w http.ResponseWriter, r *http.Requ
 //	@Produce		json
 //	@Param			sponsorID	path		string			true	"Sponsor ID"
 //	@Param			sponsor		body		SponsorPayload	true	"Sponsor updates"
+//	@Param			If-Match	header		string			true	"ETag of the sponsor being edited (its quoted updated_at)"
 //	@Success		200			{object}	store.Sponsor
 //	@Failure		400			{object}	object{error=string}
 //	@Failure		401			{object}	object{error=string}
 //	@Failure		403			{object}	object{error=string}
 //	@Failure		404			{object}	object{error=string}
+//	@Failure		409			{object}	object{error=string,data=store.Sponsor}	"Sponsor changed since it was loaded; data is the current copy"
+//	@Failure		428			{object}	object{error=string}
 //	@Failure		500			{object}	object{error=string}
 //	@Security		CookieAuth
 //	@Router			/admin/sponsors/{sponsorID} [put]
@@ -129,6 +173,11 @@ func (app *application) updateSponsorHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	loadedAt, ok := app.requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var payload SponsorPayload
 	if err := readJSON(w, r, &payload); err != nil {
 		app.badRequestResponse(w, r, err)
@@ -147,9 +196,17 @@ func (app *application) updateSponsorHandler(w http.ResponseWriter, r *http.Requ
 		WebsiteURL:   payload.WebsiteURL,
 		Description:  payload.Description,
 		DisplayOrder: payload.DisplayOrder,
+		UpdatedAt:    loadedAt,
 	}
 
 	if err := app.store.Sponsors.Update(r.Context(), sponsor); err != nil {
+		if errors.Is(err, store.ErrStale) {
+			var current *store.Sponsor
+			if current, err = app.store.Sponsors.GetByID(r.Context(), id); err == nil {
+				app.staleWriteResponse(w, r, current, current.UpdatedAt)
+				return
+			}
+		}
 		if errors.Is(err, store.ErrNotFound) {
 			app.notFoundResponse(w, r, errors.New("sponsor not found"))
 			return
@@ -158,6 +215,7 @@ func (app *application) updateSponsorHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	w.Header().Set("ETag", etagFor(sponsor.UpdatedAt))
 	if err := app.jsonResponse(w, http.StatusOK, sponsor); err != nil {
 		app.internalServerError(w, r, err)
 	}
@@ -198,12 +256,12 @@ func (app *application) deleteSponsorHandler(w http.ResponseWriter, r *http.Requ
 // uploadLogoHandler uploads a base64-encoded logo for a sponsor (Super Admin)
 //
 //	@Summary		Upload sponsor logo (Super Admin)
-//	@Description	Uploads a base64-encoded logo image for a sponsor
+//	@Description	Sets a sponsor's logo from base64 data, or from logo_path after uploading through a logo upload URL
 //	@Tags			admin/sponsors
 //	@Accept			json
 //	@Produce		json
 //	@Param			sponsorID	path		string				true	"Sponsor ID"
-//	@Param			body		body		LogoUploadPayload	true	"Base64-encoded logo"
+//	@Param			body		body		LogoUploadPayload	true	"Base64-encoded logo or uploaded logo path"
 //	@Success		200			{object}	store.Sponsor
 //	@Failure		400			{object}	object{error=string}
 //	@Failure		401			{object}	object{error=string}
@@ -239,23 +297,98 @@ func (app *application) uploadLogoHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if !allowedLogoContentTypes[payload.ContentType] {
-		app.badRequestResponse(w, r, fmt.Errorf("unsupported content type: %s", payload.ContentType))
+	logoData, contentType := payload.LogoData, payload.ContentType
+	if payload.LogoPath != "" {
+		var err error
+		logoData, contentType, err = app.readUploadedLogo(r.Context(), id, payload.LogoPath)
+		if err != nil {
+			if errors.Is(err, gcs.ErrUploadRejected) {
+				app.badRequestResponse(w, r, err)
+				return
+			}
+			app.internalServerError(w, r, err)
+			return
+		}
+	} else {
+		if !allowedLogoContentTypes[contentType] {
+			app.badRequestResponse(w, r, fmt.Errorf("unsupported content type: %s", contentType))
+			return
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(logoData)
+		if err != nil {
+			app.badRequestResponse(w, r, errors.New("invalid base64 data"))
+			return
+		}
+
+		if len(decoded) > maxLogoBytes {
+			app.badRequestResponse(w, r, fmt.Errorf("logo exceeds maximum size of %d bytes", maxLogoBytes))
+			return
+		}
+	}
+
+	if err := app.store.Sponsors.UpdateLogo(r.Context(), id, logoData, contentType); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("sponsor not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
 		return
 	}
 
-	decoded, err := base64.StdEncoding.DecodeString(payload.LogoData)
+	sponsor, err := app.store.Sponsors.GetByID(r.Context(), id)
 	if err != nil {
-		app.badRequestResponse(w, r, errors.New("invalid base64 data"))
+		app.internalServerError(w, r, err)
 		return
 	}
 
-	if len(decoded) > maxLogoBytes {
-		app.badRequestResponse(w, r, fmt.Errorf("logo exceeds maximum size of %d bytes", maxLogoBytes))
+	if err := app.jsonResponse(w, http.StatusOK, sponsor); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// generateLogoUploadURLHandler returns a signed upload URL for a sponsor logo (Super Admin)
+//
+//	@Summary		Generate sponsor logo upload URL (Super Admin)
+//	@Description	Generates a signed upload URL for a sponsor logo. Pass the returned logo_path to the logo endpoint once the upload finishes.
+//	@Tags			admin/sponsors
+//	@Accept			json
+//	@Produce		json
+//	@Param			sponsorID	path		string					true	"Sponsor ID"
+//	@Param			body		body		LogoUploadURLPayload	true	"Logo content type"
+//	@Success		200			{object}	LogoUploadURLResponse
+//	@Failure		400			{object}	object{error=string}
+//	@Failure		401			{object}	object{error=string}
+//	@Failure		403			{object}	object{error=string}
+//	@Failure		404			{object}	object{error=string}
+//	@Failure		500			{object}	object{error=string}
+//	@Failure		503			{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/sponsors/{sponsorID}/logo-upload-url [post]
+func (app *application) generateLogoUploadURLHandler(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "sponsorID")
+	if id == "" {
+		app.badRequestResponse(w, r, errors.New("missing sponsor ID"))
+		return
+	}
+
+	var payload LogoUploadURLPayload
+	if err := readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if err := Validate.Struct(payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if !allowedLogoContentTypes[payload.ContentType] {
+		app.badRequestResponse(w, r, fmt.Errorf("unsupported content type: %s", payload.ContentType))
 		return
 	}
 
-	if err := app.store.Sponsors.UpdateLogo(r.Context(), id, payload.LogoData, payload.ContentType); err != nil {
+	if _, err := app.store.Sponsors.GetByID(r.Context(), id); err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			app.notFoundResponse(w, r, errors.New("sponsor not found"))
 			return
@@ -264,13 +397,74 @@ func (app *application) uploadLogoHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	sponsor, err := app.store.Sponsors.GetByID(r.Context(), id)
+	if app.gcsClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "logo uploads are not configured")
+		return
+	}
+
+	randomID, err := randomHex(randomLogoObjectIDBytes)
 	if err != nil {
 		app.internalServerError(w, r, err)
 		return
 	}
 
-	if err := app.jsonResponse(w, http.StatusOK, sponsor); err != nil {
+	objectPath := fmt.Sprintf("sponsor-logos/%s/%s%s", id, randomID, logoExtensions[payload.ContentType])
+
+	uploadURL, err := app.gcsClient.GenerateImageUploadURL(r.Context(), objectPath, payload.ContentType)
+	if err != nil {
 		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, LogoUploadURLResponse{
+		UploadURL: uploadURL,
+		LogoPath:  objectPath,
+	}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// readUploadedLogo checks an uploaded sponsor logo against the image and logo
+// limits and returns it base64-encoded with its content type. The object is
+// only a staging copy, so it is deleted once read or rejected.
+func (app *application) readUploadedLogo(ctx context.Context, sponsorID, objectPath string) (string, string, error) {
+	if app.gcsClient == nil {
+		return "", "", fmt.Errorf("%w: logo uploads are not configured", gcs.ErrUploadRejected)
+	}
+	if !strings.HasPrefix(objectPath, "sponsor-logos/"+sponsorID+"/") {
+		return "", "", fmt.Errorf("%w: invalid logo path", gcs.ErrUploadRejected)
+	}
+
+	attrs, err := app.checkUploadedImage(ctx, objectPath, "logo")
+	if err != nil {
+		return "", "", err
 	}
+	defer func() {
+		if delErr := app.gcsClient.DeleteObject(ctx, objectPath); delErr != nil {
+			app.logger.Warnw("failed to delete uploaded logo", "path", objectPath, "error", delErr)
+		}
+	}()
+
+	if attrs.Size > maxLogoBytes {
+		return "", "", fmt.Errorf("%w: logo exceeds maximum size of %d bytes", gcs.ErrUploadRejected, maxLogoBytes)
+	}
+	if !allowedLogoContentTypes[attrs.ContentType] {
+		return "", "", fmt.Errorf("%w: unsupported content type: %s", gcs.ErrUploadRejected, attrs.ContentType)
+	}
+
+	rc, err := app.gcsClient.ReadObject(ctx, objectPath)
+	if err != nil {
+		return "", "", err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxLogoBytes+1))
+	if err != nil {
+		return "", "", err
+	}
+	if len(data) > maxLogoBytes {
+		return "", "", fmt.Errorf("%w: logo exceeds maximum size of %d bytes", gcs.ErrUploadRejected, maxLogoBytes)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), attrs.ContentType, nil
 }
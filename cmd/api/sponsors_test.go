@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -47,7 +48,7 @@ func TestListSponsors(t *testing.T) {
 		sponsors[1].LogoData = ""
 		sponsors[1].LogoContentType = ""
 
-		mockSponsors.On("List").Return(sponsors, nil).Once()
+		mockSponsors.On("List", (*string)(nil)).Return(sponsors, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/", nil)
 		require.NoError(t, err)
@@ -68,6 +69,29 @@ func TestListSponsors(t *testing.T) {
 
 		mockSponsors.AssertExpectations(t)
 	})
+
+	t.Run("should read an archived hackathon", func(t *testing.T) {
+		archived := testArchivedEventID
+		mockSponsors.On("List", &archived).Return([]store.Sponsor{newTestSponsor("sponsor-old")}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/?hackathon_id="+testArchivedEventID, nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listSponsorsHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockSponsors.AssertExpectations(t)
+	})
+
+	t.Run("should reject an invalid hackathon_id", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "/?hackathon_id=nope", nil)
+		require.NoError(t, err)
+		req = setUserContext(req, newSuperAdminUser())
+
+		rr := executeRequest(req, http.HandlerFunc(app.listSponsorsHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
 func TestGetPublicSponsors(t *testing.T) {
@@ -77,7 +101,7 @@ func TestGetPublicSponsors(t *testing.T) {
 
 	t.Run("should return sponsors with valid api key", func(t *testing.T) {
 		sponsors := []store.Sponsor{newTestSponsor("sponsor-1")}
-		mockSponsors.On("List").Return(sponsors, nil).Once()
+		mockSponsors.On("List", (*string)(nil)).Return(sponsors, nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/v1/public/sponsors", nil)
 		require.NoError(t, err)
@@ -96,6 +120,19 @@ func TestGetPublicSponsors(t *testing.T) {
 		mockSponsors.AssertExpectations(t)
 	})
 
+	t.Run("should ignore hackathon_id", func(t *testing.T) {
+		mockSponsors.On("List", (*string)(nil)).Return([]store.Sponsor{}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/v1/public/sponsors?hackathon_id="+testArchivedEventID, nil)
+		require.NoError(t, err)
+		req.Header.Set("X-API-Key", "test-api-key")
+
+		rr := executeRequest(req, mux)
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockSponsors.AssertExpectations(t)
+	})
+
 	t.Run("should return 401 with invalid api key", func(t *testing.T) {
 		req, err := http.NewRequest(http.MethodGet, "/v1/public/sponsors", nil)
 		require.NoError(t, err)
@@ -151,6 +188,7 @@ func TestCreateSponsor(t *testing.T) {
 func TestUpdateSponsor(t *testing.T) {
 	app := newTestApplication(t)
 	mockSponsors := app.store.Sponsors.(*store.MockSponsorsStore)
+	loadedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
 
 	t.Run("should update a sponsor", func(t *testing.T) {
 		sponsorID := "sponsor-to-update"
@@ -165,6 +203,7 @@ func TestUpdateSponsor(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", etagFor(loadedAt))
 		req = setUserContext(req, newSuperAdminUser())
 		req = withSponsorRouteParam(req, sponsorID)
 
@@ -188,6 +227,7 @@ func TestUpdateSponsor(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", etagFor(loadedAt))
 		req = setUserContext(req, newSuperAdminUser())
 		req = withSponsorRouteParam(req, "nonexistent")
 
@@ -196,6 +236,26 @@ func TestUpdateSponsor(t *testing.T) {
 
 		mockSponsors.AssertExpectations(t)
 	})
+
+	t.Run("should return 409 with the current copy if the sponsor changed", func(t *testing.T) {
+		current := &store.Sponsor{ID: "sponsor-1", Name: "Renamed", UpdatedAt: loadedAt.Add(time.Minute)}
+		mockSponsors.On("Update", mock.AnythingOfType("*store.Sponsor")).Return(store.ErrStale).Once()
+		mockSponsors.On("GetByID", "sponsor-1").Return(current, nil).Once()
+
+		body := `{"name":"Updated Name","tier":"Gold","display_order":1}`
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", etagFor(loadedAt))
+		req = setUserContext(req, newSuperAdminUser())
+		req = withSponsorRouteParam(req, "sponsor-1")
+
+		rr := executeRequest(req, http.HandlerFunc(app.updateSponsorHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, etagFor(current.UpdatedAt), rr.Header().Get("ETag"))
+
+		mockSponsors.AssertExpectations(t)
+	})
 }
 
 func TestDeleteSponsor(t *testing.T) {
@@ -330,6 +390,120 @@ func TestUploadLogo(t *testing.T) {
 
 		mockSponsors.AssertExpectations(t)
 	})
+
+	t.Run("should set the logo from an uploaded path", func(t *testing.T) {
+		local, err := gcs.NewLocal(t.TempDir(), "http://localhost:8080/v1/storage", []byte("test-secret"))
+		require.NoError(t, err)
+		mockGCS := app.gcsClient
+		app.gcsClient = local
+		defer func() { app.gcsClient = mockGCS }()
+
+		logoPath := "sponsor-logos/sponsor-1/abc.png"
+		require.NoError(t, local.WriteObject(context.Background(), logoPath, "image/png", strings.NewReader("fake-png-data")))
+
+		sponsor := newTestSponsor("sponsor-1")
+		mockSponsors.On("GetByID", sponsor.ID).Return(&sponsor, nil).Once()
+		mockSponsors.On("UpdateLogo", sponsor.ID, validBase64, "image/png").Return(nil).Once()
+		mockSponsors.On("GetByID", sponsor.ID).Return(&sponsor, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(`{"logo_path":"`+logoPath+`"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		req = withSponsorRouteParam(req, sponsor.ID)
+
+		rr := executeRequest(req, http.HandlerFunc(app.uploadLogoHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		_, err = local.StatObject(context.Background(), logoPath)
+		assert.ErrorIs(t, err, gcs.ErrObjectNotFound)
+
+		mockSponsors.AssertExpectations(t)
+	})
+
+	t.Run("should reject and delete an uploaded logo over the image limit", func(t *testing.T) {
+		mockGCS := app.gcsClient.(*gcs.MockClient)
+		logoPath := "sponsor-logos/sponsor-1/abc.png"
+
+		sponsor := newTestSponsor("sponsor-1")
+		mockSponsors.On("GetByID", sponsor.ID).Return(&sponsor, nil).Once()
+		mockGCS.On("StatObject", mock.Anything, logoPath).
+			Return(&gcs.ObjectAttrs{Size: 50 << 20, ContentType: "image/png"}, nil).Once()
+		mockGCS.On("DeleteObject", mock.Anything, logoPath).Return(nil).Once()
+
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(`{"logo_path":"`+logoPath+`"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		req = withSponsorRouteParam(req, sponsor.ID)
+
+		rr := executeRequest(req, http.HandlerFunc(app.uploadLogoHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+
+		mockSponsors.AssertExpectations(t)
+		mockGCS.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 for another sponsor's logo path", func(t *testing.T) {
+		sponsor := newTestSponsor("sponsor-1")
+		mockSponsors.On("GetByID", sponsor.ID).Return(&sponsor, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(`{"logo_path":"sponsor-logos/sponsor-2/abc.png"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		req = withSponsorRouteParam(req, sponsor.ID)
+
+		rr := executeRequest(req, http.HandlerFunc(app.uploadLogoHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+
+		mockSponsors.AssertExpectations(t)
+	})
+}
+
+func TestGenerateLogoUploadURL(t *testing.T) {
+	app := newTestApplication(t)
+	mockSponsors := app.store.Sponsors.(*store.MockSponsorsStore)
+	mockGCS := app.gcsClient.(*gcs.MockClient)
+
+	t.Run("should return an upload URL under the sponsor's prefix", func(t *testing.T) {
+		sponsor := newTestSponsor("sponsor-1")
+		mockSponsors.On("GetByID", sponsor.ID).Return(&sponsor, nil).Once()
+		mockGCS.On("GenerateImageUploadURL", mock.Anything, mock.MatchedBy(func(path string) bool {
+			return strings.HasPrefix(path, "sponsor-logos/sponsor-1/")
+		}), "image/png").Return("https://example.com/upload", nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(`{"content_type":"image/png"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		req = withSponsorRouteParam(req, sponsor.ID)
+
+		rr := executeRequest(req, http.HandlerFunc(app.generateLogoUploadURLHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data LogoUploadURLResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		assert.Equal(t, "https://example.com/upload", body.Data.UploadURL)
+		assert.True(t, strings.HasPrefix(body.Data.LogoPath, "sponsor-logos/sponsor-1/"))
+		assert.True(t, strings.HasSuffix(body.Data.LogoPath, ".png"))
+
+		mockSponsors.AssertExpectations(t)
+		mockGCS.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 for unsupported content type", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(`{"content_type":"image/svg+xml"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req = setUserContext(req, newSuperAdminUser())
+		req = withSponsorRouteParam(req, "sponsor-1")
+
+		rr := executeRequest(req, http.HandlerFunc(app.generateLogoUploadURLHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
 func protectedSponsorMutationRouter(app *application) chi.Router {
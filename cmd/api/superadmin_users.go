@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/go-chi/chi"
@@ -41,6 +42,7 @@ type UpdateRoleResponse struct {
 //	@Tags			superadmin/users
 //	@Produce		json
 //	@Param			search	query		string	true	"Search query (min 2 chars)"
+//	@Param			view_id	query		string	false	"Saved view to apply; request parameters override the view's"
 //	@Param			limit	query		int		false	"Page size (default 20, max 100)"
 //	@Param			offset	query		int		false	"Offset (default 0)"
 //	@Success		200		{object}	UserSearchResponse
@@ -51,12 +53,14 @@ type UpdateRoleResponse struct {
 //	@Security		CookieAuth
 //	@Router			/superadmin/users [get]
 func (app *application) searchUsersHandler(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query()
+	query, _, ok := app.applySavedView(w, r, store.SavedViewUsers)
+	if !ok {
+		return
+	}
 
 	// Role-based listing mode
-	roleParams := query["role"]
-	if len(roleParams) > 0 {
-		app.listUsersByRole(w, r, roleParams)
+	if len(query["role"]) > 0 {
+		app.listUsersByRole(w, r, query)
 		return
 	}
 
@@ -106,9 +110,8 @@ func (app *application) searchUsersHandler(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-func (app *application) listUsersByRole(w http.ResponseWriter, r *http.Request, roleParams []string) {
-	query := r.URL.Query()
-
+// parseUserRoles reads the role query parameter values.
+func parseUserRoles(roleParams []string) ([]store.UserRole, error) {
 	validRoles := map[string]store.UserRole{
 		"admin":       store.RoleAdmin,
 		"super_admin": store.RoleSuperAdmin,
@@ -119,11 +122,19 @@ func (app *application) listUsersByRole(w http.ResponseWriter, r *http.Request,
 	for _, rp := range roleParams {
 		role, ok := validRoles[rp]
 		if !ok {
-			app.badRequestResponse(w, r, errors.New("invalid role: "+rp))
-			return
+			return nil, errors.New("invalid role: " + rp)
 		}
 		roles = append(roles, role)
 	}
+	return roles, nil
+}
+
+func (app *application) listUsersByRole(w http.ResponseWriter, r *http.Request, query url.Values) {
+	roles, err := parseUserRoles(query["role"])
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
 
 	search := query.Get("search")
 	if search != "" && len(search) < 2 {
@@ -238,6 +249,16 @@ func (app *application) updateUserRoleHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	existing, err := app.store.Users.GetByID(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("user not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
 	user, err := app.store.Users.UpdateRole(r.Context(), userID, payload.Role)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
@@ -248,6 +269,11 @@ func (app *application) updateUserRoleHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	app.recordAudit(r, store.AuditActionUserRoleUpdate, store.AuditTargetUser, userID,
+		map[string]store.UserRole{"role": existing.Role},
+		map[string]store.UserRole{"role": user.Role},
+	)
+
 	if err := app.jsonResponse(w, http.StatusOK, UpdateRoleResponse{User: user}); err != nil {
 		app.internalServerError(w, r, err)
 	}
@@ -100,6 +100,7 @@ func TestUpdateUserRole(t *testing.T) {
 			Email: "hacker@test.com",
 			Role:  store.RoleAdmin,
 		}
+		mockUsers.On("GetByID", "user-1").Return(&store.User{ID: "user-1", Email: "hacker@test.com", Role: store.RoleHacker}, nil).Once()
 		mockUsers.On("UpdateRole", "user-1", store.RoleAdmin).Return(returned, nil).Once()
 
 		body := `{"role":"admin"}`
@@ -125,7 +126,7 @@ func TestUpdateUserRole(t *testing.T) {
 	})
 
 	t.Run("should return 404 when user not found", func(t *testing.T) {
-		mockUsers.On("UpdateRole", "nonexistent", store.RoleAdmin).Return(nil, store.ErrNotFound).Once()
+		mockUsers.On("GetByID", "nonexistent").Return(nil, store.ErrNotFound).Once()
 
 		body := `{"role":"admin"}`
 		req, err := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
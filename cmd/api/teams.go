@@ -0,0 +1,366 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/hackutd/portal/internal/store"
+)
+
+type CreateTeamPayload struct {
+	Name string `json:"name" validate:"required,min=1,max=64"`
+}
+
+type InviteTeamMemberPayload struct {
+	Email string `json:"email" validate:"required,email,max=254"`
+}
+
+type JoinTeamPayload struct {
+	Code string `json:"code" validate:"required,len=8,alphanum"`
+}
+
+type TeamResponse struct {
+	Team        *store.Team `json:"team"`
+	TeamSizeMax int         `json:"team_size_max"`
+}
+
+type TeamInviteResponse struct {
+	Invite *store.TeamInvite `json:"invite"`
+}
+
+type TeamListResponse struct {
+	Teams []store.TeamListItem `json:"teams"`
+}
+
+// getMyTeamHandler returns the authenticated hacker's team
+//
+//	@Summary		Get my team
+//	@Description	Returns the authenticated hacker's team with its members, pending invites and join code. team is null when the hacker is not on a team.
+//	@Tags			teams
+//	@Produce		json
+//	@Success		200	{object}	TeamResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/teams/me [get]
+func (app *application) getMyTeamHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	team, err := app.store.Teams.GetByUserID(r.Context(), user.ID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.writeTeamResponse(w, r, http.StatusOK, team)
+}
+
+// createTeamHandler creates a team with the authenticated hacker as its first member
+//
+//	@Summary		Create team
+//	@Description	Creates a team and adds the authenticated hacker to it. The response includes the join code teammates use to join.
+//	@Tags			teams
+//	@Accept			json
+//	@Produce		json
+//	@Param			team	body		CreateTeamPayload	true	"Team name"
+//	@Success		201		{object}	TeamResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		409		{object}	object{error=string}	"Already on a team"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/teams [post]
+func (app *application) createTeamHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	var req CreateTeamPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	team := &store.Team{Name: req.Name}
+	if err := app.store.Teams.Create(r.Context(), team, user.ID); err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			app.conflictResponse(w, r, errors.New("you are already on a team"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	// Reload so the response carries the member list the same way GET does.
+	created, err := app.store.Teams.GetByID(r.Context(), team.ID)
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.writeTeamResponse(w, r, http.StatusCreated, created)
+}
+
+// inviteTeamMemberHandler emails the team's join code to another hacker
+//
+//	@Summary		Invite to team
+//	@Description	Emails the team's join code to the given address and records the invite. The invitee still joins with the code.
+//	@Tags			teams
+//	@Accept			json
+//	@Produce		json
+//	@Param			invite	body		InviteTeamMemberPayload	true	"Invitee email"
+//	@Success		201		{object}	TeamInviteResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}	"Not on a team"
+//	@Failure		409		{object}	object{error=string}	"Team full, already invited, or already a member"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/teams/me/invites [post]
+func (app *application) inviteTeamMemberHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	var req InviteTeamMemberPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	req.Email = strings.TrimSpace(req.Email)
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	team, err := app.store.Teams.GetByUserID(r.Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("you are not on a team"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	inviterName := user.Email
+	for _, m := range team.Members {
+		if strings.EqualFold(m.Email, req.Email) {
+			app.conflictResponse(w, r, errors.New("this person is already on your team"))
+			return
+		}
+		if m.UserID == user.ID && m.FirstName != nil && *m.FirstName != "" {
+			inviterName = *m.FirstName
+		}
+	}
+
+	maxSize, err := app.store.Settings.GetTeamSizeMax(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	invite, err := app.store.Teams.Invite(r.Context(), team.ID, req.Email, user.ID, maxSize)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			app.notFoundResponse(w, r, errors.New("team not found"))
+		case errors.Is(err, store.ErrTeamFull):
+			app.conflictResponse(w, r, errors.New("team is full"))
+		case errors.Is(err, store.ErrConflict):
+			app.conflictResponse(w, r, errors.New("this email has already been invited"))
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	go func() {
+		if err := app.mailer.SendTeamInviteEmail(invite.Email, inviterName, team.Name, team.JoinCode); err != nil {
+			app.logger.Errorw("failed to send team invite email", "team_id", team.ID, "error", err)
+		}
+	}()
+
+	if err := app.jsonResponse(w, http.StatusCreated, TeamInviteResponse{Invite: invite}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// joinTeamHandler adds the authenticated hacker to a team by join code
+//
+//	@Summary		Join team
+//	@Description	Joins the team with the given join code. Codes are case-insensitive.
+//	@Tags			teams
+//	@Accept			json
+//	@Produce		json
+//	@Param			code	body		JoinTeamPayload	true	"Join code"
+//	@Success		200		{object}	TeamResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}	"Unknown join code"
+//	@Failure		409		{object}	object{error=string}	"Already on a team or team full"
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/teams/join [post]
+func (app *application) joinTeamHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	var req JoinTeamPayload
+	if err := readJSON(w, r, &req); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	req.Code = strings.ToUpper(strings.TrimSpace(req.Code))
+	if err := Validate.Struct(req); err != nil {
+		app.badRequestResponse(w, r, errors.New("invalid join code"))
+		return
+	}
+
+	maxSize, err := app.store.Settings.GetTeamSizeMax(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	team, err := app.store.Teams.Join(r.Context(), req.Code, user.ID, maxSize)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			app.notFoundResponse(w, r, errors.New("no team found for that join code"))
+		case errors.Is(err, store.ErrConflict):
+			app.conflictResponse(w, r, errors.New("you are already on a team"))
+		case errors.Is(err, store.ErrTeamFull):
+			app.conflictResponse(w, r, errors.New("team is full"))
+		default:
+			app.internalServerError(w, r, err)
+		}
+		return
+	}
+
+	app.writeTeamResponse(w, r, http.StatusOK, team)
+}
+
+// leaveTeamHandler removes the authenticated hacker from their team
+//
+//	@Summary		Leave team
+//	@Description	Removes the authenticated hacker from their team. The team is deleted when its last member leaves.
+//	@Tags			teams
+//	@Success		204
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		404	{object}	object{error=string}	"Not on a team"
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/teams/me [delete]
+func (app *application) leaveTeamHandler(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r.Context())
+	if user == nil {
+		app.unauthorizedErrorResponse(w, r, nil)
+		return
+	}
+
+	if err := app.store.Teams.Leave(r.Context(), user.ID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("you are not on a team"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listTeamsHandler lists every team
+//
+//	@Summary		List teams (Admin)
+//	@Description	Lists every team with its member count and how many members are accepted, largest first
+//	@Tags			admin/teams
+//	@Produce		json
+//	@Success		200	{object}	TeamListResponse
+//	@Failure		401	{object}	object{error=string}
+//	@Failure		403	{object}	object{error=string}
+//	@Failure		500	{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/teams [get]
+func (app *application) listTeamsHandler(w http.ResponseWriter, r *http.Request) {
+	teams, err := app.store.Teams.List(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, http.StatusOK, TeamListResponse{Teams: teams}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
+
+// getTeamHandler returns a single team by ID
+//
+//	@Summary		Get team by ID (Admin)
+//	@Description	Returns a team with its members and their application status. Filter the application list by team_id to act on the team as a group.
+//	@Tags			admin/teams
+//	@Produce		json
+//	@Param			teamID	path		string	true	"Team ID"
+//	@Success		200		{object}	TeamResponse
+//	@Failure		400		{object}	object{error=string}
+//	@Failure		401		{object}	object{error=string}
+//	@Failure		403		{object}	object{error=string}
+//	@Failure		404		{object}	object{error=string}
+//	@Failure		500		{object}	object{error=string}
+//	@Security		CookieAuth
+//	@Router			/admin/teams/{teamID} [get]
+func (app *application) getTeamHandler(w http.ResponseWriter, r *http.Request) {
+	teamID := chi.URLParam(r, "teamID")
+	if err := Validate.Var(teamID, "required,uuid"); err != nil {
+		app.badRequestResponse(w, r, errors.New("team ID must be a valid UUID"))
+		return
+	}
+
+	team, err := app.store.Teams.GetByID(r.Context(), teamID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			app.notFoundResponse(w, r, errors.New("team not found"))
+			return
+		}
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	app.writeTeamResponse(w, r, http.StatusOK, team)
+}
+
+// writeTeamResponse wraps team with the current size cap so clients can show
+// how many seats are left without a second request.
+func (app *application) writeTeamResponse(w http.ResponseWriter, r *http.Request, status int, team *store.Team) {
+	maxSize, err := app.store.Settings.GetTeamSizeMax(r.Context())
+	if err != nil {
+		app.internalServerError(w, r, err)
+		return
+	}
+
+	if err := app.jsonResponse(w, status, TeamResponse{Team: team, TeamSizeMax: maxSize}); err != nil {
+		app.internalServerError(w, r, err)
+	}
+}
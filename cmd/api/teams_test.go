@@ -0,0 +1,261 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hackutd/portal/internal/mailer"
+	"github.com/hackutd/portal/internal/store"
+)
+
+func newTestTeam() *store.Team {
+	first := "Test"
+	return &store.Team{
+		ID:       "team-1",
+		Name:     "Byte Me",
+		JoinCode: "ABCD2345",
+		Members: []store.TeamMember{
+			{UserID: "user-1", Email: "hacker@test.com", FirstName: &first},
+		},
+		Invites: []store.TeamInvite{},
+	}
+}
+
+func newTeamRequest(t *testing.T, method, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, "/", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return setUserContext(req, newTestUser())
+}
+
+func TestGetMyTeam(t *testing.T) {
+	t.Run("should return the team and size cap", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		mockTeams.On("GetByUserID", "user-1").Return(newTestTeam(), nil).Once()
+		mockSettings.On("GetTeamSizeMax").Return(4, nil).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodGet, ""), http.HandlerFunc(app.getMyTeamHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Data TeamResponse `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.NotNil(t, body.Data.Team)
+		assert.Equal(t, "ABCD2345", body.Data.Team.JoinCode)
+		assert.Equal(t, 4, body.Data.TeamSizeMax)
+
+		mockTeams.AssertExpectations(t)
+	})
+
+	t.Run("should return null team when not on one", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		mockTeams.On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+		mockSettings.On("GetTeamSizeMax").Return(4, nil).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodGet, ""), http.HandlerFunc(app.getMyTeamHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"data":{"team":null,"team_size_max":4}}`, rr.Body.String())
+	})
+}
+
+func TestCreateTeam(t *testing.T) {
+	t.Run("should create a team with the caller as a member", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		mockTeams.On("Create", mock.MatchedBy(func(team *store.Team) bool {
+			return team.Name == "Byte Me"
+		}), "user-1").Run(func(args mock.Arguments) {
+			args.Get(0).(*store.Team).ID = "team-1"
+		}).Return(nil).Once()
+		mockTeams.On("GetByID", "team-1").Return(newTestTeam(), nil).Once()
+		mockSettings.On("GetTeamSizeMax").Return(4, nil).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"name":"  Byte Me  "}`), http.HandlerFunc(app.createTeamHandler))
+		checkResponseCode(t, http.StatusCreated, rr.Code)
+
+		mockTeams.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 when already on a team", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+		mockTeams.On("Create", mock.Anything, "user-1").Return(store.ErrConflict).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"name":"Byte Me"}`), http.HandlerFunc(app.createTeamHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("should return 400 for a blank name", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"name":"   "}`), http.HandlerFunc(app.createTeamHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestInviteTeamMember(t *testing.T) {
+	t.Run("should record the invite and email the join code", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockMailer := app.mailer.(*mailer.MockClient)
+
+		mockTeams.On("GetByUserID", "user-1").Return(newTestTeam(), nil).Once()
+		mockSettings.On("GetTeamSizeMax").Return(4, nil).Once()
+		mockTeams.On("Invite", "team-1", "friend@test.com", "user-1", 4).
+			Return(&store.TeamInvite{ID: "inv-1", Email: "friend@test.com"}, nil).Once()
+		mockMailer.On("SendTeamInviteEmail", "friend@test.com", "Test", "Byte Me", "ABCD2345").Return(nil).Maybe()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"email":"friend@test.com"}`), http.HandlerFunc(app.inviteTeamMemberHandler))
+		checkResponseCode(t, http.StatusCreated, rr.Code)
+
+		mockTeams.AssertExpectations(t)
+	})
+
+	t.Run("should return 409 when the team is full", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+		mockMailer := app.mailer.(*mailer.MockClient)
+
+		mockTeams.On("GetByUserID", "user-1").Return(newTestTeam(), nil).Once()
+		mockSettings.On("GetTeamSizeMax").Return(1, nil).Once()
+		mockTeams.On("Invite", "team-1", "friend@test.com", "user-1", 1).Return(nil, store.ErrTeamFull).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"email":"friend@test.com"}`), http.HandlerFunc(app.inviteTeamMemberHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		mockMailer.AssertNotCalled(t, "SendTeamInviteEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return 409 when inviting an existing member", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+		mockTeams.On("GetByUserID", "user-1").Return(newTestTeam(), nil).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"email":"HACKER@test.com"}`), http.HandlerFunc(app.inviteTeamMemberHandler))
+		checkResponseCode(t, http.StatusConflict, rr.Code)
+		mockTeams.AssertNotCalled(t, "Invite", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return 404 when not on a team", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+		mockTeams.On("GetByUserID", "user-1").Return(nil, store.ErrNotFound).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"email":"friend@test.com"}`), http.HandlerFunc(app.inviteTeamMemberHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestJoinTeam(t *testing.T) {
+	t.Run("should normalize the code and join", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+		mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+		mockSettings.On("GetTeamSizeMax").Return(4, nil)
+		mockTeams.On("Join", "ABCD2345", "user-1", 4).Return(newTestTeam(), nil).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"code":" abcd2345 "}`), http.HandlerFunc(app.joinTeamHandler))
+		checkResponseCode(t, http.StatusOK, rr.Code)
+
+		mockTeams.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"unknown code", store.ErrNotFound, http.StatusNotFound},
+		{"already on a team", store.ErrConflict, http.StatusConflict},
+		{"team full", store.ErrTeamFull, http.StatusConflict},
+	} {
+		t.Run("should map "+tc.name, func(t *testing.T) {
+			app := newTestApplication(t)
+			mockTeams := app.store.Teams.(*store.MockTeamsStore)
+			mockSettings := app.store.Settings.(*store.MockSettingsStore)
+
+			mockSettings.On("GetTeamSizeMax").Return(4, nil).Once()
+			mockTeams.On("Join", "ABCD2345", "user-1", 4).Return(nil, tc.err).Once()
+
+			rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"code":"ABCD2345"}`), http.HandlerFunc(app.joinTeamHandler))
+			checkResponseCode(t, tc.status, rr.Code)
+		})
+	}
+
+	t.Run("should return 400 for a malformed code", func(t *testing.T) {
+		app := newTestApplication(t)
+
+		rr := executeRequest(newTeamRequest(t, http.MethodPost, `{"code":"ABC-123"}`), http.HandlerFunc(app.joinTeamHandler))
+		checkResponseCode(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestLeaveTeam(t *testing.T) {
+	t.Run("should leave the team", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+		mockTeams.On("Leave", "user-1").Return(nil).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodDelete, ""), http.HandlerFunc(app.leaveTeamHandler))
+		checkResponseCode(t, http.StatusNoContent, rr.Code)
+
+		mockTeams.AssertExpectations(t)
+	})
+
+	t.Run("should return 404 when not on a team", func(t *testing.T) {
+		app := newTestApplication(t)
+		mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+		mockTeams.On("Leave", "user-1").Return(store.ErrNotFound).Once()
+
+		rr := executeRequest(newTeamRequest(t, http.MethodDelete, ""), http.HandlerFunc(app.leaveTeamHandler))
+		checkResponseCode(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestListTeams(t *testing.T) {
+	app := newTestApplication(t)
+	mockTeams := app.store.Teams.(*store.MockTeamsStore)
+
+	mockTeams.On("List").Return([]store.TeamListItem{
+		{ID: "team-1", Name: "Byte Me", MemberCount: 3, AcceptedCount: 1},
+	}, nil).Once()
+
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, err)
+	req = setUserContext(req, newAdminUser())
+
+	rr := executeRequest(req, http.HandlerFunc(app.listTeamsHandler))
+	checkResponseCode(t, http.StatusOK, rr.Code)
+
+	var body struct {
+		Data TeamListResponse `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	require.Len(t, body.Data.Teams, 1)
+	assert.Equal(t, 3, body.Data.Teams[0].MemberCount)
+
+	mockTeams.AssertExpectations(t)
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/hackutd/portal/internal/gcs"
 	"github.com/hackutd/portal/internal/mailer"
+	"github.com/hackutd/portal/internal/qrtoken"
 	"github.com/hackutd/portal/internal/ratelimiter"
 	"github.com/hackutd/portal/internal/store"
+	"github.com/stretchr/testify/mock"
 	"github.com/supertokens/supertokens-golang/recipe/passwordless"
 	"github.com/supertokens/supertokens-golang/recipe/passwordless/plessmodels"
 	"github.com/supertokens/supertokens-golang/recipe/session"
@@ -60,6 +62,18 @@ func newTestApplication(t *testing.T) *application {
 	// logger = zap.Must(zap.NewProduction()).Sugar()
 
 	mockStore := store.NewMockStore()
+	// Most handlers never touch the hackathon ID; the ones that sign or
+	// verify QR tokens all see the same stable value.
+	mockStore.Settings.(*store.MockSettingsStore).On("GetHackathonID").Return(testHackathonID, nil).Maybe()
+	// Audit writes ride along with every mutation; tests that care assert on
+	// them explicitly via mockStore.AuditLog.
+	mockStore.Settings.(*store.MockSettingsStore).On("GetRaw", mock.Anything).Return(nil, nil).Maybe()
+	mockStore.AuditLog.(*store.MockAuditLogStore).On("Record", mock.Anything).Return(nil).Maybe()
+
+	qrTokens, err := qrtoken.New([]byte(testQRSigningSecret))
+	if err != nil {
+		t.Fatalf("failed to create QR token signer: %v", err)
+	}
 
 	rateLimiter := ratelimiter.NewFixedWindowLimiter(20, 5*time.Second)
 
@@ -83,10 +97,26 @@ func newTestApplication(t *testing.T) *application {
 		logger:      logger,
 		mailer:      &mailer.MockClient{},
 		gcsClient:   &gcs.MockClient{},
+		qrTokens:    qrTokens,
 		rateLimiter: rateLimiter,
 	}
 }
 
+const (
+	testHackathonID     = "hackathon-1"
+	testQRSigningSecret = "test-qr-signing-secret-0123456789"
+)
+
+// newTestQRToken signs a QR payload for userID the way the hacker's pass would.
+func newTestQRToken(t *testing.T, app *application, userID string) string {
+	t.Helper()
+	token, err := app.qrTokens.Issue(userID, testHackathonID, 0)
+	if err != nil {
+		t.Fatalf("failed to issue QR token: %v", err)
+	}
+	return token
+}
+
 func executeRequest(req *http.Request, mux http.Handler) *httptest.ResponseRecorder {
 	rr := httptest.NewRecorder()
 	mux.ServeHTTP(rr, req)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -59,7 +60,7 @@ func (app *application) getWalkInsHandler(w http.ResponseWriter, r *http.Request
 // promoteWalkInsHandler promotes the next N walk-ins and sends acceptance emails
 //
 //	@Summary		Promote walk-ins (Super Admin)
-//	@Description	Promotes the next N un-promoted walk-ins in FIFO order and sends acceptance emails
+//	@Description	Promotes the next N un-promoted walk-ins in FIFO order and sends acceptance emails. Walk-ins a full hard admission quota would take are passed over and stay queued, so fewer than N may be promoted.
 //	@Tags			superadmin/walk-ins
 //	@Accept			json
 //	@Produce		json
@@ -95,9 +96,22 @@ func (app *application) promoteWalkInsHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	promotedIDs := make([]string, len(promoted))
+	for i, u := range promoted {
+		promotedIDs[i] = u.ID
+	}
+	app.recordAudit(r, store.AuditActionWalkInPromote, store.AuditTargetWalkInQueue, "", nil,
+		map[string]any{"requested": req.Count, "promoted_user_ids": promotedIDs},
+	)
+
 	for _, u := range promoted {
 		go func() {
-			if err := app.mailer.SendWalkInAcceptedEmail(u.Email, u.ID); err != nil {
+			qrToken, err := app.issueQRToken(context.Background(), u.ID)
+			if err != nil {
+				app.logger.Errorw("failed to issue walk-in QR token", "error", err, "user_id", u.ID)
+				return
+			}
+			if err := app.mailer.SendWalkInAcceptedEmail(u.Email, qrToken); err != nil {
 				app.logger.Errorw("failed to send walk-in accepted email", "error", err, "user_id", u.ID)
 			}
 		}()
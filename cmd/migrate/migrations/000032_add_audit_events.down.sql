@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_audit_events_append_only ON audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_change();
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,38 @@
+-- Append-only record of admin and super admin mutations. actor_id and
+-- target_id carry no foreign keys on purpose: deleting a user must not
+-- rewrite or cascade away the history of what they did or what was done to
+-- them, and actor_email keeps the trail readable after the account is gone.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id UUID,
+    actor_email TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The list endpoint pages newest first on (created_at, id); the filtered
+-- variants narrow by actor, action, or target before paging.
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at
+    ON audit_events (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor
+    ON audit_events (actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_action
+    ON audit_events (action, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_target
+    ON audit_events (target_type, target_id, created_at DESC);
+
+CREATE OR REPLACE FUNCTION reject_audit_event_change()
+RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_events_append_only
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
@@ -0,0 +1,12 @@
+DROP TRIGGER IF EXISTS trg_applications_confirmation ON applications;
+DROP FUNCTION IF EXISTS sync_application_confirmation();
+
+DROP INDEX IF EXISTS idx_applications_confirmation_pending;
+
+ALTER TABLE applications
+    DROP CONSTRAINT IF EXISTS applications_confirmation_check,
+    DROP COLUMN IF EXISTS confirmation_responded_at,
+    DROP COLUMN IF EXISTS confirmation_deadline,
+    DROP COLUMN IF EXISTS confirmation_status;
+
+DROP TYPE IF EXISTS confirmation_status;
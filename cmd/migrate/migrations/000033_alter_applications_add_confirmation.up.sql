@@ -0,0 +1,60 @@
+CREATE TYPE confirmation_status AS ENUM ('pending', 'confirmed', 'declined', 'expired');
+
+-- Accepted hackers confirm or decline their spot before confirmation_deadline.
+-- The columns are only meaningful while status = 'accepted'; the trigger below
+-- keeps them in step with status so every writer (admin decisions, walk-in
+-- promotion, waitlist backfill) gets the same behaviour.
+ALTER TABLE applications
+    ADD COLUMN confirmation_status confirmation_status,
+    ADD COLUMN confirmation_deadline TIMESTAMPTZ,
+    ADD COLUMN confirmation_responded_at TIMESTAMPTZ,
+    ADD CONSTRAINT applications_confirmation_check CHECK (
+        status = 'accepted'
+        OR confirmation_status IS NULL
+    );
+
+-- Anyone accepted before this existed can still confirm, with no deadline.
+UPDATE applications SET confirmation_status = 'pending' WHERE status = 'accepted';
+
+-- Backs the expiry sweep and the held-seat count.
+CREATE INDEX idx_applications_confirmation_pending
+    ON applications (confirmation_deadline)
+    WHERE confirmation_status = 'pending';
+
+-- On the transition into 'accepted' a new confirmation window opens, sized by
+-- the rsvp_config setting (no deadline when unset). A writer that sets
+-- confirmation_status itself in the same statement — walk-ins are accepted at
+-- the door and count as confirmed — keeps its value. Leaving 'accepted' clears
+-- the window.
+CREATE OR REPLACE FUNCTION sync_application_confirmation()
+RETURNS TRIGGER AS $$
+DECLARE
+    deadline_hours INT;
+BEGIN
+    IF NEW.status <> 'accepted' THEN
+        NEW.confirmation_status := NULL;
+        NEW.confirmation_deadline := NULL;
+        NEW.confirmation_responded_at := NULL;
+    ELSIF TG_OP = 'INSERT' OR OLD.status <> 'accepted' THEN
+        IF NEW.confirmation_status IS NULL THEN
+            SELECT (value->>'deadline_hours')::int INTO deadline_hours
+            FROM settings
+            WHERE key = 'rsvp_config';
+
+            NEW.confirmation_status := 'pending';
+            NEW.confirmation_deadline := CASE
+                WHEN deadline_hours > 0 THEN now() + make_interval(hours => deadline_hours)
+            END;
+            NEW.confirmation_responded_at := NULL;
+        ELSIF NEW.confirmation_status = 'confirmed' THEN
+            NEW.confirmation_responded_at := COALESCE(NEW.confirmation_responded_at, now());
+        END IF;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_applications_confirmation
+BEFORE INSERT OR UPDATE OF status ON applications
+FOR EACH ROW EXECUTE FUNCTION sync_application_confirmation();
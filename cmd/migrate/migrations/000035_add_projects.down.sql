@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS project_judgings;
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,39 @@
+-- One submission per team. tracks holds the names configured in the
+-- judging_config setting at the time of submission.
+CREATE TABLE IF NOT EXISTS projects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL UNIQUE REFERENCES teams(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    repo_url TEXT,
+    demo_url TEXT,
+    tracks TEXT[] NOT NULL DEFAULT '{}',
+    submitted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TRIGGER trg_projects_updated_at
+BEFORE UPDATE ON projects
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Mirrors application_reviews: a row is an assignment until scored_at is set.
+-- scores maps rubric criterion IDs to the points the judge awarded.
+CREATE TABLE IF NOT EXISTS project_judgings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    judge_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    scores JSONB,
+    notes TEXT,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    scored_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (project_id, judge_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_judgings_judge ON project_judgings (judge_id, scored_at);
+
+CREATE TRIGGER trg_project_judgings_updated_at
+BEFORE UPDATE ON project_judgings
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
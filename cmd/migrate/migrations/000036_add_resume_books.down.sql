@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS resume_books;
+DROP TYPE IF EXISTS resume_book_status;
@@ -0,0 +1,22 @@
+CREATE TYPE resume_book_status AS ENUM ('building', 'ready', 'failed');
+
+-- A resume book is a ZIP of opted-in hackers' resumes built in the background
+-- for sponsors. opt_in_field is the checkbox schema field a hacker ticked to
+-- be included; object_path is set once the archive has been written.
+CREATE TABLE IF NOT EXISTS resume_books (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    status resume_book_status NOT NULL DEFAULT 'building',
+    opt_in_field TEXT NOT NULL,
+    object_path TEXT,
+    resume_count INT NOT NULL DEFAULT 0,
+    skipped_count INT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TRIGGER trg_resume_books_updated_at
+BEFORE UPDATE ON resume_books
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,80 @@
+-- Only the active event survives a rollback: the old global unique
+-- constraints cannot hold once several events share a table.
+DELETE FROM scheduled_notifications WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM schedule WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM team_members WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM teams WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM walk_ins WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM scans WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM applications WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM sponsors WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM faqs WHERE hackathon_id <> active_hackathon_id();
+DELETE FROM settings WHERE hackathon_id <> active_hackathon_id();
+
+CREATE OR REPLACE FUNCTION sync_application_confirmation()
+RETURNS TRIGGER AS $$
+DECLARE
+    deadline_hours INT;
+BEGIN
+    IF NEW.status <> 'accepted' THEN
+        NEW.confirmation_status := NULL;
+        NEW.confirmation_deadline := NULL;
+        NEW.confirmation_responded_at := NULL;
+    ELSIF TG_OP = 'INSERT' OR OLD.status <> 'accepted' THEN
+        IF NEW.confirmation_status IS NULL THEN
+            SELECT (value->>'deadline_hours')::int INTO deadline_hours
+            FROM settings
+            WHERE key = 'rsvp_config';
+
+            NEW.confirmation_status := 'pending';
+            NEW.confirmation_deadline := CASE
+                WHEN deadline_hours > 0 THEN now() + make_interval(hours => deadline_hours)
+            END;
+            NEW.confirmation_responded_at := NULL;
+        ELSIF NEW.confirmation_status = 'confirmed' THEN
+            NEW.confirmation_responded_at := COALESCE(NEW.confirmation_responded_at, now());
+        END IF;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS idx_teams_hackathon_id;
+DROP INDEX IF EXISTS idx_scheduled_notifications_hackathon_id;
+DROP INDEX IF EXISTS idx_faqs_hackathon_id;
+DROP INDEX IF EXISTS idx_sponsors_hackathon_id;
+DROP INDEX IF EXISTS idx_schedule_hackathon_id;
+DROP INDEX IF EXISTS idx_applications_hackathon_status;
+
+DROP INDEX IF EXISTS idx_scans_hackathon_user;
+CREATE INDEX idx_scans_user_id ON scans (user_id);
+
+DROP INDEX IF EXISTS uq_scans_user_scan_type_once;
+CREATE UNIQUE INDEX uq_scans_user_scan_type_once ON scans (user_id, scan_type) WHERE NOT repeatable;
+
+ALTER TABLE team_members DROP CONSTRAINT team_members_hackathon_id_user_id_key;
+ALTER TABLE team_members ADD CONSTRAINT team_members_user_id_key UNIQUE (user_id);
+
+ALTER TABLE walk_ins DROP CONSTRAINT walk_ins_hackathon_id_user_id_key;
+ALTER TABLE walk_ins ADD CONSTRAINT walk_ins_user_id_key UNIQUE (user_id);
+
+ALTER TABLE applications DROP CONSTRAINT applications_hackathon_id_user_id_key;
+ALTER TABLE applications ADD CONSTRAINT applications_user_id_key UNIQUE (user_id);
+
+ALTER TABLE settings DROP CONSTRAINT settings_hackathon_id_key_key;
+ALTER TABLE settings ADD CONSTRAINT settings_key_key UNIQUE (key);
+
+ALTER TABLE team_members DROP COLUMN hackathon_id;
+ALTER TABLE teams DROP COLUMN hackathon_id;
+ALTER TABLE walk_ins DROP COLUMN hackathon_id;
+ALTER TABLE scheduled_notifications DROP COLUMN hackathon_id;
+ALTER TABLE faqs DROP COLUMN hackathon_id;
+ALTER TABLE sponsors DROP COLUMN hackathon_id;
+ALTER TABLE schedule DROP COLUMN hackathon_id;
+ALTER TABLE scans DROP COLUMN hackathon_id;
+ALTER TABLE applications DROP COLUMN hackathon_id;
+ALTER TABLE settings DROP COLUMN hackathon_id;
+
+DROP FUNCTION IF EXISTS active_hackathon_id();
+DROP TABLE IF EXISTS hackathons;
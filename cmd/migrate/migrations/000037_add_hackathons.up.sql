@@ -0,0 +1,113 @@
+-- Each hackathon is one event cycle. Exactly one is active at a time: it is
+-- the event the portal serves, and every unscoped read and write goes to it.
+-- Switching the active event archives the previous one, whose rows stay in
+-- place for year-over-year comparison.
+CREATE TABLE IF NOT EXISTS hackathons (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    starts_on DATE,
+    ends_on DATE,
+    is_active BOOLEAN NOT NULL DEFAULT false,
+    archived_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT hackathons_dates_check CHECK (ends_on IS NULL OR starts_on IS NULL OR ends_on >= starts_on)
+);
+
+CREATE UNIQUE INDEX uq_hackathons_active ON hackathons (is_active) WHERE is_active;
+
+CREATE TRIGGER trg_hackathons_updated_at
+BEFORE UPDATE ON hackathons
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Everything that already exists belongs to the current event, named after
+-- the configured hackathon name when there is one.
+INSERT INTO hackathons (name, starts_on, ends_on, is_active)
+SELECT
+    COALESCE(
+        (SELECT NULLIF(value #>> '{}', '') FROM settings WHERE key = 'hackathon_name'),
+        'Hackathon'
+    ),
+    (SELECT (value->>'start_date')::date FROM settings
+      WHERE key = 'hackathon_date_range' AND value->>'start_date' ~ '^\d{4}-\d{2}-\d{2}$'),
+    (SELECT (value->>'end_date')::date FROM settings
+      WHERE key = 'hackathon_date_range' AND value->>'end_date' ~ '^\d{4}-\d{2}-\d{2}$'),
+    true;
+
+-- Column defaults call this, so writers that never mention hackathon_id land
+-- in the active event.
+CREATE OR REPLACE FUNCTION active_hackathon_id()
+RETURNS UUID AS $$
+    SELECT id FROM hackathons WHERE is_active
+$$ LANGUAGE sql STABLE;
+
+-- Adding the column fills existing rows from the default, which moves them
+-- into the event created above.
+ALTER TABLE settings ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE applications ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE scans ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE schedule ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE sponsors ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE faqs ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE scheduled_notifications ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE walk_ins ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE teams ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+ALTER TABLE team_members ADD COLUMN hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id);
+
+-- Uniqueness that used to be global now holds per event: a setting key, a
+-- hacker's application, walk-in and team membership, and one-time scans.
+ALTER TABLE settings DROP CONSTRAINT settings_key_key;
+ALTER TABLE settings ADD CONSTRAINT settings_hackathon_id_key_key UNIQUE (hackathon_id, key);
+
+ALTER TABLE applications DROP CONSTRAINT applications_user_id_key;
+ALTER TABLE applications ADD CONSTRAINT applications_hackathon_id_user_id_key UNIQUE (hackathon_id, user_id);
+
+ALTER TABLE walk_ins DROP CONSTRAINT walk_ins_user_id_key;
+ALTER TABLE walk_ins ADD CONSTRAINT walk_ins_hackathon_id_user_id_key UNIQUE (hackathon_id, user_id);
+
+ALTER TABLE team_members DROP CONSTRAINT team_members_user_id_key;
+ALTER TABLE team_members ADD CONSTRAINT team_members_hackathon_id_user_id_key UNIQUE (hackathon_id, user_id);
+
+DROP INDEX uq_scans_user_scan_type_once;
+CREATE UNIQUE INDEX uq_scans_user_scan_type_once ON scans (hackathon_id, user_id, scan_type) WHERE NOT repeatable;
+
+DROP INDEX idx_scans_user_id;
+CREATE INDEX idx_scans_hackathon_user ON scans (hackathon_id, user_id);
+
+CREATE INDEX idx_applications_hackathon_status ON applications (hackathon_id, status);
+CREATE INDEX idx_schedule_hackathon_id ON schedule (hackathon_id);
+CREATE INDEX idx_sponsors_hackathon_id ON sponsors (hackathon_id);
+CREATE INDEX idx_faqs_hackathon_id ON faqs (hackathon_id);
+CREATE INDEX idx_scheduled_notifications_hackathon_id ON scheduled_notifications (hackathon_id);
+CREATE INDEX idx_teams_hackathon_id ON teams (hackathon_id);
+
+-- The confirmation window is sized by the rsvp_config of the application's
+-- own event.
+CREATE OR REPLACE FUNCTION sync_application_confirmation()
+RETURNS TRIGGER AS $$
+DECLARE
+    deadline_hours INT;
+BEGIN
+    IF NEW.status <> 'accepted' THEN
+        NEW.confirmation_status := NULL;
+        NEW.confirmation_deadline := NULL;
+        NEW.confirmation_responded_at := NULL;
+    ELSIF TG_OP = 'INSERT' OR OLD.status <> 'accepted' THEN
+        IF NEW.confirmation_status IS NULL THEN
+            SELECT (value->>'deadline_hours')::int INTO deadline_hours
+            FROM settings
+            WHERE hackathon_id = NEW.hackathon_id AND key = 'rsvp_config';
+
+            NEW.confirmation_status := 'pending';
+            NEW.confirmation_deadline := CASE
+                WHEN deadline_hours > 0 THEN now() + make_interval(hours => deadline_hours)
+            END;
+            NEW.confirmation_responded_at := NULL;
+        ELSIF NEW.confirmation_status = 'confirmed' THEN
+            NEW.confirmation_responded_at := COALESCE(NEW.confirmation_responded_at, now());
+        END IF;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_applications_hackathon_schema_version;
+
+ALTER TABLE applications DROP COLUMN IF EXISTS schema_version;
+
+DROP TABLE IF EXISTS application_schema_versions;
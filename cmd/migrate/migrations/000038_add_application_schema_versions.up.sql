@@ -0,0 +1,36 @@
+-- Every save of the application schema is kept as a numbered version of its
+-- hackathon's form. The application_schema setting remains the live copy;
+-- this table is its history.
+CREATE TABLE IF NOT EXISTS application_schema_versions (
+    hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id),
+    version INT NOT NULL,
+    fields JSONB NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (hackathon_id, version),
+    CONSTRAINT application_schema_versions_version_check CHECK (version > 0)
+);
+
+INSERT INTO application_schema_versions (hackathon_id, version, fields)
+SELECT hackathon_id, 1, value
+FROM settings
+WHERE key = 'application_schema';
+
+-- The schema version an application's responses are keyed against. Saves and
+-- submission set it to the latest version, so a submitted application keeps
+-- the version it was submitted against until an admin maps its responses
+-- onto a newer one.
+ALTER TABLE applications ADD COLUMN schema_version INT;
+
+-- The backfill is bookkeeping, not an edit, so it leaves updated_at alone.
+ALTER TABLE applications DISABLE TRIGGER trg_applications_updated_at;
+
+UPDATE applications a
+SET schema_version = 1
+WHERE EXISTS (
+    SELECT 1 FROM application_schema_versions v WHERE v.hackathon_id = a.hackathon_id
+);
+
+ALTER TABLE applications ENABLE TRIGGER trg_applications_updated_at;
+
+CREATE INDEX idx_applications_hackathon_schema_version ON applications (hackathon_id, schema_version);
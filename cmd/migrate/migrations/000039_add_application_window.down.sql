@@ -0,0 +1,12 @@
+INSERT INTO settings (hackathon_id, key, value)
+SELECT hackathon_id, 'application_due_date', to_jsonb(to_char(
+    ((value->>'closes_at')::timestamptz - interval '1 second')
+        AT TIME ZONE COALESCE(value->>'timezone', 'UTC'),
+    'YYYY-MM-DD'
+))
+FROM settings
+WHERE key = 'application_window'
+  AND value->>'closes_at' IS NOT NULL
+ON CONFLICT (hackathon_id, key) DO NOTHING;
+
+DELETE FROM settings WHERE key = 'application_window';
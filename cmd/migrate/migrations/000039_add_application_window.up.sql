@@ -0,0 +1,17 @@
+-- application_window replaces the free-form application_due_date string with
+-- typed open and close timestamps that the API enforces. An existing due date
+-- becomes a close at the end of that day, UTC.
+INSERT INTO settings (hackathon_id, key, value)
+SELECT hackathon_id, 'application_window', jsonb_build_object(
+    'opens_at', NULL,
+    'closes_at', to_jsonb(((value #>> '{}')::date + 1)::timestamp AT TIME ZONE 'UTC'),
+    'timezone', 'UTC',
+    'grace_minutes', 0
+)
+FROM settings
+WHERE key = 'application_due_date'
+  AND jsonb_typeof(value) = 'string'
+  AND value #>> '{}' ~ '^\d{4}-\d{2}-\d{2}$'
+ON CONFLICT (hackathon_id, key) DO NOTHING;
+
+DELETE FROM settings WHERE key = 'application_due_date';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS application_resume_hashes;
+DROP TABLE IF EXISTS application_flags;
+DROP TYPE IF EXISTS application_flag_reason;
@@ -0,0 +1,30 @@
+CREATE TYPE application_flag_reason AS ENUM (
+    'duplicate_phone',
+    'duplicate_name_birthdate',
+    'duplicate_resume',
+    'disposable_email'
+);
+
+-- Flags written by the duplicate detection pass. Each run replaces the active
+-- hackathon's flags, so they always reflect the latest pass. A duplicate is
+-- flagged on both applications, each pointing at the other.
+CREATE TABLE IF NOT EXISTS application_flags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    reason application_flag_reason NOT NULL,
+    related_application_id UUID REFERENCES applications(id) ON DELETE CASCADE,
+    detail TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_application_flags_application_id ON application_flags (application_id);
+
+-- SHA-256 of each application's resume, kept with the path it was computed
+-- from so the pass only downloads resumes that changed since the last run.
+-- Held apart from applications so caching a hash doesn't bump updated_at.
+CREATE TABLE IF NOT EXISTS application_resume_hashes (
+    application_id UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+    resume_path TEXT NOT NULL,
+    sha256 TEXT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,14 @@
+-- Postgres can't drop an enum value, so 'withdrawn' stays on the type; any
+-- withdrawn application goes back to the status it was withdrawn from.
+UPDATE applications
+SET status = withdrawn_from
+WHERE status = 'withdrawn' AND withdrawn_from IS NOT NULL;
+
+UPDATE applications
+SET status = 'draft'
+WHERE status = 'withdrawn';
+
+ALTER TABLE applications
+    DROP COLUMN IF EXISTS withdrawal_reason,
+    DROP COLUMN IF EXISTS withdrawn_at,
+    DROP COLUMN IF EXISTS withdrawn_from;
@@ -0,0 +1,12 @@
+-- Hackers can withdraw their own application instead of deleting their
+-- account. withdrawn_from keeps the status they withdrew from so admins can
+-- tell a dropped draft from a released seat.
+--
+-- The new enum value can't be referenced in the same transaction that adds
+-- it, so nothing below mentions 'withdrawn'.
+ALTER TYPE application_status ADD VALUE IF NOT EXISTS 'withdrawn';
+
+ALTER TABLE applications
+    ADD COLUMN withdrawn_from application_status,
+    ADD COLUMN withdrawn_at TIMESTAMPTZ,
+    ADD COLUMN withdrawal_reason TEXT;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS saved_views;
+DROP TYPE IF EXISTS saved_view_resource;
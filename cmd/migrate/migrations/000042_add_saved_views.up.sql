@@ -0,0 +1,23 @@
+CREATE TYPE saved_view_resource AS ENUM ('applications', 'users');
+
+-- Named filter, sort and column combinations for the admin list pages. query
+-- holds the list endpoint's query parameters as a map of string arrays; a
+-- shared view is visible to every admin but only its owner can change it.
+CREATE TABLE IF NOT EXISTS saved_views (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    resource saved_view_resource NOT NULL,
+    name TEXT NOT NULL,
+    query JSONB NOT NULL DEFAULT '{}',
+    columns JSONB NOT NULL DEFAULT '[]',
+    shared BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (owner_id, resource, name)
+);
+
+CREATE INDEX idx_saved_views_shared ON saved_views (resource) WHERE shared;
+
+CREATE TRIGGER trg_saved_views_updated_at
+BEFORE UPDATE ON saved_views
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
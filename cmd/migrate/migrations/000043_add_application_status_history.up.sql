@@ -0,0 +1,18 @@
+-- Final decisions written by super admins, one row per application whose
+-- status changed. bulk_id groups the rows of one bulk change.
+CREATE TABLE IF NOT EXISTS application_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    from_status application_status NOT NULL,
+    to_status application_status NOT NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    bulk_id UUID,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_application_status_history_application_id
+    ON application_status_history (application_id, changed_at);
+
+CREATE INDEX idx_application_status_history_bulk_id
+    ON application_status_history (bulk_id)
+    WHERE bulk_id IS NOT NULL;
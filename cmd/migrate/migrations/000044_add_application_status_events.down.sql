@@ -0,0 +1,16 @@
+DELETE FROM application_status_events WHERE from_status IS NULL;
+
+ALTER TABLE application_status_events RENAME COLUMN actor_id TO changed_by;
+
+ALTER TABLE application_status_events
+    DROP COLUMN reason,
+    DROP COLUMN source,
+    ALTER COLUMN from_status SET NOT NULL;
+
+ALTER INDEX idx_application_status_events_bulk_id
+    RENAME TO idx_application_status_history_bulk_id;
+ALTER INDEX idx_application_status_events_application_id
+    RENAME TO idx_application_status_history_application_id;
+ALTER TABLE application_status_events RENAME TO application_status_history;
+
+DROP TYPE IF EXISTS application_status_event_source;
@@ -0,0 +1,29 @@
+-- The status history grows into an event log of every status change, not
+-- just the decisions super admins write: submissions, withdrawals, waitlist
+-- promotion and the walk-in queue are recorded too. source says which path
+-- made the change and reason carries the note an admin or hacker gave.
+CREATE TYPE application_status_event_source AS ENUM (
+    'submit', 'admin', 'bulk', 'rules', 'withdraw', 'waitlist_promotion', 'walk_in'
+);
+
+ALTER TABLE application_status_history RENAME TO application_status_events;
+ALTER INDEX idx_application_status_history_application_id
+    RENAME TO idx_application_status_events_application_id;
+ALTER INDEX idx_application_status_history_bulk_id
+    RENAME TO idx_application_status_events_bulk_id;
+
+-- A walk-in without an application gets one straight into the queue, so the
+-- event that creates it has no previous status.
+ALTER TABLE application_status_events
+    ALTER COLUMN from_status DROP NOT NULL,
+    ADD COLUMN source application_status_event_source,
+    ADD COLUMN reason TEXT;
+
+UPDATE application_status_events
+SET source = CASE WHEN bulk_id IS NULL THEN 'admin' ELSE 'bulk' END::application_status_event_source;
+
+ALTER TABLE application_status_events ALTER COLUMN source SET NOT NULL;
+
+-- Hackers and the door staff change statuses too, so the column names who
+-- acted rather than which admin changed it.
+ALTER TABLE application_status_events RENAME COLUMN changed_by TO actor_id;
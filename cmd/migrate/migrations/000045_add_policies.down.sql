@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS policy_signatures;
+DROP TABLE IF EXISTS policy_versions;
+DROP TABLE IF EXISTS policies;
@@ -0,0 +1,48 @@
+-- Documents hackers agree to, such as the code of conduct, a liability waiver
+-- or a photo release. Editing a policy's text adds a version instead of
+-- overwriting it, so every signature points at the exact text that was signed.
+CREATE TABLE IF NOT EXISTS policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id),
+    title TEXT NOT NULL,
+    -- Required policies must be signed at their latest version to check in.
+    required BOOLEAN NOT NULL DEFAULT TRUE,
+    display_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_policies_hackathon_id ON policies (hackathon_id, display_order);
+
+CREATE TRIGGER trg_policies_updated_at
+BEFORE UPDATE ON policies
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE IF NOT EXISTS policy_versions (
+    policy_id UUID NOT NULL REFERENCES policies(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    body TEXT NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (policy_id, version),
+    CONSTRAINT policy_versions_version_check CHECK (version > 0)
+);
+
+-- signed_name is the name the hacker typed. The guardian columns are set when
+-- the hacker was a minor and a parent or guardian signed alongside them.
+CREATE TABLE IF NOT EXISTS policy_signatures (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    policy_id UUID NOT NULL,
+    version INT NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    signed_name TEXT NOT NULL,
+    ip_address TEXT NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    guardian_name TEXT,
+    guardian_email TEXT,
+    signed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (policy_id, version) REFERENCES policy_versions (policy_id, version) ON DELETE CASCADE,
+    CONSTRAINT policy_signatures_unique UNIQUE (policy_id, version, user_id)
+);
+
+CREATE INDEX idx_policy_signatures_user_id ON policy_signatures (user_id);
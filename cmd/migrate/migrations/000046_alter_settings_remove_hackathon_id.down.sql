@@ -0,0 +1,5 @@
+-- Seeding the old key with each event's own ID keeps passes issued since the
+-- upgrade valid.
+INSERT INTO settings (hackathon_id, key, value)
+SELECT id, 'hackathon_id', to_jsonb(id::text) FROM hackathons
+ON CONFLICT (hackathon_id, key) DO NOTHING;
@@ -0,0 +1,3 @@
+-- QR tokens are bound to the hackathons row now, so the random per-cycle ID
+-- they used to carry is no longer read.
+DELETE FROM settings WHERE key = 'hackathon_id';
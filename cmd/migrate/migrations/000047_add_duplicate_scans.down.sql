@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS duplicate_scans;
+DROP TYPE IF EXISTS duplicate_scan_status;
@@ -0,0 +1,29 @@
+CREATE TYPE duplicate_scan_status AS ENUM ('running', 'ready', 'failed');
+
+-- One run of the duplicate detection pass. Hashing resumes can take minutes,
+-- so the pass runs in the background and records its outcome here for the
+-- admin page to poll.
+CREATE TABLE IF NOT EXISTS duplicate_scans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    hackathon_id UUID NOT NULL DEFAULT active_hackathon_id() REFERENCES hackathons(id),
+    status duplicate_scan_status NOT NULL DEFAULT 'running',
+    application_count INT NOT NULL DEFAULT 0,
+    flagged_application_count INT NOT NULL DEFAULT 0,
+    flag_count INT NOT NULL DEFAULT 0,
+    unreadable_resume_count INT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_duplicate_scans_hackathon_id ON duplicate_scans (hackathon_id, created_at DESC);
+
+-- Each pass replaces every flag, so two running at once would duplicate them.
+CREATE UNIQUE INDEX idx_duplicate_scans_one_running
+    ON duplicate_scans (hackathon_id) WHERE status = 'running';
+
+CREATE TRIGGER trg_duplicate_scans_updated_at
+BEFORE UPDATE ON duplicate_scans
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
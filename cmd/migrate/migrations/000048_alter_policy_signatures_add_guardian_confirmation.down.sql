@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_policy_signatures_guardian_token_hash;
+
+ALTER TABLE policy_signatures
+    DROP COLUMN IF EXISTS guardian_ip_address,
+    DROP COLUMN IF EXISTS guardian_confirmed_at,
+    DROP COLUMN IF EXISTS guardian_token_hash;
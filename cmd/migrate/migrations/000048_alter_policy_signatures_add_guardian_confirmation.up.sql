@@ -0,0 +1,12 @@
+-- A minor's signature stays pending until their parent or guardian opens the
+-- link emailed to them and agrees. guardian_token_hash is the SHA-256 of the
+-- token in that link; the token itself is never stored. Signatures taken
+-- before this migration have no link, so the hacker has to sign again.
+ALTER TABLE policy_signatures
+    ADD COLUMN guardian_token_hash TEXT,
+    ADD COLUMN guardian_confirmed_at TIMESTAMPTZ,
+    ADD COLUMN guardian_ip_address TEXT;
+
+CREATE UNIQUE INDEX idx_policy_signatures_guardian_token_hash
+    ON policy_signatures (guardian_token_hash)
+    WHERE guardian_token_hash IS NOT NULL;
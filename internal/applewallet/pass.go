@@ -130,12 +130,17 @@ func New(config Config) (*Generator, error) {
 	}, nil
 }
 
-func (g *Generator) Generate(userID, email string) ([]byte, error) {
+// Generate builds a signed pass for userID. qrToken is rendered into the
+// barcode as-is; userID only serves as the pass serial number.
+func (g *Generator) Generate(userID, email, qrToken string) ([]byte, error) {
 	if strings.TrimSpace(userID) == "" {
 		return nil, errors.New("user ID is required")
 	}
+	if strings.TrimSpace(qrToken) == "" {
+		return nil, errors.New("QR token is required")
+	}
 
-	passJSON, err := json.Marshal(g.passDefinition(userID, email))
+	passJSON, err := json.Marshal(g.passDefinition(userID, email, qrToken))
 	if err != nil {
 		return nil, fmt.Errorf("marshal pass definition: %w", err)
 	}
@@ -191,10 +196,10 @@ func (g *Generator) Generate(userID, email string) ([]byte, error) {
 	return output.Bytes(), nil
 }
 
-func (g *Generator) passDefinition(userID, email string) passDefinition {
+func (g *Generator) passDefinition(userID, email, qrToken string) passDefinition {
 	code := barcode{
 		Format:          "PKBarcodeFormatQR",
-		Message:         userID,
+		Message:         qrToken,
 		MessageEncoding: "iso-8859-1",
 	}
 
@@ -25,7 +25,7 @@ import (
 func TestGenerateCreatesSignedPassWithUserQRCode(t *testing.T) {
 	generator := newTestGenerator(t)
 
-	pass, err := generator.Generate("user-123", "hacker@example.com")
+	pass, err := generator.Generate("user-123", "hacker@example.com", "v1.signed-token")
 	if err != nil {
 		t.Fatalf("Generate() error = %v", err)
 	}
@@ -69,8 +69,8 @@ func TestGenerateCreatesSignedPassWithUserQRCode(t *testing.T) {
 	if definition.SerialNumber != "user-123" {
 		t.Errorf("serialNumber = %q, want user-123", definition.SerialNumber)
 	}
-	if len(definition.Barcodes) != 1 || definition.Barcodes[0].Message != "user-123" {
-		t.Errorf("QR barcode does not contain the user ID: %#v", definition.Barcodes)
+	if len(definition.Barcodes) != 1 || definition.Barcodes[0].Message != "v1.signed-token" {
+		t.Errorf("QR barcode does not contain the signed token: %#v", definition.Barcodes)
 	}
 	if len(definition.Generic.SecondaryFields) != 1 || definition.Generic.SecondaryFields[0].Value != "hacker@example.com" {
 		t.Errorf("attendee field does not contain the email: %#v", definition.Generic.SecondaryFields)
@@ -100,7 +100,15 @@ func TestGenerateCreatesSignedPassWithUserQRCode(t *testing.T) {
 func TestGenerateRequiresUserID(t *testing.T) {
 	generator := newTestGenerator(t)
 
-	if _, err := generator.Generate("", "hacker@example.com"); err == nil {
+	if _, err := generator.Generate("", "hacker@example.com", "v1.signed-token"); err == nil {
+		t.Fatal("Generate() error = nil, want an error")
+	}
+}
+
+func TestGenerateRequiresQRToken(t *testing.T) {
+	generator := newTestGenerator(t)
+
+	if _, err := generator.Generate("user-123", "hacker@example.com", ""); err == nil {
 		t.Fatal("Generate() error = nil, want an error")
 	}
 }
@@ -2,10 +2,13 @@ package gcs
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 )
 
 const (
@@ -88,10 +91,62 @@ func (c *GCSClient) GenerateDownloadURL(_ context.Context, objectPath string) (s
 	return url, nil
 }
 
+func (c *GCSClient) ReadObject(ctx context.Context, objectPath string) (io.ReadCloser, error) {
+	r, err := c.bucket.Object(objectPath).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (c *GCSClient) WriteObject(ctx context.Context, objectPath string, contentType string, r io.Reader) error {
+	w := c.bucket.Object(objectPath).NewWriter(ctx)
+	w.ContentType = contentType
+
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+func (c *GCSClient) StatObject(ctx context.Context, objectPath string) (*ObjectAttrs, error) {
+	attrs, err := c.bucket.Object(objectPath).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+
+	return &ObjectAttrs{Size: attrs.Size, ContentType: attrs.ContentType}, nil
+}
+
 func (c *GCSClient) DeleteObject(ctx context.Context, objectPath string) error {
 	return c.bucket.Object(objectPath).Delete(ctx)
 }
 
+func (c *GCSClient) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	it := c.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+
+	var objects []ObjectInfo
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, ObjectInfo{Path: attrs.Name, Size: attrs.Size, Updated: attrs.Updated})
+	}
+}
+
 func (c *GCSClient) Close() error {
 	return c.client.Close()
 }
@@ -1,12 +1,81 @@
 package gcs
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"time"
+)
+
+// ErrObjectNotFound is returned by ReadObject and StatObject when nothing is
+// stored at the path.
+var ErrObjectNotFound = errors.New("object not found")
+
+// ErrUploadRejected wraps the reason a stored object breaks the limits its
+// upload URL was issued under.
+var ErrUploadRejected = errors.New("upload rejected")
+
+// ObjectAttrs describes a stored object.
+type ObjectAttrs struct {
+	Size        int64
+	ContentType string
+}
+
+// ObjectInfo describes an object returned by ListObjects.
+type ObjectInfo struct {
+	Path    string
+	Size    int64
+	Updated time.Time
+}
 
 type Client interface {
 	GenerateUploadURL(ctx context.Context, objectPath string) (string, error)
 	GenerateImageUploadURL(ctx context.Context, objectPath string, contentType string) (string, error)
 	GenerateDownloadURL(ctx context.Context, objectPath string) (string, error)
+	// ReadObject opens the object for reading; the caller must close it.
+	ReadObject(ctx context.Context, objectPath string) (io.ReadCloser, error)
+	// WriteObject stores everything read from r at objectPath, replacing any
+	// existing object. Server-side callers use it; hackers upload through
+	// signed URLs.
+	WriteObject(ctx context.Context, objectPath string, contentType string, r io.Reader) error
+	StatObject(ctx context.Context, objectPath string) (*ObjectAttrs, error)
 	DeleteObject(ctx context.Context, objectPath string) error
+	// ListObjects returns every object whose path starts with prefix, in no
+	// particular order.
+	ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error)
 	GeneratePublicURL(objectPath string) string
 	Close() error
 }
+
+// CheckResumeObject re-applies the resume upload limits to an object that has
+// already been stored. Not every backend can bound an upload's size in the
+// signed URL itself, so callers check before accepting a resume path.
+func CheckResumeObject(attrs *ObjectAttrs) error {
+	return checkObject(attrs, maxResumeSizeBytes, func(ct string) bool { return ct == resumeContentType })
+}
+
+// CheckImageObject is CheckResumeObject for image uploads.
+func CheckImageObject(attrs *ObjectAttrs) error {
+	return checkObject(attrs, maxImageSizeBytes, func(ct string) bool { return AllowedImageContentTypes[ct] })
+}
+
+func checkObject(attrs *ObjectAttrs, maxBytes int64, allowed func(string) bool) error {
+	if attrs.Size > maxBytes {
+		return fmt.Errorf("%w: file must be %d MB or smaller", ErrUploadRejected, maxBytes>>20)
+	}
+	if !allowed(mediaType(attrs.ContentType)) {
+		return fmt.Errorf("%w: content type %q is not allowed", ErrUploadRejected, attrs.ContentType)
+	}
+	return nil
+}
+
+// mediaType strips parameters such as charset from a Content-Type value.
+func mediaType(contentType string) string {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+	return mt
+}
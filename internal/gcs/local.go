@@ -0,0 +1,348 @@
+package gcs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalClient stores objects as files under a root directory. It lets the
+// portal run without cloud credentials: signed URLs point back at the API,
+// which serves them through ServeHTTP.
+type LocalClient struct {
+	root    string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewLocal returns a LocalClient rooted at dir, creating it if needed. baseURL
+// is where ServeHTTP is mounted and secret signs the URLs it hands out.
+func NewLocal(dir, baseURL string, secret []byte) (*LocalClient, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("local storage requires a signing secret")
+	}
+
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &LocalClient{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  secret,
+		now:     time.Now,
+	}, nil
+}
+
+// path maps an object path to a file under root, rejecting anything that
+// would escape it.
+func (c *LocalClient) path(objectPath string) (string, error) {
+	if objectPath == "" || !fs.ValidPath(objectPath) {
+		return "", fmt.Errorf("invalid object path %q", objectPath)
+	}
+	return filepath.Join(c.root, filepath.FromSlash(objectPath)), nil
+}
+
+// sign covers everything a URL grants: the method, object, expiry and, for
+// uploads, the content type and size limit.
+func (c *LocalClient) sign(method, objectPath, expires, contentType, maxBytes string) string {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(strings.Join([]string{method, objectPath, expires, contentType, maxBytes}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *LocalClient) signedURL(method, objectPath, contentType string, maxBytes int64) (string, error) {
+	if _, err := c.path(objectPath); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(c.now().Add(signedURLExpiry).Unix(), 10)
+	limit := ""
+	if maxBytes > 0 {
+		limit = strconv.FormatInt(maxBytes, 10)
+	}
+
+	q := url.Values{}
+	q.Set("X-Expires", expires)
+	if contentType != "" {
+		q.Set("X-Content-Type", contentType)
+	}
+	if limit != "" {
+		q.Set("X-Max-Bytes", limit)
+	}
+	q.Set("X-Signature", c.sign(method, objectPath, expires, contentType, limit))
+
+	return c.baseURL + "/" + (&url.URL{Path: objectPath}).EscapedPath() + "?" + q.Encode(), nil
+}
+
+func (c *LocalClient) GenerateUploadURL(_ context.Context, objectPath string) (string, error) {
+	return c.signedURL(http.MethodPut, objectPath, resumeContentType, maxResumeSizeBytes)
+}
+
+func (c *LocalClient) GenerateImageUploadURL(_ context.Context, objectPath string, contentType string) (string, error) {
+	if !AllowedImageContentTypes[contentType] {
+		return "", fmt.Errorf("content type %q is not allowed", contentType)
+	}
+	return c.signedURL(http.MethodPut, objectPath, contentType, maxImageSizeBytes)
+}
+
+func (c *LocalClient) GenerateDownloadURL(_ context.Context, objectPath string) (string, error) {
+	return c.signedURL(http.MethodGet, objectPath, "", 0)
+}
+
+// ServeHTTP serves the signed URLs issued by this client. It expects to be
+// mounted with the base URL's path stripped, so the request path is the
+// object path.
+func (c *LocalClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	objectPath := strings.TrimPrefix(r.URL.Path, "/")
+
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	expires, contentType, limit := q.Get("X-Expires"), q.Get("X-Content-Type"), q.Get("X-Max-Bytes")
+
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || c.now().Unix() > expiresAt {
+		http.Error(w, "signed url has expired", http.StatusForbidden)
+		return
+	}
+	want := c.sign(method, objectPath, expires, contentType, limit)
+	if !hmac.Equal([]byte(want), []byte(q.Get("X-Signature"))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	if method == http.MethodPut {
+		c.serveUpload(w, r, objectPath, contentType, limit)
+		return
+	}
+	c.serveDownload(w, r, objectPath)
+}
+
+func (c *LocalClient) serveUpload(w http.ResponseWriter, r *http.Request, objectPath, contentType, limit string) {
+	if mediaType(r.Header.Get("Content-Type")) != contentType {
+		http.Error(w, "content type does not match the signed url", http.StatusBadRequest)
+		return
+	}
+
+	maxBytes, err := strconv.ParseInt(limit, 10, 64)
+	if err != nil {
+		http.Error(w, "signed url has no size limit", http.StatusBadRequest)
+		return
+	}
+	if r.ContentLength > maxBytes {
+		http.Error(w, "file is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxBytes)
+	if err := c.WriteObject(r.Context(), objectPath, contentType, body); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "file is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "upload failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *LocalClient) serveDownload(w http.ResponseWriter, r *http.Request, objectPath string) {
+	p, err := c.path(objectPath)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeByExtension(objectPath))
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.ServeContent(w, r, path.Base(objectPath), info.ModTime(), f)
+}
+
+func (c *LocalClient) ReadObject(_ context.Context, objectPath string) (io.ReadCloser, error) {
+	p, err := c.path(objectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// WriteObject writes to a temporary file and renames it into place, so
+// readers never see a partially written object.
+func (c *LocalClient) WriteObject(_ context.Context, objectPath string, _ string, r io.Reader) error {
+	p, err := c.path(objectPath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
+
+// StatObject reports the file size. Files carry no content type, so it is
+// derived from the extension; uploads were checked against their signed type
+// when they were written.
+func (c *LocalClient) StatObject(_ context.Context, objectPath string) (*ObjectAttrs, error) {
+	p, err := c.path(objectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+
+	return &ObjectAttrs{Size: info.Size(), ContentType: contentTypeByExtension(objectPath)}, nil
+}
+
+func (c *LocalClient) DeleteObject(_ context.Context, objectPath string) error {
+	p, err := c.path(objectPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// ListObjects walks the directory the prefix names, skipping the temporary
+// files of writes still in progress.
+func (c *LocalClient) ListObjects(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	dir := c.root
+	if d := path.Dir(prefix); strings.Contains(prefix, "/") && d != "." {
+		p, err := c.path(d)
+		if err != nil {
+			return nil, err
+		}
+		dir = p
+	}
+
+	var objects []ObjectInfo
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(c.root, p)
+		if err != nil {
+			return err
+		}
+		objectPath := filepath.ToSlash(rel)
+		if !strings.HasPrefix(objectPath, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectInfo{Path: objectPath, Size: info.Size(), Updated: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// GeneratePublicURL returns a download URL signed for the usual expiry.
+// Objects on local disk are never public, so long-lived links are not
+// possible.
+func (c *LocalClient) GeneratePublicURL(objectPath string) string {
+	url, err := c.signedURL(http.MethodGet, objectPath, "", 0)
+	if err != nil {
+		return ""
+	}
+	return url
+}
+
+func (c *LocalClient) Close() error {
+	return nil
+}
+
+func contentTypeByExtension(objectPath string) string {
+	if ct := mime.TypeByExtension(path.Ext(objectPath)); ct != "" {
+		return ct
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,189 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalClientRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewLocal(t.TempDir(), "http://api.test/v1/storage", []byte("test-secret"))
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+
+	if err := c.WriteObject(ctx, "resumes/u1/a.pdf", "application/pdf", strings.NewReader("%PDF-1.7")); err != nil {
+		t.Fatalf("WriteObject() error = %v", err)
+	}
+
+	rc, err := c.ReadObject(ctx, "resumes/u1/a.pdf")
+	if err != nil {
+		t.Fatalf("ReadObject() error = %v", err)
+	}
+	body, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(body) != "%PDF-1.7" {
+		t.Errorf("ReadObject() = %q", body)
+	}
+
+	if err := c.DeleteObject(ctx, "resumes/u1/a.pdf"); err != nil {
+		t.Fatalf("DeleteObject() error = %v", err)
+	}
+	if _, err := c.ReadObject(ctx, "resumes/u1/a.pdf"); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("ReadObject() after delete error = %v, want ErrObjectNotFound", err)
+	}
+	if err := c.DeleteObject(ctx, "resumes/u1/a.pdf"); err != nil {
+		t.Errorf("DeleteObject() of a missing object error = %v", err)
+	}
+}
+
+func TestLocalClientListObjects(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewLocal(t.TempDir(), "http://api.test/v1/storage", []byte("test-secret"))
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+
+	objects, err := c.ListObjects(ctx, "archives/")
+	if err != nil || len(objects) != 0 {
+		t.Fatalf("ListObjects() on an empty root = %+v, %v", objects, err)
+	}
+
+	for _, p := range []string{"archives/a.json.gz", "archives/b.json.gz", "resumes/u1/a.pdf"} {
+		if err := c.WriteObject(ctx, p, "application/octet-stream", strings.NewReader("x")); err != nil {
+			t.Fatalf("WriteObject(%q) error = %v", p, err)
+		}
+	}
+
+	objects, err = c.ListObjects(ctx, "archives/")
+	if err != nil {
+		t.Fatalf("ListObjects() error = %v", err)
+	}
+	got := map[string]int64{}
+	for _, o := range objects {
+		got[o.Path] = o.Size
+	}
+	if len(got) != 2 || got["archives/a.json.gz"] != 1 || got["archives/b.json.gz"] != 1 {
+		t.Errorf("ListObjects() = %+v", objects)
+	}
+
+	if _, err := c.ListObjects(ctx, "../"); err == nil {
+		t.Error("ListObjects(\"../\") succeeded, want error")
+	}
+}
+
+func TestLocalClientRejectsEscapingPaths(t *testing.T) {
+	c, err := NewLocal(t.TempDir(), "http://api.test/v1/storage", []byte("test-secret"))
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+
+	for _, p := range []string{"../secret", "/etc/passwd", "a/../../b", ""} {
+		if err := c.WriteObject(context.Background(), p, "text/plain", strings.NewReader("x")); err == nil {
+			t.Errorf("WriteObject(%q) succeeded, want error", p)
+		}
+	}
+}
+
+// serveSigned replays a signed URL against the client's handler the way the
+// API mounts it, with the base path stripped.
+func serveSigned(t *testing.T, c *LocalClient, method, signedURL, contentType string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	u, err := url.Parse(signedURL)
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	req := httptest.NewRequest(method, u.String(), bytes.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rr := httptest.NewRecorder()
+	http.StripPrefix("/v1/storage", c).ServeHTTP(rr, req)
+	return rr
+}
+
+func TestLocalClientSignedURLs(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewLocal(t.TempDir(), "http://api.test/v1/storage", []byte("test-secret"))
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+
+	uploadURL, err := c.GenerateUploadURL(ctx, "resumes/u1/a.pdf")
+	if err != nil {
+		t.Fatalf("GenerateUploadURL() error = %v", err)
+	}
+	if !strings.HasPrefix(uploadURL, "http://api.test/v1/storage/resumes/u1/a.pdf?") {
+		t.Fatalf("GenerateUploadURL() = %q", uploadURL)
+	}
+
+	if rr := serveSigned(t, c, http.MethodPut, uploadURL, "text/plain", []byte("x")); rr.Code != http.StatusBadRequest {
+		t.Errorf("upload with wrong content type = %d, want 400", rr.Code)
+	}
+	big := bytes.Repeat([]byte("x"), maxResumeSizeBytes+1)
+	if rr := serveSigned(t, c, http.MethodPut, uploadURL, "application/pdf", big); rr.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized upload = %d, want 413", rr.Code)
+	}
+	if rr := serveSigned(t, c, http.MethodPut, uploadURL, "application/pdf", []byte("%PDF-1.7")); rr.Code != http.StatusOK {
+		t.Fatalf("upload = %d: %s", rr.Code, rr.Body)
+	}
+
+	attrs, err := c.StatObject(ctx, "resumes/u1/a.pdf")
+	if err != nil {
+		t.Fatalf("StatObject() error = %v", err)
+	}
+	if err := CheckResumeObject(attrs); err != nil {
+		t.Errorf("CheckResumeObject() error = %v", err)
+	}
+
+	downloadURL, err := c.GenerateDownloadURL(ctx, "resumes/u1/a.pdf")
+	if err != nil {
+		t.Fatalf("GenerateDownloadURL() error = %v", err)
+	}
+	rr := serveSigned(t, c, http.MethodGet, downloadURL, "", nil)
+	if rr.Code != http.StatusOK || rr.Body.String() != "%PDF-1.7" {
+		t.Errorf("download = %d %q", rr.Code, rr.Body)
+	}
+
+	// A download URL must not double as an upload URL.
+	if rr := serveSigned(t, c, http.MethodPut, downloadURL, "application/pdf", []byte("x")); rr.Code != http.StatusForbidden {
+		t.Errorf("upload through download url = %d, want 403", rr.Code)
+	}
+	if rr := serveSigned(t, c, http.MethodGet, strings.Replace(downloadURL, "a.pdf", "b.pdf", 1), "", nil); rr.Code != http.StatusForbidden {
+		t.Errorf("download of another object = %d, want 403", rr.Code)
+	}
+
+	c.now = func() time.Time { return time.Now().Add(signedURLExpiry + time.Minute) }
+	if rr := serveSigned(t, c, http.MethodGet, downloadURL, "", nil); rr.Code != http.StatusForbidden {
+		t.Errorf("expired download = %d, want 403", rr.Code)
+	}
+}
+
+func TestCheckResumeObject(t *testing.T) {
+	tests := []struct {
+		attrs ObjectAttrs
+		ok    bool
+	}{
+		{ObjectAttrs{Size: 1024, ContentType: "application/pdf"}, true},
+		{ObjectAttrs{Size: maxResumeSizeBytes, ContentType: "application/pdf; charset=binary"}, true},
+		{ObjectAttrs{Size: maxResumeSizeBytes + 1, ContentType: "application/pdf"}, false},
+		{ObjectAttrs{Size: 1024, ContentType: "text/html"}, false},
+	}
+	for _, tt := range tests {
+		err := CheckResumeObject(&tt.attrs)
+		if (err == nil) != tt.ok {
+			t.Errorf("CheckResumeObject(%+v) error = %v, want ok=%v", tt.attrs, err, tt.ok)
+		}
+		if err != nil && !errors.Is(err, ErrUploadRejected) {
+			t.Errorf("CheckResumeObject(%+v) error = %v, want ErrUploadRejected", tt.attrs, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package gcs
 
 import (
 	"context"
+	"io"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -25,11 +26,40 @@ func (m *MockClient) GenerateDownloadURL(ctx context.Context, objectPath string)
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) ReadObject(ctx context.Context, objectPath string) (io.ReadCloser, error) {
+	args := m.Called(ctx, objectPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockClient) WriteObject(ctx context.Context, objectPath string, contentType string, r io.Reader) error {
+	args := m.Called(ctx, objectPath, contentType, r)
+	return args.Error(0)
+}
+
+func (m *MockClient) StatObject(ctx context.Context, objectPath string) (*ObjectAttrs, error) {
+	args := m.Called(ctx, objectPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ObjectAttrs), args.Error(1)
+}
+
 func (m *MockClient) DeleteObject(ctx context.Context, objectPath string) error {
 	args := m.Called(ctx, objectPath)
 	return args.Error(0)
 }
 
+func (m *MockClient) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ObjectInfo), args.Error(1)
+}
+
 func (m *MockClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
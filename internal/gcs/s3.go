@@ -0,0 +1,349 @@
+package gcs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3-compatible bucket such as AWS S3 or MinIO.
+type S3Config struct {
+	// Endpoint is the service URL, e.g. https://s3.us-east-1.amazonaws.com
+	// or http://minio:9000.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle addresses objects as endpoint/bucket/key instead of
+	// bucket.endpoint/key. MinIO needs it.
+	UsePathStyle bool
+}
+
+// S3Client implements Client with SigV4 presigned URLs. Server-side reads and
+// writes go through the same presigned URLs, so no SDK is needed.
+type S3Client struct {
+	cfg        S3Config
+	endpoint   *url.URL
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	// s3RequestExpiry bounds the URLs the client signs for its own requests.
+	s3RequestExpiry = time.Minute
+)
+
+func NewS3(cfg S3Config) (*S3Client, error) {
+	if cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("s3 storage requires a bucket and credentials")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+
+	return &S3Client{
+		cfg:        cfg,
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+		now:        time.Now,
+	}, nil
+}
+
+// objectURL returns the unsigned URL of an object.
+func (c *S3Client) objectURL(objectPath string) *url.URL {
+	u := *c.endpoint
+	if c.cfg.UsePathStyle {
+		u.Path = u.Path + "/" + c.cfg.Bucket + "/" + objectPath
+	} else {
+		u.Host = c.cfg.Bucket + "." + u.Host
+		u.Path = u.Path + "/" + objectPath
+	}
+	return &u
+}
+
+// presign builds a SigV4 query-string signed URL. headers lists extra headers
+// the request must send with exactly these values; the host is always signed.
+func (c *S3Client) presign(method, objectPath string, expiry time.Duration, headers map[string]string) string {
+	return c.presignQuery(method, objectPath, nil, expiry, headers)
+}
+
+// presignQuery is presign for requests that carry their own query
+// parameters, such as bucket listings. They are covered by the signature.
+func (c *S3Client) presignQuery(method, objectPath string, params url.Values, expiry time.Duration, headers map[string]string) string {
+	u := c.objectURL(objectPath)
+	now := c.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	scope := now.Format("20060102") + "/" + c.cfg.Region + "/s3/aws4_request"
+
+	signed := map[string]string{"host": u.Host}
+	for k, v := range headers {
+		signed[strings.ToLower(k)] = strings.TrimSpace(v)
+	}
+	names := make([]string, 0, len(signed))
+	for k := range signed {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + signed[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	q := url.Values{}
+	for k, v := range params {
+		q[k] = v
+	}
+	q.Set("X-Amz-Algorithm", s3Algorithm)
+	q.Set("X-Amz-Credential", c.cfg.AccessKeyID+"/"+scope)
+	q.Set("X-Amz-Date", amzDate)
+	q.Set("X-Amz-Expires", strconv.Itoa(int(expiry.Seconds())))
+	q.Set("X-Amz-SignedHeaders", signedHeaders)
+	query := s3CanonicalQuery(q)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		s3EscapePath(u.Path),
+		query,
+		canonicalHeaders.String(),
+		signedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(hashed[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.cfg.SecretAccessKey), now.Format("20060102"))
+	key = hmacSHA256(key, c.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	u.RawPath = s3EscapePath(u.Path)
+	u.RawQuery = query + "&X-Amz-Signature=" + signature
+	return u.String()
+}
+
+// GenerateUploadURL signs the content type, so S3 rejects anything but a PDF.
+// Presigned PUTs cannot bound the body size; callers enforce it afterwards
+// with CheckResumeObject.
+func (c *S3Client) GenerateUploadURL(_ context.Context, objectPath string) (string, error) {
+	return c.presign(http.MethodPut, objectPath, signedURLExpiry, map[string]string{"Content-Type": resumeContentType}), nil
+}
+
+func (c *S3Client) GenerateImageUploadURL(_ context.Context, objectPath string, contentType string) (string, error) {
+	if !AllowedImageContentTypes[contentType] {
+		return "", fmt.Errorf("content type %q is not allowed", contentType)
+	}
+	return c.presign(http.MethodPut, objectPath, signedURLExpiry, map[string]string{"Content-Type": contentType}), nil
+}
+
+func (c *S3Client) GenerateDownloadURL(_ context.Context, objectPath string) (string, error) {
+	return c.presign(http.MethodGet, objectPath, signedURLExpiry, nil), nil
+}
+
+func (c *S3Client) do(ctx context.Context, method, objectPath string, params url.Values, headers map[string]string, body io.Reader, size int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.presignQuery(method, objectPath, params, s3RequestExpiry, headers), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrObjectNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, fmt.Errorf("s3 %s %s: %s: %s", method, objectPath, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return resp, nil
+}
+
+func (c *S3Client) ReadObject(ctx context.Context, objectPath string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, objectPath, nil, nil, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// WriteObject spools r to a temporary file first: S3 needs the length up
+// front and rejects chunked uploads.
+func (c *S3Client) WriteObject(ctx context.Context, objectPath string, contentType string, r io.Reader) error {
+	tmp, err := os.CreateTemp("", "s3-upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	resp, err := c.do(ctx, http.MethodPut, objectPath, nil, map[string]string{"Content-Type": contentType}, io.NopCloser(tmp), size)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *S3Client) StatObject(ctx context.Context, objectPath string) (*ObjectAttrs, error) {
+	resp, err := c.do(ctx, http.MethodHead, objectPath, nil, nil, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return &ObjectAttrs{Size: resp.ContentLength, ContentType: resp.Header.Get("Content-Type")}, nil
+}
+
+// DeleteObject treats a missing object as deleted, as S3 itself does.
+func (c *S3Client) DeleteObject(ctx context.Context, objectPath string) error {
+	resp, err := c.do(ctx, http.MethodDelete, objectPath, nil, nil, nil, 0)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return nil
+		}
+		return err
+	}
+	return resp.Body.Close()
+}
+
+type s3ListResult struct {
+	Contents []struct {
+		Key          string
+		Size         int64
+		LastModified time.Time
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+// ListObjects pages through ListObjectsV2, which returns at most 1000 keys
+// per response.
+func (c *S3Client) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	token := ""
+	for {
+		params := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			params.Set("continuation-token", token)
+		}
+
+		resp, err := c.do(ctx, http.MethodGet, "", params, nil, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		var result s3ListResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range result.Contents {
+			objects = append(objects, ObjectInfo{Path: o.Key, Size: o.Size, Updated: o.LastModified})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// GeneratePublicURL returns the object's unsigned URL, which only works for
+// buckets with a public-read policy.
+func (c *S3Client) GeneratePublicURL(objectPath string) string {
+	u := c.objectURL(objectPath)
+	u.RawPath = s3EscapePath(u.Path)
+	return u.String()
+}
+
+func (c *S3Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3Escape percent-encodes everything except the unreserved characters, as
+// SigV4 requires.
+func s3Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if 'A' <= ch && ch <= 'Z' || 'a' <= ch && ch <= 'z' || '0' <= ch && ch <= '9' ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' {
+			b.WriteByte(ch)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", ch)
+	}
+	return b.String()
+}
+
+func s3EscapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		segments[i] = s3Escape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+func s3CanonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range q[k] {
+			parts = append(parts, s3Escape(k)+"="+s3Escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
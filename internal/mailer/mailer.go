@@ -5,6 +5,8 @@ import (
 	"embed"
 	"fmt"
 	"html/template"
+	"net/url"
+	"strings"
 )
 
 const (
@@ -27,11 +29,19 @@ const (
 )
 
 type Client interface {
-	SendQREmail(toEmail, toName, userID string) error
+	// SendQREmail and SendWalkInAcceptedEmail render qrToken verbatim into
+	// the attached QR code. Callers pass a signed token from the qrtoken
+	// package, never a bare user ID.
+	SendQREmail(toEmail, toName, qrToken string) error
 	SendWalkInQueuedEmail(toEmail string, position int) error
-	SendWalkInAcceptedEmail(toEmail, userID string) error
+	SendWalkInAcceptedEmail(toEmail, qrToken string) error
 	SendDecisionEmail(toEmail, toName string, decision Decision) error
 	SendDecisionsReleasedEmail(toEmail, toName string) error
+	SendTeamInviteEmail(toEmail, inviterName, teamName, joinCode string) error
+	// SendGuardianConsentEmail asks a minor's parent or guardian to confirm
+	// the policy the minor signed. token goes into the confirmation link
+	// verbatim and is the only credential the guardian needs.
+	SendGuardianConsentEmail(toEmail, guardianName, hackerName, policyTitle, token string) error
 	// SetIdentityResolver installs a resolver consulted on every send so the
 	// hackathon name and sender identity can come from runtime settings
 	// instead of the env vars used at boot.
@@ -108,6 +118,33 @@ type decisionEmailData struct {
 	From          string
 }
 
+// teamInviteEmailData is the template context for the team invite email.
+type teamInviteEmailData struct {
+	InviterName   string
+	TeamName      string
+	JoinCode      string
+	HackathonName string
+	PortalURL     string
+	From          string
+}
+
+// guardianConsentEmailData is the template context for the guardian consent
+// email.
+type guardianConsentEmailData struct {
+	GuardianName  string
+	HackerName    string
+	PolicyTitle   string
+	ConsentURL    string
+	HackathonName string
+	From          string
+}
+
+// guardianConsentURL is the portal page where a guardian reviews and confirms
+// the signature behind token.
+func guardianConsentURL(portalURL, token string) string {
+	return strings.TrimRight(portalURL, "/") + "/guardian-consent/" + url.PathEscape(token)
+}
+
 // decisionTemplate maps a decision to its template file (without the .html
 // suffix) and subject format string. The format string takes the hackathon
 // name. An unknown decision is an error, never silently send the wrong email.
@@ -39,3 +39,47 @@ func TestDecisionTemplatesRender(t *testing.T) {
 		t.Error("expected error for unknown decision")
 	}
 }
+
+func TestTeamInviteTemplateRenders(t *testing.T) {
+	out, err := renderTemplate("team_invite", teamInviteEmailData{
+		InviterName:   "Ada",
+		TeamName:      "Byte Club",
+		JoinCode:      "K7QX3M2P",
+		HackathonName: "HackUTD",
+		PortalURL:     "https://portal.test",
+		From:          "HackUTD",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Ada", "Byte Club", "K7QX3M2P", "https://portal.test"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+	if strings.Contains(out, "<no value>") || strings.Contains(out, "{{") {
+		t.Error("unresolved placeholder")
+	}
+}
+
+func TestGuardianConsentTemplateRenders(t *testing.T) {
+	out, err := renderTemplate("guardian_consent", guardianConsentEmailData{
+		GuardianName:  "Grace",
+		HackerName:    "Ada",
+		PolicyTitle:   "Liability Waiver",
+		ConsentURL:    guardianConsentURL("https://portal.test/", "tok-123"),
+		HackathonName: "HackUTD",
+		From:          "HackUTD",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Grace", "Ada", "Liability Waiver", "https://portal.test/guardian-consent/tok-123"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+	if strings.Contains(out, "<no value>") || strings.Contains(out, "{{") {
+		t.Error("unresolved placeholder")
+	}
+}
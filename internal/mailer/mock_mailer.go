@@ -6,8 +6,8 @@ type MockClient struct {
 	mock.Mock
 }
 
-func (m *MockClient) SendQREmail(toEmail, toName, userID string) error {
-	args := m.Called(toEmail, toName, userID)
+func (m *MockClient) SendQREmail(toEmail, toName, qrToken string) error {
+	args := m.Called(toEmail, toName, qrToken)
 	return args.Error(0)
 }
 
@@ -16,8 +16,8 @@ func (m *MockClient) SendWalkInQueuedEmail(toEmail string, position int) error {
 	return args.Error(0)
 }
 
-func (m *MockClient) SendWalkInAcceptedEmail(toEmail, userID string) error {
-	args := m.Called(toEmail, userID)
+func (m *MockClient) SendWalkInAcceptedEmail(toEmail, qrToken string) error {
+	args := m.Called(toEmail, qrToken)
 	return args.Error(0)
 }
 
@@ -32,3 +32,13 @@ func (m *MockClient) SendDecisionsReleasedEmail(toEmail, toName string) error {
 	args := m.Called(toEmail, toName)
 	return args.Error(0)
 }
+
+func (m *MockClient) SendTeamInviteEmail(toEmail, inviterName, teamName, joinCode string) error {
+	args := m.Called(toEmail, inviterName, teamName, joinCode)
+	return args.Error(0)
+}
+
+func (m *MockClient) SendGuardianConsentEmail(toEmail, guardianName, hackerName, policyTitle, token string) error {
+	args := m.Called(toEmail, guardianName, hackerName, policyTitle, token)
+	return args.Error(0)
+}
@@ -101,8 +101,42 @@ func (m *SendGridMailer) SendDecisionsReleasedEmail(toEmail, toName string) erro
 	return m.send(id, toEmail, toName, fmt.Sprintf("%s decisions are out", id.HackathonName), htmlBody)
 }
 
-func (m *SendGridMailer) SendQREmail(toEmail, toName, userID string) error {
-	qrPNG, err := qrcode.Encode(userID, qrcode.Medium, 256)
+func (m *SendGridMailer) SendTeamInviteEmail(toEmail, inviterName, teamName, joinCode string) error {
+	id := m.resolve()
+	htmlBody, err := renderTemplate("team_invite", teamInviteEmailData{
+		InviterName:   inviterName,
+		TeamName:      teamName,
+		JoinCode:      joinCode,
+		HackathonName: id.HackathonName,
+		PortalURL:     m.portalURL,
+		From:          id.FromName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.send(id, toEmail, toEmail, fmt.Sprintf("Join %s at %s", teamName, id.HackathonName), htmlBody)
+}
+
+func (m *SendGridMailer) SendGuardianConsentEmail(toEmail, guardianName, hackerName, policyTitle, token string) error {
+	id := m.resolve()
+	htmlBody, err := renderTemplate("guardian_consent", guardianConsentEmailData{
+		GuardianName:  guardianName,
+		HackerName:    hackerName,
+		PolicyTitle:   policyTitle,
+		ConsentURL:    guardianConsentURL(m.portalURL, token),
+		HackathonName: id.HackathonName,
+		From:          id.FromName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.send(id, toEmail, guardianName, fmt.Sprintf("Confirm %s's %s for %s", hackerName, policyTitle, id.HackathonName), htmlBody)
+}
+
+func (m *SendGridMailer) SendQREmail(toEmail, toName, qrToken string) error {
+	qrPNG, err := qrcode.Encode(qrToken, qrcode.Medium, 256)
 	if err != nil {
 		return fmt.Errorf("generating QR code: %w", err)
 	}
@@ -198,8 +232,8 @@ func (m *SendGridMailer) SendWalkInQueuedEmail(toEmail string, position int) err
 	return nil
 }
 
-func (m *SendGridMailer) SendWalkInAcceptedEmail(toEmail, userID string) error {
-	qrPNG, err := qrcode.Encode(userID, qrcode.Medium, 256)
+func (m *SendGridMailer) SendWalkInAcceptedEmail(toEmail, qrToken string) error {
+	qrPNG, err := qrcode.Encode(qrToken, qrcode.Medium, 256)
 	if err != nil {
 		return fmt.Errorf("generating QR code: %w", err)
 	}
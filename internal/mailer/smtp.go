@@ -97,8 +97,42 @@ func (m *SMTPMailer) SendDecisionsReleasedEmail(toEmail, toName string) error {
 	return m.send(id, toEmail, toName, fmt.Sprintf("%s decisions are out", id.HackathonName), htmlBody)
 }
 
-func (m *SMTPMailer) SendQREmail(toEmail, toName, userID string) error {
-	qrPNG, err := qrcode.Encode(userID, qrcode.Medium, 256)
+func (m *SMTPMailer) SendTeamInviteEmail(toEmail, inviterName, teamName, joinCode string) error {
+	id := m.resolve()
+	htmlBody, err := renderTemplate("team_invite", teamInviteEmailData{
+		InviterName:   inviterName,
+		TeamName:      teamName,
+		JoinCode:      joinCode,
+		HackathonName: id.HackathonName,
+		PortalURL:     m.portalURL,
+		From:          id.FromName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.send(id, toEmail, toEmail, fmt.Sprintf("Join %s at %s", teamName, id.HackathonName), htmlBody)
+}
+
+func (m *SMTPMailer) SendGuardianConsentEmail(toEmail, guardianName, hackerName, policyTitle, token string) error {
+	id := m.resolve()
+	htmlBody, err := renderTemplate("guardian_consent", guardianConsentEmailData{
+		GuardianName:  guardianName,
+		HackerName:    hackerName,
+		PolicyTitle:   policyTitle,
+		ConsentURL:    guardianConsentURL(m.portalURL, token),
+		HackathonName: id.HackathonName,
+		From:          id.FromName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.send(id, toEmail, guardianName, fmt.Sprintf("Confirm %s's %s for %s", hackerName, policyTitle, id.HackathonName), htmlBody)
+}
+
+func (m *SMTPMailer) SendQREmail(toEmail, toName, qrToken string) error {
+	qrPNG, err := qrcode.Encode(qrToken, qrcode.Medium, 256)
 	if err != nil {
 		return fmt.Errorf("generating QR code: %w", err)
 	}
@@ -175,8 +209,8 @@ func (m *SMTPMailer) SendWalkInQueuedEmail(toEmail string, position int) error {
 	return nil
 }
 
-func (m *SMTPMailer) SendWalkInAcceptedEmail(toEmail, userID string) error {
-	qrPNG, err := qrcode.Encode(userID, qrcode.Medium, 256)
+func (m *SMTPMailer) SendWalkInAcceptedEmail(toEmail, qrToken string) error {
+	qrPNG, err := qrcode.Encode(qrToken, qrcode.Medium, 256)
 	if err != nil {
 		return fmt.Errorf("generating QR code: %w", err)
 	}
@@ -0,0 +1,103 @@
+package qrtoken
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestSigner(t *testing.T, now time.Time) *Signer {
+	t.Helper()
+	signer, err := New(testSecret)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	signer.now = func() time.Time { return now }
+	return signer
+}
+
+func TestIssueAndVerify(t *testing.T) {
+	issuedAt := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+	signer := newTestSigner(t, issuedAt)
+
+	token, err := signer.Issue("user-123", "hack-1", 0)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if !strings.HasPrefix(token, "v1.") {
+		t.Errorf("token = %q, want v1 prefix", token)
+	}
+	if strings.Contains(token, "user-123") {
+		t.Errorf("token leaks the raw user ID: %q", token)
+	}
+
+	claims, err := signer.Verify(token, "hack-1")
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if claims.UserID != "user-123" || claims.HackathonID != "hack-1" {
+		t.Errorf("claims = %#v", claims)
+	}
+	if !claims.IssuedAt.Equal(issuedAt) {
+		t.Errorf("IssuedAt = %v, want %v", claims.IssuedAt, issuedAt)
+	}
+	if !claims.ExpiresAt.IsZero() {
+		t.Errorf("ExpiresAt = %v, want zero", claims.ExpiresAt)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	issuedAt := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+	signer := newTestSigner(t, issuedAt)
+
+	token, err := signer.Issue("user-123", "hack-1", time.Hour)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	other, err := New([]byte("fedcba9876543210fedcba9876543210"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	forged, err := other.Issue("user-123", "hack-1", 0)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	swapped := parts[0] + "." + strings.Split(forged, ".")[1] + "." + parts[2]
+
+	tests := []struct {
+		name        string
+		token       string
+		hackathonID string
+		now         time.Time
+		want        error
+	}{
+		{"raw user ID", "user-123", "hack-1", issuedAt, ErrMalformed},
+		{"unknown version", "v2." + parts[1] + "." + parts[2], "hack-1", issuedAt, ErrMalformed},
+		{"bad signature encoding", parts[0] + "." + parts[1] + ".!!", "hack-1", issuedAt, ErrMalformed},
+		{"different secret", forged, "hack-1", issuedAt, ErrInvalidSignature},
+		{"tampered payload", swapped, "hack-1", issuedAt, ErrInvalidSignature},
+		{"expired", token, "hack-1", issuedAt.Add(time.Hour), ErrExpired},
+		{"previous hackathon", token, "hack-2", issuedAt, ErrWrongHackathon},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer.now = func() time.Time { return tt.now }
+			if _, err := signer.Verify(tt.token, tt.hackathonID); !errors.Is(err, tt.want) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRejectsShortSecret(t *testing.T) {
+	if _, err := New([]byte("too-short")); err == nil {
+		t.Error("expected error for short secret")
+	}
+}
@@ -0,0 +1,243 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ApplicationStatuses lists every status in lifecycle order, for reports
+// that give each one a column.
+var ApplicationStatuses = []ApplicationStatus{
+	StatusDraft, StatusSubmitted, StatusAccepted, StatusWaitlisted, StatusRejected, StatusWithdrawn,
+}
+
+// AnalyticsField is a schema field to break answers down by. List marks
+// fields answered with an array, such as multi-select, whose options are
+// counted separately.
+type AnalyticsField struct {
+	ID   string
+	List bool
+}
+
+// FieldAnswerCount is how many applications in Status gave Answer to a field.
+// Numbers and booleans are counted as their JSON text.
+type FieldAnswerCount struct {
+	FieldID string            `json:"field_id"`
+	Answer  string            `json:"answer"`
+	Status  ApplicationStatus `json:"status"`
+	Count   int               `json:"count"`
+}
+
+// DailyApplicationCount is one calendar day of application activity. Date is
+// YYYY-MM-DD in the zone the report was run for.
+type DailyApplicationCount struct {
+	Date          string `json:"date"`
+	DraftsCreated int    `json:"drafts_created"`
+	Submitted     int    `json:"submitted"`
+	// CohortSubmitted is how many of the applications started that day have
+	// been submitted since, whenever that was.
+	CohortSubmitted int `json:"cohort_submitted"`
+}
+
+// ApplicationConversion summarizes how many started applications were
+// submitted. Submitted counts every application that was ever submitted,
+// including those withdrawn since. A draft can also be withdrawn without being
+// submitted; those are WithdrawnDrafts, so Started is always Submitted +
+// Drafts + WithdrawnDrafts.
+type ApplicationConversion struct {
+	Started         int     `json:"started"`
+	Submitted       int     `json:"submitted"`
+	Drafts          int     `json:"drafts"`
+	WithdrawnDrafts int     `json:"withdrawn_drafts"`
+	ConversionRate  float64 `json:"conversion_rate"`
+	// MedianHoursToSubmit is nil until something has been submitted.
+	MedianHoursToSubmit *float64 `json:"median_hours_to_submit"`
+}
+
+// DailyReviewCount is the review votes cast on one calendar day.
+type DailyReviewCount struct {
+	Date      string `json:"date"`
+	Reviews   int    `json:"reviews"`
+	Accept    int    `json:"accept"`
+	Reject    int    `json:"reject"`
+	Waitlist  int    `json:"waitlist"`
+	Reviewers int    `json:"reviewers"`
+}
+
+type AnalyticsStore struct {
+	db *sql.DB
+}
+
+// FieldBreakdown counts the active hackathon's answers to fields by status.
+// Applications that left a field blank don't appear under it.
+func (s *AnalyticsStore) FieldBreakdown(ctx context.Context, fields []AnalyticsField) ([]FieldAnswerCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	counts := []FieldAnswerCount{}
+	if len(fields) == 0 {
+		return counts, nil
+	}
+
+	ids := make([]string, len(fields))
+	lists := make([]bool, len(fields))
+	for i, f := range fields {
+		ids[i], lists[i] = f.ID, f.List
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT f.id, v.answer, a.status, COUNT(*)
+		FROM applications a
+		CROSS JOIN unnest($1::text[], $2::bool[]) AS f(id, list)
+		CROSS JOIN LATERAL (
+			SELECT DISTINCT btrim(e) AS answer
+			FROM jsonb_array_elements_text(
+				CASE WHEN jsonb_typeof(a.responses->f.id) = 'array' THEN a.responses->f.id ELSE '[]' END
+			) AS e
+			WHERE f.list
+			UNION ALL
+			SELECT btrim(a.responses->>f.id)
+			WHERE NOT f.list
+			  AND jsonb_typeof(a.responses->f.id) IN ('string', 'number', 'boolean')
+		) v
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND v.answer <> ''
+		GROUP BY f.id, v.answer, a.status
+		ORDER BY f.id, v.answer, a.status
+	`, ids, lists)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c FieldAnswerCount
+		if err := rows.Scan(&c.FieldID, &c.Answer, &c.Status, &c.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, c)
+	}
+
+	return counts, rows.Err()
+}
+
+// DailyApplications returns application activity per day in timezone, from
+// the first application started to the last one submitted. Days with no
+// activity in between are included with zero counts.
+func (s *AnalyticsStore) DailyApplications(ctx context.Context, timezone string) ([]DailyApplicationCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		WITH apps AS (
+			SELECT (created_at AT TIME ZONE $1)::date AS created_day,
+			       (submitted_at AT TIME ZONE $1)::date AS submitted_day
+			FROM applications
+			WHERE hackathon_id = active_hackathon_id()
+		), created AS (
+			SELECT created_day AS day, COUNT(*) AS started, COUNT(submitted_day) AS cohort_submitted
+			FROM apps
+			GROUP BY created_day
+		), submitted AS (
+			SELECT submitted_day AS day, COUNT(*) AS submitted
+			FROM apps
+			WHERE submitted_day IS NOT NULL
+			GROUP BY submitted_day
+		), bounds AS (
+			SELECT MIN(created_day) AS first_day, GREATEST(MAX(created_day), MAX(submitted_day)) AS last_day
+			FROM apps
+		)
+		SELECT to_char(g.day, 'YYYY-MM-DD'),
+		       COALESCE(c.started, 0), COALESCE(s.submitted, 0), COALESCE(c.cohort_submitted, 0)
+		FROM bounds b
+		CROSS JOIN LATERAL generate_series(b.first_day, b.last_day, interval '1 day') AS g(day)
+		LEFT JOIN created c ON c.day = g.day::date
+		LEFT JOIN submitted s ON s.day = g.day::date
+		ORDER BY g.day
+	`, timezone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []DailyApplicationCount{}
+	for rows.Next() {
+		var d DailyApplicationCount
+		if err := rows.Scan(&d.Date, &d.DraftsCreated, &d.Submitted, &d.CohortSubmitted); err != nil {
+			return nil, err
+		}
+		days = append(days, d)
+	}
+
+	return days, rows.Err()
+}
+
+// Conversion returns how many of the active hackathon's applications were
+// submitted, and how long submitting took.
+func (s *AnalyticsStore) Conversion(ctx context.Context) (*ApplicationConversion, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var c ApplicationConversion
+	var median sql.NullFloat64
+	err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*),
+		       COUNT(submitted_at),
+		       COUNT(*) FILTER (WHERE status = 'draft'),
+		       COUNT(*) FILTER (WHERE status = 'withdrawn' AND submitted_at IS NULL),
+		       percentile_cont(0.5) WITHIN GROUP (
+		           ORDER BY EXTRACT(EPOCH FROM submitted_at - created_at) / 3600
+		       ) FILTER (WHERE submitted_at IS NOT NULL)
+		FROM applications
+		WHERE hackathon_id = active_hackathon_id()
+	`).Scan(&c.Started, &c.Submitted, &c.Drafts, &c.WithdrawnDrafts, &median)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Started > 0 {
+		c.ConversionRate = float64(c.Submitted) / float64(c.Started) * 100
+	}
+	if median.Valid {
+		c.MedianHoursToSubmit = &median.Float64
+	}
+
+	return &c, nil
+}
+
+// DailyReviews returns the review votes cast on the active hackathon's
+// applications per day in timezone. Only days with votes are included.
+func (s *AnalyticsStore) DailyReviews(ctx context.Context, timezone string) ([]DailyReviewCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT to_char((r.reviewed_at AT TIME ZONE $1)::date, 'YYYY-MM-DD') AS day,
+		       COUNT(*),
+		       COUNT(*) FILTER (WHERE r.vote = 'accept'),
+		       COUNT(*) FILTER (WHERE r.vote = 'reject'),
+		       COUNT(*) FILTER (WHERE r.vote = 'waitlist'),
+		       COUNT(DISTINCT r.admin_id)
+		FROM application_reviews r
+		INNER JOIN applications a ON a.id = r.application_id
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND r.reviewed_at IS NOT NULL
+		GROUP BY day
+		ORDER BY day
+	`, timezone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []DailyReviewCount{}
+	for rows.Next() {
+		var d DailyReviewCount
+		if err := rows.Scan(&d.Date, &d.Reviews, &d.Accept, &d.Reject, &d.Waitlist, &d.Reviewers); err != nil {
+			return nil, err
+		}
+		days = append(days, d)
+	}
+
+	return days, rows.Err()
+}
@@ -0,0 +1,296 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ApplicationFlagReason names why the duplicate detection pass flagged an
+// application.
+type ApplicationFlagReason string
+
+const (
+	FlagReasonDuplicatePhone         ApplicationFlagReason = "duplicate_phone"
+	FlagReasonDuplicateNameBirthdate ApplicationFlagReason = "duplicate_name_birthdate"
+	FlagReasonDuplicateResume        ApplicationFlagReason = "duplicate_resume"
+	FlagReasonDisposableEmail        ApplicationFlagReason = "disposable_email"
+)
+
+// ApplicationFlag marks an application as a likely duplicate or fraudulent
+// entry. RelatedApplicationID is the application it duplicates, nil for flags
+// about the application alone; RelatedEmail is filled on reads.
+type ApplicationFlag struct {
+	ApplicationID        string                `json:"application_id"`
+	Reason               ApplicationFlagReason `json:"reason"`
+	RelatedApplicationID *string               `json:"related_application_id"`
+	RelatedEmail         *string               `json:"related_email"`
+	Detail               string                `json:"detail"`
+	CreatedAt            time.Time             `json:"created_at"`
+}
+
+// DuplicateCandidate is a submitted application as the detection pass sees
+// it. ResumeHash is the cached hash of ResumePath, nil when it hasn't been
+// computed for the current resume.
+type DuplicateCandidate struct {
+	ApplicationID string
+	Email         string
+	Responses     json.RawMessage
+	ResumePath    *string
+	ResumeHash    *string
+}
+
+type DuplicateScanStatus string
+
+const (
+	DuplicateScanRunning DuplicateScanStatus = "running"
+	DuplicateScanReady   DuplicateScanStatus = "ready"
+	DuplicateScanFailed  DuplicateScanStatus = "failed"
+)
+
+// DuplicateScan is one run of the duplicate detection pass. The counts are
+// filled in once it is ready.
+type DuplicateScan struct {
+	ID                  string              `json:"id"`
+	Status              DuplicateScanStatus `json:"status"`
+	Applications        int                 `json:"applications"`
+	FlaggedApplications int                 `json:"flagged_applications"`
+	Flags               int                 `json:"flags"`
+	// ResumesUnreadable counts resumes the pass couldn't download, which were
+	// left out of the resume comparison.
+	ResumesUnreadable int        `json:"resumes_unreadable"`
+	Error             *string    `json:"error"`
+	CreatedBy         *string    `json:"created_by"`
+	CompletedAt       *time.Time `json:"completed_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+type ApplicationFlagsStore struct {
+	db *sql.DB
+}
+
+const duplicateScanColumns = `id, status, application_count, flagged_application_count, flag_count,
+	unreadable_resume_count, error, created_by, completed_at, created_at, updated_at`
+
+func scanDuplicateScan(row interface{ Scan(dest ...any) error }, d *DuplicateScan) error {
+	return row.Scan(
+		&d.ID, &d.Status, &d.Applications, &d.FlaggedApplications, &d.Flags,
+		&d.ResumesUnreadable, &d.Error, &d.CreatedBy, &d.CompletedAt, &d.CreatedAt, &d.UpdatedAt,
+	)
+}
+
+// CreateScan inserts a running duplicate scan for the active hackathon.
+// Returns ErrConflict if one is already running.
+func (s *ApplicationFlagsStore) CreateScan(ctx context.Context, scan *DuplicateScan) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		INSERT INTO duplicate_scans (created_by)
+		VALUES ($1)
+		RETURNING ` + duplicateScanColumns
+
+	if err := scanDuplicateScan(s.db.QueryRowContext(ctx, query, scan.CreatedBy), scan); err != nil {
+		if isUniqueViolation(err) {
+			return ErrConflict
+		}
+		return err
+	}
+	return nil
+}
+
+// LatestScan returns the active hackathon's most recent duplicate scan.
+func (s *ApplicationFlagsStore) LatestScan(ctx context.Context) (*DuplicateScan, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var d DuplicateScan
+	err := scanDuplicateScan(s.db.QueryRowContext(ctx, `
+		SELECT `+duplicateScanColumns+` FROM duplicate_scans
+		WHERE hackathon_id = active_hackathon_id()
+		ORDER BY created_at DESC
+		LIMIT 1`), &d)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// CompleteScan marks a duplicate scan ready with its counts.
+func (s *ApplicationFlagsStore) CompleteScan(ctx context.Context, scan *DuplicateScan) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		UPDATE duplicate_scans
+		SET status = 'ready', application_count = $2, flagged_application_count = $3,
+		    flag_count = $4, unreadable_resume_count = $5, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := s.db.ExecContext(ctx, query, scan.ID, scan.Applications, scan.FlaggedApplications, scan.Flags, scan.ResumesUnreadable)
+	return err
+}
+
+// FailScan records why a duplicate scan could not finish.
+func (s *ApplicationFlagsStore) FailScan(ctx context.Context, id, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		UPDATE duplicate_scans
+		SET status = 'failed', error = $2, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := s.db.ExecContext(ctx, query, id, reason)
+	return err
+}
+
+// FailInterruptedScans marks every running duplicate scan failed. Scans run
+// inside the API process, so at startup any still running were cut off by a
+// restart and would otherwise block new scans for good. Returns how many were
+// marked.
+func (s *ApplicationFlagsStore) FailInterruptedScans(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		UPDATE duplicate_scans
+		SET status = 'failed', error = 'interrupted by a server restart', completed_at = NOW()
+		WHERE status = 'running'
+	`
+
+	result, err := s.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// ListDuplicateCandidates returns the active hackathon's non-draft
+// applications.
+func (s *ApplicationFlagsStore) ListDuplicateCandidates(ctx context.Context) ([]DuplicateCandidate, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2)
+	defer cancel()
+
+	query := `
+		SELECT a.id, u.email, a.responses, NULLIF(a.resume_path, ''),
+		       CASE WHEN h.resume_path = a.resume_path THEN h.sha256 END
+		FROM applications a
+		INNER JOIN users u ON u.id = a.user_id
+		LEFT JOIN application_resume_hashes h ON h.application_id = a.id
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND a.status <> 'draft'
+		ORDER BY a.submitted_at, a.id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := []DuplicateCandidate{}
+	for rows.Next() {
+		var c DuplicateCandidate
+		if err := rows.Scan(&c.ApplicationID, &c.Email, &c.Responses, &c.ResumePath, &c.ResumeHash); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+
+	return candidates, rows.Err()
+}
+
+// SetResumeHash caches the hash of the resume at resumePath.
+func (s *ApplicationFlagsStore) SetResumeHash(ctx context.Context, applicationID, resumePath, hash string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		INSERT INTO application_resume_hashes (application_id, resume_path, sha256)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (application_id) DO UPDATE
+		SET resume_path = EXCLUDED.resume_path, sha256 = EXCLUDED.sha256, computed_at = NOW()
+	`
+
+	_, err := s.db.ExecContext(ctx, query, applicationID, resumePath, hash)
+	return err
+}
+
+// Replace swaps the active hackathon's flags for flags in one transaction.
+func (s *ApplicationFlagsStore) Replace(ctx context.Context, flags []ApplicationFlag) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM application_flags f
+		USING applications a
+		WHERE a.id = f.application_id AND a.hackathon_id = active_hackathon_id()
+	`); err != nil {
+		return err
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO application_flags (application_id, reason, related_application_id, detail)
+		VALUES ($1, $2, $3, $4)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, f := range flags {
+		if _, err := stmt.ExecContext(ctx, f.ApplicationID, f.Reason, f.RelatedApplicationID, f.Detail); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListByApplicationID returns an application's flags grouped by reason.
+func (s *ApplicationFlagsStore) ListByApplicationID(ctx context.Context, applicationID string) ([]ApplicationFlag, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT f.application_id, f.reason, f.related_application_id, u.email, f.detail, f.created_at
+		FROM application_flags f
+		LEFT JOIN applications r ON r.id = f.related_application_id
+		LEFT JOIN users u ON u.id = r.user_id
+		WHERE f.application_id = $1
+		ORDER BY f.reason, u.email
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	flags := []ApplicationFlag{}
+	for rows.Next() {
+		var f ApplicationFlag
+		if err := rows.Scan(&f.ApplicationID, &f.Reason, &f.RelatedApplicationID, &f.RelatedEmail, &f.Detail, &f.CreatedAt); err != nil {
+			return nil, err
+		}
+		flags = append(flags, f)
+	}
+
+	return flags, rows.Err()
+}
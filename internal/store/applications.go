@@ -19,6 +19,19 @@ const (
 	StatusAccepted   ApplicationStatus = "accepted"
 	StatusRejected   ApplicationStatus = "rejected"
 	StatusWaitlisted ApplicationStatus = "waitlisted"
+	// StatusWithdrawn is set by the hacker, never by an admin decision.
+	StatusWithdrawn ApplicationStatus = "withdrawn"
+)
+
+// ConfirmationStatus tracks whether an accepted hacker has claimed their spot.
+// It is only set while the application is accepted.
+type ConfirmationStatus string
+
+const (
+	ConfirmationPending   ConfirmationStatus = "pending"
+	ConfirmationConfirmed ConfirmationStatus = "confirmed"
+	ConfirmationDeclined  ConfirmationStatus = "declined"
+	ConfirmationExpired   ConfirmationStatus = "expired"
 )
 
 // PaginationDirection for bidirectional cursor traversal
@@ -50,7 +63,50 @@ type ApplicationCursor struct {
 type ApplicationListFilters struct {
 	Status *ApplicationStatus
 	Search *string
-	SortBy ApplicationSortBy
+	TeamID *string
+	// HackathonID reads an archived event; nil means the active one.
+	HackathonID *string
+	// Flagged keeps only applications the duplicate detection pass did
+	// (true) or did not (false) flag.
+	Flagged *bool
+	SortBy  ApplicationSortBy
+
+	// Responses narrows on individual schema answers; every one must hold.
+	Responses []ResponseFilter
+	HasResume *bool
+	// Ranges are inclusive; a nil bound is open.
+	AIPercentMin  *int
+	AIPercentMax  *int
+	PointsMin     *int
+	PointsMax     *int
+	SubmittedFrom *time.Time
+	SubmittedTo   *time.Time
+	MealGroup     *string
+	// TextQuery is a web-search style full-text query (quoted phrases, or,
+	// -negation) matched against the answers to TextFields.
+	TextQuery  *string
+	TextFields []string
+}
+
+// ResponseFilterOp is how a ResponseFilter compares a schema answer.
+type ResponseFilterOp string
+
+const (
+	// ResponseFilterEquals matches a single-choice answer equal to Value.
+	ResponseFilterEquals ResponseFilterOp = "eq"
+	// ResponseFilterContains matches a multi-choice answer that includes Value.
+	ResponseFilterContains ResponseFilterOp = "contains"
+	// ResponseFilterRange matches a numeric answer between Min and Max.
+	ResponseFilterRange ResponseFilterOp = "range"
+)
+
+// ResponseFilter narrows the application list on one schema field's answer.
+type ResponseFilter struct {
+	FieldID string
+	Op      ResponseFilterOp
+	Value   string
+	Min     *float64
+	Max     *float64
 }
 
 // ApplicationListItem is a lightweight view for admin listing
@@ -81,6 +137,14 @@ type ApplicationListItem struct {
 	HasResume          bool              `json:"has_resume"`
 	MealGroup          *string           `json:"meal_group"`
 	Points             int               `json:"points"`
+
+	ConfirmationStatus *ConfirmationStatus `json:"confirmation_status"`
+
+	TeamID   *string `json:"team_id"`
+	TeamName *string `json:"team_name"`
+
+	// Flagged is set when the duplicate detection pass flagged the application
+	Flagged bool `json:"flagged"`
 }
 
 // ApplicationListResult contains paginated results
@@ -99,7 +163,14 @@ type ApplicationStats struct {
 	Rejected          int64   `json:"rejected"`
 	Waitlisted        int64   `json:"waitlisted"`
 	Draft             int64   `json:"draft"`
+	Withdrawn         int64   `json:"withdrawn"`
 	AcceptanceRate    float64 `json:"acceptance_rate"`
+
+	// Breakdown of accepted applications by confirmation status
+	ConfirmationPending int64 `json:"confirmation_pending"`
+	Confirmed           int64 `json:"confirmed"`
+	Declined            int64 `json:"declined"`
+	Expired             int64 `json:"expired"`
 }
 
 // EncodeCursor creates a base64-encoded cursor string for created_at sorting
@@ -144,6 +215,9 @@ type Application struct {
 	Responses  json.RawMessage `json:"responses"`
 	ResumePath *string         `json:"resume_path"`
 	AIPercent  *int16          `json:"ai_percent"`
+	// SchemaVersion is the application schema version Responses are keyed
+	// against. Nil for applications saved before any schema existed.
+	SchemaVersion *int `json:"schema_version"`
 
 	AcceptVotes      int `json:"accept_votes"`
 	RejectVotes      int `json:"reject_votes"`
@@ -155,6 +229,17 @@ type Application struct {
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 	MealGroup   *string    `json:"meal_group"`
+
+	// Set only while Status is accepted. A nil deadline means the hacker can
+	// respond at any time.
+	ConfirmationStatus      *ConfirmationStatus `json:"confirmation_status"`
+	ConfirmationDeadline    *time.Time          `json:"confirmation_deadline"`
+	ConfirmationRespondedAt *time.Time          `json:"confirmation_responded_at"`
+
+	// Set only once Status is withdrawn.
+	WithdrawnFrom    *ApplicationStatus `json:"withdrawn_from"`
+	WithdrawnAt      *time.Time         `json:"withdrawn_at"`
+	WithdrawalReason *string            `json:"withdrawal_reason"`
 }
 
 type ApplicationsStore struct {
@@ -163,16 +248,20 @@ type ApplicationsStore struct {
 
 // applicationSelectCols is the standard SELECT for loading a full Application
 const applicationSelectCols = `
-	id, user_id, status, responses, resume_path, ai_percent,
+	id, user_id, status, responses, resume_path, ai_percent, schema_version,
 	accept_votes, reject_votes, waitlist_votes, reviews_assigned, reviews_completed,
-	submitted_at, created_at, updated_at, meal_group`
+	submitted_at, created_at, updated_at, meal_group,
+	confirmation_status, confirmation_deadline, confirmation_responded_at,
+	withdrawn_from, withdrawn_at, withdrawal_reason`
 
 // scanApplication scans a row into an Application struct
 func scanApplication(row interface{ Scan(dest ...any) error }, app *Application) error {
 	return row.Scan(
-		&app.ID, &app.UserID, &app.Status, &app.Responses, &app.ResumePath, &app.AIPercent,
+		&app.ID, &app.UserID, &app.Status, &app.Responses, &app.ResumePath, &app.AIPercent, &app.SchemaVersion,
 		&app.AcceptVotes, &app.RejectVotes, &app.WaitlistVotes, &app.ReviewsAssigned, &app.ReviewsCompleted,
 		&app.SubmittedAt, &app.CreatedAt, &app.UpdatedAt, &app.MealGroup,
+		&app.ConfirmationStatus, &app.ConfirmationDeadline, &app.ConfirmationRespondedAt,
+		&app.WithdrawnFrom, &app.WithdrawnAt, &app.WithdrawalReason,
 	)
 }
 
@@ -198,7 +287,7 @@ func (s *ApplicationsStore) GetByUserID(ctx context.Context, userID string) (*Ap
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
-	query := `SELECT ` + applicationSelectCols + ` FROM applications WHERE user_id = $1`
+	query := `SELECT ` + applicationSelectCols + ` FROM applications WHERE hackathon_id = active_hackathon_id() AND user_id = $1`
 
 	var app Application
 	err := scanApplication(s.db.QueryRowContext(ctx, query, userID), &app)
@@ -227,7 +316,7 @@ func (s *ApplicationsStore) Create(ctx context.Context, app *Application) error
 		&app.CreatedAt, &app.UpdatedAt,
 	)
 	if err != nil {
-		if strings.Contains(err.Error(), "applications_user_id_key") {
+		if strings.Contains(err.Error(), "applications_hackathon_id_user_id_key") {
 			return ErrConflict
 		}
 		return err
@@ -236,6 +325,9 @@ func (s *ApplicationsStore) Create(ctx context.Context, app *Application) error
 	return nil
 }
 
+// Update writes the application's responses and resume path if the row is
+// unchanged since app was read, as recorded by app.UpdatedAt. Returns
+// ErrStale when another write got there first.
 func (s *ApplicationsStore) Update(ctx context.Context, app *Application) error {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
@@ -243,38 +335,48 @@ func (s *ApplicationsStore) Update(ctx context.Context, app *Application) error
 	query := `
 		UPDATE applications SET
 			responses = $2,
-			resume_path = $3
-		WHERE id = $1
-		RETURNING updated_at
+			resume_path = $3,
+			schema_version = ` + latestSchemaVersion + `
+		WHERE id = $1 AND updated_at = $4
+		RETURNING schema_version, updated_at
 	`
 
 	err := s.db.QueryRowContext(ctx, query,
 		app.ID,
 		app.Responses, app.ResumePath,
-	).Scan(&app.UpdatedAt)
+		app.UpdatedAt,
+	).Scan(&app.SchemaVersion, &app.UpdatedAt)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return ErrNotFound
+			return staleOrMissing(ctx, s.db, "applications", app.ID)
 		}
 		return err
 	}
 	return nil
 }
 
+// Submit moves a draft to submitted and records the hacker as the actor in
+// its status events.
 func (s *ApplicationsStore) Submit(ctx context.Context, app *Application) error {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
 	query := `
-		UPDATE applications
-		SET status = 'submitted', submitted_at = NOW()
-		WHERE id = $1 AND status = 'draft'
-		RETURNING status, submitted_at, updated_at
+		WITH submitted AS (
+			UPDATE applications
+			SET status = 'submitted', submitted_at = NOW(), schema_version = ` + latestSchemaVersion + `
+			WHERE id = $1 AND status = 'draft'
+			RETURNING id, user_id, status, schema_version, submitted_at, updated_at
+		), event AS (
+			INSERT INTO application_status_events (application_id, from_status, to_status, source, actor_id)
+			SELECT id, 'draft', status, 'submit', user_id FROM submitted
+		)
+		SELECT status, schema_version, submitted_at, updated_at FROM submitted
 	`
 
 	err := s.db.QueryRowContext(ctx, query, app.ID).Scan(
-		&app.Status, &app.SubmittedAt, &app.UpdatedAt,
+		&app.Status, &app.SchemaVersion, &app.SubmittedAt, &app.UpdatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -285,6 +387,72 @@ func (s *ApplicationsStore) Submit(ctx context.Context, app *Application) error
 	return nil
 }
 
+// RemapResponses moves the active hackathon's applications keyed against
+// fromVersion onto toVersion, renaming each response key found in mapping
+// (old field ID to new). All renames happen at once, so swapping two field IDs
+// works. Answers to fields that were neither mapped nor kept are left in
+// place rather than discarded. Returns ErrConflict, moving nothing, if a
+// renamed answer would replace one already stored under its new key, and
+// otherwise the number of applications moved.
+func (s *ApplicationsStore) RemapResponses(ctx context.Context, fromVersion, toVersion int, mapping map[string]string) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*4)
+	defer cancel()
+
+	mappingJSON, err := json.Marshal(mapping)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var clobbers bool
+	if err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM applications a
+			CROSS JOIN jsonb_each_text($2::jsonb) m
+			WHERE a.hackathon_id = active_hackathon_id() AND a.schema_version = $1
+			  AND a.responses ? m.key
+			  AND a.responses ? m.value
+			  AND NOT ($2::jsonb ? m.value)
+		)
+	`, fromVersion, mappingJSON).Scan(&clobbers); err != nil {
+		return 0, err
+	}
+	if clobbers {
+		return 0, ErrConflict
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE applications a
+		SET responses = (a.responses - ARRAY(SELECT jsonb_object_keys($2::jsonb)))
+		                || COALESCE((
+		                    SELECT jsonb_object_agg(m.value, a.responses -> m.key)
+		                    FROM jsonb_each_text($2::jsonb) m
+		                    WHERE a.responses ? m.key
+		                ), '{}'::jsonb),
+		    schema_version = $3
+		WHERE a.hackathon_id = active_hackathon_id() AND a.schema_version = $1
+	`, fromVersion, mappingJSON, toVersion)
+	if err != nil {
+		return 0, err
+	}
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(moved), nil
+}
+
 // sortColumnName returns the SQL column name for a given sort key.
 // Only whitelisted values are accepted to prevent SQL injection.
 func sortColumnName(sortBy ApplicationSortBy) string {
@@ -324,6 +492,106 @@ func getVoteVal(item ApplicationListItem, sortBy ApplicationSortBy) int {
 	}
 }
 
+// applicationPointsExpr totals an application's scan points.
+const applicationPointsExpr = `(SELECT COALESCE(SUM(s.points), 0) FROM scans s
+		WHERE s.hackathon_id = a.hackathon_id AND s.user_id = a.user_id)`
+
+// structuredFilterClause renders the filters beyond status, search, team and
+// flag as extra AND conditions on applications a. Placeholders are numbered
+// after the bound parameters the caller already passes.
+func structuredFilterClause(filters ApplicationListFilters, bound int) (string, []any) {
+	var b strings.Builder
+	var args []any
+	param := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", bound+len(args))
+	}
+	and := func(format string, a ...any) {
+		b.WriteString("\n\t\t  AND ")
+		fmt.Fprintf(&b, format, a...)
+	}
+
+	for _, f := range filters.Responses {
+		field := param(f.FieldID) + "::text"
+		switch f.Op {
+		case ResponseFilterEquals:
+			and("a.responses->>%s = %s::text", field, param(f.Value))
+		case ResponseFilterContains:
+			and("a.responses->%s @> jsonb_build_array(%s::text)", field, param(f.Value))
+		case ResponseFilterRange:
+			// Answers are free-form JSON, so only values that read as a
+			// number are compared; anything else never matches a range.
+			number := fmt.Sprintf(`(CASE WHEN a.responses->>%[1]s ~ '^-?[0-9]+(\.[0-9]+)?$'
+			      THEN (a.responses->>%[1]s)::numeric END)`, field)
+			if f.Min != nil {
+				and("%s >= %s::numeric", number, param(*f.Min))
+			}
+			if f.Max != nil {
+				and("%s <= %s::numeric", number, param(*f.Max))
+			}
+		}
+	}
+
+	if filters.HasResume != nil {
+		and("(a.resume_path IS NOT NULL) = %s::bool", param(*filters.HasResume))
+	}
+	if filters.AIPercentMin != nil {
+		and("a.ai_percent >= %s::int", param(*filters.AIPercentMin))
+	}
+	if filters.AIPercentMax != nil {
+		and("a.ai_percent <= %s::int", param(*filters.AIPercentMax))
+	}
+	if filters.PointsMin != nil {
+		and("%s >= %s::int", applicationPointsExpr, param(*filters.PointsMin))
+	}
+	if filters.PointsMax != nil {
+		and("%s <= %s::int", applicationPointsExpr, param(*filters.PointsMax))
+	}
+	if filters.SubmittedFrom != nil {
+		and("a.submitted_at >= %s::timestamptz", param(*filters.SubmittedFrom))
+	}
+	if filters.SubmittedTo != nil {
+		and("a.submitted_at <= %s::timestamptz", param(*filters.SubmittedTo))
+	}
+	if filters.MealGroup != nil {
+		and("a.meal_group = %s::text", param(*filters.MealGroup))
+	}
+	if filters.TextQuery != nil {
+		and(`to_tsvector('english', COALESCE((
+			SELECT string_agg(a.responses->>f, ' ') FROM unnest(%s::text[]) AS f
+		  ), '')) @@ websearch_to_tsquery('english', %s::text)`, param(filters.TextFields), param(*filters.TextQuery))
+	}
+
+	return b.String(), args
+}
+
+// applicationFilterClause renders every filter in filters as the WHERE
+// conditions shared by List, Export and BulkSetStatus. Queries using it must
+// join users u and teams t alongside applications a. Placeholders are
+// numbered after the bound parameters the caller already passes.
+func applicationFilterClause(filters ApplicationListFilters, bound int) (string, []any) {
+	var statusParam any
+	if filters.Status != nil {
+		statusParam = *filters.Status
+	}
+	args := []any{filters.HackathonID, statusParam, filters.Search, filters.TeamID, filters.Flagged}
+
+	clause := fmt.Sprintf(`a.hackathon_id = COALESCE($%[1]d::uuid, active_hackathon_id())
+		  AND ($%[2]d::application_status IS NULL OR a.status = $%[2]d)
+		  AND ($%[3]d::text IS NULL OR (
+		    u.email ILIKE '%%' || $%[3]d || '%%'
+		    OR a.responses->>'first_name' ILIKE '%%' || $%[3]d || '%%'
+		    OR a.responses->>'last_name' ILIKE '%%' || $%[3]d || '%%'
+		  ))
+		  AND ($%[4]d::uuid IS NULL OR t.id = $%[4]d)
+		  AND ($%[5]d::bool IS NULL OR EXISTS (
+		    SELECT 1 FROM application_flags f WHERE f.application_id = a.id
+		  ) = $%[5]d)`, bound+1, bound+2, bound+3, bound+4, bound+5)
+
+	structuredClause, structuredArgs := structuredFilterClause(filters, bound+len(args))
+	return clause + structuredClause, append(args, structuredArgs...)
+}
+
 // Cursor pagination for applications
 func (s *ApplicationsStore) List(
 	ctx context.Context,
@@ -350,11 +618,6 @@ func (s *ApplicationsStore) List(
 	voteSort := isVoteSort(sortBy)
 	col := sortColumnName(sortBy)
 
-	var searchParam *string
-	if filters.Search != nil {
-		searchParam = filters.Search
-	}
-
 	// responses is free-text JSONB, so a hacker can store any string in a
 	// numeric field. A bare ::smallint cast makes one out-of-range value fail
 	// the whole query and 500 the list for every admin, so only values that
@@ -376,24 +639,21 @@ func (s *ApplicationsStore) List(
 		       a.submitted_at, a.created_at, a.updated_at,
 		       a.accept_votes, a.reject_votes, a.waitlist_votes, a.reviews_assigned, a.reviews_completed, a.ai_percent,
 		       a.resume_path IS NOT NULL AS has_resume, a.meal_group,
-		       (SELECT COALESCE(SUM(s.points), 0) FROM scans s WHERE s.user_id = a.user_id) AS points
+		       (SELECT COALESCE(SUM(s.points), 0) FROM scans s
+		         WHERE s.hackathon_id = a.hackathon_id AND s.user_id = a.user_id) AS points,
+		       a.confirmation_status,
+		       t.id AS team_id, t.name AS team_name,
+		       EXISTS (SELECT 1 FROM application_flags f WHERE f.application_id = a.id) AS flagged
 		FROM applications a
-		INNER JOIN users u ON a.user_id = u.id`
+		INNER JOIN users u ON a.user_id = u.id
+		LEFT JOIN team_members tm ON tm.user_id = a.user_id AND tm.hackathon_id = a.hackathon_id
+		LEFT JOIN teams t ON t.id = tm.team_id`
 
-	searchClause := `AND ($5::text IS NULL OR (
-		    u.email ILIKE '%' || $5 || '%'
-		    OR a.responses->>'first_name' ILIKE '%' || $5 || '%'
-		    OR a.responses->>'last_name' ILIKE '%' || $5 || '%'
-		))`
+	filterClause, filterArgs := applicationFilterClause(filters, 3)
 
 	// Fetch limit+1 to determine hasMore
 	queryLimit := limit + 1
 
-	var statusParam any
-	if filters.Status != nil {
-		statusParam = *filters.Status
-	}
-
 	var rows *sql.Rows
 	var err error
 
@@ -410,22 +670,21 @@ func (s *ApplicationsStore) List(
 		if direction == DirectionBackward && cursor != nil {
 			// Backward: fetch items AFTER cursor in ASC order, then reverse
 			query = fmt.Sprintf(`%s
-				WHERE ($1::application_status IS NULL OR a.status = $1)
-				  AND ($2::int IS NULL OR (%s, a.id) > ($2, $3::uuid))
-				  %s
+				WHERE %s
+				  AND ($1::int IS NULL OR (%s, a.id) > ($1, $2::uuid))
 				ORDER BY %s ASC, a.id ASC
-				LIMIT $4`, selectCols, col, searchClause, col)
+				LIMIT $3`, selectCols, filterClause, col, col)
 		} else {
 			// Forward (default): DESC order
 			query = fmt.Sprintf(`%s
-				WHERE ($1::application_status IS NULL OR a.status = $1)
-				  AND ($2::int IS NULL OR (%s, a.id) < ($2, $3::uuid))
-				  %s
+				WHERE %s
+				  AND ($1::int IS NULL OR (%s, a.id) < ($1, $2::uuid))
 				ORDER BY %s DESC, a.id DESC
-				LIMIT $4`, selectCols, col, searchClause, col)
+				LIMIT $3`, selectCols, filterClause, col, col)
 		}
 
-		rows, err = s.db.QueryContext(ctx, query, statusParam, cursorVal, cursorID, queryLimit, searchParam)
+		args := append([]any{cursorVal, cursorID, queryLimit}, filterArgs...)
+		rows, err = s.db.QueryContext(ctx, query, args...)
 	} else {
 		// Default created_at sorting
 		var cursorTime *time.Time
@@ -438,21 +697,20 @@ func (s *ApplicationsStore) List(
 		var query string
 		if direction == DirectionBackward && cursor != nil {
 			query = fmt.Sprintf(`%s
-				WHERE ($1::application_status IS NULL OR a.status = $1)
-				  AND (a.created_at, a.id) > ($2, $3::uuid)
-				  %s
+				WHERE %s
+				  AND (a.created_at, a.id) > ($1, $2::uuid)
 				ORDER BY a.created_at ASC, a.id ASC
-				LIMIT $4`, selectCols, searchClause)
+				LIMIT $3`, selectCols, filterClause)
 		} else {
 			query = fmt.Sprintf(`%s
-				WHERE ($1::application_status IS NULL OR a.status = $1)
-				  AND ($2::timestamptz IS NULL OR (a.created_at, a.id) < ($2, $3::uuid))
-				  %s
+				WHERE %s
+				  AND ($1::timestamptz IS NULL OR (a.created_at, a.id) < ($1, $2::uuid))
 				ORDER BY a.created_at DESC, a.id DESC
-				LIMIT $4`, selectCols, searchClause)
+				LIMIT $3`, selectCols, filterClause)
 		}
 
-		rows, err = s.db.QueryContext(ctx, query, statusParam, cursorTime, cursorID, queryLimit, searchParam)
+		args := append([]any{cursorTime, cursorID, queryLimit}, filterArgs...)
+		rows, err = s.db.QueryContext(ctx, query, args...)
 	}
 
 	if err != nil {
@@ -472,6 +730,8 @@ func (s *ApplicationsStore) List(
 			&item.SubmittedAt, &item.CreatedAt, &item.UpdatedAt,
 			&item.AcceptVotes, &item.RejectVotes, &item.WaitlistVotes, &item.ReviewsAssigned, &item.ReviewsCompleted, &item.AIPercent,
 			&item.HasResume, &item.MealGroup, &item.Points,
+			&item.ConfirmationStatus,
+			&item.TeamID, &item.TeamName, &item.Flagged,
 		); err != nil {
 			return nil, err
 		}
@@ -536,26 +796,381 @@ func (s *ApplicationsStore) encodeCursorForItem(item ApplicationListItem, sortBy
 	return EncodeCursor(item.CreatedAt, item.ID)
 }
 
-func (s *ApplicationsStore) SetStatus(ctx context.Context, id string, status ApplicationStatus) (*Application, error) {
+// SetStatus sets the final status on an application and records the change
+// and the admin's reason, if any, in its status events. A changed decision clears decision_email_sent_at so
+// the applicant is emailed the new one. Accepting is checked against the
+// admission quotas: it fails with a QuotaExceededError past a hard quota, and
+// the soft quotas it exceeds are returned. A withdrawn application belongs to
+// the hacker, so changing it returns ErrConflict; the check holds the row
+// lock, so a withdrawal racing the decision is never overwritten.
+func (s *ApplicationsStore) SetStatus(ctx context.Context, id string, status ApplicationStatus, changedBy, reason string) (*Application, []QuotaBreach, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	if status == StatusAccepted {
+		if _, err := tx.ExecContext(ctx, quotaLockSQL); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var from ApplicationStatus
+	err = tx.QueryRowContext(ctx, `SELECT status FROM applications WHERE id = $1 FOR UPDATE`, id).Scan(&from)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+	if from == StatusWithdrawn {
+		return nil, nil, ErrConflict
+	}
+
+	breaches := []QuotaBreach{}
+	if status == StatusAccepted && from != status {
+		var hard bool
+		if breaches, hard, err = checkQuotas(ctx, tx, []string{id}); err != nil {
+			return nil, nil, err
+		}
+		if hard {
+			return nil, nil, &QuotaExceededError{Breaches: breaches}
+		}
+	}
+
 	query := `
 		UPDATE applications
-		SET status = $2, updated_at = NOW()
+		SET status = $2, updated_at = NOW(),
+		    decision_email_sent_at = CASE WHEN status = $2 THEN decision_email_sent_at END
 		WHERE id = $1
 		RETURNING ` + applicationSelectCols
 
 	var app Application
-	err := scanApplication(s.db.QueryRowContext(ctx, query, id, status), &app)
+	if err := scanApplication(tx.QueryRowContext(ctx, query, id, status), &app); err != nil {
+		return nil, nil, err
+	}
+
+	if from != status {
+		if err := recordStatusEvent(ctx, tx, id, &from, status, StatusEventAdmin, changedBy, reason); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return &app, breaches, nil
+}
+
+// bulkStatusSampleSize caps how many affected applications a bulk status
+// change lists back.
+const bulkStatusSampleSize = 20
+
+// BulkStatusTarget picks the applications of a bulk status change: IDs when
+// set, otherwise every application in the active hackathon matching Filters.
+type BulkStatusTarget struct {
+	IDs     []string
+	Filters ApplicationListFilters
+}
+
+// BulkStatusSample is one application a bulk status change affects.
+type BulkStatusSample struct {
+	ApplicationID string            `json:"application_id"`
+	Email         string            `json:"email"`
+	FirstName     *string           `json:"first_name"`
+	LastName      *string           `json:"last_name"`
+	FromStatus    ApplicationStatus `json:"from_status"`
+}
+
+type BulkStatusResult struct {
+	DryRun bool `json:"dry_run"`
+	// BulkID tags the status events of the change; empty on a dry run.
+	BulkID  string `json:"bulk_id,omitempty"`
+	Matched int    `json:"matched"`
+	// Affected counts the applications changed, or that would be on a dry
+	// run. Unchanged ones already had the status; drafts and withdrawn
+	// applications are skipped.
+	Affected  int                `json:"affected"`
+	Unchanged int                `json:"unchanged"`
+	Skipped   int                `json:"skipped"`
+	Sample    []BulkStatusSample `json:"sample"`
+	// QuotaBreaches lists the admission quotas accepting would exceed. Hard
+	// ones fail the change unless it is a dry run.
+	QuotaBreaches []QuotaBreach `json:"quota_breaches"`
+}
+
+// BulkSetStatus sets status on every submitted or decided application target
+// selects, in one transaction, recording each change and reason in the status
+// events under a shared bulk ID and clearing decision_email_sent_at as SetStatus
+// does. A dry run reports the same counts and sample without writing.
+func (s *ApplicationsStore) BulkSetStatus(ctx context.Context, target BulkStatusTarget, status ApplicationStatus, changedBy, reason string, dryRun bool) (*BulkStatusResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*4)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Bulk changes only ever touch the active hackathon.
+	filters := target.Filters
+	filters.HackathonID = nil
+	var idsParam any
+	if target.IDs != nil {
+		idsParam = target.IDs
+	}
+
+	lock := ""
+	if !dryRun {
+		lock = "\n\t\tFOR UPDATE OF a"
+		if status == StatusAccepted {
+			if _, err := tx.ExecContext(ctx, quotaLockSQL); err != nil {
+				return nil, err
+			}
 		}
+	}
+
+	filterClause, filterArgs := applicationFilterClause(filters, 1)
+	query := fmt.Sprintf(`
+		SELECT a.id, a.status, u.email,
+		       a.responses->>'first_name' AS first_name,
+		       a.responses->>'last_name' AS last_name
+		FROM applications a
+		INNER JOIN users u ON a.user_id = u.id
+		LEFT JOIN team_members tm ON tm.user_id = a.user_id AND tm.hackathon_id = a.hackathon_id
+		LEFT JOIN teams t ON t.id = tm.team_id
+		WHERE %s
+		  AND ($1::uuid[] IS NULL OR a.id = ANY($1::uuid[]))
+		ORDER BY a.submitted_at ASC NULLS LAST, a.id ASC%s`, filterClause, lock)
+
+	args := append([]any{idsParam}, filterArgs...)
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	return &app, nil
+	result := &BulkStatusResult{DryRun: dryRun, Sample: []BulkStatusSample{}}
+	var affected []string
+	for rows.Next() {
+		var app BulkStatusSample
+		if err := rows.Scan(&app.ApplicationID, &app.FromStatus, &app.Email, &app.FirstName, &app.LastName); err != nil {
+			return nil, err
+		}
+		result.Matched++
+
+		switch app.FromStatus {
+		case status:
+			result.Unchanged++
+		case StatusDraft, StatusWithdrawn:
+			result.Skipped++
+		default:
+			affected = append(affected, app.ApplicationID)
+			if len(result.Sample) < bulkStatusSampleSize {
+				result.Sample = append(result.Sample, app)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	result.Affected = len(affected)
+
+	result.QuotaBreaches = []QuotaBreach{}
+	if status == StatusAccepted && len(affected) > 0 {
+		breaches, hard, err := checkQuotas(ctx, tx, affected)
+		if err != nil {
+			return nil, err
+		}
+		if hard && !dryRun {
+			return nil, &QuotaExceededError{Breaches: breaches}
+		}
+		result.QuotaBreaches = breaches
+	}
+
+	if dryRun || len(affected) == 0 {
+		return result, nil
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT gen_random_uuid()`).Scan(&result.BulkID); err != nil {
+		return nil, err
+	}
+
+	if err := setStatusInBulk(ctx, tx, affected, status, StatusEventBulk, changedBy, reason, result.BulkID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// setStatusInBulk sets status on ids inside tx, clearing
+// decision_email_sent_at and recording each change under bulkID.
+func setStatusInBulk(ctx context.Context, tx *sql.Tx, ids []string, status ApplicationStatus, source StatusEventSource, changedBy, reason, bulkID string) error {
+	_, err := tx.ExecContext(ctx, `
+		WITH old AS (
+			SELECT id, status FROM applications WHERE id = ANY($1::uuid[])
+		), changed AS (
+			UPDATE applications a
+			SET status = $2, decision_email_sent_at = NULL, updated_at = NOW()
+			FROM old
+			WHERE a.id = old.id
+			RETURNING a.id, old.status AS from_status
+		)
+		INSERT INTO application_status_events (application_id, from_status, to_status, source, actor_id, reason, bulk_id)
+		SELECT id, from_status, $2, $3, $4, NULLIF($5, ''), $6 FROM changed
+	`, ids, status, source, changedBy, reason, bulkID)
+	return err
+}
+
+// DecisionCandidate is a submitted application as the decision rules see it.
+type DecisionCandidate struct {
+	ApplicationID string                 `json:"application_id"`
+	Email         string                 `json:"email"`
+	FirstName     *string                `json:"first_name"`
+	LastName      *string                `json:"last_name"`
+	AcceptVotes   int                    `json:"accept_votes"`
+	RejectVotes   int                    `json:"reject_votes"`
+	WaitlistVotes int                    `json:"waitlist_votes"`
+	AIPercent     *int16                 `json:"ai_percent"`
+	Responses     map[string]interface{} `json:"-"`
+}
+
+// ApplicationDecision is the status a rule gives one application.
+type ApplicationDecision struct {
+	DecisionCandidate
+	Status ApplicationStatus `json:"status"`
+	Rule   string            `json:"rule"`
+}
+
+type DecisionRunResult struct {
+	DryRun bool   `json:"dry_run"`
+	BulkID string `json:"bulk_id,omitempty"`
+	// Counts is keyed by the status given; Undecided counts the submitted
+	// applications no rule matched, which keep their status.
+	Counts    map[ApplicationStatus]int `json:"counts"`
+	Undecided int                       `json:"undecided"`
+	Decisions []ApplicationDecision     `json:"decisions"`
+	// QuotaBreaches lists the admission quotas the acceptances would exceed.
+	// Hard ones fail the run unless it is a dry run.
+	QuotaBreaches []QuotaBreach `json:"quota_breaches"`
+}
+
+// Decide runs decide over every submitted application in the active
+// hackathon and sets the status of the rule it returns, if any. All changes
+// are made in one transaction with the applications locked, so votes cast
+// meanwhile wait for the run to finish; they are recorded in the status
+// events under one bulk ID, with the rule's name as the reason. A dry run
+// only reports the decisions.
+func (s *ApplicationsStore) Decide(ctx context.Context, decide func(*DecisionCandidate) *DecisionRule, changedBy string, dryRun bool) (*DecisionRunResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*4)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	lock := ""
+	if !dryRun {
+		lock = "\n\t\tFOR UPDATE OF a"
+		if _, err := tx.ExecContext(ctx, quotaLockSQL); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT a.id, u.email, a.responses->>'first_name', a.responses->>'last_name',
+		       a.accept_votes, a.reject_votes, a.waitlist_votes, a.ai_percent, a.responses
+		FROM applications a
+		INNER JOIN users u ON a.user_id = u.id
+		WHERE a.hackathon_id = active_hackathon_id() AND a.status = 'submitted'
+		ORDER BY a.submitted_at ASC, a.id ASC`+lock)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &DecisionRunResult{
+		DryRun:    dryRun,
+		Counts:    map[ApplicationStatus]int{StatusAccepted: 0, StatusRejected: 0, StatusWaitlisted: 0},
+		Decisions: []ApplicationDecision{},
+	}
+	type ruleDecision struct {
+		status ApplicationStatus
+		rule   string
+	}
+	byStatus := map[ApplicationStatus][]string{}
+	byRule := map[ruleDecision][]string{}
+	for rows.Next() {
+		var c DecisionCandidate
+		var responses []byte
+		if err := rows.Scan(
+			&c.ApplicationID, &c.Email, &c.FirstName, &c.LastName,
+			&c.AcceptVotes, &c.RejectVotes, &c.WaitlistVotes, &c.AIPercent, &responses,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(responses, &c.Responses); err != nil {
+			return nil, err
+		}
+
+		rule := decide(&c)
+		if rule == nil {
+			result.Undecided++
+			continue
+		}
+		result.Counts[rule.Status]++
+		result.Decisions = append(result.Decisions, ApplicationDecision{DecisionCandidate: c, Status: rule.Status, Rule: rule.Name})
+		byStatus[rule.Status] = append(byStatus[rule.Status], c.ApplicationID)
+		key := ruleDecision{status: rule.Status, rule: rule.Name}
+		byRule[key] = append(byRule[key], c.ApplicationID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result.QuotaBreaches = []QuotaBreach{}
+	if accepted := byStatus[StatusAccepted]; len(accepted) > 0 {
+		breaches, hard, err := checkQuotas(ctx, tx, accepted)
+		if err != nil {
+			return nil, err
+		}
+		if hard && !dryRun {
+			return nil, &QuotaExceededError{Breaches: breaches}
+		}
+		result.QuotaBreaches = breaches
+	}
+
+	if dryRun || len(result.Decisions) == 0 {
+		return result, nil
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT gen_random_uuid()`).Scan(&result.BulkID); err != nil {
+		return nil, err
+	}
+
+	for d, ids := range byRule {
+		if err := setStatusInBulk(ctx, tx, ids, d.status, StatusEventRules, changedBy, d.rule, result.BulkID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 // GetStats returns aggregated application statistics
@@ -570,8 +1185,14 @@ func (s *ApplicationsStore) GetStats(ctx context.Context) (*ApplicationStats, er
 			COUNT(*) FILTER (WHERE status = 'accepted') AS accepted,
 			COUNT(*) FILTER (WHERE status = 'rejected') AS rejected,
 			COUNT(*) FILTER (WHERE status = 'waitlisted') AS waitlisted,
-			COUNT(*) FILTER (WHERE status = 'draft') AS draft
+			COUNT(*) FILTER (WHERE status = 'draft') AS draft,
+			COUNT(*) FILTER (WHERE status = 'withdrawn') AS withdrawn,
+			COUNT(*) FILTER (WHERE confirmation_status = 'pending') AS confirmation_pending,
+			COUNT(*) FILTER (WHERE confirmation_status = 'confirmed') AS confirmed,
+			COUNT(*) FILTER (WHERE confirmation_status = 'declined') AS declined,
+			COUNT(*) FILTER (WHERE confirmation_status = 'expired') AS expired
 		FROM applications
+		WHERE hackathon_id = active_hackathon_id()
 	`
 
 	var stats ApplicationStats
@@ -582,6 +1203,11 @@ func (s *ApplicationsStore) GetStats(ctx context.Context) (*ApplicationStats, er
 		&stats.Rejected,
 		&stats.Waitlisted,
 		&stats.Draft,
+		&stats.Withdrawn,
+		&stats.ConfirmationPending,
+		&stats.Confirmed,
+		&stats.Declined,
+		&stats.Expired,
 	)
 	if err != nil {
 		return nil, err
@@ -609,7 +1235,7 @@ func (s *ApplicationsStore) GetStatusByUserID(ctx context.Context, userID string
 
 	var status ApplicationStatus
 	err := s.db.QueryRowContext(ctx,
-		`SELECT status FROM applications WHERE user_id = $1`, userID).Scan(&status)
+		`SELECT status FROM applications WHERE hackathon_id = active_hackathon_id() AND user_id = $1`, userID).Scan(&status)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return "", ErrNotFound
@@ -629,7 +1255,7 @@ func (s *ApplicationsStore) GetEmailsByStatus(ctx context.Context, status Applic
 		       a.responses->>'last_name' AS last_name
 		FROM applications a
 		INNER JOIN users u ON a.user_id = u.id
-		WHERE a.status = $1
+		WHERE a.hackathon_id = active_hackathon_id() AND a.status = $1
 		ORDER BY u.email`
 
 	rows, err := s.db.QueryContext(ctx, query, status)
@@ -681,7 +1307,7 @@ func (s *ApplicationsStore) GetMealGroupByUserID(ctx context.Context, userID str
 	defer cancel()
 
 	var mealGroup *string
-	err := s.db.QueryRowContext(ctx, "SELECT meal_group FROM applications WHERE user_id = $1", userID).Scan(&mealGroup)
+	err := s.db.QueryRowContext(ctx, "SELECT meal_group FROM applications WHERE hackathon_id = active_hackathon_id() AND user_id = $1", userID).Scan(&mealGroup)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrNotFound
 	}
@@ -768,7 +1394,8 @@ func (s *ApplicationsStore) GetDecisionEmailRecipients(ctx context.Context, stat
 		       a.status
 		FROM applications a
 		INNER JOIN users u ON a.user_id = u.id
-		WHERE a.status = ANY($1::application_status[])`
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND a.status = ANY($1::application_status[])`
 
 	if onlyUnsent {
 		query += "\n\t\t  AND a." + column + " IS NULL"
@@ -848,6 +1475,7 @@ func (s *ApplicationsStore) GetDecisionEmailStats(ctx context.Context) (*Decisio
 			COUNT(*) FILTER (WHERE status IN ('accepted', 'waitlisted', 'rejected')) AS announcement_total,
 			COUNT(*) FILTER (WHERE status IN ('accepted', 'waitlisted', 'rejected') AND announcement_email_sent_at IS NOT NULL) AS announcement_sent
 		FROM applications
+		WHERE hackathon_id = active_hackathon_id()
 	`
 
 	var stats DecisionEmailStats
@@ -869,3 +1497,336 @@ func (s *ApplicationsStore) GetDecisionEmailStats(ctx context.Context) (*Decisio
 
 	return &stats, nil
 }
+
+// SetConfirmation records an accepted hacker's response. Pending applications
+// may confirm or decline until their deadline; a confirmed hacker may still
+// decline to release the seat, but a decline is final since the seat may
+// already be backfilled. Returns ErrConflict when the response isn't allowed.
+func (s *ApplicationsStore) SetConfirmation(ctx context.Context, userID string, status ConfirmationStatus) (*Application, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		UPDATE applications
+		SET confirmation_status = $2, confirmation_responded_at = NOW()
+		WHERE hackathon_id = active_hackathon_id()
+		  AND user_id = $1
+		  AND status = 'accepted'
+		  AND (
+		      (confirmation_status = 'pending'
+		       AND (confirmation_deadline IS NULL OR confirmation_deadline > NOW()))
+		      OR ($2::confirmation_status = 'declined' AND confirmation_status = 'confirmed')
+		  )
+		RETURNING ` + applicationSelectCols
+
+	var app Application
+	err := scanApplication(s.db.QueryRowContext(ctx, query, userID, status), &app)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Withdraw withdraws a hacker's draft, submitted or accepted application with
+// their reason. Unvoted reviews are cancelled and any unpromoted walk-in entry
+// is dropped; the status change alone takes them off decision email lists and
+// releases an accepted seat to the waitlist. Returns ErrConflict when the
+// application is in any other status.
+func (s *ApplicationsStore) Withdraw(ctx context.Context, userID string, reason string) (*Application, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE applications
+		SET status = 'withdrawn', withdrawn_from = status, withdrawn_at = NOW(),
+		    withdrawal_reason = $2, updated_at = NOW()
+		WHERE hackathon_id = active_hackathon_id()
+		  AND user_id = $1
+		  AND status IN ('draft', 'submitted', 'accepted')
+		RETURNING ` + applicationSelectCols
+
+	var app Application
+	if err := scanApplication(tx.QueryRowContext(ctx, query, userID, reason), &app); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+
+	if err := recordStatusEvent(ctx, tx, app.ID, app.WithdrawnFrom, StatusWithdrawn, StatusEventWithdraw, userID, reason); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM application_reviews
+		WHERE application_id = $1 AND vote IS NULL
+	`, app.ID); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM walk_ins
+		WHERE hackathon_id = active_hackathon_id() AND user_id = $1 AND promoted_at IS NULL
+	`, userID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// ExpireConfirmations marks the active hackathon's pending confirmations past
+// their deadline as expired and returns how many were expired.
+func (s *ApplicationsStore) ExpireConfirmations(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE applications
+		SET confirmation_status = 'expired'
+		WHERE hackathon_id = active_hackathon_id()
+		  AND confirmation_status = 'pending'
+		  AND confirmation_deadline <= NOW()
+	`)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// PromoteWaitlisted accepts the top waitlisted applications until the seats
+// held by pending or confirmed hackers reach capacity. Walk-ins are skipped;
+// they are promoted from the door queue instead. Promoted applications are
+// marked as decision-emailed in the same transaction and returned so the
+// caller can send the emails, clearing the mark on failure. Their status
+// events have no actor.
+func (s *ApplicationsStore) PromoteWaitlisted(ctx context.Context, capacity int) ([]DecisionEmailRecipient, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Serialize promotion across API instances so two sweeps can't both fill
+	// the same open seats.
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('rsvp_promotion'))`); err != nil {
+		return nil, err
+	}
+
+	var held int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM applications
+		WHERE hackathon_id = active_hackathon_id()
+		  AND confirmation_status IN ('pending', 'confirmed')
+	`).Scan(&held)
+	if err != nil {
+		return nil, err
+	}
+
+	open := capacity - held
+	if open <= 0 {
+		return []DecisionEmailRecipient{}, tx.Commit()
+	}
+
+	// Promotion accepts, so it is held to the hard admission quotas too:
+	// waitlisted hackers a full quota would take are passed over for the
+	// next in line.
+	if _, err := tx.ExecContext(ctx, quotaLockSQL); err != nil {
+		return nil, err
+	}
+	tally, err := loadQuotaTally(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// With quotas some candidates may be passed over, so every one is read;
+	// LIMIT NULL means no limit.
+	var limit any = open
+	if len(tally.quotas) > 0 {
+		limit = nil
+	}
+	candidates, err := tx.QueryContext(ctx, `
+		SELECT a.id, a.responses
+		FROM applications a
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND a.status = 'waitlisted'
+		  AND NOT EXISTS (
+		      SELECT 1 FROM walk_ins w
+		      WHERE w.hackathon_id = a.hackathon_id AND w.user_id = a.user_id
+		  )
+		ORDER BY a.accept_votes DESC, a.submitted_at ASC NULLS LAST, a.id ASC
+		LIMIT $1::int
+		FOR UPDATE SKIP LOCKED
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for candidates.Next() && len(ids) < open {
+		var id string
+		var raw []byte
+		if err := candidates.Scan(&id, &raw); err != nil {
+			candidates.Close()
+			return nil, err
+		}
+		var responses map[string]interface{}
+		if err := json.Unmarshal(raw, &responses); err != nil {
+			candidates.Close()
+			return nil, err
+		}
+		if !tally.fits(responses) {
+			continue
+		}
+		tally.admit(responses)
+		ids = append(ids, id)
+	}
+	candidates.Close()
+	if err := candidates.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []DecisionEmailRecipient{}, tx.Commit()
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		WITH promoted AS (
+			UPDATE applications
+			SET status = 'accepted', decision_email_sent_at = NOW()
+			WHERE id = ANY($1::uuid[])
+			RETURNING id, user_id, responses, status
+		), event AS (
+			INSERT INTO application_status_events (application_id, from_status, to_status, source)
+			SELECT id, 'waitlisted', status, 'waitlist_promotion' FROM promoted
+		)
+		SELECT p.id, p.user_id, u.email,
+		       p.responses->>'first_name' AS first_name,
+		       p.responses->>'last_name' AS last_name,
+		       p.status
+		FROM promoted p
+		INNER JOIN users u ON p.user_id = u.id
+		ORDER BY u.email
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := []DecisionEmailRecipient{}
+	for rows.Next() {
+		var recipient DecisionEmailRecipient
+		if err := rows.Scan(
+			&recipient.ApplicationID,
+			&recipient.UserID,
+			&recipient.Email,
+			&recipient.FirstName,
+			&recipient.LastName,
+			&recipient.Status,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recipients, tx.Commit()
+}
+
+// ApplicationExportRow is one application as written to a spreadsheet export.
+// Responses is left raw so the caller can lay it out by schema field.
+type ApplicationExportRow struct {
+	ID                 string
+	Email              string
+	Status             ApplicationStatus
+	Responses          json.RawMessage
+	SubmittedAt        *time.Time
+	CreatedAt          time.Time
+	AcceptVotes        int
+	RejectVotes        int
+	WaitlistVotes      int
+	ConfirmationStatus *ConfirmationStatus
+	TeamName           *string
+	MealGroup          *string
+	Points             int
+	CheckedInAt        *time.Time
+}
+
+// Export streams every application matching filters to fn in list order,
+// without pagination. Rows are read one at a time, so fn may write straight
+// to the client; returning an error from fn stops the export. A hacker counts
+// as checked in once they have a scan of any of checkInTypes.
+func (s *ApplicationsStore) Export(ctx context.Context, filters ApplicationListFilters, checkInTypes []string, fn func(*ApplicationExportRow) error) error {
+	ctx, cancel := context.WithTimeout(ctx, ExportTimeoutDuration)
+	defer cancel()
+
+	sortBy := filters.SortBy
+	if sortBy == "" {
+		sortBy = SortByCreatedAt
+	}
+
+	if checkInTypes == nil {
+		checkInTypes = []string{}
+	}
+
+	filterClause, filterArgs := applicationFilterClause(filters, 1)
+
+	query := fmt.Sprintf(`
+		SELECT a.id, u.email, a.status, a.responses, a.submitted_at, a.created_at,
+		       a.accept_votes, a.reject_votes, a.waitlist_votes, a.confirmation_status,
+		       t.name, a.meal_group,
+		       (SELECT COALESCE(SUM(s.points), 0) FROM scans s
+		         WHERE s.hackathon_id = a.hackathon_id AND s.user_id = a.user_id) AS points,
+		       (SELECT MIN(s.scanned_at) FROM scans s
+		         WHERE s.hackathon_id = a.hackathon_id AND s.user_id = a.user_id AND s.scan_type = ANY($1)) AS checked_in_at
+		FROM applications a
+		INNER JOIN users u ON a.user_id = u.id
+		LEFT JOIN team_members tm ON tm.user_id = a.user_id AND tm.hackathon_id = a.hackathon_id
+		LEFT JOIN teams t ON t.id = tm.team_id
+		WHERE %s
+		ORDER BY %s DESC, a.id DESC`, filterClause, sortColumnName(sortBy))
+
+	args := append([]any{checkInTypes}, filterArgs...)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var row ApplicationExportRow
+	for rows.Next() {
+		row = ApplicationExportRow{}
+		if err := rows.Scan(
+			&row.ID, &row.Email, &row.Status, &row.Responses, &row.SubmittedAt, &row.CreatedAt,
+			&row.AcceptVotes, &row.RejectVotes, &row.WaitlistVotes, &row.ConfirmationStatus,
+			&row.TeamName, &row.MealGroup, &row.Points, &row.CheckedInAt,
+		); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
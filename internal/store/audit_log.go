@@ -0,0 +1,294 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AuditAction names a kind of audited mutation. Values are stable strings
+// stored in audit_events.action, so never rename one once it has shipped.
+type AuditAction string
+
+const (
+	AuditActionUserRoleUpdate           AuditAction = "user.role_update"
+	AuditActionApplicationStatusUpdate  AuditAction = "application.status_update"
+	AuditActionSettingUpdate            AuditAction = "setting.update"
+	AuditActionScanCreate               AuditAction = "scan.create"
+	AuditActionWalkInPromote            AuditAction = "walk_in.promote"
+	AuditActionHackathonReset           AuditAction = "hackathon.reset"
+	AuditActionHackathonCreate          AuditAction = "hackathon.create"
+	AuditActionHackathonActivate        AuditAction = "hackathon.activate"
+	AuditActionHackathonArchive         AuditAction = "hackathon.archive"
+	AuditActionHackathonRestore         AuditAction = "hackathon.restore"
+	AuditActionResumeBookCreate         AuditAction = "resume_book.create"
+	AuditActionSchemaResponsesRemap     AuditAction = "application_schema.responses_remap"
+	AuditActionApplicationDuplicateScan AuditAction = "application.duplicate_scan"
+	AuditActionApplicationBulkStatus    AuditAction = "application.bulk_status_update"
+	AuditActionApplicationAutoDecide    AuditAction = "application.auto_decide"
+	AuditActionPolicyCreate             AuditAction = "policy.create"
+	AuditActionPolicyUpdate             AuditAction = "policy.update"
+	AuditActionPolicyDelete             AuditAction = "policy.delete"
+)
+
+// Audit target types identify what TargetID refers to.
+const (
+	AuditTargetUser             = "user"
+	AuditTargetApplication      = "application"
+	AuditTargetSetting          = "setting"
+	AuditTargetWalkInQueue      = "walk_in_queue"
+	AuditTargetHackathon        = "hackathon"
+	AuditTargetHackathonArchive = "hackathon_archive"
+	AuditTargetResumeBook       = "resume_book"
+	AuditTargetSchemaVersion    = "application_schema_version"
+	AuditTargetPolicy           = "policy"
+)
+
+type AuditEvent struct {
+	ID         string          `json:"id"`
+	ActorID    *string         `json:"actor_id"`
+	ActorEmail string          `json:"actor_email"`
+	Action     AuditAction     `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditCursor represents pagination cursor for audit event listing
+type AuditCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func EncodeAuditCursor(createdAt time.Time, id string) string {
+	cursor := AuditCursor{CreatedAt: createdAt, ID: id}
+	data, _ := json.Marshal(cursor)
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+func DecodeAuditCursor(encoded string) (*AuditCursor, error) {
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor encoding")
+	}
+	var cursor AuditCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor format")
+	}
+	if cursor.ID == "" || cursor.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("invalid cursor: missing fields")
+	}
+	return &cursor, nil
+}
+
+// AuditEventFilters narrows an audit event listing. Zero values are ignored.
+type AuditEventFilters struct {
+	ActorID    string
+	Action     AuditAction
+	TargetType string
+	TargetID   string
+	Since      *time.Time
+	Until      *time.Time
+}
+
+// AuditEventListResult contains cursor-paginated audit events
+type AuditEventListResult struct {
+	Events     []AuditEvent `json:"events"`
+	NextCursor *string      `json:"next_cursor,omitempty"`
+	PrevCursor *string      `json:"prev_cursor,omitempty"`
+	HasMore    bool         `json:"has_more"`
+}
+
+type AuditLogStore struct {
+	db *sql.DB
+}
+
+// Record appends an event. ID and CreatedAt are filled in from the database.
+func (s *AuditLogStore) Record(ctx context.Context, event *AuditEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		INSERT INTO audit_events (actor_id, actor_email, action, target_type, target_id, before, after, request_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+
+	return s.db.QueryRowContext(ctx, query,
+		event.ActorID,
+		event.ActorEmail,
+		event.Action,
+		event.TargetType,
+		event.TargetID,
+		nullableJSON(event.Before),
+		nullableJSON(event.After),
+		event.RequestID,
+	).Scan(&event.ID, &event.CreatedAt)
+}
+
+// List returns audit events newest first with bidirectional cursor pagination.
+func (s *AuditLogStore) List(ctx context.Context, filters AuditEventFilters, cursor *AuditCursor, direction PaginationDirection, limit int) (*AuditEventListResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var conditions []string
+	var args []any
+	paramIdx := 1
+
+	if filters.ActorID != "" {
+		conditions = append(conditions, fmt.Sprintf("actor_id = $%d::uuid", paramIdx))
+		args = append(args, filters.ActorID)
+		paramIdx++
+	}
+
+	if filters.Action != "" {
+		conditions = append(conditions, fmt.Sprintf("action = $%d", paramIdx))
+		args = append(args, filters.Action)
+		paramIdx++
+	}
+
+	if filters.TargetType != "" {
+		conditions = append(conditions, fmt.Sprintf("target_type = $%d", paramIdx))
+		args = append(args, filters.TargetType)
+		paramIdx++
+	}
+
+	if filters.TargetID != "" {
+		conditions = append(conditions, fmt.Sprintf("target_id = $%d", paramIdx))
+		args = append(args, filters.TargetID)
+		paramIdx++
+	}
+
+	if filters.Since != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", paramIdx))
+		args = append(args, *filters.Since)
+		paramIdx++
+	}
+
+	if filters.Until != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", paramIdx))
+		args = append(args, *filters.Until)
+		paramIdx++
+	}
+
+	if cursor != nil {
+		if direction == DirectionBackward {
+			conditions = append(conditions, fmt.Sprintf(
+				"(created_at, id) > ($%d, $%d::uuid)",
+				paramIdx, paramIdx+1,
+			))
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"(created_at, id) < ($%d, $%d::uuid)",
+				paramIdx, paramIdx+1,
+			))
+		}
+		args = append(args, cursor.CreatedAt, cursor.ID)
+		paramIdx += 2
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	orderDir := "DESC"
+	if direction == DirectionBackward {
+		orderDir = "ASC"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, actor_id, actor_email, action, target_type, target_id, before, after, request_id, created_at
+		FROM audit_events
+		%s
+		ORDER BY created_at %s, id %s
+		LIMIT $%d
+	`, whereClause, orderDir, orderDir, paramIdx)
+
+	args = append(args, limit+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]AuditEvent, 0, limit+1)
+	for rows.Next() {
+		var e AuditEvent
+		var before, after []byte
+		if err := rows.Scan(
+			&e.ID, &e.ActorID, &e.ActorEmail, &e.Action, &e.TargetType, &e.TargetID,
+			&before, &after, &e.RequestID, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if before != nil {
+			e.Before = json.RawMessage(before)
+		}
+		if after != nil {
+			e.After = json.RawMessage(after)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	hasMore := len(events) > limit
+	if hasMore {
+		events = events[:limit]
+	}
+
+	if direction == DirectionBackward {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+		}
+	}
+
+	result := &AuditEventListResult{
+		Events:  events,
+		HasMore: hasMore,
+	}
+
+	if len(events) > 0 {
+		first, last := events[0], events[len(events)-1]
+		if direction == DirectionBackward {
+			nc := EncodeAuditCursor(last.CreatedAt, last.ID)
+			result.NextCursor = &nc
+
+			if hasMore {
+				pc := EncodeAuditCursor(first.CreatedAt, first.ID)
+				result.PrevCursor = &pc
+			}
+		} else {
+			if hasMore {
+				nc := EncodeAuditCursor(last.CreatedAt, last.ID)
+				result.NextCursor = &nc
+			}
+
+			if cursor != nil {
+				pc := EncodeAuditCursor(first.CreatedAt, first.ID)
+				result.PrevCursor = &pc
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// nullableJSON maps an empty payload to SQL NULL so "no before state" (a
+// creation) is distinguishable from an explicit JSON null.
+func nullableJSON(raw json.RawMessage) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	return string(raw)
+}
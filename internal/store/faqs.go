@@ -20,17 +20,20 @@ type FAQsStore struct {
 	db *sql.DB
 }
 
-func (s *FAQsStore) List(ctx context.Context) ([]FAQ, error) {
+// List returns a hackathon's FAQs in display order. A nil hackathonID reads
+// the active one.
+func (s *FAQsStore) List(ctx context.Context, hackathonID *string) ([]FAQ, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
 	query := `
 		SELECT id, question, answer, display_order, created_at, updated_at
 		FROM faqs
+		WHERE hackathon_id = COALESCE($1::uuid, active_hackathon_id())
 		ORDER BY display_order ASC
 	`
 
-	rows, err := s.db.QueryContext(ctx, query)
+	rows, err := s.db.QueryContext(ctx, query, hackathonID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +73,8 @@ func (s *FAQsStore) Create(ctx context.Context, faq *FAQ) error {
 	).Scan(&faq.ID, &faq.CreatedAt, &faq.UpdatedAt)
 }
 
+// Update writes faq if the row still has the updated_at the caller read, as
+// given by faq.UpdatedAt. Returns ErrStale otherwise.
 func (s *FAQsStore) Update(ctx context.Context, faq *FAQ) error {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
@@ -77,16 +82,17 @@ func (s *FAQsStore) Update(ctx context.Context, faq *FAQ) error {
 	query := `
 		UPDATE faqs
 		SET question = $1, answer = $2, display_order = $3
-		WHERE id = $4
+		WHERE id = $4 AND updated_at = $5
 		RETURNING created_at, updated_at
 	`
 
 	err := s.db.QueryRowContext(ctx, query,
 		faq.Question, faq.Answer, faq.DisplayOrder, faq.ID,
+		faq.UpdatedAt,
 	).Scan(&faq.CreatedAt, &faq.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return ErrNotFound
+			return staleOrMissing(ctx, s.db, "faqs", faq.ID)
 		}
 		return err
 	}
@@ -116,3 +122,28 @@ func (s *FAQsStore) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *FAQsStore) GetByID(ctx context.Context, id string) (*FAQ, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT id, question, answer, display_order, created_at, updated_at
+		FROM faqs
+		WHERE id = $1
+	`
+
+	var faq FAQ
+	err := s.db.QueryRowContext(ctx, query, id).Scan(
+		&faq.ID, &faq.Question, &faq.Answer, &faq.DisplayOrder,
+		&faq.CreatedAt, &faq.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &faq, nil
+}
@@ -3,12 +3,326 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
 )
 
+// Hackathon is one event cycle. Exactly one is active; the rest are archived
+// and stay readable for year-over-year comparison.
+type Hackathon struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	StartsOn   *string    `json:"starts_on"`
+	EndsOn     *string    `json:"ends_on"`
+	IsActive   bool       `json:"is_active"`
+	ArchivedAt *time.Time `json:"archived_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// HackathonStats summarizes one event for side-by-side comparison.
+// Applications excludes drafts; CheckedIn counts hackers with a scan of any
+// check-in type configured for that event.
+type HackathonStats struct {
+	HackathonID  string  `json:"hackathon_id"`
+	Name         string  `json:"name"`
+	StartsOn     *string `json:"starts_on"`
+	IsActive     bool    `json:"is_active"`
+	Applications int     `json:"applications"`
+	Accepted     int     `json:"accepted"`
+	Rejected     int     `json:"rejected"`
+	Waitlisted   int     `json:"waitlisted"`
+	Confirmed    int     `json:"confirmed"`
+	CheckedIn    int     `json:"checked_in"`
+	Teams        int     `json:"teams"`
+	Projects     int     `json:"projects"`
+	Sponsors     int     `json:"sponsors"`
+}
+
 type HackathonStore struct {
 	db *sql.DB
 }
 
+const hackathonColumns = `id, name, starts_on::text, ends_on::text, is_active, archived_at, created_at, updated_at`
+
+func scanHackathon(row interface{ Scan(dest ...any) error }, h *Hackathon) error {
+	return row.Scan(
+		&h.ID, &h.Name, &h.StartsOn, &h.EndsOn, &h.IsActive,
+		&h.ArchivedAt, &h.CreatedAt, &h.UpdatedAt,
+	)
+}
+
+// perCycleSettings are not carried over to a new event: they describe a
+// single cycle, or are rewritten by Create.
+var perCycleSettings = []string{
+	SettingsKeyHackathonName,
+	SettingsKeyHackathonDateRange,
+	SettingsKeyPointsName,
+	SettingsKeyHackerPackURL,
+	SettingsKeyJudgingConfig,
+	SettingsKeyScanTypes,
+	SettingsKeyScanStats,
+	SettingsKeyApplicationsEnabled,
+	SettingsKeyApplicationWindow,
+}
+
+// List returns every hackathon, newest first.
+func (s *HackathonStore) List(ctx context.Context) ([]Hackathon, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+hackathonColumns+`
+		FROM hackathons
+		ORDER BY starts_on DESC NULLS LAST, created_at DESC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hackathons := []Hackathon{}
+	for rows.Next() {
+		var h Hackathon
+		if err := scanHackathon(rows, &h); err != nil {
+			return nil, err
+		}
+		hackathons = append(hackathons, h)
+	}
+
+	return hackathons, rows.Err()
+}
+
+// GetActive returns the event the portal currently serves.
+func (s *HackathonStore) GetActive(ctx context.Context) (*Hackathon, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var h Hackathon
+	err := scanHackathon(s.db.QueryRowContext(ctx, `SELECT `+hackathonColumns+` FROM hackathons WHERE is_active`), &h)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// Create adds an inactive hackathon. Its settings start as a copy of the
+// active event's, minus the per-cycle ones: scan types go back to the
+// defaults and applications start closed.
+func (s *HackathonStore) Create(ctx context.Context, h *Hackathon) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = scanHackathon(tx.QueryRowContext(ctx, `
+		INSERT INTO hackathons (name, starts_on, ends_on)
+		VALUES ($1, $2::date, $3::date)
+		RETURNING `+hackathonColumns,
+		h.Name, h.StartsOn, h.EndsOn,
+	), h)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO settings (hackathon_id, key, value)
+		SELECT $1, key, value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND NOT (key = ANY($2))
+	`, h.ID, perCycleSettings); err != nil {
+		return err
+	}
+
+	// The copied schema starts the new event's version history.
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO application_schema_versions (hackathon_id, version, fields)
+		SELECT hackathon_id, 1, value
+		FROM settings
+		WHERE hackathon_id = $1 AND key = $2
+	`, h.ID, SettingsKeyApplicationSchema); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO settings (hackathon_id, key, value) VALUES
+			($1, $2, to_jsonb($3::text)),
+			($1, $4, jsonb_build_object('start_date', $5::text, 'end_date', $6::text)),
+			($1, $7, $8::jsonb),
+			($1, $9, '{}'::jsonb),
+			($1, $10, 'false'::jsonb)
+	`, h.ID,
+		SettingsKeyHackathonName, h.Name,
+		SettingsKeyHackathonDateRange, h.StartsOn, h.EndsOn,
+		SettingsKeyScanTypes, DefaultScanTypes,
+		SettingsKeyScanStats,
+		SettingsKeyApplicationsEnabled,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Activate makes id the active hackathon and archives the one it replaces.
+// Returns ErrNotFound for an unknown id.
+func (s *HackathonStore) Activate(ctx context.Context, id string) (*Hackathon, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM hackathons WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	// The unique index on is_active serializes concurrent switches: the
+	// second one fails instead of leaving two events active.
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE hackathons
+		SET is_active = false, archived_at = NOW()
+		WHERE is_active AND id <> $1
+	`, id); err != nil {
+		return nil, err
+	}
+
+	var h Hackathon
+	err = scanHackathon(tx.QueryRowContext(ctx, `
+		UPDATE hackathons
+		SET is_active = true, archived_at = NULL
+		WHERE id = $1
+		RETURNING `+hackathonColumns, id), &h)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// Stats returns headline numbers for every hackathon, newest first.
+func (s *HackathonStore) Stats(ctx context.Context) ([]HackathonStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT h.id, h.name, h.starts_on::text, h.is_active,
+		       a.applications, a.accepted, a.rejected, a.waitlisted, a.confirmed,
+		       c.checked_in,
+		       (SELECT COUNT(*) FROM teams t WHERE t.hackathon_id = h.id)::int,
+		       (SELECT COUNT(*) FROM projects p
+		         JOIN teams t ON t.id = p.team_id
+		         WHERE t.hackathon_id = h.id)::int,
+		       (SELECT COUNT(*) FROM sponsors sp WHERE sp.hackathon_id = h.id)::int
+		FROM hackathons h
+		CROSS JOIN LATERAL (
+			SELECT
+				COUNT(*) FILTER (WHERE status <> 'draft')::int AS applications,
+				COUNT(*) FILTER (WHERE status = 'accepted')::int AS accepted,
+				COUNT(*) FILTER (WHERE status = 'rejected')::int AS rejected,
+				COUNT(*) FILTER (WHERE status = 'waitlisted')::int AS waitlisted,
+				COUNT(*) FILTER (WHERE confirmation_status = 'confirmed')::int AS confirmed
+			FROM applications
+			WHERE hackathon_id = h.id
+		) a
+		CROSS JOIN LATERAL (
+			SELECT COUNT(DISTINCT sc.user_id)::int AS checked_in
+			FROM scans sc
+			WHERE sc.hackathon_id = h.id
+			  AND sc.scan_type IN (
+			      SELECT st->>'name'
+			      FROM settings cfg
+			      CROSS JOIN jsonb_array_elements(cfg.value) AS st
+			      WHERE cfg.hackathon_id = h.id
+			        AND cfg.key = $1
+			        AND st->>'category' = $2
+			  )
+		) c
+		ORDER BY h.starts_on DESC NULLS LAST, h.created_at DESC
+	`, SettingsKeyScanTypes, string(ScanCategoryCheckIn))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []HackathonStats{}
+	for rows.Next() {
+		var st HackathonStats
+		if err := rows.Scan(
+			&st.HackathonID, &st.Name, &st.StartsOn, &st.IsActive,
+			&st.Applications, &st.Accepted, &st.Rejected, &st.Waitlisted, &st.Confirmed,
+			&st.CheckedIn, &st.Teams, &st.Projects, &st.Sponsors,
+		); err != nil {
+			return nil, err
+		}
+		stats = append(stats, st)
+	}
+
+	return stats, rows.Err()
+}
+
+// Settings returns every setting stored for the hackathon, active or
+// archived, keyed by setting name. Returns ErrNotFound for an unknown
+// hackathon.
+func (s *HackathonStore) Settings(ctx context.Context, id string) (map[string]json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM hackathons WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT key, value FROM settings
+		WHERE hackathon_id = $1
+		ORDER BY key
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	settings := map[string]json.RawMessage{}
+	for rows.Next() {
+		var key string
+		var value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+		settings[key] = value
+	}
+
+	return settings, rows.Err()
+}
+
 // ResetOptions selects which domains of hackathon data a reset clears.
 type ResetOptions struct {
 	Applications  bool
@@ -28,8 +342,10 @@ func (o ResetOptions) Any() bool {
 		o.Notifications || o.Settings || o.Sponsors || o.FAQs || o.Config
 }
 
-// Reset resets the selected domains of hackathon data in a single transaction.
-// Returns a list of resume paths that should be deleted from storage if applications were reset.
+// Reset resets the selected domains of the active hackathon's data in a
+// single transaction. Archived events are left untouched.
+// Returns the resume and application file paths that should be deleted from
+// storage if applications were reset.
 func (s *HackathonStore) Reset(ctx context.Context, opts ResetOptions) ([]string, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2) // Longer timeout for bulk operations
 	defer cancel()
@@ -43,24 +359,43 @@ func (s *HackathonStore) Reset(ctx context.Context, opts ResetOptions) ([]string
 	var resumePaths []string
 
 	if opts.Applications {
-		// Collect resume paths before truncation
+		// Collect resume and uploaded file paths before the rows go
 		resumePaths, err = collectResumePaths(ctx, tx)
 		if err != nil {
 			return nil, err
 		}
+		filePaths, err := collectApplicationFilePaths(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		resumePaths = append(resumePaths, filePaths...)
+
+		// Deleting applications cascades to application_reviews. walk_ins is
+		// cleared explicitly: it references users rather than applications, so
+		// nothing cascades to it, yet every walk-in row owns the waitlisted
+		// application it created. Leaving the queue behind would orphan those
+		// rows and permanently block re-queuing, since Enqueue inserts
+		// ON CONFLICT (hackathon_id, user_id) DO NOTHING. Teams are formed per
+		// event, so they go with the applications; the cascade clears their
+		// members, invites, projects and judging scores.
+		for _, table := range []string{"applications", "walk_ins", "teams"} {
+			if err := deleteActiveRows(ctx, tx, table); err != nil {
+				return nil, err
+			}
+		}
 
-		// CASCADE picks up application_reviews. walk_ins is listed explicitly:
-		// it references users rather than applications, so nothing cascades to
-		// it, yet every walk-in row owns the waitlisted application it created.
-		// Leaving the queue behind would orphan those rows and permanently
-		// block re-queuing, since Enqueue inserts ON CONFLICT (user_id) DO NOTHING.
-		if _, err := tx.ExecContext(ctx, "TRUNCATE TABLE applications, walk_ins CASCADE"); err != nil {
+		// Signatures belong to the hackers being cleared; the policies
+		// themselves stay for the next round of applicants.
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM policy_signatures
+			WHERE policy_id IN (SELECT id FROM policies WHERE hackathon_id = active_hackathon_id())
+		`); err != nil {
 			return nil, err
 		}
 	}
 
 	if opts.Scans {
-		if _, err := tx.ExecContext(ctx, "TRUNCATE TABLE scans"); err != nil {
+		if err := deleteActiveRows(ctx, tx, "scans"); err != nil {
 			return nil, err
 		}
 	}
@@ -72,28 +407,28 @@ func (s *HackathonStore) Reset(ctx context.Context, opts ResetOptions) ([]string
 	}
 
 	if opts.Schedule {
-		// DELETE, not TRUNCATE: scheduled_notifications.schedule_id references
-		// schedule, and only DELETE fires its ON DELETE CASCADE.
-		if _, err := tx.ExecContext(ctx, "DELETE FROM schedule"); err != nil {
+		// Deleting schedule rows also removes their reminders through
+		// scheduled_notifications.schedule_id ON DELETE CASCADE.
+		if err := deleteActiveRows(ctx, tx, "schedule"); err != nil {
 			return nil, err
 		}
 	}
 
 	if opts.Notifications {
-		if _, err := tx.ExecContext(ctx, "TRUNCATE TABLE scheduled_notifications"); err != nil {
+		if err := deleteActiveRows(ctx, tx, "scheduled_notifications"); err != nil {
 			return nil, err
 		}
 	}
 
 	if opts.Sponsors {
 		// Logos live in the logo_data column as base64, so they go with the row.
-		if _, err := tx.ExecContext(ctx, "TRUNCATE TABLE sponsors"); err != nil {
+		if err := deleteActiveRows(ctx, tx, "sponsors"); err != nil {
 			return nil, err
 		}
 	}
 
 	if opts.FAQs {
-		if _, err := tx.ExecContext(ctx, "TRUNCATE TABLE faqs"); err != nil {
+		if err := deleteActiveRows(ctx, tx, "faqs"); err != nil {
 			return nil, err
 		}
 	}
@@ -130,7 +465,25 @@ func (s *HackathonStore) Reset(ctx context.Context, opts ResetOptions) ([]string
 // collectResumePaths reads every non-empty resume path so the objects can be
 // removed from storage once the rows pointing at them are gone.
 func collectResumePaths(ctx context.Context, tx *sql.Tx) ([]string, error) {
-	rows, err := tx.QueryContext(ctx, "SELECT resume_path FROM applications WHERE resume_path IS NOT NULL AND resume_path <> ''")
+	return collectPaths(ctx, tx, `
+		SELECT resume_path FROM applications
+		WHERE hackathon_id = active_hackathon_id()
+		  AND resume_path IS NOT NULL AND resume_path <> ''`)
+}
+
+// collectApplicationFilePaths reads the uploads answering file fields, which
+// are stored as object paths among the responses.
+func collectApplicationFilePaths(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	return collectPaths(ctx, tx, `
+		SELECT r.value FROM applications a
+		CROSS JOIN LATERAL jsonb_each_text(a.responses) r
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND r.value LIKE 'application-files/%'`)
+}
+
+// collectPaths scans the single text column query returns.
+func collectPaths(ctx context.Context, tx *sql.Tx, query string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -147,3 +500,10 @@ func collectResumePaths(ctx context.Context, tx *sql.Tx) ([]string, error) {
 
 	return paths, rows.Err()
 }
+
+// deleteActiveRows removes the active hackathon's rows from table. table is
+// always one of Reset's literals, never user input.
+func deleteActiveRows(ctx context.Context, tx *sql.Tx, table string) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE hackathon_id = active_hackathon_id()")
+	return err
+}
@@ -0,0 +1,514 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// HackathonArchiveVersion is bumped whenever the shape of an archive changes,
+// so restore can refuse bundles it does not know how to read. Version 2 adds
+// status events, flags, schema versions, policies and saved views; version 3
+// adds teams and their projects, the walk-in queue, FAQs and notifications,
+// so the archive holds everything Reset can delete.
+const HackathonArchiveVersion = 3
+
+// HackathonArchive is a point-in-time copy of the active hackathon's data.
+// Each table holds its rows exactly as row_to_json renders them, so the bundle
+// follows the schema without a Go type per table.
+type HackathonArchive struct {
+	Version   int                          `json:"version"`
+	Hackathon Hackathon                    `json:"hackathon"`
+	CreatedAt time.Time                    `json:"created_at"`
+	Tables    map[string][]json.RawMessage `json:"tables"`
+}
+
+type archiveTable struct {
+	name     string
+	snapshot string
+	restore  string
+}
+
+// archiveTables is in restore order: users first, since every other table
+// references them, applications before the rows hanging off them, teams
+// before their members, invites and projects, and policies before their
+// versions and signatures. It covers every table Reset can delete, so an
+// archived reset loses nothing. Only the users the archived rows point at are
+// kept.
+var archiveTables = []archiveTable{
+	{
+		name: "users",
+		snapshot: `
+			SELECT row_to_json(u) FROM users u
+			WHERE u.id IN (
+				SELECT user_id FROM applications WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT r.admin_id FROM application_reviews r
+				JOIN applications a ON a.id = r.application_id
+				WHERE a.hackathon_id = active_hackathon_id()
+				UNION
+				SELECT user_id FROM scans WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT scanned_by FROM scans WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT e.actor_id FROM application_status_events e
+				JOIN applications a ON a.id = e.application_id
+				WHERE a.hackathon_id = active_hackathon_id()
+				UNION
+				SELECT created_by FROM application_schema_versions WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT pv.created_by FROM policy_versions pv
+				JOIN policies p ON p.id = pv.policy_id
+				WHERE p.hackathon_id = active_hackathon_id()
+				UNION
+				SELECT ps.user_id FROM policy_signatures ps
+				JOIN policies p ON p.id = ps.policy_id
+				WHERE p.hackathon_id = active_hackathon_id()
+				UNION
+				SELECT owner_id FROM saved_views
+				UNION
+				SELECT created_by FROM teams WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT user_id FROM team_members WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT i.invited_by FROM team_invites i
+				JOIN teams t ON t.id = i.team_id
+				WHERE t.hackathon_id = active_hackathon_id()
+				UNION
+				SELECT p.submitted_by FROM projects p
+				JOIN teams t ON t.id = p.team_id
+				WHERE t.hackathon_id = active_hackathon_id()
+				UNION
+				SELECT j.judge_id FROM project_judgings j
+				JOIN projects p ON p.id = j.project_id
+				JOIN teams t ON t.id = p.team_id
+				WHERE t.hackathon_id = active_hackathon_id()
+				UNION
+				SELECT user_id FROM walk_ins WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT promoted_by FROM walk_ins WHERE hackathon_id = active_hackathon_id()
+				UNION
+				SELECT created_by FROM scheduled_notifications WHERE hackathon_id = active_hackathon_id()
+			)
+			ORDER BY u.created_at`,
+		// Accounts outlive events, so a user who is still around is kept as is.
+		restore: `
+			INSERT INTO users
+			SELECT (jsonb_populate_record(NULL::users, r)).*
+			FROM jsonb_array_elements($1::jsonb) r
+			ON CONFLICT DO NOTHING`,
+	},
+	{
+		name: "settings",
+		snapshot: `
+			SELECT row_to_json(s) FROM settings s
+			WHERE s.hackathon_id = active_hackathon_id()
+			ORDER BY s.key`,
+		// Every event already has its own settings rows, so archived values
+		// overwrite them by key.
+		restore: `
+			INSERT INTO settings (key, value)
+			SELECT r->>'key', r->'value'
+			FROM jsonb_array_elements($1::jsonb) r
+			ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value`,
+	},
+	{
+		name: "application_schema_versions",
+		snapshot: `
+			SELECT row_to_json(v) FROM application_schema_versions v
+			WHERE v.hackathon_id = active_hackathon_id()
+			ORDER BY v.version`,
+		// Like settings, the archived form history replaces the active
+		// event's, so each restored application finds the version it was
+		// keyed against.
+		restore: `
+			INSERT INTO application_schema_versions
+			SELECT (jsonb_populate_record(NULL::application_schema_versions, r || jsonb_build_object('hackathon_id', active_hackathon_id()))).*
+			FROM jsonb_array_elements($1::jsonb) r
+			ON CONFLICT (hackathon_id, version) DO UPDATE
+			SET fields = EXCLUDED.fields, created_by = EXCLUDED.created_by, created_at = EXCLUDED.created_at`,
+	},
+	{
+		name: "applications",
+		snapshot: `
+			SELECT row_to_json(a) FROM applications a
+			WHERE a.hackathon_id = active_hackathon_id()
+			ORDER BY a.created_at`,
+		restore: restoreIntoActive("applications"),
+	},
+	{
+		name: "application_reviews",
+		snapshot: `
+			SELECT row_to_json(r) FROM application_reviews r
+			JOIN applications a ON a.id = r.application_id
+			WHERE a.hackathon_id = active_hackathon_id()
+			ORDER BY r.created_at`,
+		restore: restoreRows("application_reviews"),
+	},
+	{
+		name: "application_status_events",
+		snapshot: `
+			SELECT row_to_json(e) FROM application_status_events e
+			JOIN applications a ON a.id = e.application_id
+			WHERE a.hackathon_id = active_hackathon_id()
+			ORDER BY e.changed_at`,
+		restore: restoreRows("application_status_events"),
+	},
+	{
+		name: "application_flags",
+		snapshot: `
+			SELECT row_to_json(f) FROM application_flags f
+			JOIN applications a ON a.id = f.application_id
+			WHERE a.hackathon_id = active_hackathon_id()
+			ORDER BY f.created_at`,
+		restore: restoreRows("application_flags"),
+	},
+	{
+		name: "application_resume_hashes",
+		snapshot: `
+			SELECT row_to_json(h) FROM application_resume_hashes h
+			JOIN applications a ON a.id = h.application_id
+			WHERE a.hackathon_id = active_hackathon_id()
+			ORDER BY h.computed_at`,
+		restore: restoreRows("application_resume_hashes"),
+	},
+	{
+		name: "walk_ins",
+		snapshot: `
+			SELECT row_to_json(w) FROM walk_ins w
+			WHERE w.hackathon_id = active_hackathon_id()
+			ORDER BY w.queued_at`,
+		restore: restoreIntoActive("walk_ins"),
+	},
+	{
+		name: "teams",
+		snapshot: `
+			SELECT row_to_json(t) FROM teams t
+			WHERE t.hackathon_id = active_hackathon_id()
+			ORDER BY t.created_at`,
+		restore: restoreIntoActive("teams"),
+	},
+	{
+		name: "team_members",
+		snapshot: `
+			SELECT row_to_json(m) FROM team_members m
+			WHERE m.hackathon_id = active_hackathon_id()
+			ORDER BY m.joined_at`,
+		restore: restoreIntoActive("team_members"),
+	},
+	{
+		name: "team_invites",
+		snapshot: `
+			SELECT row_to_json(i) FROM team_invites i
+			JOIN teams t ON t.id = i.team_id
+			WHERE t.hackathon_id = active_hackathon_id()
+			ORDER BY i.created_at`,
+		restore: restoreRows("team_invites"),
+	},
+	{
+		name: "projects",
+		snapshot: `
+			SELECT row_to_json(p) FROM projects p
+			JOIN teams t ON t.id = p.team_id
+			WHERE t.hackathon_id = active_hackathon_id()
+			ORDER BY p.created_at`,
+		restore: restoreRows("projects"),
+	},
+	{
+		name: "project_judgings",
+		snapshot: `
+			SELECT row_to_json(j) FROM project_judgings j
+			JOIN projects p ON p.id = j.project_id
+			JOIN teams t ON t.id = p.team_id
+			WHERE t.hackathon_id = active_hackathon_id()
+			ORDER BY j.assigned_at`,
+		restore: restoreRows("project_judgings"),
+	},
+	{
+		name: "scans",
+		snapshot: `
+			SELECT row_to_json(s) FROM scans s
+			WHERE s.hackathon_id = active_hackathon_id()
+			ORDER BY s.scanned_at`,
+		restore: restoreIntoActive("scans"),
+	},
+	{
+		name: "schedule",
+		snapshot: `
+			SELECT row_to_json(s) FROM schedule s
+			WHERE s.hackathon_id = active_hackathon_id()
+			ORDER BY s.start_time`,
+		restore: restoreIntoActive("schedule"),
+	},
+	// Reminders point at their schedule rows, so they come after them.
+	{
+		name: "scheduled_notifications",
+		snapshot: `
+			SELECT row_to_json(n) FROM scheduled_notifications n
+			WHERE n.hackathon_id = active_hackathon_id()
+			ORDER BY n.scheduled_at`,
+		restore: restoreIntoActive("scheduled_notifications"),
+	},
+	{
+		name: "faqs",
+		snapshot: `
+			SELECT row_to_json(f) FROM faqs f
+			WHERE f.hackathon_id = active_hackathon_id()
+			ORDER BY f.display_order`,
+		restore: restoreIntoActive("faqs"),
+	},
+	{
+		name: "sponsors",
+		snapshot: `
+			SELECT row_to_json(s) FROM sponsors s
+			WHERE s.hackathon_id = active_hackathon_id()
+			ORDER BY s.display_order`,
+		restore: restoreIntoActive("sponsors"),
+	},
+	// A reset keeps policies for the next round of applicants, so policies
+	// and their text may already be there; only their signatures are gone.
+	{
+		name: "policies",
+		snapshot: `
+			SELECT row_to_json(p) FROM policies p
+			WHERE p.hackathon_id = active_hackathon_id()
+			ORDER BY p.display_order, p.created_at`,
+		restore: restoreIntoActive("policies") + `
+		ON CONFLICT DO NOTHING`,
+	},
+	{
+		name: "policy_versions",
+		snapshot: `
+			SELECT row_to_json(v) FROM policy_versions v
+			JOIN policies p ON p.id = v.policy_id
+			WHERE p.hackathon_id = active_hackathon_id()
+			ORDER BY v.policy_id, v.version`,
+		restore: restoreRows("policy_versions") + `
+		ON CONFLICT DO NOTHING`,
+	},
+	// Signing needs no application, so a hacker may have signed again since
+	// the reset; their newer signature is kept.
+	{
+		name: "policy_signatures",
+		snapshot: `
+			SELECT row_to_json(s) FROM policy_signatures s
+			JOIN policies p ON p.id = s.policy_id
+			WHERE p.hackathon_id = active_hackathon_id()
+			ORDER BY s.signed_at`,
+		restore: restoreRows("policy_signatures") + `
+		ON CONFLICT DO NOTHING`,
+	},
+	// Saved views belong to admins rather than an event, so all of them are
+	// kept and any that survived are left as they are.
+	{
+		name: "saved_views",
+		snapshot: `
+			SELECT row_to_json(v) FROM saved_views v
+			ORDER BY v.created_at`,
+		restore: restoreRows("saved_views") + `
+		ON CONFLICT DO NOTHING`,
+	},
+}
+
+// restoreRows inserts archived rows as they are, with their original IDs.
+func restoreRows(table string) string {
+	return `
+		INSERT INTO ` + table + `
+		SELECT (jsonb_populate_record(NULL::` + table + `, r)).*
+		FROM jsonb_array_elements($1::jsonb) r`
+}
+
+// restoreIntoActive inserts archived rows with their original IDs, moved into
+// the active hackathon whichever event they were taken from.
+func restoreIntoActive(table string) string {
+	return `
+		INSERT INTO ` + table + `
+		SELECT (jsonb_populate_record(NULL::` + table + `, r || jsonb_build_object('hackathon_id', active_hackathon_id()))).*
+		FROM jsonb_array_elements($1::jsonb) r`
+}
+
+// Snapshot copies the active hackathon's archivable tables. The reads share
+// one repeatable-read transaction, so the tables agree with each other even
+// while the portal keeps taking writes.
+func (s *HackathonStore) Snapshot(ctx context.Context) (*HackathonArchive, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	archive := &HackathonArchive{
+		Version:   HackathonArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+		Tables:    make(map[string][]json.RawMessage, len(archiveTables)),
+	}
+
+	query := `SELECT ` + hackathonColumns + ` FROM hackathons WHERE is_active`
+	if err := scanHackathon(tx.QueryRowContext(ctx, query), &archive.Hackathon); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	for _, table := range archiveTables {
+		rows, err := snapshotTable(ctx, tx, table.snapshot)
+		if err != nil {
+			return nil, err
+		}
+		archive.Tables[table.name] = rows
+	}
+
+	return archive, nil
+}
+
+func snapshotTable(ctx context.Context, tx *sql.Tx, query string) ([]json.RawMessage, error) {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []json.RawMessage{}
+	for rows.Next() {
+		var row []byte
+		if err := rows.Scan(&row); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+
+	return out, rows.Err()
+}
+
+// UserEmailConflictError is returned instead of restoring an archive whose
+// users have since registered again under a new ID. Keeping the current
+// account would leave the archived rows pointing at a user that is gone.
+type UserEmailConflictError struct {
+	Emails []string
+}
+
+func (e *UserEmailConflictError) Error() string {
+	return "archived users have registered again under a new account: " + strings.Join(e.Emails, ", ")
+}
+
+// Restore loads an archive into the active hackathon in a single transaction
+// and returns how many rows each table received; users that already exist are
+// not counted. It only restores into an event that has none of the archived
+// event data yet (applications, walk-ins, teams, scans, schedule,
+// notifications, sponsors or FAQs), returning ErrConflict otherwise, so an
+// archive never mixes with live data. Rows keep their original IDs; restoring
+// an archive whose rows are still in the database is also ErrConflict. An
+// archived user whose email now belongs to a different account is a
+// UserEmailConflictError.
+func (s *HackathonStore) Restore(ctx context.Context, archive *HackathonArchive) (map[string]int, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var hasData bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM applications WHERE hackathon_id = active_hackathon_id())
+			OR EXISTS (SELECT 1 FROM walk_ins WHERE hackathon_id = active_hackathon_id())
+			OR EXISTS (SELECT 1 FROM teams WHERE hackathon_id = active_hackathon_id())
+			OR EXISTS (SELECT 1 FROM scans WHERE hackathon_id = active_hackathon_id())
+			OR EXISTS (SELECT 1 FROM schedule WHERE hackathon_id = active_hackathon_id())
+			OR EXISTS (SELECT 1 FROM scheduled_notifications WHERE hackathon_id = active_hackathon_id())
+			OR EXISTS (SELECT 1 FROM sponsors WHERE hackathon_id = active_hackathon_id())
+			OR EXISTS (SELECT 1 FROM faqs WHERE hackathon_id = active_hackathon_id())`,
+	).Scan(&hasData)
+	if err != nil {
+		return nil, err
+	}
+	if hasData {
+		return nil, ErrConflict
+	}
+
+	if err := checkArchivedUserEmails(ctx, tx, archive.Tables["users"]); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(archiveTables))
+	for _, table := range archiveTables {
+		rows := archive.Tables[table.name]
+		if len(rows) == 0 {
+			counts[table.name] = 0
+			continue
+		}
+
+		payload, err := json.Marshal(rows)
+		if err != nil {
+			return nil, err
+		}
+		res, err := tx.ExecContext(ctx, table.restore, string(payload))
+		if err != nil {
+			if isUniqueViolation(err) {
+				return nil, ErrConflict
+			}
+			return nil, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		counts[table.name] = int(n)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// checkArchivedUserEmails refuses archived users whose email is now taken by
+// another account. The users restore skips rows that conflict, so otherwise
+// the rows pointing at them would fail their foreign keys.
+func checkArchivedUserEmails(ctx context.Context, tx *sql.Tx, users []json.RawMessage) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(users)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT u.email FROM jsonb_array_elements($1::jsonb) r
+		JOIN users u ON u.email = (r->>'email')::citext
+		WHERE u.id::text <> r->>'id'
+		ORDER BY u.email`, string(payload))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return err
+		}
+		emails = append(emails, email)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(emails) > 0 {
+		return &UserEmailConflictError{Emails: emails}
+	}
+	return nil
+}
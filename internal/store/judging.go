@@ -0,0 +1,337 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ProjectJudging is a judge's assignment to a project. Scores stays nil
+// until the judge submits them.
+type ProjectJudging struct {
+	ID         string         `json:"id"`
+	ProjectID  string         `json:"project_id"`
+	JudgeID    string         `json:"judge_id"`
+	Scores     map[string]int `json:"scores"`
+	Notes      *string        `json:"notes"`
+	AssignedAt time.Time      `json:"assigned_at"`
+	ScoredAt   *time.Time     `json:"scored_at"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+// ProjectJudgingWithDetails includes the project fields a judge needs
+type ProjectJudgingWithDetails struct {
+	ProjectJudging
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	TeamName    string      `json:"team_name"`
+	RepoURL     *string     `json:"repo_url"`
+	DemoURL     *string     `json:"demo_url"`
+	Tracks      StringArray `json:"tracks"`
+}
+
+// JudgingScore is one judge's submitted scores for one project
+type JudgingScore struct {
+	ProjectID string         `json:"project_id"`
+	JudgeID   string         `json:"judge_id"`
+	Scores    map[string]int `json:"scores"`
+}
+
+// JudgingAssignmentResult reports how many judge assignments a batch created
+type JudgingAssignmentResult struct {
+	AssignmentsCreated int `json:"assignments_created"`
+}
+
+// JudgingStore handles database operations for project judging
+type JudgingStore struct {
+	db *sql.DB
+}
+
+// BatchAssign assigns judges to projects that have fewer than judgesPerProject
+// judges. Admins and super admins judge; those with the fewest unscored
+// projects are assigned first, and nobody judges their own team.
+func (s *JudgingStore) BatchAssign(ctx context.Context, judgesPerProject int) (*JudgingAssignmentResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	judgesQuery := `
+		SELECT u.id
+		FROM users u
+		LEFT JOIN project_judgings pj
+			ON u.id = pj.judge_id AND pj.scored_at IS NULL
+		WHERE u.role IN ('admin', 'super_admin')
+		GROUP BY u.id, u.created_at
+		ORDER BY COUNT(pj.id) ASC, u.created_at ASC
+	`
+
+	judgeRows, err := tx.QueryContext(ctx, judgesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer judgeRows.Close()
+
+	var judgeIDs []string
+	for judgeRows.Next() {
+		var id string
+		if err := judgeRows.Scan(&id); err != nil {
+			return nil, err
+		}
+		judgeIDs = append(judgeIDs, id)
+	}
+	if err := judgeRows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(judgeIDs) == 0 {
+		return &JudgingAssignmentResult{}, nil
+	}
+
+	// Lock the projects being topped up so a concurrent run cannot assign
+	// the same slots twice.
+	projectsQuery := `
+		SELECT p.id, (SELECT COUNT(*) FROM project_judgings pj WHERE pj.project_id = p.id) AS judges
+		FROM projects p
+		JOIN teams t ON t.id = p.team_id
+		WHERE t.hackathon_id = active_hackathon_id()
+		  AND (SELECT COUNT(*) FROM project_judgings pj WHERE pj.project_id = p.id) < $1
+		ORDER BY judges ASC, p.created_at ASC
+		FOR UPDATE OF p
+	`
+
+	projectRows, err := tx.QueryContext(ctx, projectsQuery, judgesPerProject)
+	if err != nil {
+		return nil, err
+	}
+	defer projectRows.Close()
+
+	type projectInfo struct {
+		ID     string
+		Judges int
+	}
+
+	var projects []projectInfo
+	for projectRows.Next() {
+		var p projectInfo
+		if err := projectRows.Scan(&p.ID, &p.Judges); err != nil {
+			return nil, err
+		}
+		projects = append(projects, p)
+	}
+	if err := projectRows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(projects) == 0 {
+		return &JudgingAssignmentResult{}, nil
+	}
+
+	// Pairs that must be skipped: existing assignments and judges who are
+	// members of the project's team.
+	excludedQuery := `
+		SELECT project_id, judge_id FROM project_judgings
+		UNION
+		SELECT p.id, tm.user_id
+		FROM projects p
+		JOIN team_members tm ON tm.team_id = p.team_id
+	`
+
+	excludedRows, err := tx.QueryContext(ctx, excludedQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer excludedRows.Close()
+
+	excluded := make(map[[2]string]bool)
+	for excludedRows.Next() {
+		var projectID, judgeID string
+		if err := excludedRows.Scan(&projectID, &judgeID); err != nil {
+			return nil, err
+		}
+		excluded[[2]string{projectID, judgeID}] = true
+	}
+	if err := excludedRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Round-robin over the judges, same as application review assignment.
+	var pairProjectIDs []string
+	var pairJudgeIDs []string
+	judgeIndex := 0
+
+	for _, p := range projects {
+		needed := judgesPerProject - p.Judges
+
+		for range needed {
+			for range judgeIDs {
+				judgeID := judgeIDs[judgeIndex]
+				judgeIndex = (judgeIndex + 1) % len(judgeIDs)
+
+				key := [2]string{p.ID, judgeID}
+				if excluded[key] {
+					continue
+				}
+
+				excluded[key] = true
+				pairProjectIDs = append(pairProjectIDs, p.ID)
+				pairJudgeIDs = append(pairJudgeIDs, judgeID)
+				break
+			}
+		}
+	}
+
+	created := 0
+	if len(pairProjectIDs) > 0 {
+		insertQuery := `
+			INSERT INTO project_judgings (project_id, judge_id)
+			SELECT * FROM unnest($1::uuid[], $2::uuid[])
+			ON CONFLICT (project_id, judge_id) DO NOTHING
+		`
+
+		result, err := tx.ExecContext(ctx, insertQuery, pairProjectIDs, pairJudgeIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		created = int(rowsAffected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &JudgingAssignmentResult{AssignmentsCreated: created}, nil
+}
+
+// GetByJudgeID returns every project assigned to a judge, unscored first,
+// including project details for display
+func (s *JudgingStore) GetByJudgeID(ctx context.Context, judgeID string) ([]ProjectJudgingWithDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT
+			pj.id, pj.project_id, pj.judge_id, pj.scores, pj.notes,
+			pj.assigned_at, pj.scored_at, pj.created_at, pj.updated_at,
+			p.title, p.description, t.name, p.repo_url, p.demo_url, p.tracks
+		FROM project_judgings pj
+		JOIN projects p ON p.id = pj.project_id
+		JOIN teams t ON t.id = p.team_id
+		WHERE pj.judge_id = $1 AND t.hackathon_id = active_hackathon_id()
+		ORDER BY pj.scored_at IS NOT NULL, pj.assigned_at ASC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, judgeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	judgings := []ProjectJudgingWithDetails{}
+	for rows.Next() {
+		var j ProjectJudgingWithDetails
+		var scores []byte
+		if err := rows.Scan(
+			&j.ID, &j.ProjectID, &j.JudgeID, &scores, &j.Notes,
+			&j.AssignedAt, &j.ScoredAt, &j.CreatedAt, &j.UpdatedAt,
+			&j.Title, &j.Description, &j.TeamName, &j.RepoURL, &j.DemoURL, &j.Tracks,
+		); err != nil {
+			return nil, err
+		}
+		if err := unmarshalScores(scores, &j.Scores); err != nil {
+			return nil, err
+		}
+		judgings = append(judgings, j)
+	}
+
+	return judgings, rows.Err()
+}
+
+// SubmitScores records a judge's scores for an assignment. Judges may revise
+// their scores; ErrNotFound is returned if the assignment is not theirs.
+func (s *JudgingStore) SubmitScores(ctx context.Context, judgingID, judgeID string, scores map[string]int, notes *string) (*ProjectJudging, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	scoresJSON, err := json.Marshal(scores)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		UPDATE project_judgings
+		SET scores = $3, notes = $4, scored_at = NOW()
+		WHERE id = $1 AND judge_id = $2
+		RETURNING id, project_id, judge_id, scores, notes, assigned_at, scored_at, created_at, updated_at
+	`
+
+	var j ProjectJudging
+	var raw []byte
+	err = s.db.QueryRowContext(ctx, query, judgingID, judgeID, string(scoresJSON), notes).Scan(
+		&j.ID, &j.ProjectID, &j.JudgeID, &raw, &j.Notes,
+		&j.AssignedAt, &j.ScoredAt, &j.CreatedAt, &j.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if err := unmarshalScores(raw, &j.Scores); err != nil {
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+// ListScores returns every submitted set of scores
+func (s *JudgingStore) ListScores(ctx context.Context) ([]JudgingScore, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT pj.project_id, pj.judge_id, pj.scores
+		FROM project_judgings pj
+		JOIN projects p ON p.id = pj.project_id
+		JOIN teams t ON t.id = p.team_id
+		WHERE pj.scored_at IS NOT NULL AND t.hackathon_id = active_hackathon_id()
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []JudgingScore{}
+	for rows.Next() {
+		var js JudgingScore
+		var raw []byte
+		if err := rows.Scan(&js.ProjectID, &js.JudgeID, &raw); err != nil {
+			return nil, err
+		}
+		if err := unmarshalScores(raw, &js.Scores); err != nil {
+			return nil, err
+		}
+		result = append(result, js)
+	}
+
+	return result, rows.Err()
+}
+
+func unmarshalScores(raw []byte, dst *map[string]int) error {
+	if raw == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, dst)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/stretchr/testify/mock"
@@ -119,6 +120,11 @@ func (m *MockApplicationStore) Submit(ctx context.Context, app *Application) err
 	return args.Error(0)
 }
 
+func (m *MockApplicationStore) RemapResponses(ctx context.Context, fromVersion, toVersion int, mapping map[string]string) (int, error) {
+	args := m.Called(fromVersion, toVersion, mapping)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockApplicationStore) List(ctx context.Context, filters ApplicationListFilters, cursor *ApplicationCursor, direction PaginationDirection, limit int) (*ApplicationListResult, error) {
 	args := m.Called(filters, cursor, direction, limit)
 	if args.Get(0) == nil {
@@ -135,12 +141,45 @@ func (m *MockApplicationStore) GetStats(ctx context.Context) (*ApplicationStats,
 	return args.Get(0).(*ApplicationStats), args.Error(1)
 }
 
-func (m *MockApplicationStore) SetStatus(ctx context.Context, id string, status ApplicationStatus) (*Application, error) {
-	args := m.Called(id, status)
+func (m *MockApplicationStore) SetStatus(ctx context.Context, id string, status ApplicationStatus, changedBy, reason string) (*Application, []QuotaBreach, error) {
+	args := m.Called(id, status, changedBy, reason)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	breaches, _ := args.Get(1).([]QuotaBreach)
+	return args.Get(0).(*Application), breaches, args.Error(2)
+}
+
+func (m *MockApplicationStore) BulkSetStatus(ctx context.Context, target BulkStatusTarget, status ApplicationStatus, changedBy, reason string, dryRun bool) (*BulkStatusResult, error) {
+	args := m.Called(target, status, changedBy, reason, dryRun)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*Application), args.Error(1)
+	return args.Get(0).(*BulkStatusResult), args.Error(1)
+}
+
+func (m *MockApplicationStore) ListStatusEvents(ctx context.Context, applicationID string) ([]ApplicationStatusEvent, error) {
+	args := m.Called(applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ApplicationStatusEvent), args.Error(1)
+}
+
+func (m *MockApplicationStore) Decide(ctx context.Context, decide func(*DecisionCandidate) *DecisionRule, changedBy string, dryRun bool) (*DecisionRunResult, error) {
+	args := m.Called(decide, changedBy, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*DecisionRunResult), args.Error(1)
+}
+
+func (m *MockApplicationStore) QuotaUsage(ctx context.Context) ([]QuotaUsage, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]QuotaUsage), args.Error(1)
 }
 
 func (m *MockApplicationStore) GetStatusByUserID(ctx context.Context, userID string) (ApplicationStatus, error) {
@@ -193,6 +232,47 @@ func (m *MockApplicationStore) GetMealGroupByUserID(ctx context.Context, userID
 	return args.Get(0).(*string), args.Error(1)
 }
 
+func (m *MockApplicationStore) SetConfirmation(ctx context.Context, userID string, status ConfirmationStatus) (*Application, error) {
+	args := m.Called(userID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Application), args.Error(1)
+}
+
+func (m *MockApplicationStore) Withdraw(ctx context.Context, userID string, reason string) (*Application, error) {
+	args := m.Called(userID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Application), args.Error(1)
+}
+
+func (m *MockApplicationStore) ExpireConfirmations(ctx context.Context) (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockApplicationStore) PromoteWaitlisted(ctx context.Context, capacity int) ([]DecisionEmailRecipient, error) {
+	args := m.Called(capacity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]DecisionEmailRecipient), args.Error(1)
+}
+
+func (m *MockApplicationStore) Export(ctx context.Context, filters ApplicationListFilters, checkInTypes []string, fn func(*ApplicationExportRow) error) error {
+	args := m.Called(filters, checkInTypes)
+	if rows, ok := args.Get(0).([]ApplicationExportRow); ok {
+		for i := range rows {
+			if err := fn(&rows[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
 // mock implementation of the Settings interface
 type MockSettingsStore struct {
 	mock.Mock
@@ -206,9 +286,33 @@ func (m *MockSettingsStore) GetApplicationSchema(ctx context.Context) ([]Applica
 	return args.Get(0).([]ApplicationSchemaField), args.Error(1)
 }
 
-func (m *MockSettingsStore) UpdateApplicationSchema(ctx context.Context, fields []ApplicationSchemaField) error {
-	args := m.Called(fields)
-	return args.Error(0)
+func (m *MockSettingsStore) UpdateApplicationSchema(ctx context.Context, fields []ApplicationSchemaField, createdBy string) (int, error) {
+	args := m.Called(fields, createdBy)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSettingsStore) ListApplicationSchemaVersions(ctx context.Context) ([]ApplicationSchemaVersion, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ApplicationSchemaVersion), args.Error(1)
+}
+
+func (m *MockSettingsStore) GetApplicationSchemaVersion(ctx context.Context, version int) (*ApplicationSchemaVersion, error) {
+	args := m.Called(version)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ApplicationSchemaVersion), args.Error(1)
+}
+
+func (m *MockSettingsStore) GetApplicationSchemaForApplication(ctx context.Context, applicationID string) ([]ApplicationSchemaField, error) {
+	args := m.Called(applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ApplicationSchemaField), args.Error(1)
 }
 
 func (m *MockSettingsStore) GetReviewsPerApplication(ctx context.Context) (int, error) {
@@ -282,6 +386,36 @@ func (m *MockSettingsStore) SetHackathonDateRange(ctx context.Context, dateRange
 	return args.Error(0)
 }
 
+func (m *MockSettingsStore) GetRSVPConfig(ctx context.Context) (RSVPConfig, error) {
+	args := m.Called()
+	return args.Get(0).(RSVPConfig), args.Error(1)
+}
+
+func (m *MockSettingsStore) SetRSVPConfig(ctx context.Context, config RSVPConfig) error {
+	args := m.Called(config)
+	return args.Error(0)
+}
+
+func (m *MockSettingsStore) GetTeamSizeMax(ctx context.Context) (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSettingsStore) SetTeamSizeMax(ctx context.Context, value int) error {
+	args := m.Called(value)
+	return args.Error(0)
+}
+
+func (m *MockSettingsStore) GetJudgingConfig(ctx context.Context) (JudgingConfig, error) {
+	args := m.Called()
+	return args.Get(0).(JudgingConfig), args.Error(1)
+}
+
+func (m *MockSettingsStore) SetJudgingConfig(ctx context.Context, config JudgingConfig) error {
+	args := m.Called(config)
+	return args.Error(0)
+}
+
 func (m *MockSettingsStore) GetHackerPackURL(ctx context.Context) (string, error) {
 	args := m.Called()
 	return args.String(0), args.Error(1)
@@ -352,16 +486,29 @@ func (m *MockSettingsStore) SetFromName(ctx context.Context, name string) error
 	return args.Error(0)
 }
 
-func (m *MockSettingsStore) GetApplicationDueDate(ctx context.Context) (string, error) {
+func (m *MockSettingsStore) GetApplicationWindow(ctx context.Context) (ApplicationWindow, error) {
 	args := m.Called()
-	return args.String(0), args.Error(1)
+	return args.Get(0).(ApplicationWindow), args.Error(1)
 }
 
-func (m *MockSettingsStore) SetApplicationDueDate(ctx context.Context, date string) error {
-	args := m.Called(date)
+func (m *MockSettingsStore) SetApplicationWindow(ctx context.Context, window ApplicationWindow) error {
+	args := m.Called(window)
 	return args.Error(0)
 }
 
+func (m *MockSettingsStore) GetHackathonID(ctx context.Context) (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSettingsStore) GetRaw(ctx context.Context, key string) (json.RawMessage, error) {
+	args := m.Called(key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(json.RawMessage), args.Error(1)
+}
+
 func (m *MockSettingsStore) GetScanTypes(ctx context.Context) ([]ScanType, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
@@ -396,6 +543,32 @@ func (m *MockSettingsStore) SetMealGroups(ctx context.Context, groups []string)
 	return args.Error(0)
 }
 
+func (m *MockSettingsStore) GetDecisionRules(ctx context.Context) ([]DecisionRule, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]DecisionRule), args.Error(1)
+}
+
+func (m *MockSettingsStore) SetDecisionRules(ctx context.Context, rules []DecisionRule) error {
+	args := m.Called(rules)
+	return args.Error(0)
+}
+
+func (m *MockSettingsStore) GetAdmissionQuotas(ctx context.Context) ([]AdmissionQuota, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]AdmissionQuota), args.Error(1)
+}
+
+func (m *MockSettingsStore) SetAdmissionQuotas(ctx context.Context, quotas []AdmissionQuota) error {
+	args := m.Called(quotas)
+	return args.Error(0)
+}
+
 func (m *MockSettingsStore) GetMealGroupStats(ctx context.Context) (map[string]int, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
@@ -427,6 +600,67 @@ func (m *MockHackathonStore) Reset(ctx context.Context, opts ResetOptions) ([]st
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockHackathonStore) List(ctx context.Context) ([]Hackathon, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Hackathon), args.Error(1)
+}
+
+func (m *MockHackathonStore) GetActive(ctx context.Context) (*Hackathon, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Hackathon), args.Error(1)
+}
+
+func (m *MockHackathonStore) Create(ctx context.Context, h *Hackathon) error {
+	args := m.Called(h)
+	return args.Error(0)
+}
+
+func (m *MockHackathonStore) Activate(ctx context.Context, id string) (*Hackathon, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Hackathon), args.Error(1)
+}
+
+func (m *MockHackathonStore) Stats(ctx context.Context) ([]HackathonStats, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]HackathonStats), args.Error(1)
+}
+
+func (m *MockHackathonStore) Settings(ctx context.Context, id string) (map[string]json.RawMessage, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]json.RawMessage), args.Error(1)
+}
+
+func (m *MockHackathonStore) Snapshot(ctx context.Context) (*HackathonArchive, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*HackathonArchive), args.Error(1)
+}
+
+func (m *MockHackathonStore) Restore(ctx context.Context, archive *HackathonArchive) (map[string]int, error) {
+	args := m.Called(archive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
 // MockApplicationReviewsStore is a mock implementation of the ApplicationReviews interface
 type MockApplicationReviewsStore struct {
 	mock.Mock
@@ -500,16 +734,16 @@ func (m *MockScansStore) CreatePurchase(ctx context.Context, scan *Scan) (int, e
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockScansStore) GetByUserID(ctx context.Context, userID string) ([]Scan, error) {
-	args := m.Called(userID)
+func (m *MockScansStore) GetByUserID(ctx context.Context, userID string, hackathonID *string) ([]Scan, error) {
+	args := m.Called(userID, hackathonID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]Scan), args.Error(1)
 }
 
-func (m *MockScansStore) GetStats(ctx context.Context) ([]ScanStat, error) {
-	args := m.Called()
+func (m *MockScansStore) GetStats(ctx context.Context, hackathonID *string) ([]ScanStat, error) {
+	args := m.Called(hackathonID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -539,8 +773,8 @@ type MockScheduleStore struct {
 	mock.Mock
 }
 
-func (m *MockScheduleStore) List(ctx context.Context) ([]ScheduleItem, error) {
-	args := m.Called()
+func (m *MockScheduleStore) List(ctx context.Context, hackathonID *string) ([]ScheduleItem, error) {
+	args := m.Called(hackathonID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -562,13 +796,21 @@ func (m *MockScheduleStore) Delete(ctx context.Context, id string) error {
 	return args.Error(0)
 }
 
+func (m *MockScheduleStore) GetByID(ctx context.Context, id string) (*ScheduleItem, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ScheduleItem), args.Error(1)
+}
+
 // MockSponsorsStore is a mock implementation of the Sponsors interface
 type MockSponsorsStore struct {
 	mock.Mock
 }
 
-func (m *MockSponsorsStore) List(ctx context.Context) ([]Sponsor, error) {
-	args := m.Called()
+func (m *MockSponsorsStore) List(ctx context.Context, hackathonID *string) ([]Sponsor, error) {
+	args := m.Called(hackathonID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -608,8 +850,8 @@ type MockFAQsStore struct {
 	mock.Mock
 }
 
-func (m *MockFAQsStore) List(ctx context.Context) ([]FAQ, error) {
-	args := m.Called()
+func (m *MockFAQsStore) List(ctx context.Context, hackathonID *string) ([]FAQ, error) {
+	args := m.Called(hackathonID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -631,6 +873,14 @@ func (m *MockFAQsStore) Delete(ctx context.Context, id string) error {
 	return args.Error(0)
 }
 
+func (m *MockFAQsStore) GetByID(ctx context.Context, id string) (*FAQ, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*FAQ), args.Error(1)
+}
+
 // MockPushSubscriptionsStore is a mock implementation of the PushSubscriptions interface
 type MockPushSubscriptionsStore struct {
 	mock.Mock
@@ -729,8 +979,8 @@ type MockWalkInsStore struct {
 	mock.Mock
 }
 
-func (m *MockWalkInsStore) Enqueue(ctx context.Context, userID string) (bool, int, error) {
-	args := m.Called(userID)
+func (m *MockWalkInsStore) Enqueue(ctx context.Context, userID, scannedBy string) (bool, int, error) {
+	args := m.Called(userID, scannedBy)
 	return args.Bool(0), args.Int(1), args.Error(2)
 }
 
@@ -755,6 +1005,406 @@ func (m *MockWalkInsStore) List(ctx context.Context) ([]WalkIn, error) {
 	return args.Get(0).([]WalkIn), args.Error(1)
 }
 
+// MockAuditLogStore is a mock implementation of the AuditLog interface
+type MockAuditLogStore struct {
+	mock.Mock
+}
+
+func (m *MockAuditLogStore) Record(ctx context.Context, event *AuditEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func (m *MockAuditLogStore) List(ctx context.Context, filters AuditEventFilters, cursor *AuditCursor, direction PaginationDirection, limit int) (*AuditEventListResult, error) {
+	args := m.Called(filters, cursor, direction, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AuditEventListResult), args.Error(1)
+}
+
+// MockTeamsStore is a mock implementation of the Teams interface
+type MockTeamsStore struct {
+	mock.Mock
+}
+
+func (m *MockTeamsStore) Create(ctx context.Context, team *Team, userID string) error {
+	args := m.Called(team, userID)
+	return args.Error(0)
+}
+
+func (m *MockTeamsStore) GetByID(ctx context.Context, id string) (*Team, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Team), args.Error(1)
+}
+
+func (m *MockTeamsStore) GetByUserID(ctx context.Context, userID string) (*Team, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Team), args.Error(1)
+}
+
+func (m *MockTeamsStore) Invite(ctx context.Context, teamID, email, invitedBy string, maxSize int) (*TeamInvite, error) {
+	args := m.Called(teamID, email, invitedBy, maxSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TeamInvite), args.Error(1)
+}
+
+func (m *MockTeamsStore) Join(ctx context.Context, code, userID string, maxSize int) (*Team, error) {
+	args := m.Called(code, userID, maxSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Team), args.Error(1)
+}
+
+func (m *MockTeamsStore) Leave(ctx context.Context, userID string) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockTeamsStore) List(ctx context.Context) ([]TeamListItem, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]TeamListItem), args.Error(1)
+}
+
+// MockProjectsStore is a mock implementation of the Projects interface
+type MockProjectsStore struct {
+	mock.Mock
+}
+
+func (m *MockProjectsStore) GetByTeamID(ctx context.Context, teamID string) (*Project, error) {
+	args := m.Called(teamID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Project), args.Error(1)
+}
+
+func (m *MockProjectsStore) Upsert(ctx context.Context, project *Project) error {
+	args := m.Called(project)
+	return args.Error(0)
+}
+
+func (m *MockProjectsStore) List(ctx context.Context) ([]Project, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Project), args.Error(1)
+}
+
+// MockJudgingStore is a mock implementation of the Judging interface
+type MockJudgingStore struct {
+	mock.Mock
+}
+
+func (m *MockJudgingStore) BatchAssign(ctx context.Context, judgesPerProject int) (*JudgingAssignmentResult, error) {
+	args := m.Called(judgesPerProject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*JudgingAssignmentResult), args.Error(1)
+}
+
+func (m *MockJudgingStore) GetByJudgeID(ctx context.Context, judgeID string) ([]ProjectJudgingWithDetails, error) {
+	args := m.Called(judgeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ProjectJudgingWithDetails), args.Error(1)
+}
+
+func (m *MockJudgingStore) SubmitScores(ctx context.Context, judgingID, judgeID string, scores map[string]int, notes *string) (*ProjectJudging, error) {
+	args := m.Called(judgingID, judgeID, scores, notes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ProjectJudging), args.Error(1)
+}
+
+func (m *MockJudgingStore) ListScores(ctx context.Context) ([]JudgingScore, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]JudgingScore), args.Error(1)
+}
+
+// MockResumeBooksStore is a mock implementation of the ResumeBooks interface
+type MockResumeBooksStore struct {
+	mock.Mock
+}
+
+func (m *MockResumeBooksStore) Create(ctx context.Context, book *ResumeBook) error {
+	args := m.Called(book)
+	return args.Error(0)
+}
+
+func (m *MockResumeBooksStore) GetByID(ctx context.Context, id string) (*ResumeBook, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ResumeBook), args.Error(1)
+}
+
+func (m *MockResumeBooksStore) List(ctx context.Context, limit int) ([]ResumeBook, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ResumeBook), args.Error(1)
+}
+
+func (m *MockResumeBooksStore) Complete(ctx context.Context, id, objectPath string, resumeCount, skippedCount int) error {
+	args := m.Called(id, objectPath, resumeCount, skippedCount)
+	return args.Error(0)
+}
+
+func (m *MockResumeBooksStore) Fail(ctx context.Context, id, reason string) error {
+	args := m.Called(id, reason)
+	return args.Error(0)
+}
+
+func (m *MockResumeBooksStore) ListEntries(ctx context.Context, optInField string, checkInTypes []string) ([]ResumeBookEntry, error) {
+	args := m.Called(optInField, checkInTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ResumeBookEntry), args.Error(1)
+}
+
+// MockApplicationFlagsStore is a mock implementation of the ApplicationFlags interface
+type MockApplicationFlagsStore struct {
+	mock.Mock
+}
+
+func (m *MockApplicationFlagsStore) ListDuplicateCandidates(ctx context.Context) ([]DuplicateCandidate, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]DuplicateCandidate), args.Error(1)
+}
+
+func (m *MockApplicationFlagsStore) SetResumeHash(ctx context.Context, applicationID, resumePath, hash string) error {
+	args := m.Called(applicationID, resumePath, hash)
+	return args.Error(0)
+}
+
+func (m *MockApplicationFlagsStore) Replace(ctx context.Context, flags []ApplicationFlag) error {
+	args := m.Called(flags)
+	return args.Error(0)
+}
+
+func (m *MockApplicationFlagsStore) ListByApplicationID(ctx context.Context, applicationID string) ([]ApplicationFlag, error) {
+	args := m.Called(applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ApplicationFlag), args.Error(1)
+}
+
+func (m *MockApplicationFlagsStore) CreateScan(ctx context.Context, scan *DuplicateScan) error {
+	args := m.Called(scan)
+	return args.Error(0)
+}
+
+func (m *MockApplicationFlagsStore) LatestScan(ctx context.Context) (*DuplicateScan, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*DuplicateScan), args.Error(1)
+}
+
+func (m *MockApplicationFlagsStore) CompleteScan(ctx context.Context, scan *DuplicateScan) error {
+	args := m.Called(scan)
+	return args.Error(0)
+}
+
+func (m *MockApplicationFlagsStore) FailScan(ctx context.Context, id, reason string) error {
+	args := m.Called(id, reason)
+	return args.Error(0)
+}
+
+func (m *MockApplicationFlagsStore) FailInterruptedScans(ctx context.Context) (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockSavedViewsStore is a mock implementation of the SavedViews interface
+type MockSavedViewsStore struct {
+	mock.Mock
+}
+
+func (m *MockSavedViewsStore) List(ctx context.Context, ownerID string, resource SavedViewResource) ([]SavedView, error) {
+	args := m.Called(ownerID, resource)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]SavedView), args.Error(1)
+}
+
+func (m *MockSavedViewsStore) GetByID(ctx context.Context, id string) (*SavedView, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SavedView), args.Error(1)
+}
+
+func (m *MockSavedViewsStore) Create(ctx context.Context, view *SavedView) error {
+	args := m.Called(view)
+	return args.Error(0)
+}
+
+func (m *MockSavedViewsStore) Update(ctx context.Context, view *SavedView) error {
+	args := m.Called(view)
+	return args.Error(0)
+}
+
+func (m *MockSavedViewsStore) Delete(ctx context.Context, id, ownerID string) error {
+	args := m.Called(id, ownerID)
+	return args.Error(0)
+}
+
+type MockAnalyticsStore struct {
+	mock.Mock
+}
+
+func (m *MockAnalyticsStore) FieldBreakdown(ctx context.Context, fields []AnalyticsField) ([]FieldAnswerCount, error) {
+	args := m.Called(fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]FieldAnswerCount), args.Error(1)
+}
+
+func (m *MockAnalyticsStore) DailyApplications(ctx context.Context, timezone string) ([]DailyApplicationCount, error) {
+	args := m.Called(timezone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]DailyApplicationCount), args.Error(1)
+}
+
+func (m *MockAnalyticsStore) Conversion(ctx context.Context) (*ApplicationConversion, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ApplicationConversion), args.Error(1)
+}
+
+func (m *MockAnalyticsStore) DailyReviews(ctx context.Context, timezone string) ([]DailyReviewCount, error) {
+	args := m.Called(timezone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]DailyReviewCount), args.Error(1)
+}
+
+// MockPoliciesStore is a mock implementation of the Policies interface
+type MockPoliciesStore struct {
+	mock.Mock
+}
+
+func (m *MockPoliciesStore) List(ctx context.Context) ([]Policy, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Policy), args.Error(1)
+}
+
+func (m *MockPoliciesStore) GetByID(ctx context.Context, id string) (*Policy, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Policy), args.Error(1)
+}
+
+func (m *MockPoliciesStore) Create(ctx context.Context, policy *Policy, createdBy string) error {
+	args := m.Called(policy, createdBy)
+	return args.Error(0)
+}
+
+func (m *MockPoliciesStore) Update(ctx context.Context, policy *Policy, createdBy string) error {
+	args := m.Called(policy, createdBy)
+	return args.Error(0)
+}
+
+func (m *MockPoliciesStore) Delete(ctx context.Context, id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockPoliciesStore) ListVersions(ctx context.Context, policyID string) ([]PolicyVersion, error) {
+	args := m.Called(policyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]PolicyVersion), args.Error(1)
+}
+
+func (m *MockPoliciesStore) ListForUser(ctx context.Context, userID string, guardianRequired bool) ([]UserPolicy, error) {
+	args := m.Called(userID, guardianRequired)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]UserPolicy), args.Error(1)
+}
+
+func (m *MockPoliciesStore) Sign(ctx context.Context, sig *PolicySignature) error {
+	args := m.Called(sig)
+	return args.Error(0)
+}
+
+func (m *MockPoliciesStore) GuardianConsent(ctx context.Context, tokenHash string) (*GuardianConsent, error) {
+	args := m.Called(tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*GuardianConsent), args.Error(1)
+}
+
+func (m *MockPoliciesStore) ConfirmGuardian(ctx context.Context, tokenHash, ipAddress string) (*GuardianConsent, error) {
+	args := m.Called(tokenHash, ipAddress)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*GuardianConsent), args.Error(1)
+}
+
+func (m *MockPoliciesStore) MissingRequired(ctx context.Context, userID string, guardianRequired bool) ([]Policy, error) {
+	args := m.Called(userID, guardianRequired)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Policy), args.Error(1)
+}
+
+func (m *MockPoliciesStore) Signers(ctx context.Context, policyID string) ([]PolicySigner, error) {
+	args := m.Called(policyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]PolicySigner), args.Error(1)
+}
+
 // returns a Storage with all mock implementations
 func NewMockStore() Storage {
 	return Storage{
@@ -770,5 +1420,14 @@ func NewMockStore() Storage {
 		PushSubscriptions:      &MockPushSubscriptionsStore{},
 		ScheduledNotifications: &MockScheduledNotificationsStore{},
 		WalkIns:                &MockWalkInsStore{},
+		AuditLog:               &MockAuditLogStore{},
+		Teams:                  &MockTeamsStore{},
+		Projects:               &MockProjectsStore{},
+		Judging:                &MockJudgingStore{},
+		ResumeBooks:            &MockResumeBooksStore{},
+		ApplicationFlags:       &MockApplicationFlagsStore{},
+		SavedViews:             &MockSavedViewsStore{},
+		Analytics:              &MockAnalyticsStore{},
+		Policies:               &MockPoliciesStore{},
 	}
 }
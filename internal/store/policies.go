@@ -0,0 +1,571 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Policy is a document hackers agree to, at its latest version.
+type Policy struct {
+	ID           string    `json:"id"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	Version      int       `json:"version"`
+	Required     bool      `json:"required"`
+	DisplayOrder int       `json:"display_order"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// PolicyVersion is one saved revision of a policy's text.
+type PolicyVersion struct {
+	Version   int       `json:"version"`
+	Body      string    `json:"body"`
+	CreatedBy *string   `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PolicySignature records a hacker agreeing to one version of a policy.
+// GuardianName and GuardianEmail are set when the hacker was a minor; their
+// signature is pending until GuardianConfirmedAt is set by the guardian
+// following the link emailed to them. GuardianTokenHash is the SHA-256 of
+// that link's token, written by Sign and never read back.
+type PolicySignature struct {
+	ID                  string     `json:"id"`
+	PolicyID            string     `json:"policy_id"`
+	Version             int        `json:"version"`
+	UserID              string     `json:"user_id"`
+	SignedName          string     `json:"signed_name"`
+	IPAddress           string     `json:"ip_address"`
+	UserAgent           string     `json:"user_agent"`
+	GuardianName        *string    `json:"guardian_name"`
+	GuardianEmail       *string    `json:"guardian_email"`
+	GuardianConfirmedAt *time.Time `json:"guardian_confirmed_at"`
+	GuardianTokenHash   *string    `json:"-"`
+	SignedAt            time.Time  `json:"signed_at"`
+}
+
+// GuardianConsent is what a parent or guardian is shown before confirming a
+// minor's signature: the exact text signed and who signed it.
+type GuardianConsent struct {
+	SignatureID  string     `json:"signature_id"`
+	PolicyTitle  string     `json:"policy_title"`
+	PolicyBody   string     `json:"policy_body"`
+	Version      int        `json:"version"`
+	SignedName   string     `json:"signed_name"`
+	GuardianName string     `json:"guardian_name"`
+	SignedAt     time.Time  `json:"signed_at"`
+	ConfirmedAt  *time.Time `json:"confirmed_at"`
+}
+
+// UserPolicy is a policy with the user's signature of its newest version
+// they signed, nil if they never signed it. Current reports whether that
+// signature covers the policy's latest version, with a confirmed guardian
+// when one is required.
+type UserPolicy struct {
+	Policy
+	Signature *PolicySignature `json:"signature"`
+	Current   bool             `json:"current"`
+}
+
+// PolicySigner is one row of a policy's signature report: an accepted
+// applicant, or anyone else who signed. The signature fields are nil for
+// applicants who haven't signed any version.
+type PolicySigner struct {
+	UserID              string            `json:"user_id"`
+	Email               string            `json:"email"`
+	FirstName           *string           `json:"first_name"`
+	LastName            *string           `json:"last_name"`
+	Status              ApplicationStatus `json:"status"`
+	Version             *int              `json:"version"`
+	Current             bool              `json:"current"`
+	SignedName          *string           `json:"signed_name"`
+	GuardianName        *string           `json:"guardian_name"`
+	GuardianEmail       *string           `json:"guardian_email"`
+	GuardianConfirmedAt *time.Time        `json:"guardian_confirmed_at"`
+	IPAddress           *string           `json:"ip_address"`
+	SignedAt            *time.Time        `json:"signed_at"`
+}
+
+type PoliciesStore struct {
+	db *sql.DB
+}
+
+// policySelect reads the active hackathon's policies joined to their latest
+// version.
+const policySelect = `
+	SELECT p.id, p.title, v.body, v.version, p.required, p.display_order, p.created_at, p.updated_at
+	FROM policies p
+	CROSS JOIN LATERAL (
+		SELECT version, body FROM policy_versions
+		WHERE policy_id = p.id
+		ORDER BY version DESC
+		LIMIT 1
+	) v
+	WHERE p.hackathon_id = active_hackathon_id()`
+
+func scanPolicy(row interface{ Scan(...any) error }, p *Policy) error {
+	return row.Scan(&p.ID, &p.Title, &p.Body, &p.Version, &p.Required, &p.DisplayOrder, &p.CreatedAt, &p.UpdatedAt)
+}
+
+// List returns the active hackathon's policies in display order.
+func (s *PoliciesStore) List(ctx context.Context) ([]Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, policySelect+`
+		ORDER BY p.display_order, p.created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := []Policy{}
+	for rows.Next() {
+		var p Policy
+		if err := scanPolicy(rows, &p); err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, rows.Err()
+}
+
+func (s *PoliciesStore) GetByID(ctx context.Context, id string) (*Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var p Policy
+	if err := scanPolicy(s.db.QueryRowContext(ctx, policySelect+` AND p.id = $1`, id), &p); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Create inserts policy with its body as version 1.
+func (s *PoliciesStore) Create(ctx context.Context, policy *Policy, createdBy string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO policies (title, required, display_order)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at
+	`, policy.Title, policy.Required, policy.DisplayOrder).Scan(&policy.ID, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	policy.Version = 1
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO policy_versions (policy_id, version, body, created_by)
+		VALUES ($1, $2, $3, $4)
+	`, policy.ID, policy.Version, policy.Body, createdBy); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Update writes policy if the row still has the updated_at the caller read,
+// as given by policy.UpdatedAt, and returns ErrStale otherwise. A changed body
+// is saved as the next version, which everyone has to sign again; the same
+// body keeps the current one.
+func (s *PoliciesStore) Update(ctx context.Context, policy *Policy, createdBy string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The row lock taken here also queues concurrent edits, so each numbers
+	// its version after the last.
+	err = tx.QueryRowContext(ctx, `
+		UPDATE policies
+		SET title = $2, required = $3, display_order = $4
+		WHERE id = $1 AND hackathon_id = active_hackathon_id() AND updated_at = $5
+		RETURNING created_at, updated_at
+	`, policy.ID, policy.Title, policy.Required, policy.DisplayOrder, policy.UpdatedAt,
+	).Scan(&policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return staleOrMissing(ctx, s.db, "policies", policy.ID)
+		}
+		return err
+	}
+
+	var latest int
+	var body string
+	if err := tx.QueryRowContext(ctx, `
+		SELECT version, body FROM policy_versions
+		WHERE policy_id = $1
+		ORDER BY version DESC
+		LIMIT 1
+	`, policy.ID).Scan(&latest, &body); err != nil {
+		return err
+	}
+
+	policy.Version = latest
+	if body != policy.Body {
+		policy.Version++
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO policy_versions (policy_id, version, body, created_by)
+			VALUES ($1, $2, $3, $4)
+		`, policy.ID, policy.Version, policy.Body, createdBy); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a policy nobody has signed. Returns ErrConflict once it has
+// signatures, since they must keep the text they agreed to.
+func (s *PoliciesStore) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM policies p
+		WHERE p.id = $1
+		  AND p.hackathon_id = active_hackathon_id()
+		  AND NOT EXISTS (SELECT 1 FROM policy_signatures s WHERE s.policy_id = p.id)
+	`, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM policies WHERE id = $1 AND hackathon_id = active_hackathon_id())
+	`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return ErrConflict
+	}
+	return ErrNotFound
+}
+
+// ListVersions returns every version of a policy, newest first.
+func (s *PoliciesStore) ListVersions(ctx context.Context, policyID string) ([]PolicyVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT version, body, created_by, created_at
+		FROM policy_versions
+		WHERE policy_id = $1
+		ORDER BY version DESC
+	`, policyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := []PolicyVersion{}
+	for rows.Next() {
+		var v PolicyVersion
+		if err := rows.Scan(&v.Version, &v.Body, &v.CreatedBy, &v.CreatedAt); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, rows.Err()
+}
+
+// ListForUser returns the active hackathon's policies in display order, each
+// with the user's signature of the newest version they signed. With
+// guardianRequired, a signature without a confirmed guardian isn't current.
+func (s *PoliciesStore) ListForUser(ctx context.Context, userID string, guardianRequired bool) ([]UserPolicy, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT p.id, p.title, p.body, p.version, p.required, p.display_order, p.created_at, p.updated_at,
+		       s.id, s.version, s.signed_name, s.ip_address, s.user_agent,
+		       s.guardian_name, s.guardian_email, s.guardian_confirmed_at, s.signed_at
+		FROM (`+policySelect+`
+		) p
+		LEFT JOIN LATERAL (
+			SELECT * FROM policy_signatures
+			WHERE policy_id = p.id AND user_id = $1
+			ORDER BY version DESC
+			LIMIT 1
+		) s ON TRUE
+		ORDER BY p.display_order, p.created_at
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := []UserPolicy{}
+	for rows.Next() {
+		var p UserPolicy
+		var sigID, signedName, ip, userAgent sql.NullString
+		var version sql.NullInt64
+		var signedAt sql.NullTime
+		var guardianName, guardianEmail *string
+		var guardianConfirmedAt *time.Time
+		if err := rows.Scan(
+			&p.ID, &p.Title, &p.Body, &p.Version, &p.Required, &p.DisplayOrder, &p.CreatedAt, &p.UpdatedAt,
+			&sigID, &version, &signedName, &ip, &userAgent,
+			&guardianName, &guardianEmail, &guardianConfirmedAt, &signedAt,
+		); err != nil {
+			return nil, err
+		}
+		if sigID.Valid {
+			p.Signature = &PolicySignature{
+				ID:                  sigID.String,
+				PolicyID:            p.ID,
+				Version:             int(version.Int64),
+				UserID:              userID,
+				SignedName:          signedName.String,
+				IPAddress:           ip.String,
+				UserAgent:           userAgent.String,
+				GuardianName:        guardianName,
+				GuardianEmail:       guardianEmail,
+				GuardianConfirmedAt: guardianConfirmedAt,
+				SignedAt:            signedAt.Time,
+			}
+			p.Current = p.Signature.Version == p.Version && (!guardianRequired || guardianConfirmedAt != nil)
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, rows.Err()
+}
+
+// Sign records sig against sig.Version of its policy. Returns ErrNotFound for
+// a policy outside the active hackathon, ErrStale when sig.Version is no
+// longer the latest, and ErrConflict when the user already signed it. A
+// signature with a guardian who hasn't confirmed yet, or with no guardian at
+// all, is replaced by one with a guardian: hackers may give their age only
+// after signing, and a minor can correct the guardian's email or send them a
+// fresh link. The new signature is pending until ConfirmGuardian.
+func (s *PoliciesStore) Sign(ctx context.Context, sig *PolicySignature) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var latest sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT MAX(v.version)
+		FROM policies p
+		INNER JOIN policy_versions v ON v.policy_id = p.id
+		WHERE p.id = $1 AND p.hackathon_id = active_hackathon_id()
+	`, sig.PolicyID).Scan(&latest); err != nil {
+		return err
+	}
+	if !latest.Valid {
+		return ErrNotFound
+	}
+	if int(latest.Int64) != sig.Version {
+		return ErrStale
+	}
+
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO policy_signatures (
+			policy_id, version, user_id, signed_name, ip_address, user_agent,
+			guardian_name, guardian_email, guardian_token_hash
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT ON CONSTRAINT policy_signatures_unique DO UPDATE
+		SET signed_name = EXCLUDED.signed_name,
+		    ip_address = EXCLUDED.ip_address,
+		    user_agent = EXCLUDED.user_agent,
+		    guardian_name = EXCLUDED.guardian_name,
+		    guardian_email = EXCLUDED.guardian_email,
+		    guardian_token_hash = EXCLUDED.guardian_token_hash,
+		    guardian_confirmed_at = NULL,
+		    guardian_ip_address = NULL,
+		    signed_at = now()
+		WHERE policy_signatures.guardian_confirmed_at IS NULL AND EXCLUDED.guardian_name IS NOT NULL
+		RETURNING id, signed_at
+	`, sig.PolicyID, sig.Version, sig.UserID, sig.SignedName, sig.IPAddress, sig.UserAgent,
+		sig.GuardianName, sig.GuardianEmail, sig.GuardianTokenHash,
+	).Scan(&sig.ID, &sig.SignedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrConflict
+	}
+	return err
+}
+
+// guardianConsentSelect reads a pending or confirmed guardian signature by
+// its token hash, with the policy text at the signed version. Links for
+// another hackathon's policies don't resolve.
+const guardianConsentSelect = `
+	SELECT s.id, p.title, v.body, s.version, s.signed_name, s.guardian_name, s.signed_at, s.guardian_confirmed_at
+	FROM policy_signatures s
+	INNER JOIN policies p ON p.id = s.policy_id
+	INNER JOIN policy_versions v ON v.policy_id = s.policy_id AND v.version = s.version
+	WHERE s.guardian_token_hash = $1 AND p.hackathon_id = active_hackathon_id()`
+
+func scanGuardianConsent(row interface{ Scan(...any) error }, c *GuardianConsent) error {
+	return row.Scan(&c.SignatureID, &c.PolicyTitle, &c.PolicyBody, &c.Version, &c.SignedName, &c.GuardianName, &c.SignedAt, &c.ConfirmedAt)
+}
+
+// GuardianConsent returns the signature a guardian link points at. Returns
+// ErrNotFound for an unknown token or one replaced by a newer signature.
+func (s *PoliciesStore) GuardianConsent(ctx context.Context, tokenHash string) (*GuardianConsent, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var c GuardianConsent
+	if err := scanGuardianConsent(s.db.QueryRowContext(ctx, guardianConsentSelect, tokenHash), &c); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// ConfirmGuardian records the guardian agreeing to the signature their link
+// points at, from ipAddress. Returns ErrNotFound like GuardianConsent, and
+// ErrConflict when the guardian already confirmed it.
+func (s *PoliciesStore) ConfirmGuardian(ctx context.Context, tokenHash, ipAddress string) (*GuardianConsent, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE policy_signatures s
+		SET guardian_confirmed_at = now(), guardian_ip_address = $2
+		FROM policies p
+		WHERE s.guardian_token_hash = $1
+		  AND s.guardian_confirmed_at IS NULL
+		  AND p.id = s.policy_id
+		  AND p.hackathon_id = active_hackathon_id()
+	`, tokenHash, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	consent, err := s.GuardianConsent(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, ErrConflict
+	}
+
+	return consent, nil
+}
+
+// MissingRequired returns the required policies the user hasn't signed at
+// their latest version. With guardianRequired, only signatures the guardian
+// has confirmed count: the hacker may have signed before giving an age under
+// 18, or their guardian may not have followed the link yet.
+func (s *PoliciesStore) MissingRequired(ctx context.Context, userID string, guardianRequired bool) ([]Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, policySelect+`
+		  AND p.required
+		  AND NOT EXISTS (
+		      SELECT 1 FROM policy_signatures s
+		      WHERE s.policy_id = p.id AND s.version = v.version AND s.user_id = $1
+		        AND (NOT $2 OR s.guardian_confirmed_at IS NOT NULL)
+		  )
+		ORDER BY p.display_order, p.created_at`, userID, guardianRequired)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := []Policy{}
+	for rows.Next() {
+		var p Policy
+		if err := scanPolicy(rows, &p); err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, rows.Err()
+}
+
+// Signers reports who has signed a policy: every accepted applicant in the
+// active hackathon, signed or not, and anyone else who signed it, by email.
+// Each row carries the newest version the user signed.
+func (s *PoliciesStore) Signers(ctx context.Context, policyID string) ([]PolicySigner, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		WITH policy AS (
+			SELECT p.id, MAX(v.version) AS version
+			FROM policies p
+			INNER JOIN policy_versions v ON v.policy_id = p.id
+			WHERE p.id = $1 AND p.hackathon_id = active_hackathon_id()
+			GROUP BY p.id
+		)
+		SELECT u.id, u.email, a.responses->>'first_name', a.responses->>'last_name', a.status,
+		       s.version, s.version = policy.version, s.signed_name,
+		       s.guardian_name, s.guardian_email, s.guardian_confirmed_at, s.ip_address, s.signed_at
+		FROM policy
+		CROSS JOIN applications a
+		INNER JOIN users u ON u.id = a.user_id
+		LEFT JOIN LATERAL (
+			SELECT * FROM policy_signatures
+			WHERE policy_id = policy.id AND user_id = a.user_id
+			ORDER BY version DESC
+			LIMIT 1
+		) s ON TRUE
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND (a.status = 'accepted' OR s.id IS NOT NULL)
+		ORDER BY u.email
+	`, policyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	signers := []PolicySigner{}
+	for rows.Next() {
+		var signer PolicySigner
+		var current sql.NullBool
+		if err := rows.Scan(
+			&signer.UserID, &signer.Email, &signer.FirstName, &signer.LastName, &signer.Status,
+			&signer.Version, &current, &signer.SignedName,
+			&signer.GuardianName, &signer.GuardianEmail, &signer.GuardianConfirmedAt, &signer.IPAddress, &signer.SignedAt,
+		); err != nil {
+			return nil, err
+		}
+		signer.Current = current.Bool
+		signers = append(signers, signer)
+	}
+
+	return signers, rows.Err()
+}
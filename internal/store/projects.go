@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type Project struct {
+	ID          string      `json:"id"`
+	TeamID      string      `json:"team_id"`
+	TeamName    string      `json:"team_name"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	RepoURL     *string     `json:"repo_url"`
+	DemoURL     *string     `json:"demo_url"`
+	Tracks      StringArray `json:"tracks"`
+	SubmittedBy *string     `json:"submitted_by"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type ProjectsStore struct {
+	db *sql.DB
+}
+
+const projectSelect = `
+	SELECT p.id, p.team_id, t.name, p.title, p.description, p.repo_url, p.demo_url,
+	       p.tracks, p.submitted_by, p.created_at, p.updated_at
+	FROM projects p
+	JOIN teams t ON t.id = p.team_id`
+
+func scanProject(row interface{ Scan(dest ...any) error }, p *Project) error {
+	return row.Scan(
+		&p.ID, &p.TeamID, &p.TeamName, &p.Title, &p.Description, &p.RepoURL, &p.DemoURL,
+		&p.Tracks, &p.SubmittedBy, &p.CreatedAt, &p.UpdatedAt,
+	)
+}
+
+// GetByTeamID returns the team's project submission, or ErrNotFound.
+func (s *ProjectsStore) GetByTeamID(ctx context.Context, teamID string) (*Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var p Project
+	if err := scanProject(s.db.QueryRowContext(ctx, projectSelect+` WHERE p.team_id = $1`, teamID), &p); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Upsert creates the team's project or replaces its editable fields.
+func (s *ProjectsStore) Upsert(ctx context.Context, p *Project) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		WITH upserted AS (
+			INSERT INTO projects (team_id, title, description, repo_url, demo_url, tracks, submitted_by)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (team_id) DO UPDATE
+			SET title = EXCLUDED.title,
+			    description = EXCLUDED.description,
+			    repo_url = EXCLUDED.repo_url,
+			    demo_url = EXCLUDED.demo_url,
+			    tracks = EXCLUDED.tracks,
+			    submitted_by = EXCLUDED.submitted_by
+			RETURNING *
+		)
+		SELECT p.id, p.team_id, t.name, p.title, p.description, p.repo_url, p.demo_url,
+		       p.tracks, p.submitted_by, p.created_at, p.updated_at
+		FROM upserted p
+		JOIN teams t ON t.id = p.team_id
+	`
+
+	tracks := p.Tracks
+	if tracks == nil {
+		tracks = StringArray{}
+	}
+
+	return scanProject(s.db.QueryRowContext(ctx, query,
+		p.TeamID, p.Title, p.Description, p.RepoURL, p.DemoURL, tracks, p.SubmittedBy,
+	), p)
+}
+
+// List returns every project submission, most recently updated first.
+func (s *ProjectsStore) List(ctx context.Context) ([]Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, projectSelect+` WHERE t.hackathon_id = active_hackathon_id() ORDER BY p.updated_at DESC, p.id DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	projects := []Project{}
+	for rows.Next() {
+		var p Project
+		if err := scanProject(rows, &p); err != nil {
+			return nil, err
+		}
+		projects = append(projects, p)
+	}
+
+	return projects, rows.Err()
+}
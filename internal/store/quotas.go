@@ -0,0 +1,313 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AdmissionQuota caps the seats held by accepted hackers that match it. Field
+// is a schema field ID: with Value set only that answer counts (or answers
+// including it, for multi-select fields), and without it every distinct
+// answer is capped separately, e.g. per university. An empty Field caps
+// everyone. Seats are held while a confirmation is pending or confirmed, the
+// same as for waitlist promotion.
+type AdmissionQuota struct {
+	ID    string  `json:"id" validate:"required,max=50"`
+	Label string  `json:"label" validate:"required,max=100"`
+	Field string  `json:"field" validate:"max=50"`
+	Value *string `json:"value,omitempty" validate:"omitempty,max=200"`
+	Max   int     `json:"max" validate:"min=0,max=100000"`
+	// Hard quotas block status changes that would exceed Max; soft ones
+	// only warn.
+	Hard bool `json:"hard"`
+}
+
+type QuotaAnswerCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// QuotaUsage is the seats held against a quota. For a per-answer quota
+// Answers lists every answer's count, fullest first, and Count is the
+// fullest one's.
+type QuotaUsage struct {
+	AdmissionQuota
+	Count   int                `json:"count"`
+	Answers []QuotaAnswerCount `json:"answers,omitempty"`
+}
+
+// QuotaBreach is a quota that a status change takes past Max. Answer names
+// the answer over its cap for a per-answer quota; Count is after the change.
+type QuotaBreach struct {
+	QuotaID string  `json:"quota_id"`
+	Label   string  `json:"label"`
+	Answer  *string `json:"answer,omitempty"`
+	Max     int     `json:"max"`
+	Count   int     `json:"count"`
+	Hard    bool    `json:"hard"`
+}
+
+// QuotaExceededError is returned instead of making a status change that
+// would take hard quotas past their cap.
+type QuotaExceededError struct {
+	Breaches []QuotaBreach
+}
+
+func (e *QuotaExceededError) Error() string {
+	labels := make([]string, len(e.Breaches))
+	for i, b := range e.Breaches {
+		labels[i] = b.Label
+		if b.Answer != nil {
+			labels[i] += " (" + *b.Answer + ")"
+		}
+	}
+	return fmt.Sprintf("would exceed admission quota %s", strings.Join(labels, ", "))
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// quotaLockSQL serializes acceptances while their quotas are checked, so two
+// concurrent changes can't both take the last seat of a quota.
+const quotaLockSQL = `SELECT pg_advisory_xact_lock(hashtext('admission_quotas'))`
+
+// quotaTally counts held seats per quota and answer. Value quotas and the
+// overall quota keep their count under the empty answer.
+type quotaTally struct {
+	quotas  []AdmissionQuota
+	counts  []map[string]int
+	touched []map[string]bool
+}
+
+// loadQuotaTally reads the active hackathon's quotas and counts the seats
+// held against them. The tally is empty when no quotas are configured.
+func loadQuotaTally(ctx context.Context, q queryer) (*quotaTally, error) {
+	var value []byte
+	err := q.QueryRowContext(ctx, `
+		SELECT value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
+	`, SettingsKeyAdmissionQuotas).Scan(&value)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	t := &quotaTally{}
+	if value != nil {
+		if err := json.Unmarshal(value, &t.quotas); err != nil {
+			return nil, err
+		}
+	}
+	if len(t.quotas) == 0 {
+		return t, nil
+	}
+	for range t.quotas {
+		t.counts = append(t.counts, map[string]int{})
+		t.touched = append(t.touched, map[string]bool{})
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT responses
+		FROM applications
+		WHERE hackathon_id = active_hackathon_id()
+		  AND confirmation_status IN ('pending', 'confirmed')
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, err
+		}
+		var responses map[string]interface{}
+		if err := json.Unmarshal(raw, &responses); err != nil {
+			return nil, err
+		}
+		for i, quota := range t.quotas {
+			if key, ok := quotaKey(quota, responses); ok {
+				t.counts[i][key]++
+			}
+		}
+	}
+
+	return t, rows.Err()
+}
+
+// quotaKey reports whether responses count against quota and under which
+// answer.
+func quotaKey(quota AdmissionQuota, responses map[string]interface{}) (string, bool) {
+	if quota.Field == "" {
+		return "", true
+	}
+
+	answer := responses[quota.Field]
+	if quota.Value == nil {
+		s, ok := quotaAnswer(answer)
+		return s, ok && s != ""
+	}
+
+	if list, ok := answer.([]interface{}); ok {
+		for _, v := range list {
+			if s, ok := quotaAnswer(v); ok && s == *quota.Value {
+				return "", true
+			}
+		}
+		return "", false
+	}
+	s, ok := quotaAnswer(answer)
+	return "", ok && s == *quota.Value
+}
+
+// quotaAnswer formats a scalar answer as text to compare with quota values.
+func quotaAnswer(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// fits reports whether one more seat matching responses stays within every
+// hard quota.
+func (t *quotaTally) fits(responses map[string]interface{}) bool {
+	for i, quota := range t.quotas {
+		if !quota.Hard {
+			continue
+		}
+		if key, ok := quotaKey(quota, responses); ok && t.counts[i][key] >= quota.Max {
+			return false
+		}
+	}
+	return true
+}
+
+// admit counts a new seat matching responses.
+func (t *quotaTally) admit(responses map[string]interface{}) {
+	for i, quota := range t.quotas {
+		if key, ok := quotaKey(quota, responses); ok {
+			t.counts[i][key]++
+			t.touched[i][key] = true
+		}
+	}
+}
+
+// breaches returns the quotas admitted seats took past their cap, and
+// whether any of them is hard.
+func (t *quotaTally) breaches() ([]QuotaBreach, bool) {
+	breaches := []QuotaBreach{}
+	hard := false
+	for i, quota := range t.quotas {
+		keys := make([]string, 0, len(t.touched[i]))
+		for key := range t.touched[i] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if t.counts[i][key] <= quota.Max {
+				continue
+			}
+			b := QuotaBreach{QuotaID: quota.ID, Label: quota.Label, Max: quota.Max, Count: t.counts[i][key], Hard: quota.Hard}
+			if quota.Field != "" && quota.Value == nil {
+				answer := key
+				b.Answer = &answer
+			}
+			breaches = append(breaches, b)
+			hard = hard || quota.Hard
+		}
+	}
+	return breaches, hard
+}
+
+// usage reports the seats held against every quota.
+func (t *quotaTally) usage() []QuotaUsage {
+	usage := make([]QuotaUsage, len(t.quotas))
+	for i, quota := range t.quotas {
+		u := QuotaUsage{AdmissionQuota: quota}
+		if quota.Field != "" && quota.Value == nil {
+			u.Answers = []QuotaAnswerCount{}
+			for answer, count := range t.counts[i] {
+				u.Answers = append(u.Answers, QuotaAnswerCount{Value: answer, Count: count})
+			}
+			sort.Slice(u.Answers, func(a, b int) bool {
+				if u.Answers[a].Count != u.Answers[b].Count {
+					return u.Answers[a].Count > u.Answers[b].Count
+				}
+				return u.Answers[a].Value < u.Answers[b].Value
+			})
+			if len(u.Answers) > 0 {
+				u.Count = u.Answers[0].Count
+			}
+		} else {
+			u.Count = t.counts[i][""]
+		}
+		usage[i] = u
+	}
+	return usage
+}
+
+// checkQuotas counts accepting the applications ids against the quotas,
+// before they are accepted in tx, and returns the quotas that would be
+// exceeded and whether any of them is hard. To accept ids afterwards, tx
+// must hold the quota lock, taken before any application row lock so that
+// acceptances always lock in the same order.
+func checkQuotas(ctx context.Context, tx *sql.Tx, ids []string) ([]QuotaBreach, bool, error) {
+	t, err := loadQuotaTally(ctx, tx)
+	if err != nil || len(t.quotas) == 0 {
+		return []QuotaBreach{}, false, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT responses FROM applications WHERE id = ANY($1::uuid[])`, ids)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, false, err
+		}
+		var responses map[string]interface{}
+		if err := json.Unmarshal(raw, &responses); err != nil {
+			return nil, false, err
+		}
+		t.admit(responses)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	breaches, hard := t.breaches()
+	return breaches, hard, nil
+}
+
+// QuotaUsage returns the seats held against each admission quota of the
+// active hackathon, in configured order.
+func (s *ApplicationsStore) QuotaUsage(ctx context.Context) ([]QuotaUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	t, err := loadQuotaTally(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.usage(), nil
+}
@@ -0,0 +1,191 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type ResumeBookStatus string
+
+const (
+	ResumeBookBuilding ResumeBookStatus = "building"
+	ResumeBookReady    ResumeBookStatus = "ready"
+	ResumeBookFailed   ResumeBookStatus = "failed"
+)
+
+// ResumeBook is one resume book build. ObjectPath is internal; clients
+// download through a short-lived signed URL instead.
+type ResumeBook struct {
+	ID           string           `json:"id"`
+	Status       ResumeBookStatus `json:"status"`
+	OptInField   string           `json:"opt_in_field"`
+	ObjectPath   *string          `json:"-"`
+	ResumeCount  int              `json:"resume_count"`
+	SkippedCount int              `json:"skipped_count"`
+	Error        *string          `json:"error"`
+	CreatedBy    *string          `json:"created_by"`
+	CompletedAt  *time.Time       `json:"completed_at"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// ResumeBookEntry is a hacker whose resume goes into a resume book
+type ResumeBookEntry struct {
+	ApplicationID string
+	Email         string
+	FirstName     *string
+	LastName      *string
+	University    *string
+	Major         *string
+	LevelOfStudy  *string
+	ResumePath    string
+}
+
+type ResumeBooksStore struct {
+	db *sql.DB
+}
+
+const resumeBookColumns = `id, status, opt_in_field, object_path, resume_count, skipped_count,
+	error, created_by, completed_at, created_at, updated_at`
+
+func scanResumeBook(row interface{ Scan(dest ...any) error }, b *ResumeBook) error {
+	return row.Scan(
+		&b.ID, &b.Status, &b.OptInField, &b.ObjectPath, &b.ResumeCount, &b.SkippedCount,
+		&b.Error, &b.CreatedBy, &b.CompletedAt, &b.CreatedAt, &b.UpdatedAt,
+	)
+}
+
+// Create inserts a resume book in the building state
+func (s *ResumeBooksStore) Create(ctx context.Context, book *ResumeBook) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		INSERT INTO resume_books (opt_in_field, created_by)
+		VALUES ($1, $2)
+		RETURNING ` + resumeBookColumns
+
+	return scanResumeBook(s.db.QueryRowContext(ctx, query, book.OptInField, book.CreatedBy), book)
+}
+
+func (s *ResumeBooksStore) GetByID(ctx context.Context, id string) (*ResumeBook, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var b ResumeBook
+	err := scanResumeBook(s.db.QueryRowContext(ctx, `SELECT `+resumeBookColumns+` FROM resume_books WHERE id = $1`, id), &b)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// List returns the most recent resume books, newest first
+func (s *ResumeBooksStore) List(ctx context.Context, limit int) ([]ResumeBook, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+resumeBookColumns+` FROM resume_books ORDER BY created_at DESC LIMIT $1`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := []ResumeBook{}
+	for rows.Next() {
+		var b ResumeBook
+		if err := scanResumeBook(rows, &b); err != nil {
+			return nil, err
+		}
+		books = append(books, b)
+	}
+
+	return books, rows.Err()
+}
+
+// Complete marks a resume book ready for download
+func (s *ResumeBooksStore) Complete(ctx context.Context, id, objectPath string, resumeCount, skippedCount int) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		UPDATE resume_books
+		SET status = 'ready', object_path = $2, resume_count = $3, skipped_count = $4, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := s.db.ExecContext(ctx, query, id, objectPath, resumeCount, skippedCount)
+	return err
+}
+
+// Fail records why a resume book could not be built
+func (s *ResumeBooksStore) Fail(ctx context.Context, id, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		UPDATE resume_books
+		SET status = 'failed', error = $2, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := s.db.ExecContext(ctx, query, id, reason)
+	return err
+}
+
+// ListEntries returns accepted hackers who have checked in (a scan of any of
+// checkInTypes), uploaded a resume and ticked the optInField checkbox,
+// ordered by name.
+func (s *ResumeBooksStore) ListEntries(ctx context.Context, optInField string, checkInTypes []string) ([]ResumeBookEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration*2)
+	defer cancel()
+
+	if len(checkInTypes) == 0 {
+		return []ResumeBookEntry{}, nil
+	}
+
+	query := `
+		SELECT a.id, u.email,
+		       a.responses->>'first_name', a.responses->>'last_name',
+		       a.responses->>'university', a.responses->>'major', a.responses->>'level_of_study',
+		       a.resume_path
+		FROM applications a
+		INNER JOIN users u ON u.id = a.user_id
+		WHERE a.hackathon_id = active_hackathon_id()
+		  AND a.status = 'accepted'
+		  AND a.resume_path IS NOT NULL AND a.resume_path <> ''
+		  AND a.responses->$1 = 'true'::jsonb
+		  AND EXISTS (
+		    SELECT 1 FROM scans s
+		    WHERE s.hackathon_id = a.hackathon_id AND s.user_id = a.user_id AND s.scan_type = ANY($2)
+		  )
+		ORDER BY lower(a.responses->>'last_name'), lower(a.responses->>'first_name'), a.id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, optInField, checkInTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []ResumeBookEntry{}
+	for rows.Next() {
+		var e ResumeBookEntry
+		if err := rows.Scan(
+			&e.ApplicationID, &e.Email, &e.FirstName, &e.LastName,
+			&e.University, &e.Major, &e.LevelOfStudy, &e.ResumePath,
+		); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
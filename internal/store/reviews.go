@@ -42,6 +42,9 @@ type ApplicationReviewWithDetails struct {
 	Major              *string `json:"major"`
 	CountryOfResidence *string `json:"country_of_residence"`
 	HackathonsAttended *int16  `json:"hackathons_attended"`
+	// Team the applicant belongs to, if any
+	TeamID   *string `json:"team_id"`
+	TeamName *string `json:"team_name"`
 }
 
 // ReviewNote represents a note from an admin review (without vote information)
@@ -100,11 +103,15 @@ func (s *ApplicationReviewsStore) GetPendingByAdminID(ctx context.Context, admin
 			NULLIF(a.responses->>'age', '')::smallint,
 			a.responses->>'university', a.responses->>'major',
 			a.responses->>'country_of_residence',
-			NULLIF(a.responses->>'hackathons_attended', '')::smallint
+			NULLIF(a.responses->>'hackathons_attended', '')::smallint,
+			t.id, t.name
 		FROM application_reviews ar
 		JOIN applications a ON ar.application_id = a.id
 		JOIN users u ON a.user_id = u.id
+		LEFT JOIN team_members tm ON tm.user_id = a.user_id AND tm.hackathon_id = a.hackathon_id
+		LEFT JOIN teams t ON t.id = tm.team_id
 		WHERE ar.admin_id = $1 AND ar.vote IS NULL
+		  AND a.hackathon_id = active_hackathon_id()
 		ORDER BY ar.assigned_at ASC
 	`
 
@@ -124,6 +131,7 @@ func (s *ApplicationReviewsStore) GetPendingByAdminID(ctx context.Context, admin
 			&review.CreatedAt, &review.UpdatedAt,
 			&review.FirstName, &review.LastName, &review.Email, &review.Age,
 			&review.University, &review.Major, &review.CountryOfResidence, &review.HackathonsAttended,
+			&review.TeamID, &review.TeamName,
 		); err != nil {
 			return nil, err
 		}
@@ -151,11 +159,15 @@ func (s *ApplicationReviewsStore) GetCompletedByAdminID(ctx context.Context, adm
 			NULLIF(a.responses->>'age', '')::smallint,
 			a.responses->>'university', a.responses->>'major',
 			a.responses->>'country_of_residence',
-			NULLIF(a.responses->>'hackathons_attended', '')::smallint
+			NULLIF(a.responses->>'hackathons_attended', '')::smallint,
+			t.id, t.name
 		FROM application_reviews ar
 		JOIN applications a ON ar.application_id = a.id
 		JOIN users u ON a.user_id = u.id
+		LEFT JOIN team_members tm ON tm.user_id = a.user_id AND tm.hackathon_id = a.hackathon_id
+		LEFT JOIN teams t ON t.id = tm.team_id
 		WHERE ar.admin_id = $1 AND ar.vote IS NOT NULL
+		  AND a.hackathon_id = active_hackathon_id()
 		ORDER BY ar.reviewed_at DESC
 	`
 
@@ -175,6 +187,7 @@ func (s *ApplicationReviewsStore) GetCompletedByAdminID(ctx context.Context, adm
 			&review.CreatedAt, &review.UpdatedAt,
 			&review.FirstName, &review.LastName, &review.Email, &review.Age,
 			&review.University, &review.Major, &review.CountryOfResidence, &review.HackathonsAttended,
+			&review.TeamID, &review.TeamName,
 		); err != nil {
 			return nil, err
 		}
@@ -247,7 +260,7 @@ func (s *ApplicationReviewsStore) BatchAssign(ctx context.Context, reviewsPerApp
 	// or were added to the database manually.
 	var entries []ReviewAssignmentEntry
 
-	selectSettingQuery := `SELECT value FROM settings WHERE key = $1 FOR UPDATE`
+	selectSettingQuery := `SELECT value FROM settings WHERE hackathon_id = active_hackathon_id() AND key = $1 FOR UPDATE`
 	var value []byte
 	err = tx.QueryRowContext(ctx, selectSettingQuery, SettingsKeyReviewAssignmentToggle).Scan(&value)
 
@@ -330,7 +343,7 @@ func (s *ApplicationReviewsStore) BatchAssign(ctx context.Context, reviewsPerApp
 			upsertQuery := `
 				INSERT INTO settings (key, value)
 				VALUES ($1, $2)
-				ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+				ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 			`
 			if _, err := tx.ExecContext(ctx, upsertQuery, SettingsKeyReviewAssignmentToggle, string(jsonValue)); err != nil {
 				return nil, err
@@ -349,7 +362,8 @@ func (s *ApplicationReviewsStore) BatchAssign(ctx context.Context, reviewsPerApp
 			SELECT 1
 			FROM settings s
 			CROSS JOIN jsonb_array_elements(s.value) AS elem
-			WHERE s.key = 'review_assignment_toggle'
+			WHERE s.hackathon_id = active_hackathon_id()
+			AND s.key = 'review_assignment_toggle'
 			AND elem->>'id' = ar.admin_id::text
 			AND (elem->'enabled')::boolean = false
 		);
@@ -366,7 +380,7 @@ func (s *ApplicationReviewsStore) BatchAssign(ctx context.Context, reviewsPerApp
 		LEFT JOIN application_reviews ar 
 			ON u.id = ar.admin_id AND ar.vote IS NULL
 		LEFT JOIN settings s 
-			ON s.key = 'review_assignment_toggle'
+			ON s.hackathon_id = active_hackathon_id() AND s.key = 'review_assignment_toggle'
 		WHERE u.role IN ('admin', 'super_admin')
 		AND NOT EXISTS (
 			SELECT 1
@@ -404,7 +418,8 @@ func (s *ApplicationReviewsStore) BatchAssign(ctx context.Context, reviewsPerApp
 	appsQuery := `
 		SELECT id, user_id, reviews_assigned
 		FROM applications
-		WHERE status = 'submitted' AND reviews_assigned < $1
+		WHERE hackathon_id = active_hackathon_id()
+		  AND status = 'submitted' AND reviews_assigned < $1
 		ORDER BY reviews_assigned ASC, submitted_at ASC
 		FOR UPDATE
 	`
@@ -543,7 +558,8 @@ func (s *ApplicationReviewsStore) AssignNextForAdmin(ctx context.Context, adminI
 	// not already assigned to this admin, not the admin's own application
 	findQuery := `
 		SELECT id FROM applications
-		WHERE status = 'submitted'
+		WHERE hackathon_id = active_hackathon_id()
+		  AND status = 'submitted'
 		  AND reviews_assigned < $1
 		  AND user_id != $2
 		  AND NOT EXISTS (
@@ -0,0 +1,199 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// SavedViewResource names the admin list a saved view belongs to.
+type SavedViewResource string
+
+const (
+	SavedViewApplications SavedViewResource = "applications"
+	SavedViewUsers        SavedViewResource = "users"
+)
+
+// SavedView is a named set of list query parameters (filters and sort) and
+// visible columns. Query is keyed by parameter name like url.Values.
+type SavedView struct {
+	ID         string              `json:"id"`
+	OwnerID    string              `json:"owner_id"`
+	OwnerEmail string              `json:"owner_email"`
+	Resource   SavedViewResource   `json:"resource"`
+	Name       string              `json:"name"`
+	Query      map[string][]string `json:"query"`
+	Columns    []string            `json:"columns"`
+	Shared     bool                `json:"shared"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+type SavedViewsStore struct {
+	db *sql.DB
+}
+
+const savedViewSelect = `
+	SELECT v.id, v.owner_id, u.email, v.resource, v.name, v.query, v.columns,
+	       v.shared, v.created_at, v.updated_at
+	FROM saved_views v
+	INNER JOIN users u ON u.id = v.owner_id
+`
+
+func scanSavedView(row interface{ Scan(...any) error }) (*SavedView, error) {
+	var v SavedView
+	var query, columns []byte
+	if err := row.Scan(
+		&v.ID, &v.OwnerID, &v.OwnerEmail, &v.Resource, &v.Name, &query, &columns,
+		&v.Shared, &v.CreatedAt, &v.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(query, &v.Query); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(columns, &v.Columns); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// List returns the views of resource that ownerID owns or that are shared,
+// the caller's own first.
+func (s *SavedViewsStore) List(ctx context.Context, ownerID string, resource SavedViewResource) ([]SavedView, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := savedViewSelect + `
+		WHERE v.resource = $2 AND (v.owner_id = $1 OR v.shared)
+		ORDER BY v.owner_id <> $1, v.name
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, ownerID, resource)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	views := []SavedView{}
+	for rows.Next() {
+		v, err := scanSavedView(rows)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, *v)
+	}
+
+	return views, rows.Err()
+}
+
+func (s *SavedViewsStore) GetByID(ctx context.Context, id string) (*SavedView, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	v, err := scanSavedView(s.db.QueryRowContext(ctx, savedViewSelect+`WHERE v.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Create inserts view. Returns ErrConflict if the owner already has a view of
+// that name for the resource.
+func (s *SavedViewsStore) Create(ctx context.Context, view *SavedView) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query, columns, err := marshalSavedView(view)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO saved_views (owner_id, resource, name, query, columns, shared)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`, view.OwnerID, view.Resource, view.Name, query, columns, view.Shared,
+	).Scan(&view.ID, &view.CreatedAt, &view.UpdatedAt)
+	if isUniqueViolation(err) {
+		return ErrConflict
+	}
+	return err
+}
+
+// Update rewrites the name, query, columns and sharing of a view owned by
+// view.OwnerID. Returns ErrNotFound if no such view exists and ErrConflict if
+// the new name is taken.
+func (s *SavedViewsStore) Update(ctx context.Context, view *SavedView) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query, columns, err := marshalSavedView(view)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.QueryRowContext(ctx, `
+		UPDATE saved_views
+		SET name = $3, query = $4, columns = $5, shared = $6
+		WHERE id = $1 AND owner_id = $2
+		RETURNING resource, created_at, updated_at
+	`, view.ID, view.OwnerID, view.Name, query, columns, view.Shared,
+	).Scan(&view.Resource, &view.CreatedAt, &view.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return ErrConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Delete removes a view owned by ownerID.
+func (s *SavedViewsStore) Delete(ctx context.Context, id, ownerID string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	result, err := s.db.ExecContext(ctx, `DELETE FROM saved_views WHERE id = $1 AND owner_id = $2`, id, ownerID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func marshalSavedView(view *SavedView) (string, string, error) {
+	if view.Query == nil {
+		view.Query = map[string][]string{}
+	}
+	if view.Columns == nil {
+		view.Columns = []string{}
+	}
+	query, err := json.Marshal(view.Query)
+	if err != nil {
+		return "", "", err
+	}
+	columns, err := json.Marshal(view.Columns)
+	if err != nil {
+		return "", "", err
+	}
+	return string(query), string(columns), nil
+}
@@ -107,7 +107,7 @@ func (s *ScansStore) CreatePurchase(ctx context.Context, scan *Scan) (int, error
 	}
 
 	var balance int
-	err = tx.QueryRowContext(ctx, `SELECT COALESCE(SUM(points), 0) FROM scans WHERE user_id = $1`, scan.UserID).
+	err = tx.QueryRowContext(ctx, `SELECT COALESCE(SUM(points), 0) FROM scans WHERE hackathon_id = active_hackathon_id() AND user_id = $1`, scan.UserID).
 		Scan(&balance)
 	if err != nil {
 		return 0, err
@@ -144,18 +144,20 @@ func (s *ScansStore) CreatePurchase(ctx context.Context, scan *Scan) (int, error
 	return balance + scan.Points, nil
 }
 
-func (s *ScansStore) GetByUserID(ctx context.Context, userID string) ([]Scan, error) {
+// GetByUserID returns the user's scans at a hackathon, newest first. A nil
+// hackathonID reads the active one.
+func (s *ScansStore) GetByUserID(ctx context.Context, userID string, hackathonID *string) ([]Scan, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
 	query := `
 		SELECT id, user_id, scan_type, scanned_by, points, scanned_at, created_at
 		FROM scans
-		WHERE user_id = $1
+		WHERE hackathon_id = COALESCE($2::uuid, active_hackathon_id()) AND user_id = $1
 		ORDER BY scanned_at DESC
 	`
 
-	rows, err := s.db.QueryContext(ctx, query, userID)
+	rows, err := s.db.QueryContext(ctx, query, userID, hackathonID)
 	if err != nil {
 		return nil, err
 	}
@@ -177,14 +179,16 @@ func (s *ScansStore) GetByUserID(ctx context.Context, userID string) ([]Scan, er
 	return scans, rows.Err()
 }
 
-func (s *ScansStore) GetStats(ctx context.Context) ([]ScanStat, error) {
+// GetStats returns the scan counts per type at a hackathon. A nil
+// hackathonID reads the active one.
+func (s *ScansStore) GetStats(ctx context.Context, hackathonID *string) ([]ScanStat, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
-	query := `SELECT value FROM settings WHERE key = $1`
+	query := `SELECT value FROM settings WHERE hackathon_id = COALESCE($2::uuid, active_hackathon_id()) AND key = $1`
 
 	var value []byte
-	err := s.db.QueryRowContext(ctx, query, SettingsKeyScanStats).Scan(&value)
+	err := s.db.QueryRowContext(ctx, query, SettingsKeyScanStats, hackathonID).Scan(&value)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return []ScanStat{}, nil
@@ -220,7 +224,7 @@ func (s *ScansStore) HasCheckIn(ctx context.Context, userID string, checkInTypes
 	query := `
 		SELECT EXISTS(
 			SELECT 1 FROM scans
-			WHERE user_id = $1 AND scan_type = ANY($2)
+			WHERE hackathon_id = active_hackathon_id() AND user_id = $1 AND scan_type = ANY($2)
 		)
 	`
 
@@ -238,7 +242,7 @@ func (s *ScansStore) GetTotalPointsByUserID(ctx context.Context, userID string)
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
-	query := `SELECT COALESCE(SUM(points), 0) FROM scans WHERE user_id = $1`
+	query := `SELECT COALESCE(SUM(points), 0) FROM scans WHERE hackathon_id = active_hackathon_id() AND user_id = $1`
 
 	var total int
 	if err := s.db.QueryRowContext(ctx, query, userID).Scan(&total); err != nil {
@@ -266,11 +270,11 @@ func (s *ScansStore) RebalanceStats(ctx context.Context) ([]ScanStat, error) {
 	}
 	defer tx.Rollback()
 
-	if _, err := tx.ExecContext(ctx, `SELECT value FROM settings WHERE key = $1 FOR UPDATE`, SettingsKeyScanStats); err != nil {
+	if _, err := tx.ExecContext(ctx, `SELECT value FROM settings WHERE hackathon_id = active_hackathon_id() AND key = $1 FOR UPDATE`, SettingsKeyScanStats); err != nil {
 		return nil, err
 	}
 
-	rows, err := tx.QueryContext(ctx, `SELECT scan_type, COUNT(*) FROM scans GROUP BY scan_type`)
+	rows, err := tx.QueryContext(ctx, `SELECT scan_type, COUNT(*) FROM scans WHERE hackathon_id = active_hackathon_id() GROUP BY scan_type`)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +298,7 @@ func (s *ScansStore) RebalanceStats(ctx context.Context) ([]ScanStat, error) {
 		return nil, err
 	}
 
-	if _, err := tx.ExecContext(ctx, `UPDATE settings SET value = $1, updated_at = NOW() WHERE key = $2`, value, SettingsKeyScanStats); err != nil {
+	if _, err := tx.ExecContext(ctx, `UPDATE settings SET value = $1, updated_at = NOW() WHERE hackathon_id = active_hackathon_id() AND key = $2`, value, SettingsKeyScanStats); err != nil {
 		return nil, err
 	}
 
@@ -74,17 +74,20 @@ type ScheduleStore struct {
 	db *sql.DB
 }
 
-func (s *ScheduleStore) List(ctx context.Context) ([]ScheduleItem, error) {
+// List returns a hackathon's schedule by start time. A nil hackathonID reads
+// the active one.
+func (s *ScheduleStore) List(ctx context.Context, hackathonID *string) ([]ScheduleItem, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
 	query := `
 		SELECT id, event_name, description, start_time, end_time, location, tags, created_at, updated_at
 		FROM schedule
+		WHERE hackathon_id = COALESCE($1::uuid, active_hackathon_id())
 		ORDER BY start_time ASC
 	`
 
-	rows, err := s.db.QueryContext(ctx, query)
+	rows, err := s.db.QueryContext(ctx, query, hackathonID)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +128,8 @@ func (s *ScheduleStore) Create(ctx context.Context, item *ScheduleItem) error {
 	).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
 }
 
+// Update writes item if the row still has the updated_at the caller read, as
+// given by item.UpdatedAt. Returns ErrStale otherwise.
 func (s *ScheduleStore) Update(ctx context.Context, item *ScheduleItem) error {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
@@ -132,16 +137,17 @@ func (s *ScheduleStore) Update(ctx context.Context, item *ScheduleItem) error {
 	query := `
 		UPDATE schedule
 		SET event_name = $1, description = $2, start_time = $3, end_time = $4, location = $5, tags = $6
-		WHERE id = $7
-		RETURNING updated_at
+		WHERE id = $7 AND updated_at = $8
+		RETURNING created_at, updated_at
 	`
 
 	err := s.db.QueryRowContext(ctx, query,
 		item.EventName, item.Description, item.StartTime, item.EndTime, item.Location, item.Tags, item.ID,
-	).Scan(&item.UpdatedAt)
+		item.UpdatedAt,
+	).Scan(&item.CreatedAt, &item.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return ErrNotFound
+			return staleOrMissing(ctx, s.db, "schedule", item.ID)
 		}
 		return err
 	}
@@ -171,3 +177,29 @@ func (s *ScheduleStore) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *ScheduleStore) GetByID(ctx context.Context, id string) (*ScheduleItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT id, event_name, description, start_time, end_time, location, tags, created_at, updated_at
+		FROM schedule
+		WHERE id = $1
+	`
+
+	var item ScheduleItem
+	err := s.db.QueryRowContext(ctx, query, id).Scan(
+		&item.ID, &item.EventName, &item.Description,
+		&item.StartTime, &item.EndTime, &item.Location, &item.Tags,
+		&item.CreatedAt, &item.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &item, nil
+}
@@ -80,6 +80,7 @@ func (s *ScheduledNotificationsStore) List(ctx context.Context) ([]ScheduledNoti
 	query := `
 		SELECT id, title, body, url, target_role, scheduled_at, sent_at, recipient_count, schedule_id, created_by, created_at, updated_at
 		FROM scheduled_notifications
+		WHERE hackathon_id = active_hackathon_id()
 		ORDER BY scheduled_at DESC
 	`
 
@@ -111,7 +112,8 @@ func (s *ScheduledNotificationsStore) ListSentForRole(ctx context.Context, role
 	query := `
 		SELECT id, title, body, url, target_role, scheduled_at, sent_at, recipient_count, schedule_id, created_by, created_at, updated_at
 		FROM scheduled_notifications
-		WHERE sent_at IS NOT NULL AND (target_role IS NULL OR target_role = $1)
+		WHERE hackathon_id = active_hackathon_id()
+		  AND sent_at IS NOT NULL AND (target_role IS NULL OR target_role = $1)
 		ORDER BY sent_at DESC
 		LIMIT $2
 	`
@@ -203,7 +205,8 @@ func (s *ScheduledNotificationsStore) ClaimDue(ctx context.Context, now time.Tim
 	rows, err := tx.QueryContext(ctx, `
 		SELECT id, title, body, url, target_role, scheduled_at, sent_at, recipient_count, schedule_id, created_by, created_at, updated_at
 		FROM scheduled_notifications
-		WHERE scheduled_at <= $1 AND sent_at IS NULL
+		WHERE hackathon_id = active_hackathon_id()
+		  AND scheduled_at <= $1 AND sent_at IS NULL
 		ORDER BY scheduled_at
 		FOR UPDATE SKIP LOCKED
 		LIMIT $2
@@ -273,7 +276,8 @@ func (s *ScheduledNotificationsStore) GenerateFromSchedule(ctx context.Context,
 	// Clear pending schedule-sourced reminders so a re-run reflects the latest schedule.
 	if _, err := tx.ExecContext(ctx, `
 		DELETE FROM scheduled_notifications
-		WHERE schedule_id IS NOT NULL AND sent_at IS NULL
+		WHERE hackathon_id = active_hackathon_id()
+		  AND schedule_id IS NOT NULL AND sent_at IS NULL
 	`); err != nil {
 		return nil, err
 	}
@@ -281,6 +285,7 @@ func (s *ScheduledNotificationsStore) GenerateFromSchedule(ctx context.Context,
 	rows, err := tx.QueryContext(ctx, `
 		SELECT id, event_name, location, start_time
 		FROM schedule
+		WHERE hackathon_id = active_hackathon_id()
 		ORDER BY start_time ASC
 	`)
 	if err != nil {
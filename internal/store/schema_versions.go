@@ -0,0 +1,183 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ApplicationSchemaVersion is one saved revision of a hackathon's application
+// schema. Fields is omitted from listings.
+type ApplicationSchemaVersion struct {
+	Version    int                      `json:"version"`
+	Fields     []ApplicationSchemaField `json:"fields,omitempty"`
+	FieldCount int                      `json:"field_count"`
+	CreatedBy  *string                  `json:"created_by"`
+	CreatedAt  time.Time                `json:"created_at"`
+}
+
+// latestSchemaVersion is an SQL expression for the newest schema version of
+// the application row being written.
+const latestSchemaVersion = `(
+	SELECT MAX(version) FROM application_schema_versions v
+	WHERE v.hackathon_id = applications.hackathon_id
+)`
+
+// UpdateApplicationSchema replaces the application form schema with the
+// provided fields and records them as a new version. Saving fields identical
+// to the latest version does not add one. Returns the version now current.
+func (s *SettingsStore) UpdateApplicationSchema(ctx context.Context, fields []ApplicationSchemaField, createdBy string) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	value, err := json.Marshal(fields)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO settings (key, value)
+		VALUES ($1, $2)
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`, SettingsKeyApplicationSchema, string(value)); err != nil {
+		return 0, err
+	}
+
+	// Concurrent saves queue on the event's row so each numbers itself after
+	// the last. NO KEY UPDATE leaves inserts referencing the event unblocked.
+	if _, err := tx.ExecContext(ctx,
+		`SELECT 1 FROM hackathons WHERE is_active FOR NO KEY UPDATE`); err != nil {
+		return 0, err
+	}
+
+	var latest int
+	var unchanged bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT version, fields = $1::jsonb
+		FROM application_schema_versions
+		WHERE hackathon_id = active_hackathon_id()
+		ORDER BY version DESC
+		LIMIT 1
+	`, string(value)).Scan(&latest, &unchanged)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	if !unchanged {
+		latest++
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO application_schema_versions (version, fields, created_by)
+			VALUES ($1, $2, $3)
+		`, latest, string(value), createdBy); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return latest, nil
+}
+
+// ListApplicationSchemaVersions returns the active hackathon's schema
+// versions, newest first, without their fields.
+func (s *SettingsStore) ListApplicationSchemaVersions(ctx context.Context) ([]ApplicationSchemaVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT version, jsonb_array_length(fields), created_by, created_at
+		FROM application_schema_versions
+		WHERE hackathon_id = active_hackathon_id()
+		ORDER BY version DESC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := []ApplicationSchemaVersion{}
+	for rows.Next() {
+		var v ApplicationSchemaVersion
+		if err := rows.Scan(&v.Version, &v.FieldCount, &v.CreatedBy, &v.CreatedAt); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, rows.Err()
+}
+
+// GetApplicationSchemaVersion returns one of the active hackathon's schema
+// versions. Returns ErrNotFound for an unknown version.
+func (s *SettingsStore) GetApplicationSchemaVersion(ctx context.Context, version int) (*ApplicationSchemaVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var v ApplicationSchemaVersion
+	var value []byte
+	err := s.db.QueryRowContext(ctx, `
+		SELECT version, fields, created_by, created_at
+		FROM application_schema_versions
+		WHERE hackathon_id = active_hackathon_id() AND version = $1
+	`, version).Scan(&v.Version, &value, &v.CreatedBy, &v.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(value, &v.Fields); err != nil {
+		return nil, err
+	}
+	v.FieldCount = len(v.Fields)
+
+	return &v, nil
+}
+
+// GetApplicationSchemaForApplication returns the schema version the
+// application's responses are keyed against, from the application's own
+// hackathon. Applications without a recorded version get that hackathon's
+// live schema.
+func (s *SettingsStore) GetApplicationSchemaForApplication(ctx context.Context, applicationID string) ([]ApplicationSchemaField, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT COALESCE(
+			(SELECT v.fields FROM application_schema_versions v
+			  WHERE v.hackathon_id = a.hackathon_id AND v.version = a.schema_version),
+			(SELECT s.value FROM settings s
+			  WHERE s.hackathon_id = a.hackathon_id AND s.key = $2),
+			'[]'::jsonb
+		)
+		FROM applications a
+		WHERE a.id = $1
+	`
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, query, applicationID, SettingsKeyApplicationSchema).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var fields []ApplicationSchemaField
+	if err := json.Unmarshal(value, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
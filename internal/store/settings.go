@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"time"
 )
 
 // SettingsStore handles database operations for hackathon settings
@@ -30,13 +31,70 @@ const SettingsKeyHackathonName = "hackathon_name"
 const SettingsKeyContactEmail = "contact_email"
 const SettingsKeyFromEmail = "from_email"
 const SettingsKeyFromName = "from_name"
-const SettingsKeyApplicationDueDate = "application_due_date"
+const SettingsKeyApplicationWindow = "application_window"
+const SettingsKeyRSVPConfig = "rsvp_config"
+const SettingsKeyTeamSizeMax = "team_size_max"
+const SettingsKeyJudgingConfig = "judging_config"
+const SettingsKeyDecisionRules = "decision_rules"
+const SettingsKeyAdmissionQuotas = "admission_quotas"
 
 type HackathonDateRange struct {
 	StartDate *string `json:"start_date"`
 	EndDate   *string `json:"end_date"`
 }
 
+// RSVPConfig controls the confirmation step after acceptance. The
+// applications confirmation trigger reads deadline_hours directly, so keep the
+// JSON names in sync with migration 000033.
+type RSVPConfig struct {
+	// DeadlineHours is how long a newly accepted hacker has to confirm. Zero
+	// means no deadline.
+	DeadlineHours int `json:"deadline_hours"`
+	// Capacity is the number of seats held by pending and confirmed hackers
+	// that waitlist promotion fills up to. Zero turns auto-promotion off.
+	Capacity int `json:"capacity"`
+}
+
+// ApplicationWindow schedules when the application form accepts work. Either
+// bound may be nil to leave that side open. Timezone is the IANA zone the
+// times were entered in and are shown in; the times themselves are absolute.
+type ApplicationWindow struct {
+	OpensAt  *time.Time `json:"opens_at"`
+	ClosesAt *time.Time `json:"closes_at"`
+	Timezone string     `json:"timezone" validate:"required,timezone"`
+	// GraceMinutes keeps the form open past ClosesAt for hackers whose draft
+	// was started before it.
+	GraceMinutes int `json:"grace_minutes" validate:"min=0,max=10080"`
+}
+
+// RubricCriterion is one line of the judging rubric. Judges score each
+// criterion from 0 to MaxScore; Weight sets its share of the total.
+type RubricCriterion struct {
+	ID       string  `json:"id" validate:"required,max=50"`
+	Label    string  `json:"label" validate:"required,max=200"`
+	MaxScore int     `json:"max_score" validate:"min=1,max=100"`
+	Weight   float64 `json:"weight" validate:"gt=0,lte=100"`
+}
+
+// JudgingConfig controls project submission and judging. A nil
+// SubmissionDeadline leaves submissions editable indefinitely.
+type JudgingConfig struct {
+	SubmissionDeadline *time.Time        `json:"submission_deadline"`
+	Tracks             []string          `json:"tracks" validate:"dive,required,max=100"`
+	Rubric             []RubricCriterion `json:"rubric" validate:"dive"`
+	JudgesPerProject   int               `json:"judges_per_project" validate:"min=1,max=20"`
+}
+
+// DecisionRule gives an application Status when all of its Conditions hold.
+// A condition's Field is accept_votes, reject_votes, waitlist_votes or
+// ai_percent, or a schema answer as "f.<field id>". Rules are tried in order
+// and the first match decides.
+type DecisionRule struct {
+	Name       string            `json:"name" validate:"required,max=100"`
+	Status     ApplicationStatus `json:"status" validate:"required,oneof=accepted rejected waitlisted"`
+	Conditions []FieldCondition  `json:"conditions" validate:"min=1,max=20,dive"`
+}
+
 // ApplicationSchemaField defines a single field in the configurable application form.
 // The full schema is stored as a JSON array in the settings table under key "application_schema".
 type ApplicationSchemaField struct {
@@ -50,6 +108,20 @@ type ApplicationSchemaField struct {
 	DisplayOrder int                    `json:"display_order"`
 	Options      []string               `json:"options,omitempty"`
 	Validation   map[string]interface{} `json:"validation,omitempty"`
+	// ShowIf hides the field unless the condition holds. A hidden field is
+	// never required and its answer is not stored.
+	ShowIf *FieldCondition `json:"show_if,omitempty"`
+	// RequireIf makes an optional field required while the condition holds.
+	RequireIf *FieldCondition `json:"require_if,omitempty"`
+}
+
+// FieldCondition compares another field's answer against Value. Op is one of
+// eq, neq, lt, lte, gt, gte (numbers), in (Value is a list), contains (the
+// answer is a multi_select list), empty or not_empty (Value unused).
+type FieldCondition struct {
+	Field string      `json:"field" validate:"required,max=50"`
+	Op    string      `json:"op" validate:"required,oneof=eq neq lt lte gt gte in contains empty not_empty"`
+	Value interface{} `json:"value,omitempty"`
 }
 
 // ReviewAssignmentEntry represents a single admin's review assignment toggle state.
@@ -67,7 +139,7 @@ func (s *SettingsStore) GetApplicationSchema(ctx context.Context) ([]Application
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -87,26 +159,6 @@ func (s *SettingsStore) GetApplicationSchema(ctx context.Context) ([]Application
 	return fields, nil
 }
 
-// UpdateApplicationSchema replaces the application form schema with the provided fields
-func (s *SettingsStore) UpdateApplicationSchema(ctx context.Context, fields []ApplicationSchemaField) error {
-	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
-	defer cancel()
-
-	value, err := json.Marshal(fields)
-	if err != nil {
-		return err
-	}
-
-	query := `
-		INSERT INTO settings (key, value)
-		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
-	`
-
-	_, err = s.db.ExecContext(ctx, query, SettingsKeyApplicationSchema, string(value))
-	return err
-}
-
 // GetReviewsPerApplication returns the configured number of reviews per application
 func (s *SettingsStore) GetReviewsPerApplication(ctx context.Context) (int, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
@@ -115,7 +167,7 @@ func (s *SettingsStore) GetReviewsPerApplication(ctx context.Context) (int, erro
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -148,7 +200,7 @@ func (s *SettingsStore) SetReviewsPerApplication(ctx context.Context, value int)
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyReviewsPerApplication, string(jsonValue))
@@ -163,7 +215,7 @@ func (s *SettingsStore) GetScanTypes(ctx context.Context) ([]ScanType, error) {
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -196,7 +248,7 @@ func (s *SettingsStore) UpdateScanTypes(ctx context.Context, scanTypes []ScanTyp
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyScanTypes, value)
@@ -208,7 +260,7 @@ func (s *SettingsStore) GetScanStats(ctx context.Context) (map[string]int, error
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
-	query := `SELECT value FROM settings WHERE key = $1`
+	query := `SELECT value FROM settings WHERE hackathon_id = active_hackathon_id() AND key = $1`
 
 	var value []byte
 	err := s.db.QueryRowContext(ctx, query, SettingsKeyScanStats).Scan(&value)
@@ -229,7 +281,7 @@ func (s *SettingsStore) GetScanStats(ctx context.Context) (map[string]int, error
 
 // incrementScanStat atomically increments the counter for a scan type within an existing transaction.
 func incrementScanStat(ctx context.Context, tx *sql.Tx, scanType string) error {
-	query := `SELECT value FROM settings WHERE key = $1 FOR UPDATE`
+	query := `SELECT value FROM settings WHERE hackathon_id = active_hackathon_id() AND key = $1 FOR UPDATE`
 
 	var value []byte
 	err := tx.QueryRowContext(ctx, query, SettingsKeyScanStats).Scan(&value)
@@ -249,21 +301,21 @@ func incrementScanStat(ctx context.Context, tx *sql.Tx, scanType string) error {
 		return err
 	}
 
-	updateQuery := `UPDATE settings SET value = $1, updated_at = NOW() WHERE key = $2`
+	updateQuery := `UPDATE settings SET value = $1, updated_at = NOW() WHERE hackathon_id = active_hackathon_id() AND key = $2`
 	_, err = tx.ExecContext(ctx, updateQuery, updated, SettingsKeyScanStats)
 	return err
 }
 
 // resetScanStats resets the scan stats within an existing transaction.
 func resetScanStats(ctx context.Context, tx *sql.Tx) error {
-	query := `UPDATE settings SET value = '{}', updated_at = NOW() WHERE key = $1`
+	query := `UPDATE settings SET value = '{}', updated_at = NOW() WHERE hackathon_id = active_hackathon_id() AND key = $1`
 	_, err := tx.ExecContext(ctx, query, SettingsKeyScanStats)
 	return err
 }
 
 // resetReviewAssignmentToggle resets review assignment toggles within an existing transaction.
 func resetReviewAssignmentToggle(ctx context.Context, tx *sql.Tx) error {
-	query := `UPDATE settings SET value = '[]', updated_at = NOW() WHERE key = $1`
+	query := `UPDATE settings SET value = '[]', updated_at = NOW() WHERE hackathon_id = active_hackathon_id() AND key = $1`
 	_, err := tx.ExecContext(ctx, query, SettingsKeyReviewAssignmentToggle)
 	return err
 }
@@ -282,7 +334,7 @@ func resetScanTypes(ctx context.Context, tx *sql.Tx) error {
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2::jsonb)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()`
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()`
 	_, err := tx.ExecContext(ctx, query, SettingsKeyScanTypes, DefaultScanTypes)
 	return err
 }
@@ -291,11 +343,12 @@ func resetScanTypes(ctx context.Context, tx *sql.Tx) error {
 // existing transaction.
 func resetHackathonConfig(ctx context.Context, tx *sql.Tx) error {
 	// Deleting these rows returns each getter to its documented "not
-	// configured" default — empty date range, "Points", empty hacker pack URL —
-	// so the defaults live in exactly one place.
+	// configured" default — empty date range, "Points", empty hacker pack URL,
+	// no judging tracks or deadline, no application window — so the defaults live in exactly one place.
 	if _, err := tx.ExecContext(ctx,
-		`DELETE FROM settings WHERE key IN ($1, $2, $3)`,
+		`DELETE FROM settings WHERE hackathon_id = active_hackathon_id() AND key IN ($1, $2, $3, $4, $5)`,
 		SettingsKeyHackathonDateRange, SettingsKeyPointsName, SettingsKeyHackerPackURL,
+		SettingsKeyJudgingConfig, SettingsKeyApplicationWindow,
 	); err != nil {
 		return err
 	}
@@ -307,7 +360,7 @@ func resetHackathonConfig(ctx context.Context, tx *sql.Tx) error {
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, 'false'::jsonb)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()`
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()`
 	_, err := tx.ExecContext(ctx, query, SettingsKeyApplicationsEnabled)
 	return err
 }
@@ -339,7 +392,7 @@ func (s *SettingsStore) GetAllReviewAssignmentToggles(ctx context.Context) ([]Re
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -369,7 +422,7 @@ func (s *SettingsStore) GetReviewAssignmentToggle(ctx context.Context, superAdmi
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -415,7 +468,7 @@ func (s *SettingsStore) SetReviewAssignmentToggle(ctx context.Context, superAdmi
 	defer tx.Rollback()
 
 	// load current array (if any) with FOR UPDATE to prevent concurrent overwrites
-	querySelect := `SELECT value FROM settings WHERE key = $1 FOR UPDATE`
+	querySelect := `SELECT value FROM settings WHERE hackathon_id = active_hackathon_id() AND key = $1 FOR UPDATE`
 
 	var value []byte
 	err = tx.QueryRowContext(ctx, querySelect, SettingsKeyReviewAssignmentToggle).Scan(&value)
@@ -456,7 +509,7 @@ func (s *SettingsStore) SetReviewAssignmentToggle(ctx context.Context, superAdmi
 	queryUpsert := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	if _, err := tx.ExecContext(ctx, queryUpsert, SettingsKeyReviewAssignmentToggle, string(jsonValue)); err != nil {
@@ -475,7 +528,7 @@ func (s *SettingsStore) GetAdminScheduleEditEnabled(ctx context.Context) (bool,
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -508,7 +561,7 @@ func (s *SettingsStore) SetAdminScheduleEditEnabled(ctx context.Context, enabled
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyAdminScheduleEditEnabled, string(jsonValue))
@@ -524,7 +577,7 @@ func (s *SettingsStore) GetHackathonDateRange(ctx context.Context) (HackathonDat
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -557,13 +610,269 @@ func (s *SettingsStore) SetHackathonDateRange(ctx context.Context, dateRange Hac
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyHackathonDateRange, string(jsonValue))
 	return err
 }
 
+// GetRSVPConfig returns the confirmation deadline and capacity. Defaults to
+// no deadline and no auto-promotion if the row does not exist.
+func (s *SettingsStore) GetRSVPConfig(ctx context.Context) (RSVPConfig, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
+	`
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, query, SettingsKeyRSVPConfig).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RSVPConfig{}, nil
+		}
+		return RSVPConfig{}, err
+	}
+
+	var config RSVPConfig
+	if err := json.Unmarshal(value, &config); err != nil {
+		return RSVPConfig{}, err
+	}
+
+	return config, nil
+}
+
+// SetRSVPConfig updates the confirmation deadline and capacity. The deadline
+// applies to hackers accepted from now on.
+func (s *SettingsStore) SetRSVPConfig(ctx context.Context, config RSVPConfig) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	jsonValue, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO settings (key, value)
+		VALUES ($1, $2)
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`
+
+	_, err = s.db.ExecContext(ctx, query, SettingsKeyRSVPConfig, string(jsonValue))
+	return err
+}
+
+// DefaultTeamSizeMax is the team size cap used until one is configured.
+const DefaultTeamSizeMax = 4
+
+// GetTeamSizeMax returns the maximum number of members per team.
+// Defaults to DefaultTeamSizeMax if the row does not exist.
+func (s *SettingsStore) GetTeamSizeMax(ctx context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
+	`
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, query, SettingsKeyTeamSizeMax).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return DefaultTeamSizeMax, nil
+		}
+		return 0, err
+	}
+
+	var size int
+	if err := json.Unmarshal(value, &size); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// SetTeamSizeMax updates the maximum number of members per team. Teams that
+// are already larger keep their members but accept no one new.
+func (s *SettingsStore) SetTeamSizeMax(ctx context.Context, value int) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO settings (key, value)
+		VALUES ($1, $2)
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`
+
+	_, err = s.db.ExecContext(ctx, query, SettingsKeyTeamSizeMax, string(jsonValue))
+	return err
+}
+
+// GetJudgingConfig returns the project submission and judging settings.
+// Defaults to no deadline, no tracks, an empty rubric and three judges per
+// project if the row does not exist.
+func (s *SettingsStore) GetJudgingConfig(ctx context.Context) (JudgingConfig, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
+	`
+
+	config := JudgingConfig{
+		Tracks:           []string{},
+		Rubric:           []RubricCriterion{},
+		JudgesPerProject: 3,
+	}
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, query, SettingsKeyJudgingConfig).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return config, nil
+		}
+		return JudgingConfig{}, err
+	}
+
+	if err := json.Unmarshal(value, &config); err != nil {
+		return JudgingConfig{}, err
+	}
+
+	return config, nil
+}
+
+// SetJudgingConfig updates the project submission and judging settings.
+func (s *SettingsStore) SetJudgingConfig(ctx context.Context, config JudgingConfig) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	jsonValue, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO settings (key, value)
+		VALUES ($1, $2)
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`
+
+	_, err = s.db.ExecContext(ctx, query, SettingsKeyJudgingConfig, string(jsonValue))
+	return err
+}
+
+// GetDecisionRules returns the ordered auto-decision rules. Defaults to none
+// if the row does not exist.
+func (s *SettingsStore) GetDecisionRules(ctx context.Context) ([]DecisionRule, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
+	`
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, query, SettingsKeyDecisionRules).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []DecisionRule{}, nil
+		}
+		return nil, err
+	}
+
+	var rules []DecisionRule
+	if err := json.Unmarshal(value, &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// SetDecisionRules replaces the auto-decision rules.
+func (s *SettingsStore) SetDecisionRules(ctx context.Context, rules []DecisionRule) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	value, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO settings (key, value)
+		VALUES ($1, $2)
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`
+
+	_, err = s.db.ExecContext(ctx, query, SettingsKeyDecisionRules, string(value))
+	return err
+}
+
+// GetAdmissionQuotas returns the admission quotas. Defaults to none if the row
+// does not exist.
+func (s *SettingsStore) GetAdmissionQuotas(ctx context.Context) ([]AdmissionQuota, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
+	`
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, query, SettingsKeyAdmissionQuotas).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []AdmissionQuota{}, nil
+		}
+		return nil, err
+	}
+
+	var quotas []AdmissionQuota
+	if err := json.Unmarshal(value, &quotas); err != nil {
+		return nil, err
+	}
+
+	return quotas, nil
+}
+
+// SetAdmissionQuotas replaces the admission quotas.
+func (s *SettingsStore) SetAdmissionQuotas(ctx context.Context, quotas []AdmissionQuota) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	value, err := json.Marshal(quotas)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO settings (key, value)
+		VALUES ($1, $2)
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`
+
+	_, err = s.db.ExecContext(ctx, query, SettingsKeyAdmissionQuotas, string(value))
+	return err
+}
+
 // GetHackerPackURL returns the configured Hacker Pack Notion URL.
 // Defaults to an empty string if the row does not exist (not configured).
 func (s *SettingsStore) GetHackerPackURL(ctx context.Context) (string, error) {
@@ -573,7 +882,7 @@ func (s *SettingsStore) GetHackerPackURL(ctx context.Context) (string, error) {
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -606,7 +915,7 @@ func (s *SettingsStore) SetHackerPackURL(ctx context.Context, url string) error
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyHackerPackURL, string(jsonValue))
@@ -622,7 +931,7 @@ func (s *SettingsStore) GetPointsName(ctx context.Context) (string, error) {
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -655,7 +964,7 @@ func (s *SettingsStore) SetPointsName(ctx context.Context, name string) error {
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyPointsName, string(jsonValue))
@@ -671,7 +980,7 @@ func (s *SettingsStore) GetPointsEnabled(ctx context.Context) (bool, error) {
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -705,7 +1014,7 @@ func (s *SettingsStore) SetPointsEnabled(ctx context.Context, enabled bool) erro
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyPointsEnabled, string(jsonValue))
@@ -720,7 +1029,7 @@ func (s *SettingsStore) GetMealGroups(ctx context.Context) ([]string, error) {
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -753,14 +1062,15 @@ func (s *SettingsStore) SetMealGroups(ctx context.Context, groups []string) erro
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyMealGroups, value)
 	return err
 }
 
-// GetMealGroupStats returns the number of hackers assigned to each meal group
+// GetMealGroupStats returns the number of the active hackathon's hackers
+// assigned to each meal group
 func (s *SettingsStore) GetMealGroupStats(ctx context.Context) (map[string]int, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
@@ -768,7 +1078,7 @@ func (s *SettingsStore) GetMealGroupStats(ctx context.Context) (map[string]int,
 	query := `
 		SELECT meal_group, COUNT(*)
 		FROM applications
-		WHERE meal_group IS NOT NULL
+		WHERE hackathon_id = active_hackathon_id() AND meal_group IS NOT NULL
 		GROUP BY meal_group
 	`
 
@@ -798,7 +1108,7 @@ func (s *SettingsStore) GetApplicationsEnabled(ctx context.Context) (bool, error
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -830,7 +1140,7 @@ func (s *SettingsStore) SetApplicationsEnabled(ctx context.Context, enabled bool
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyApplicationsEnabled, string(jsonValue))
@@ -844,7 +1154,7 @@ func (s *SettingsStore) GetAdminSponsorEditEnabled(ctx context.Context) (bool, e
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -876,7 +1186,7 @@ func (s *SettingsStore) SetAdminSponsorEditEnabled(ctx context.Context, enabled
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyAdminSponsorEditEnabled, string(jsonValue))
@@ -890,7 +1200,7 @@ func (s *SettingsStore) GetAdminFAQEditEnabled(ctx context.Context) (bool, error
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -922,7 +1232,7 @@ func (s *SettingsStore) SetAdminFAQEditEnabled(ctx context.Context, enabled bool
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, SettingsKeyAdminFAQEditEnabled, string(jsonValue))
@@ -938,7 +1248,7 @@ func (s *SettingsStore) getStringSetting(ctx context.Context, key string) (strin
 	query := `
 		SELECT value
 		FROM settings
-		WHERE key = $1
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
 	`
 
 	var value []byte
@@ -974,7 +1284,7 @@ func (s *SettingsStore) setStringSetting(ctx context.Context, key, value string)
 	query := `
 		INSERT INTO settings (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 	`
 
 	_, err = s.db.ExecContext(ctx, query, key, string(jsonValue))
@@ -1021,12 +1331,88 @@ func (s *SettingsStore) SetFromName(ctx context.Context, name string) error {
 	return s.setStringSetting(ctx, SettingsKeyFromName, name)
 }
 
-// GetApplicationDueDate returns the application deadline as YYYY-MM-DD.
-func (s *SettingsStore) GetApplicationDueDate(ctx context.Context) (string, error) {
-	return s.getStringSetting(ctx, SettingsKeyApplicationDueDate)
+// GetApplicationWindow returns when applications open and close. Defaults to
+// an unbounded window in UTC if the row does not exist.
+func (s *SettingsStore) GetApplicationWindow(ctx context.Context) (ApplicationWindow, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	query := `
+		SELECT value
+		FROM settings
+		WHERE hackathon_id = active_hackathon_id() AND key = $1
+	`
+
+	window := ApplicationWindow{Timezone: "UTC"}
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, query, SettingsKeyApplicationWindow).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return window, nil
+		}
+		return ApplicationWindow{}, err
+	}
+
+	if err := json.Unmarshal(value, &window); err != nil {
+		return ApplicationWindow{}, err
+	}
+
+	return window, nil
 }
 
-// SetApplicationDueDate updates the application deadline (YYYY-MM-DD).
-func (s *SettingsStore) SetApplicationDueDate(ctx context.Context, date string) error {
-	return s.setStringSetting(ctx, SettingsKeyApplicationDueDate, date)
+// SetApplicationWindow updates when applications open and close.
+func (s *SettingsStore) SetApplicationWindow(ctx context.Context, window ApplicationWindow) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	jsonValue, err := json.Marshal(window)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO settings (key, value)
+		VALUES ($1, $2)
+		ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`
+
+	_, err = s.db.ExecContext(ctx, query, SettingsKeyApplicationWindow, string(jsonValue))
+	return err
+}
+
+// GetHackathonID returns the ID of the active hackathon. QR tokens are bound
+// to it, so activating another event retires every pass issued before.
+func (s *SettingsStore) GetHackathonID(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var id sql.NullString
+	if err := s.db.QueryRowContext(ctx, `SELECT active_hackathon_id()`).Scan(&id); err != nil {
+		return "", err
+	}
+	if !id.Valid {
+		return "", ErrNotFound
+	}
+
+	return id.String, nil
+}
+
+// GetRaw returns the stored JSON value for key, or nil when the row does not
+// exist. It backs audit snapshots, which record settings verbatim rather than
+// through each typed getter.
+func (s *SettingsStore) GetRaw(ctx context.Context, key string) (json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var value []byte
+	err := s.db.QueryRowContext(ctx, `SELECT value FROM settings WHERE hackathon_id = active_hackathon_id() AND key = $1`, key).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return json.RawMessage(value), nil
 }
@@ -24,7 +24,9 @@ type SponsorsStore struct {
 	db *sql.DB
 }
 
-func (s *SponsorsStore) List(ctx context.Context) ([]Sponsor, error) {
+// List returns a hackathon's sponsors in display order. A nil hackathonID
+// reads the active one.
+func (s *SponsorsStore) List(ctx context.Context, hackathonID *string) ([]Sponsor, error) {
 
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
@@ -32,10 +34,11 @@ func (s *SponsorsStore) List(ctx context.Context) ([]Sponsor, error) {
 	query := `
 		SELECT id, name, tier, logo_data, logo_content_type, website_url, description, display_order, created_at, updated_at
 		FROM sponsors
+		WHERE hackathon_id = COALESCE($1::uuid, active_hackathon_id())
 		ORDER BY display_order ASC
 	`
 
-	rows, err := s.db.QueryContext(ctx, query)
+	rows, err := s.db.QueryContext(ctx, query, hackathonID)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +79,8 @@ func (s *SponsorsStore) Create(ctx context.Context, sponsor *Sponsor) error {
 	).Scan(&sponsor.ID, &sponsor.CreatedAt, &sponsor.UpdatedAt)
 }
 
+// Update writes sponsor if the row still has the updated_at the caller read,
+// as given by sponsor.UpdatedAt. Returns ErrStale otherwise.
 func (s *SponsorsStore) Update(ctx context.Context, sponsor *Sponsor) error {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
@@ -83,16 +88,17 @@ func (s *SponsorsStore) Update(ctx context.Context, sponsor *Sponsor) error {
 	query := `
 		UPDATE sponsors
 		SET name = $1, tier = $2, website_url = $3, description = $4, display_order = $5
-		WHERE id = $6
+		WHERE id = $6 AND updated_at = $7
 		RETURNING logo_data, logo_content_type, created_at, updated_at
 	`
 
 	err := s.db.QueryRowContext(ctx, query,
 		sponsor.Name, sponsor.Tier, sponsor.WebsiteURL, sponsor.Description, sponsor.DisplayOrder, sponsor.ID,
+		sponsor.UpdatedAt,
 	).Scan(&sponsor.LogoData, &sponsor.LogoContentType, &sponsor.CreatedAt, &sponsor.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return ErrNotFound
+			return staleOrMissing(ctx, s.db, "sponsors", sponsor.ID)
 		}
 		return err
 	}
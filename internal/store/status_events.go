@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// StatusEventSource names the path an application's status change came
+// through.
+type StatusEventSource string
+
+const (
+	StatusEventSubmit            StatusEventSource = "submit"
+	StatusEventAdmin             StatusEventSource = "admin"
+	StatusEventBulk              StatusEventSource = "bulk"
+	StatusEventRules             StatusEventSource = "rules"
+	StatusEventWithdraw          StatusEventSource = "withdraw"
+	StatusEventWaitlistPromotion StatusEventSource = "waitlist_promotion"
+	StatusEventWalkIn            StatusEventSource = "walk_in"
+)
+
+// ApplicationStatusEvent is one change of an application's status.
+// FromStatus is nil when the change created the application. ActorID is nil
+// for changes nobody made by hand, such as waitlist promotion, and once the
+// actor's account is deleted; ActorEmail is filled on reads. BulkID groups
+// the events of one bulk change or decision rule run.
+type ApplicationStatusEvent struct {
+	ID            string             `json:"id"`
+	ApplicationID string             `json:"application_id"`
+	FromStatus    *ApplicationStatus `json:"from_status"`
+	ToStatus      ApplicationStatus  `json:"to_status"`
+	Source        StatusEventSource  `json:"source"`
+	Reason        *string            `json:"reason"`
+	ActorID       *string            `json:"actor_id"`
+	ActorEmail    *string            `json:"actor_email"`
+	BulkID        *string            `json:"bulk_id"`
+	ChangedAt     time.Time          `json:"changed_at"`
+}
+
+// recordStatusEvent records a status change made inside tx. An empty actorID
+// or reason is stored as NULL.
+func recordStatusEvent(ctx context.Context, tx *sql.Tx, applicationID string, from *ApplicationStatus, to ApplicationStatus, source StatusEventSource, actorID, reason string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO application_status_events (application_id, from_status, to_status, source, actor_id, reason)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, NULLIF($6, ''))
+	`, applicationID, from, to, source, actorID, reason)
+	return err
+}
+
+// ListStatusEvents returns an application's status changes, oldest first.
+func (s *ApplicationsStore) ListStatusEvents(ctx context.Context, applicationID string) ([]ApplicationStatusEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT e.id, e.application_id, e.from_status, e.to_status, e.source, e.reason,
+		       e.actor_id, u.email, e.bulk_id, e.changed_at
+		FROM application_status_events e
+		LEFT JOIN users u ON u.id = e.actor_id
+		WHERE e.application_id = $1
+		ORDER BY e.changed_at, e.id
+	`, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []ApplicationStatusEvent{}
+	for rows.Next() {
+		var e ApplicationStatusEvent
+		if err := rows.Scan(
+			&e.ID, &e.ApplicationID, &e.FromStatus, &e.ToStatus, &e.Source, &e.Reason,
+			&e.ActorID, &e.ActorEmail, &e.BulkID, &e.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
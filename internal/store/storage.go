@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -10,10 +11,17 @@ import (
 )
 
 var (
-	ErrNotFound           = errors.New("resource not found")
-	ErrConflict           = errors.New("resource already exists")
+	ErrNotFound = errors.New("resource not found")
+	ErrConflict = errors.New("resource already exists")
+	// ErrStale is returned by conditional updates when the row changed after
+	// the caller read it.
+	ErrStale              = errors.New("resource was modified since it was read")
 	ErrInsufficientPoints = errors.New("insufficient points")
+	ErrTeamFull           = errors.New("team is full")
 	QueryTimeoutDuration  = time.Second * 5
+	// ExportTimeoutDuration bounds streaming exports, which hold a cursor
+	// open while rows are written to the client.
+	ExportTimeoutDuration = time.Minute * 5
 )
 
 type Storage struct {
@@ -36,19 +44,32 @@ type Storage struct {
 		Create(ctx context.Context, app *Application) error
 		Update(ctx context.Context, app *Application) error
 		Submit(ctx context.Context, app *Application) error
+		RemapResponses(ctx context.Context, fromVersion, toVersion int, mapping map[string]string) (int, error)
 		List(ctx context.Context, filters ApplicationListFilters, cursor *ApplicationCursor, direction PaginationDirection, limit int) (*ApplicationListResult, error)
 		GetStats(ctx context.Context) (*ApplicationStats, error)
-		SetStatus(ctx context.Context, id string, status ApplicationStatus) (*Application, error)
+		SetStatus(ctx context.Context, id string, status ApplicationStatus, changedBy, reason string) (*Application, []QuotaBreach, error)
+		BulkSetStatus(ctx context.Context, target BulkStatusTarget, status ApplicationStatus, changedBy, reason string, dryRun bool) (*BulkStatusResult, error)
+		ListStatusEvents(ctx context.Context, applicationID string) ([]ApplicationStatusEvent, error)
+		Decide(ctx context.Context, decide func(*DecisionCandidate) *DecisionRule, changedBy string, dryRun bool) (*DecisionRunResult, error)
+		QuotaUsage(ctx context.Context) ([]QuotaUsage, error)
 		GetEmailsByStatus(ctx context.Context, status ApplicationStatus) ([]UserEmailInfo, error)
 		GetDecisionEmailRecipients(ctx context.Context, statuses []ApplicationStatus, kind DecisionEmailKind, onlyUnsent bool) ([]DecisionEmailRecipient, error)
 		SetDecisionEmailSent(ctx context.Context, applicationIDs []string, kind DecisionEmailKind, sent bool) error
 		GetDecisionEmailStats(ctx context.Context) (*DecisionEmailStats, error)
 		SetMealGroup(ctx context.Context, id string, mealGroup string) (*string, error)
 		GetMealGroupByUserID(ctx context.Context, userID string) (*string, error)
+		SetConfirmation(ctx context.Context, userID string, status ConfirmationStatus) (*Application, error)
+		Withdraw(ctx context.Context, userID string, reason string) (*Application, error)
+		ExpireConfirmations(ctx context.Context) (int64, error)
+		PromoteWaitlisted(ctx context.Context, capacity int) ([]DecisionEmailRecipient, error)
+		Export(ctx context.Context, filters ApplicationListFilters, checkInTypes []string, fn func(*ApplicationExportRow) error) error
 	}
 	Settings interface {
 		GetApplicationSchema(ctx context.Context) ([]ApplicationSchemaField, error)
-		UpdateApplicationSchema(ctx context.Context, fields []ApplicationSchemaField) error
+		UpdateApplicationSchema(ctx context.Context, fields []ApplicationSchemaField, createdBy string) (int, error)
+		ListApplicationSchemaVersions(ctx context.Context) ([]ApplicationSchemaVersion, error)
+		GetApplicationSchemaVersion(ctx context.Context, version int) (*ApplicationSchemaVersion, error)
+		GetApplicationSchemaForApplication(ctx context.Context, applicationID string) ([]ApplicationSchemaField, error)
 		GetReviewsPerApplication(ctx context.Context) (int, error)
 		SetReviewsPerApplication(ctx context.Context, value int) error
 		GetAllReviewAssignmentToggles(ctx context.Context) ([]ReviewAssignmentEntry, error)
@@ -58,6 +79,12 @@ type Storage struct {
 		SetAdminScheduleEditEnabled(ctx context.Context, enabled bool) error
 		GetHackathonDateRange(ctx context.Context) (HackathonDateRange, error)
 		SetHackathonDateRange(ctx context.Context, dateRange HackathonDateRange) error
+		GetRSVPConfig(ctx context.Context) (RSVPConfig, error)
+		SetRSVPConfig(ctx context.Context, config RSVPConfig) error
+		GetTeamSizeMax(ctx context.Context) (int, error)
+		SetTeamSizeMax(ctx context.Context, value int) error
+		GetJudgingConfig(ctx context.Context) (JudgingConfig, error)
+		SetJudgingConfig(ctx context.Context, config JudgingConfig) error
 		GetHackerPackURL(ctx context.Context) (string, error)
 		SetHackerPackURL(ctx context.Context, url string) error
 		GetPointsName(ctx context.Context) (string, error)
@@ -72,13 +99,19 @@ type Storage struct {
 		SetFromEmail(ctx context.Context, email string) error
 		GetFromName(ctx context.Context) (string, error)
 		SetFromName(ctx context.Context, name string) error
-		GetApplicationDueDate(ctx context.Context) (string, error)
-		SetApplicationDueDate(ctx context.Context, date string) error
+		GetApplicationWindow(ctx context.Context) (ApplicationWindow, error)
+		SetApplicationWindow(ctx context.Context, window ApplicationWindow) error
+		GetHackathonID(ctx context.Context) (string, error)
+		GetRaw(ctx context.Context, key string) (json.RawMessage, error)
 		GetScanTypes(ctx context.Context) ([]ScanType, error)
 		UpdateScanTypes(ctx context.Context, scanTypes []ScanType) error
 		GetScanStats(ctx context.Context) (map[string]int, error)
 		GetMealGroups(ctx context.Context) ([]string, error)
 		SetMealGroups(ctx context.Context, groups []string) error
+		GetDecisionRules(ctx context.Context) ([]DecisionRule, error)
+		SetDecisionRules(ctx context.Context, rules []DecisionRule) error
+		GetAdmissionQuotas(ctx context.Context) ([]AdmissionQuota, error)
+		SetAdmissionQuotas(ctx context.Context, quotas []AdmissionQuota) error
 		GetMealGroupStats(ctx context.Context) (map[string]int, error)
 		GetApplicationsEnabled(ctx context.Context) (bool, error)
 		SetApplicationsEnabled(ctx context.Context, enabled bool) error
@@ -89,12 +122,20 @@ type Storage struct {
 	}
 	Hackathon interface {
 		Reset(ctx context.Context, opts ResetOptions) ([]string, error)
+		List(ctx context.Context) ([]Hackathon, error)
+		GetActive(ctx context.Context) (*Hackathon, error)
+		Create(ctx context.Context, h *Hackathon) error
+		Activate(ctx context.Context, id string) (*Hackathon, error)
+		Stats(ctx context.Context) ([]HackathonStats, error)
+		Settings(ctx context.Context, id string) (map[string]json.RawMessage, error)
+		Snapshot(ctx context.Context) (*HackathonArchive, error)
+		Restore(ctx context.Context, archive *HackathonArchive) (map[string]int, error)
 	}
 	Scans interface {
 		Create(ctx context.Context, scan *Scan) error
 		CreatePurchase(ctx context.Context, scan *Scan) (int, error)
-		GetByUserID(ctx context.Context, userID string) ([]Scan, error)
-		GetStats(ctx context.Context) ([]ScanStat, error)
+		GetByUserID(ctx context.Context, userID string, hackathonID *string) ([]Scan, error)
+		GetStats(ctx context.Context, hackathonID *string) ([]ScanStat, error)
 		HasCheckIn(ctx context.Context, userID string, checkInTypes []string) (bool, error)
 		GetTotalPointsByUserID(ctx context.Context, userID string) (int, error)
 		RebalanceStats(ctx context.Context) ([]ScanStat, error)
@@ -109,13 +150,14 @@ type Storage struct {
 		SetAIPercent(ctx context.Context, applicationID string, adminID string, percent int16) error
 	}
 	Schedule interface {
-		List(ctx context.Context) ([]ScheduleItem, error)
+		List(ctx context.Context, hackathonID *string) ([]ScheduleItem, error)
 		Create(ctx context.Context, item *ScheduleItem) error
 		Update(ctx context.Context, item *ScheduleItem) error
 		Delete(ctx context.Context, id string) error
+		GetByID(ctx context.Context, id string) (*ScheduleItem, error)
 	}
 	Sponsors interface {
-		List(ctx context.Context) ([]Sponsor, error)
+		List(ctx context.Context, hackathonID *string) ([]Sponsor, error)
 		Create(ctx context.Context, sponsor *Sponsor) error
 		Update(ctx context.Context, sponsor *Sponsor) error
 		Delete(ctx context.Context, id string) error
@@ -123,10 +165,11 @@ type Storage struct {
 		UpdateLogo(ctx context.Context, id string, logoData string, logoContentType string) error
 	}
 	FAQs interface {
-		List(ctx context.Context) ([]FAQ, error)
+		List(ctx context.Context, hackathonID *string) ([]FAQ, error)
 		Create(ctx context.Context, faq *FAQ) error
 		Update(ctx context.Context, faq *FAQ) error
 		Delete(ctx context.Context, id string) error
+		GetByID(ctx context.Context, id string) (*FAQ, error)
 	}
 	PushSubscriptions interface {
 		Upsert(ctx context.Context, sub *PushSubscription) error
@@ -146,11 +189,81 @@ type Storage struct {
 		GenerateFromSchedule(ctx context.Context, lead time.Duration, targetRole *UserRole, createdBy string, now time.Time) (*ScheduleNotificationGenerationResult, error)
 	}
 	WalkIns interface {
-		Enqueue(ctx context.Context, userID string) (inserted bool, position int, err error)
+		Enqueue(ctx context.Context, userID, scannedBy string) (inserted bool, position int, err error)
 		PromoteNext(ctx context.Context, count int, promotedBy string) ([]User, error)
 		QueueDepth(ctx context.Context) (pending int, total int, err error)
 		List(ctx context.Context) ([]WalkIn, error)
 	}
+	AuditLog interface {
+		Record(ctx context.Context, event *AuditEvent) error
+		List(ctx context.Context, filters AuditEventFilters, cursor *AuditCursor, direction PaginationDirection, limit int) (*AuditEventListResult, error)
+	}
+	Teams interface {
+		Create(ctx context.Context, team *Team, userID string) error
+		GetByID(ctx context.Context, id string) (*Team, error)
+		GetByUserID(ctx context.Context, userID string) (*Team, error)
+		Invite(ctx context.Context, teamID, email, invitedBy string, maxSize int) (*TeamInvite, error)
+		Join(ctx context.Context, code, userID string, maxSize int) (*Team, error)
+		Leave(ctx context.Context, userID string) error
+		List(ctx context.Context) ([]TeamListItem, error)
+	}
+	Projects interface {
+		GetByTeamID(ctx context.Context, teamID string) (*Project, error)
+		Upsert(ctx context.Context, project *Project) error
+		List(ctx context.Context) ([]Project, error)
+	}
+	Judging interface {
+		BatchAssign(ctx context.Context, judgesPerProject int) (*JudgingAssignmentResult, error)
+		GetByJudgeID(ctx context.Context, judgeID string) ([]ProjectJudgingWithDetails, error)
+		SubmitScores(ctx context.Context, judgingID, judgeID string, scores map[string]int, notes *string) (*ProjectJudging, error)
+		ListScores(ctx context.Context) ([]JudgingScore, error)
+	}
+	ResumeBooks interface {
+		Create(ctx context.Context, book *ResumeBook) error
+		GetByID(ctx context.Context, id string) (*ResumeBook, error)
+		List(ctx context.Context, limit int) ([]ResumeBook, error)
+		Complete(ctx context.Context, id, objectPath string, resumeCount, skippedCount int) error
+		Fail(ctx context.Context, id, reason string) error
+		ListEntries(ctx context.Context, optInField string, checkInTypes []string) ([]ResumeBookEntry, error)
+	}
+	ApplicationFlags interface {
+		ListDuplicateCandidates(ctx context.Context) ([]DuplicateCandidate, error)
+		SetResumeHash(ctx context.Context, applicationID, resumePath, hash string) error
+		Replace(ctx context.Context, flags []ApplicationFlag) error
+		ListByApplicationID(ctx context.Context, applicationID string) ([]ApplicationFlag, error)
+		CreateScan(ctx context.Context, scan *DuplicateScan) error
+		LatestScan(ctx context.Context) (*DuplicateScan, error)
+		CompleteScan(ctx context.Context, scan *DuplicateScan) error
+		FailScan(ctx context.Context, id, reason string) error
+		FailInterruptedScans(ctx context.Context) (int64, error)
+	}
+	Analytics interface {
+		FieldBreakdown(ctx context.Context, fields []AnalyticsField) ([]FieldAnswerCount, error)
+		DailyApplications(ctx context.Context, timezone string) ([]DailyApplicationCount, error)
+		Conversion(ctx context.Context) (*ApplicationConversion, error)
+		DailyReviews(ctx context.Context, timezone string) ([]DailyReviewCount, error)
+	}
+	SavedViews interface {
+		List(ctx context.Context, ownerID string, resource SavedViewResource) ([]SavedView, error)
+		GetByID(ctx context.Context, id string) (*SavedView, error)
+		Create(ctx context.Context, view *SavedView) error
+		Update(ctx context.Context, view *SavedView) error
+		Delete(ctx context.Context, id, ownerID string) error
+	}
+	Policies interface {
+		List(ctx context.Context) ([]Policy, error)
+		GetByID(ctx context.Context, id string) (*Policy, error)
+		Create(ctx context.Context, policy *Policy, createdBy string) error
+		Update(ctx context.Context, policy *Policy, createdBy string) error
+		Delete(ctx context.Context, id string) error
+		ListVersions(ctx context.Context, policyID string) ([]PolicyVersion, error)
+		ListForUser(ctx context.Context, userID string, guardianRequired bool) ([]UserPolicy, error)
+		Sign(ctx context.Context, sig *PolicySignature) error
+		GuardianConsent(ctx context.Context, tokenHash string) (*GuardianConsent, error)
+		ConfirmGuardian(ctx context.Context, tokenHash, ipAddress string) (*GuardianConsent, error)
+		MissingRequired(ctx context.Context, userID string, guardianRequired bool) ([]Policy, error)
+		Signers(ctx context.Context, policyID string) ([]PolicySigner, error)
+	}
 }
 
 func NewStorage(db *sql.DB) Storage {
@@ -167,5 +280,28 @@ func NewStorage(db *sql.DB) Storage {
 		PushSubscriptions:      &PushSubscriptionsStore{db: db},
 		ScheduledNotifications: &ScheduledNotificationsStore{db: db},
 		WalkIns:                &WalkInsStore{db: db},
+		AuditLog:               &AuditLogStore{db: db},
+		Teams:                  &TeamsStore{db: db},
+		Projects:               &ProjectsStore{db: db},
+		Judging:                &JudgingStore{db: db},
+		ResumeBooks:            &ResumeBooksStore{db: db},
+		ApplicationFlags:       &ApplicationFlagsStore{db: db},
+		SavedViews:             &SavedViewsStore{db: db},
+		Analytics:              &AnalyticsStore{db: db},
+		Policies:               &PoliciesStore{db: db},
+	}
+}
+
+// staleOrMissing tells apart the two reasons a conditional update of table
+// matched no row. table is always a literal from the caller.
+func staleOrMissing(ctx context.Context, db *sql.DB, table, id string) error {
+	var exists bool
+	if err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM `+table+` WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return ErrStale
 	}
+	return ErrNotFound
 }
@@ -0,0 +1,448 @@
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// joinCodeAlphabet leaves out characters that are easy to misread when a
+// code is read aloud or copied from a screen (0/O, 1/I/L).
+const (
+	joinCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	joinCodeLength   = 8
+	joinCodeAttempts = 5
+)
+
+type Team struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	JoinCode  string       `json:"join_code"`
+	CreatedBy *string      `json:"created_by"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	Members   []TeamMember `json:"members"`
+	Invites   []TeamInvite `json:"invites"`
+}
+
+// TeamMember is a team member with the application fields reviewers need to
+// weigh the team as a group.
+type TeamMember struct {
+	UserID            string             `json:"user_id"`
+	Email             string             `json:"email"`
+	FirstName         *string            `json:"first_name"`
+	LastName          *string            `json:"last_name"`
+	ApplicationID     *string            `json:"application_id"`
+	ApplicationStatus *ApplicationStatus `json:"application_status"`
+	JoinedAt          time.Time          `json:"joined_at"`
+}
+
+type TeamInvite struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	InvitedBy *string   `json:"invited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TeamListItem is a lightweight view for admin listing
+type TeamListItem struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	MemberCount   int       `json:"member_count"`
+	AcceptedCount int       `json:"accepted_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type TeamsStore struct {
+	db *sql.DB
+}
+
+// Create inserts a new team with a generated join code and adds userID as
+// its first member. Returns ErrConflict if the user is already on a team.
+func (s *TeamsStore) Create(ctx context.Context, team *Team, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := ensureNotOnTeam(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	// Codes are random, so a collision is rare but possible; the unique
+	// index is the source of truth and a clash simply draws again.
+	for attempt := 0; ; attempt++ {
+		code, err := generateJoinCode()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO teams (name, join_code, created_by)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (join_code) DO NOTHING
+			RETURNING id, name, join_code, created_by, created_at, updated_at
+		`, team.Name, code, userID).Scan(
+			&team.ID, &team.Name, &team.JoinCode, &team.CreatedBy, &team.CreatedAt, &team.UpdatedAt,
+		)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, sql.ErrNoRows) || attempt+1 >= joinCodeAttempts {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO team_members (team_id, user_id) VALUES ($1, $2)`, team.ID, userID); err != nil {
+		if isUniqueViolation(err) {
+			return ErrConflict
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID returns a team with its members and pending invites.
+func (s *TeamsStore) GetByID(ctx context.Context, id string) (*Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.load(ctx, `WHERE t.id = $1`, id)
+}
+
+// GetByUserID returns the team the user belongs to, or ErrNotFound.
+func (s *TeamsStore) GetByUserID(ctx context.Context, userID string) (*Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.load(ctx, `WHERE t.id = (SELECT team_id FROM team_members WHERE hackathon_id = active_hackathon_id() AND user_id = $1)`, userID)
+}
+
+func (s *TeamsStore) load(ctx context.Context, where string, arg any) (*Team, error) {
+	var team Team
+	err := s.db.QueryRowContext(ctx, `
+		SELECT t.id, t.name, t.join_code, t.created_by, t.created_at, t.updated_at
+		FROM teams t
+		`+where, arg).Scan(
+		&team.ID, &team.Name, &team.JoinCode, &team.CreatedBy, &team.CreatedAt, &team.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	members, err := s.members(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	team.Members = members
+
+	invites, err := s.invites(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	team.Invites = invites
+
+	return &team, nil
+}
+
+func (s *TeamsStore) members(ctx context.Context, teamID string) ([]TeamMember, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT tm.user_id, u.email,
+		       a.responses->>'first_name', a.responses->>'last_name',
+		       a.id, a.status, tm.joined_at
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		LEFT JOIN applications a ON a.user_id = tm.user_id AND a.hackathon_id = tm.hackathon_id
+		WHERE tm.team_id = $1
+		ORDER BY tm.joined_at ASC
+	`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []TeamMember{}
+	for rows.Next() {
+		var m TeamMember
+		if err := rows.Scan(
+			&m.UserID, &m.Email, &m.FirstName, &m.LastName,
+			&m.ApplicationID, &m.ApplicationStatus, &m.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	return members, rows.Err()
+}
+
+// invites returns outstanding invites, skipping addresses that have since
+// joined the team.
+func (s *TeamsStore) invites(ctx context.Context, teamID string) ([]TeamInvite, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT ti.id, ti.email, ti.invited_by, ti.created_at
+		FROM team_invites ti
+		WHERE ti.team_id = $1
+		  AND NOT EXISTS (
+		      SELECT 1 FROM team_members tm
+		      JOIN users u ON u.id = tm.user_id
+		      WHERE tm.team_id = ti.team_id AND u.email = ti.email
+		  )
+		ORDER BY ti.created_at ASC
+	`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invites := []TeamInvite{}
+	for rows.Next() {
+		var inv TeamInvite
+		if err := rows.Scan(&inv.ID, &inv.Email, &inv.InvitedBy, &inv.CreatedAt); err != nil {
+			return nil, err
+		}
+		invites = append(invites, inv)
+	}
+
+	return invites, rows.Err()
+}
+
+// Invite records that email was sent the team's join code. Returns
+// ErrNotFound if the team no longer exists, ErrTeamFull if it has no free
+// seats and ErrConflict if the address was already invited.
+func (s *TeamsStore) Invite(ctx context.Context, teamID, email, invitedBy string, maxSize int) (*TeamInvite, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Lock the team row like Join and Leave so the seat count cannot change
+	// underneath the check.
+	if err := tx.QueryRowContext(ctx,
+		`SELECT id FROM teams WHERE id = $1 FOR UPDATE`, teamID).Scan(&teamID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var members int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM team_members WHERE team_id = $1`, teamID).Scan(&members); err != nil {
+		return nil, err
+	}
+	if members >= maxSize {
+		return nil, ErrTeamFull
+	}
+
+	inv := &TeamInvite{}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO team_invites (team_id, email, invited_by)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id, email) DO NOTHING
+		RETURNING id, email, invited_by, created_at
+	`, teamID, email, invitedBy).Scan(&inv.ID, &inv.Email, &inv.InvitedBy, &inv.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// Join adds userID to the team with the given join code. Returns ErrNotFound
+// for an unknown code, ErrConflict if the user is already on a team, and
+// ErrTeamFull if the team already has maxSize members.
+func (s *TeamsStore) Join(ctx context.Context, code, userID string, maxSize int) (*Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Locking the team row serializes concurrent joins so two hackers cannot
+	// both take the last seat.
+	var teamID string
+	err = tx.QueryRowContext(ctx,
+		`SELECT id FROM teams WHERE hackathon_id = active_hackathon_id() AND join_code = $1 FOR UPDATE`, code).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := ensureNotOnTeam(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	var members int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM team_members WHERE team_id = $1`, teamID).Scan(&members); err != nil {
+		return nil, err
+	}
+	if members >= maxSize {
+		return nil, ErrTeamFull
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO team_members (team_id, user_id) VALUES ($1, $2)`, teamID, userID); err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.load(ctx, `WHERE t.id = $1`, teamID)
+}
+
+// Leave removes userID from their team. The team is deleted once its last
+// member leaves. Returns ErrNotFound if the user is not on a team.
+func (s *TeamsStore) Leave(ctx context.Context, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var teamID string
+	err = tx.QueryRowContext(ctx,
+		`SELECT team_id FROM team_members WHERE hackathon_id = active_hackathon_id() AND user_id = $1`, userID).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	// Take the team row lock before touching its members, in the same order
+	// as Join, so a concurrent join cannot land on a team being deleted.
+	if err := tx.QueryRowContext(ctx,
+		`SELECT id FROM teams WHERE id = $1 FOR UPDATE`, teamID).Scan(&teamID); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`DELETE FROM team_members WHERE team_id = $1 AND user_id = $2`, teamID, userID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM teams t
+		WHERE t.id = $1
+		  AND NOT EXISTS (SELECT 1 FROM team_members tm WHERE tm.team_id = t.id)
+	`, teamID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// List returns every team with member and accepted counts, largest first.
+func (s *TeamsStore) List(ctx context.Context) ([]TeamListItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT t.id, t.name,
+		       COUNT(tm.user_id)::int AS member_count,
+		       COUNT(a.id) FILTER (WHERE a.status = 'accepted')::int AS accepted_count,
+		       t.created_at
+		FROM teams t
+		LEFT JOIN team_members tm ON tm.team_id = t.id
+		LEFT JOIN applications a ON a.user_id = tm.user_id AND a.hackathon_id = tm.hackathon_id
+		WHERE t.hackathon_id = active_hackathon_id()
+		GROUP BY t.id
+		ORDER BY member_count DESC, t.created_at ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := []TeamListItem{}
+	for rows.Next() {
+		var t TeamListItem
+		if err := rows.Scan(&t.ID, &t.Name, &t.MemberCount, &t.AcceptedCount, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+
+	return teams, rows.Err()
+}
+
+// ensureNotOnTeam returns ErrConflict if userID already belongs to a team.
+// The unique index on team_members (hackathon_id, user_id) still backs this
+// up under races.
+func ensureNotOnTeam(ctx context.Context, tx *sql.Tx, userID string) error {
+	var exists bool
+	if err := tx.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM team_members WHERE hackathon_id = active_hackathon_id() AND user_id = $1)`, userID).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return ErrConflict
+	}
+	return nil
+}
+
+func generateJoinCode() (string, error) {
+	max := big.NewInt(int64(len(joinCodeAlphabet)))
+	code := make([]byte, joinCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = joinCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -142,7 +142,7 @@ func (s *UsersStore) Create(ctx context.Context, user *User) error {
 	if user.Role == RoleSuperAdmin {
 		defaultEnabled := true
 
-		querySelect := `SELECT value FROM settings WHERE key = $1 FOR UPDATE`
+		querySelect := `SELECT value FROM settings WHERE hackathon_id = active_hackathon_id() AND key = $1 FOR UPDATE`
 
 		var value []byte
 		err = tx.QueryRowContext(ctx, querySelect, SettingsKeyReviewAssignmentToggle).Scan(&value)
@@ -183,7 +183,7 @@ func (s *UsersStore) Create(ctx context.Context, user *User) error {
 		queryUpsert := `
 			INSERT INTO settings (key, value)
 			VALUES ($1, $2)
-			ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+			ON CONFLICT (hackathon_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
 		`
 
 		if _, err := tx.ExecContext(ctx, queryUpsert, SettingsKeyReviewAssignmentToggle, string(jsonValue)); err != nil {
@@ -259,7 +259,7 @@ func (s *UsersStore) Search(ctx context.Context, query string, limit int, offset
 	countQuery := `
 		SELECT COUNT(*)
 		FROM users u
-		LEFT JOIN applications a ON a.user_id = u.id
+		LEFT JOIN applications a ON a.user_id = u.id AND a.hackathon_id = active_hackathon_id()
 		WHERE u.email ILIKE '%' || $1 || '%'
 		   OR a.responses->>'first_name' ILIKE '%' || $1 || '%'
 		   OR a.responses->>'last_name' ILIKE '%' || $1 || '%'
@@ -273,7 +273,7 @@ func (s *UsersStore) Search(ctx context.Context, query string, limit int, offset
 	searchQuery := `
 		SELECT u.id, u.email, u.role, a.responses->>'first_name', a.responses->>'last_name', u.profile_picture_url, u.created_at
 		FROM users u
-		LEFT JOIN applications a ON a.user_id = u.id
+		LEFT JOIN applications a ON a.user_id = u.id AND a.hackathon_id = active_hackathon_id()
 		WHERE u.email ILIKE '%' || $1 || '%'
 		   OR a.responses->>'first_name' ILIKE '%' || $1 || '%'
 		   OR a.responses->>'last_name' ILIKE '%' || $1 || '%'
@@ -518,7 +518,7 @@ func (s *UsersStore) ListUsers(ctx context.Context, filters UserListFilters, cur
 	query := fmt.Sprintf(`
 		SELECT u.id, u.email, u.role, a.responses->>'first_name', a.responses->>'last_name', u.profile_picture_url, u.created_at
 		FROM users u
-		LEFT JOIN applications a ON a.user_id = u.id
+		LEFT JOIN applications a ON a.user_id = u.id AND a.hackathon_id = active_hackathon_id()
 		%s
 		ORDER BY u.created_at %s, u.id %s
 		LIMIT $%d
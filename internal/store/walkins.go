@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 )
@@ -21,11 +22,12 @@ type WalkInsStore struct {
 	db *sql.DB
 }
 
-// Enqueue adds a user to the walk-in queue.
+// Enqueue adds a user to the walk-in queue, waitlisting their application
+// with scannedBy as the actor of the status change.
 // Returns (inserted, position, err).
 // inserted=true on first enqueue; false on re-scan or if user is already accepted.
 // position is the 1-indexed FIFO position at enqueue time (only valid when inserted=true).
-func (s *WalkInsStore) Enqueue(ctx context.Context, userID string) (bool, int, error) {
+func (s *WalkInsStore) Enqueue(ctx context.Context, userID, scannedBy string) (bool, int, error) {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
@@ -38,7 +40,7 @@ func (s *WalkInsStore) Enqueue(ctx context.Context, userID string) (bool, int, e
 	// 1. Short-circuit: already accepted, do not re-enqueue.
 	var status sql.NullString
 	err = tx.QueryRowContext(ctx,
-		`SELECT status FROM applications WHERE user_id = $1`, userID).Scan(&status)
+		`SELECT status FROM applications WHERE hackathon_id = active_hackathon_id() AND user_id = $1 FOR UPDATE`, userID).Scan(&status)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return false, 0, err
 	}
@@ -48,7 +50,7 @@ func (s *WalkInsStore) Enqueue(ctx context.Context, userID string) (bool, int, e
 
 	// 2. Insert walk_ins row; no-op on conflict (re-scan).
 	res, err := tx.ExecContext(ctx,
-		`INSERT INTO walk_ins (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING`, userID)
+		`INSERT INTO walk_ins (user_id) VALUES ($1) ON CONFLICT (hackathon_id, user_id) DO NOTHING`, userID)
 	if err != nil {
 		return false, 0, err
 	}
@@ -57,17 +59,29 @@ func (s *WalkInsStore) Enqueue(ctx context.Context, userID string) (bool, int, e
 		return false, 0, tx.Commit()
 	}
 
-	// 3. Upsert application to waitlisted.
-	_, err = tx.ExecContext(ctx, `
+	// 3. Upsert application to waitlisted, recording the change when it is one.
+	var applicationID string
+	err = tx.QueryRowContext(ctx, `
 		INSERT INTO applications (user_id, status, submitted_at, responses)
 		VALUES ($1, 'waitlisted', NOW(), '{}')
-		ON CONFLICT (user_id) DO UPDATE
+		ON CONFLICT (hackathon_id, user_id) DO UPDATE
 		SET status = 'waitlisted',
 		    submitted_at = COALESCE(applications.submitted_at, EXCLUDED.submitted_at)
-	`, userID)
+		RETURNING id
+	`, userID).Scan(&applicationID)
 	if err != nil {
 		return false, 0, err
 	}
+	if status.String != string(StatusWaitlisted) {
+		var from *ApplicationStatus
+		if status.Valid {
+			prev := ApplicationStatus(status.String)
+			from = &prev
+		}
+		if err := recordStatusEvent(ctx, tx, applicationID, from, StatusWaitlisted, StatusEventWalkIn, scannedBy, ""); err != nil {
+			return false, 0, err
+		}
+	}
 
 	// 4. Compute FIFO position for the queued email.
 	var position int
@@ -76,7 +90,7 @@ func (s *WalkInsStore) Enqueue(ctx context.Context, userID string) (bool, int, e
 			SELECT user_id,
 			       ROW_NUMBER() OVER (ORDER BY queued_at ASC, id ASC)::int AS pos
 			FROM walk_ins
-			WHERE promoted_at IS NULL
+			WHERE hackathon_id = active_hackathon_id() AND promoted_at IS NULL
 		) ranked
 		WHERE user_id = $1
 	`, userID).Scan(&position)
@@ -88,7 +102,9 @@ func (s *WalkInsStore) Enqueue(ctx context.Context, userID string) (bool, int, e
 }
 
 // PromoteNext promotes the next count un-promoted walk-ins in FIFO order.
-// Returns the promoted users (ID and email) so the caller can send emails.
+// Promotion accepts, so it is held to the hard admission quotas: a walk-in a
+// full quota would take is passed over and stays queued. Returns the promoted
+// users (ID and email) so the caller can send emails.
 func (s *WalkInsStore) PromoteNext(ctx context.Context, count int, promotedBy string) ([]User, error) {
 	ctx, cancel := context.WithTimeout(ctx, 2*QueryTimeoutDuration)
 	defer cancel()
@@ -99,16 +115,38 @@ func (s *WalkInsStore) PromoteNext(ctx context.Context, count int, promotedBy st
 	}
 	defer tx.Rollback()
 
+	// The quota lock comes before any row lock, as in every other path that
+	// accepts, so door promotions and admin decisions can't both take the
+	// last seat.
+	if _, err := tx.ExecContext(ctx, quotaLockSQL); err != nil {
+		return nil, err
+	}
+	tally, err := loadQuotaTally(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// With quotas some walk-ins may be passed over, so the whole queue is
+	// read; LIMIT NULL means no limit.
+	var limit any = count
+	if len(tally.quotas) > 0 {
+		limit = nil
+	}
+
 	// Select the next N un-promoted entries, SKIP LOCKED prevents double-promotion.
+	// holds_seat is set for hackers whose application already counts toward
+	// the quotas, so promoting them takes no new seat.
 	rows, err := tx.QueryContext(ctx, `
-		SELECT w.id, w.user_id, u.email
+		SELECT w.id, w.user_id, u.email, COALESCE(a.responses, '{}'),
+		       COALESCE(a.confirmation_status IN ('pending', 'confirmed'), false) AS holds_seat
 		FROM walk_ins w
 		JOIN users u ON u.id = w.user_id
-		WHERE w.promoted_at IS NULL
+		LEFT JOIN applications a ON a.hackathon_id = w.hackathon_id AND a.user_id = w.user_id
+		WHERE w.hackathon_id = active_hackathon_id() AND w.promoted_at IS NULL
 		ORDER BY w.queued_at ASC, w.id ASC
-		LIMIT $1
+		LIMIT $1::int
 		FOR UPDATE OF w SKIP LOCKED
-	`, count)
+	`, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -119,12 +157,25 @@ func (s *WalkInsStore) PromoteNext(ctx context.Context, count int, promotedBy st
 		email    string
 	}
 	var selected []selectedRow
-	for rows.Next() {
+	for rows.Next() && len(selected) < count {
 		var r selectedRow
-		if err := rows.Scan(&r.walkInID, &r.userID, &r.email); err != nil {
+		var raw []byte
+		var holdsSeat bool
+		if err := rows.Scan(&r.walkInID, &r.userID, &r.email, &raw, &holdsSeat); err != nil {
 			rows.Close()
 			return nil, err
 		}
+		if !holdsSeat {
+			var responses map[string]interface{}
+			if err := json.Unmarshal(raw, &responses); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if !tally.fits(responses) {
+				continue
+			}
+			tally.admit(responses)
+		}
 		selected = append(selected, r)
 	}
 	rows.Close()
@@ -154,14 +205,30 @@ func (s *WalkInsStore) PromoteNext(ctx context.Context, count int, promotedBy st
 	}
 
 	// Flip application status to accepted, creating rows for any user without one.
+	// Walk-ins are standing at the door, so their spot is confirmed outright.
+	// The statement sees the applications as they were before the upsert, so
+	// old gives each change's previous status.
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO applications (user_id, status, submitted_at, responses)
-		SELECT uid, 'accepted', NOW(), '{}'
-		FROM unnest($1::uuid[]) AS uid
-		ON CONFLICT (user_id) DO UPDATE
-		SET status = 'accepted',
-		    submitted_at = COALESCE(applications.submitted_at, EXCLUDED.submitted_at)
-	`, userIDs)
+		WITH old AS (
+			SELECT user_id, status
+			FROM applications
+			WHERE hackathon_id = active_hackathon_id() AND user_id = ANY($1::uuid[])
+		), promoted AS (
+			INSERT INTO applications (user_id, status, submitted_at, responses, confirmation_status)
+			SELECT uid, 'accepted', NOW(), '{}', 'confirmed'
+			FROM unnest($1::uuid[]) AS uid
+			ON CONFLICT (hackathon_id, user_id) DO UPDATE
+			SET status = 'accepted',
+			    confirmation_status = 'confirmed',
+			    submitted_at = COALESCE(applications.submitted_at, EXCLUDED.submitted_at)
+			RETURNING id, user_id
+		)
+		INSERT INTO application_status_events (application_id, from_status, to_status, source, actor_id)
+		SELECT p.id, o.status, 'accepted', 'walk_in', $2
+		FROM promoted p
+		LEFT JOIN old o ON o.user_id = p.user_id
+		WHERE o.status IS DISTINCT FROM 'accepted'
+	`, userIDs, promotedBy)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +255,7 @@ func (s *WalkInsStore) QueueDepth(ctx context.Context) (int, int, error) {
 			COUNT(*) FILTER (WHERE promoted_at IS NULL),
 			COUNT(*)
 		FROM walk_ins
+		WHERE hackathon_id = active_hackathon_id()
 	`).Scan(&pending, &total)
 	if err != nil {
 		return 0, 0, err
@@ -207,7 +275,7 @@ func (s *WalkInsStore) List(ctx context.Context) ([]WalkIn, error) {
 			ROW_NUMBER() OVER (ORDER BY w.queued_at ASC, w.id ASC)::int AS position
 		FROM walk_ins w
 		JOIN users u ON u.id = w.user_id
-		WHERE w.promoted_at IS NULL
+		WHERE w.hackathon_id = active_hackathon_id() AND w.promoted_at IS NULL
 		ORDER BY w.queued_at ASC, w.id ASC
 	`)
 	if err != nil {